@@ -2,6 +2,7 @@ package main
 
 import (
 	"context"
+	"flag"
 	"fmt"
 	"log"
 	"net/http"
@@ -19,33 +20,35 @@ import (
 	"github.com/sschwartz96/syncapod-backend/internal/twirp"
 
 	"github.com/sschwartz96/syncapod-backend/internal/handler"
+	"github.com/sschwartz96/syncapod-backend/internal/mail"
 	"github.com/sschwartz96/syncapod-backend/internal/podcast"
 	"golang.org/x/crypto/acme/autocert"
 )
 
 func main() {
-	log.Println("Running syncapod")
-
 	// read config
 	cfg, err := readConfig("config.json")
 	if err != nil {
 		log.Fatal("Main() error, could not read config: ", err)
 	}
 
+	// maintenance commands, ie: syncapod check -repair
+	if len(os.Args) > 1 {
+		err = runCommand(cfg, os.Args[1], os.Args[2:])
+		if err != nil {
+			log.Fatalf("%s error: %v", os.Args[1], err)
+		}
+		return
+	}
+
+	log.Println("Running syncapod")
+
 	// manage certificate
 	certMan := createCertManager(cfg)
 
-	// setup context
-	ctx, cncFn := context.WithTimeout(context.Background(), time.Second*5)
-	defer cncFn()
-
 	// connect to db
 	log.Println("connecting to db")
-
-	pgURI := fmt.Sprintf("postgresql://%s:%s@%s:%d/%s?sslmode=disable",
-		cfg.DbUser, url.QueryEscape(cfg.DbPass), cfg.DbHost, cfg.DbPort, cfg.DbName)
-	log.Println("pgURI:", pgURI)
-	pgdb, err := pgxpool.Connect(ctx, pgURI)
+	pgdb, pgURI, err := connectDB(cfg)
 	if err != nil {
 		log.Fatalf("couldn't connect to db: %v", err)
 	}
@@ -65,8 +68,27 @@ func main() {
 	oauthStore := db.NewOAuthStorePG(pgdb)
 	podStore := db.NewPodcastStore(pgdb)
 
+	// setup mailer
+	var mailer mail.Mailer
+	if cfg.SMTPHost != "" {
+		mailer = mail.NewSMTPMailer(cfg.SMTPHost, cfg.SMTPPort, cfg.SMTPUser, cfg.SMTPPass, cfg.SMTPFrom)
+	} else {
+		log.Println("smtp_host not configured, emails will not be sent")
+		mailer = mail.LogMailer{}
+	}
+
 	// setup controllers
-	authController := auth.NewAuthController(authStore, oauthStore)
+	hasher := auth.Hasher{
+		Algorithm:     cfg.PasswordHasher,
+		BcryptCost:    cfg.BcryptCost,
+		Argon2Time:    cfg.Argon2Time,
+		Argon2Memory:  cfg.Argon2Memory,
+		Argon2Threads: cfg.Argon2Threads,
+	}
+	if err = hasher.Validate(); err != nil {
+		log.Fatalf("main() error invalid password hashing config: %v", err)
+	}
+	authController := auth.NewAuthController(authStore, oauthStore, mailer, cfg.BaseURL, hasher, db.NewLimiterStorePG(pgdb))
 	podController, err := podcast.NewPodController(podStore)
 	if err != nil {
 		log.Fatalf("main() error setting up pod controller: %v", err)
@@ -74,9 +96,13 @@ func main() {
 	rssController := podcast.NewRSSController(podController)
 
 	// setup grpc services
-	gAuthService := twirp.NewAuthService(authController)
+	gAuthService := twirp.NewAuthService(authController, map[string]string{
+		cfg.AlexaClientID:   "Alexa",
+		cfg.ActionsClientID: "Google Assistant",
+	})
 	gPodService := twirp.NewPodcastService(podController)
-	gAdminService := twirp.NewAdminService(podController, rssController)
+	janitor := auth.NewJanitor(authStore, auth.JanitorBatchSize)
+	gAdminService := twirp.NewAdminService(podController, rssController, janitor)
 
 	// setup & start gRPC server
 	grpcServer := twirp.NewServer(certMan,
@@ -84,6 +110,7 @@ func main() {
 		gAuthService,
 		gPodService,
 		gAdminService,
+		podController.Events(),
 	)
 
 	if err != nil {
@@ -101,6 +128,15 @@ func main() {
 	// start updating podcasts
 	go updatePodcasts(rssController)
 
+	// start computing popularity charts
+	go updateCharts(podController)
+
+	// start computing recommendations
+	go updateRecommendations(podController)
+
+	// start purging expired sessions and tokens
+	go purgeExpired(janitor)
+
 	log.Println("setting up handlers")
 
 	// setup handler
@@ -135,6 +171,77 @@ func main() {
 
 }
 
+func connectDB(cfg *config.Config) (*pgxpool.Pool, string, error) {
+	ctx, cncFn := context.WithTimeout(context.Background(), time.Second*5)
+	defer cncFn()
+	pgURI := fmt.Sprintf("postgresql://%s:%s@%s:%d/%s?sslmode=disable",
+		cfg.DbUser, url.QueryEscape(cfg.DbPass), cfg.DbHost, cfg.DbPort, cfg.DbName)
+	log.Println("pgURI:", pgURI)
+	pgdb, err := pgxpool.Connect(ctx, pgURI)
+	if err != nil {
+		return nil, "", err
+	}
+	return pgdb, pgURI, nil
+}
+
+// runCommand runs a maintenance command instead of the server
+func runCommand(cfg *config.Config, name string, args []string) error {
+	pgdb, _, err := connectDB(cfg)
+	if err != nil {
+		return fmt.Errorf("couldn't connect to db: %v", err)
+	}
+	defer pgdb.Close()
+	switch name {
+	case "check":
+		return checkConsistency(db.NewPodcastStore(pgdb), args)
+	case "admin":
+		return setRole(db.NewAuthStorePG(pgdb), args)
+	default:
+		return fmt.Errorf("unknown command, available commands: check, admin")
+	}
+}
+
+// setRole grants a role to the user, ie: syncapod admin [-role moderator] <username or email>
+// without the flag the user becomes an admin, so the first admin can be bootstrapped
+func setRole(authStore db.AuthStore, args []string) error {
+	flags := flag.NewFlagSet("admin", flag.ExitOnError)
+	roleName := flags.String("role", string(db.RoleAdmin), "the role to grant: user, moderator or admin")
+	flags.Parse(args)
+	if flags.NArg() != 1 {
+		return fmt.Errorf("usage: admin [-role admin] <username or email>")
+	}
+	role, err := auth.ParseRole(*roleName)
+	if err != nil {
+		return err
+	}
+
+	ctx, cncFn := context.WithTimeout(context.Background(), time.Minute)
+	defer cncFn()
+	authController := auth.NewAuthController(authStore, nil, nil, "", auth.Hasher{}, nil)
+	user, err := authController.SetRole(ctx, flags.Arg(0), role)
+	if err != nil {
+		return err
+	}
+	log.Printf("%s is now %s\n", user.Username, user.Role)
+	return nil
+}
+
+// checkConsistency reports (and optionally repairs) rows with dangling references
+// migrations are not run, so it can report what an upgrade would remove
+func checkConsistency(podStore *db.PodcastStore, args []string) error {
+	flags := flag.NewFlagSet("check", flag.ExitOnError)
+	repair := flags.Bool("repair", false, "delete or fix the inconsistent rows")
+	flags.Parse(args)
+
+	ctx, cncFn := context.WithTimeout(context.Background(), time.Minute*10)
+	defer cncFn()
+	results, err := podStore.CheckConsistency(ctx, *repair)
+	for _, r := range results {
+		log.Printf("%s: found %d, repaired %d\n", r.Name, r.Found, r.Repaired)
+	}
+	return err
+}
+
 func createCertManager(cfg *config.Config) *autocert.Manager {
 	if cfg.Production {
 		return &autocert.Manager{
@@ -157,6 +264,41 @@ func updatePodcasts(rssController *podcast.RSSController) {
 	}
 }
 
+func updateCharts(podController *podcast.PodController) {
+	for {
+		err := podController.UpdateCharts(context.Background())
+		if err != nil {
+			log.Println("main/updateCharts() error:", err)
+		}
+		time.Sleep(time.Hour)
+	}
+}
+
+func updateRecommendations(podController *podcast.PodController) {
+	for {
+		err := podController.UpdateRecommendations(context.Background())
+		if err != nil {
+			log.Println("main/updateRecommendations() error:", err)
+		}
+		time.Sleep(time.Hour * 6)
+	}
+}
+
+func purgeExpired(janitor *auth.Janitor) {
+	for {
+		results, err := janitor.Run(context.Background())
+		if err != nil {
+			log.Println("main/purgeExpired() error:", err)
+		}
+		for _, r := range results {
+			if r.Deleted > 0 {
+				log.Printf("main/purgeExpired() purged %d expired %s\n", r.Deleted, r.Name)
+			}
+		}
+		time.Sleep(time.Minute * 15)
+	}
+}
+
 func readConfig(path string) (*config.Config, error) {
 	cfgFile, err := os.Open(path)
 	if err != nil {
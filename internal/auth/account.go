@@ -0,0 +1,311 @@
+package auth
+
+import (
+	"context"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"log"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v4"
+	"github.com/sschwartz96/syncapod-backend/internal/db"
+	"github.com/sschwartz96/syncapod-backend/internal/mail"
+)
+
+const (
+	userTokenLength   = 32
+	verifyEmailExpiry = time.Hour * 48
+	resetExpiry       = time.Hour
+)
+
+// Errors of the account methods, returned as is so they may be shown to the user
+var (
+	// ErrInvalidToken is returned when an emailed token is unknown, already used or expired
+	ErrInvalidToken = errors.New("the link is invalid or has expired")
+	// ErrIncorrectPassword is returned when the user fails to re-authenticate
+	ErrIncorrectPassword = errors.New("incorrect password")
+)
+
+// SendVerificationEmail emails the user a link to verify their email address,
+// previously sent links stop working
+func (a *AuthController) SendVerificationEmail(ctx context.Context, userID uuid.UUID) error {
+	user, err := a.authStore.GetUserByID(ctx, userID)
+	if err != nil {
+		return fmt.Errorf("AuthController.SendVerificationEmail() error finding user: %v", err)
+	}
+	if user.EmailVerified {
+		return nil
+	}
+	token, err := a.createUserToken(ctx, user.ID, db.TokenVerifyEmail, verifyEmailExpiry)
+	if err != nil {
+		return fmt.Errorf("AuthController.SendVerificationEmail() error: %v", err)
+	}
+	err = a.mailer.Send(ctx, &mail.Message{
+		To:      user.Email,
+		Subject: "Verify your syncapod email address",
+		Body: fmt.Sprintf("Hi %s,\n\nPlease verify your email address by opening the link below:\n\n%s\n\nThe link expires in %d hours.\n",
+			user.Username, a.link("/account/verify", token), int(verifyEmailExpiry.Hours())),
+	})
+	if err != nil {
+		return fmt.Errorf("AuthController.SendVerificationEmail() error sending email: %v", err)
+	}
+	return nil
+}
+
+// VerifyEmail consumes the token and marks the user's email as verified
+func (a *AuthController) VerifyEmail(ctx context.Context, token string) error {
+	t, err := a.consumeUserToken(ctx, token, db.TokenVerifyEmail)
+	if err != nil {
+		return err
+	}
+	if err = a.authStore.SetEmailVerified(ctx, t.UserID); err != nil {
+		return fmt.Errorf("AuthController.VerifyEmail() error: %v", err)
+	}
+	return nil
+}
+
+// RequestPasswordReset emails a reset link if the email is registered,
+// unknown emails are not reported so they can't be enumerated.
+// Requests of the email and ip are throttled, returning a *ThrottledError
+func (a *AuthController) RequestPasswordReset(ctx context.Context, email, ip string) error {
+	if err := a.throttler.requestReset(ctx, email, ip); err != nil {
+		return err
+	}
+	user, err := a.authStore.GetUserByEmail(ctx, email)
+	if err != nil {
+		return nil
+	}
+	token, err := a.createUserToken(ctx, user.ID, db.TokenResetPassword, resetExpiry)
+	if err != nil {
+		return fmt.Errorf("AuthController.RequestPasswordReset() error: %v", err)
+	}
+	err = a.mailer.Send(ctx, &mail.Message{
+		To:      user.Email,
+		Subject: "Reset your syncapod password",
+		Body: fmt.Sprintf("Hi %s,\n\nA password reset was requested for your account, open the link below to choose a new password:\n\n%s\n\nThe link expires in %d minutes. If you did not request it you can ignore this email.\n",
+			user.Username, a.link("/account/reset", token), int(resetExpiry.Minutes())),
+	})
+	if err != nil {
+		return fmt.Errorf("AuthController.RequestPasswordReset() error sending email: %v", err)
+	}
+	return nil
+}
+
+// ResetPassword consumes the token, sets the new password and signs the user out everywhere,
+// all at once so a failure leaves the token usable and the old sessions revoked with it
+func (a *AuthController) ResetPassword(ctx context.Context, token, password string) error {
+	if !strongPassword(password) {
+		return ErrWeakPassword
+	}
+	// checked before hashing, which is expensive
+	if _, err := a.findUserToken(ctx, token, db.TokenResetPassword); err != nil {
+		return err
+	}
+	pwdHash, err := a.hasher.Hash(password)
+	if err != nil {
+		return fmt.Errorf("AuthController.ResetPassword() error hashing password: %v", err)
+	}
+	_, err = a.authStore.ResetUserPassword(ctx, hashUserToken(token), pwdHash, time.Now())
+	if errors.Is(err, pgx.ErrNoRows) {
+		return ErrInvalidToken
+	}
+	if err != nil {
+		return fmt.Errorf("AuthController.ResetPassword() error: %v", err)
+	}
+	return nil
+}
+
+// ChangePassword sets a new password after checking the current one
+func (a *AuthController) ChangePassword(ctx context.Context, userID uuid.UUID, current, password string) error {
+	user, err := a.reauthenticate(ctx, userID, current)
+	if err != nil {
+		return err
+	}
+	if !strongPassword(password) || strings.EqualFold(password, user.Username) {
+		return ErrWeakPassword
+	}
+	pwdHash, err := a.hasher.Hash(password)
+	if err != nil {
+		return fmt.Errorf("AuthController.ChangePassword() error hashing password: %v", err)
+	}
+	if err = a.authStore.UpdateUserPassword(ctx, userID, pwdHash); err != nil {
+		return fmt.Errorf("AuthController.ChangePassword() error: %v", err)
+	}
+	return nil
+}
+
+// ChangeEmail changes the user's email after checking their password,
+// the new address is emailed a verification link and the old one a notice
+func (a *AuthController) ChangeEmail(ctx context.Context, userID uuid.UUID, password, email string) (*db.UserRow, error) {
+	email = strings.TrimSpace(email)
+	if !validEmail(email) {
+		return nil, ErrInvalidEmail
+	}
+	user, err := a.reauthenticate(ctx, userID, password)
+	if err != nil {
+		return nil, err
+	}
+	if email == user.Email {
+		return user, nil
+	}
+	err = a.authStore.UpdateUserEmail(ctx, userID, email)
+	if errors.Is(err, db.ErrDuplicateEmail) {
+		return nil, ErrEmailTaken
+	}
+	if err != nil {
+		return nil, fmt.Errorf("AuthController.ChangeEmail() error: %v", err)
+	}
+	// links sent to the old address stop working
+	if err = a.authStore.DeleteUserTokens(ctx, userID, db.TokenVerifyEmail); err != nil {
+		return nil, fmt.Errorf("AuthController.ChangeEmail() error deleting tokens: %v", err)
+	}
+	if err = a.authStore.DeleteUserTokens(ctx, userID, db.TokenResetPassword); err != nil {
+		return nil, fmt.Errorf("AuthController.ChangeEmail() error deleting tokens: %v", err)
+	}
+	err = a.mailer.Send(ctx, &mail.Message{
+		To:      user.Email,
+		Subject: "Your syncapod email address was changed",
+		Body:    fmt.Sprintf("Hi %s,\n\nThe email address of your account was changed to %s.\nIf you did not change it, please contact us.\n", user.Username, email),
+	})
+	if err != nil {
+		log.Printf("AuthController.ChangeEmail() error notifying old address: %v\n", err)
+	}
+	if err = a.SendVerificationEmail(ctx, userID); err != nil {
+		log.Printf("AuthController.ChangeEmail() error: %v\n", err)
+	}
+	user.Email = email
+	user.EmailVerified = false
+	return user, nil
+}
+
+// UpdateProfile changes the username and birthdate of the user
+func (a *AuthController) UpdateProfile(ctx context.Context, userID uuid.UUID, username string, dob time.Time) (*db.UserRow, error) {
+	if !usernameRegex.MatchString(username) {
+		return nil, ErrInvalidUsername
+	}
+	if !oldEnough(dob, time.Now()) {
+		return nil, ErrTooYoung
+	}
+	user, err := a.authStore.GetUserByID(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("AuthController.UpdateProfile() error finding user: %v", err)
+	}
+	user.Username = username
+	user.Birthdate = dob
+	err = a.authStore.UpdateUser(ctx, user)
+	if errors.Is(err, db.ErrDuplicateUsername) {
+		return nil, ErrUsernameTaken
+	}
+	if err != nil {
+		return nil, fmt.Errorf("AuthController.UpdateProfile() error: %v", err)
+	}
+	user.PasswordHash = []byte{}
+	return user, nil
+}
+
+// DeleteAccount deletes the user after checking their password,
+// everything belonging to the user is deleted along by the foreign keys
+func (a *AuthController) DeleteAccount(ctx context.Context, userID uuid.UUID, password string) error {
+	if _, err := a.reauthenticate(ctx, userID, password); err != nil {
+		return err
+	}
+	if err := a.authStore.DeleteUser(ctx, userID); err != nil {
+		return fmt.Errorf("AuthController.DeleteAccount() error: %v", err)
+	}
+	return nil
+}
+
+// reauthenticate checks the password of an already authenticated user before sensitive changes,
+// attempts are throttled and audited like logins of the account so a stolen session can't guess it
+func (a *AuthController) reauthenticate(ctx context.Context, userID uuid.UUID, password string) (*db.UserRow, error) {
+	user, err := a.authStore.GetUserByID(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("AuthController.reauthenticate() error finding user: %v", err)
+	}
+	if err = a.throttler.reserveAccount(ctx, user.ID); err != nil {
+		return nil, err
+	}
+	if !a.hasher.Compare(user.PasswordHash, password) {
+		a.loginFailed(ctx, &db.FailedLoginRow{UserID: &user.ID, Identifier: user.Username}, db.FailedLoginIncorrectPassword)
+		return nil, ErrIncorrectPassword
+	}
+	if _, err = a.secondFactorRequired(ctx, user.ID, ""); err != nil {
+		return nil, fmt.Errorf("AuthController.reauthenticate() error checking two factor: %v", err)
+	}
+	user.PasswordHash = []byte{}
+	return user, nil
+}
+
+// createUserToken replaces the user's tokens of the purpose with a new one,
+// only its hash is stored
+func (a *AuthController) createUserToken(ctx context.Context, userID uuid.UUID, purpose db.TokenPurpose, expiry time.Duration) (string, error) {
+	key, err := createKey(userTokenLength)
+	if err != nil {
+		return "", err
+	}
+	token := EncodeKey(key)
+	if err = a.authStore.DeleteUserTokens(ctx, userID, purpose); err != nil {
+		return "", err
+	}
+	now := time.Now()
+	err = a.authStore.InsertUserToken(ctx, &db.UserTokenRow{
+		TokenHash: hashUserToken(token),
+		UserID:    userID,
+		Purpose:   purpose,
+		Created:   now,
+		Expires:   now.Add(expiry),
+	})
+	if err != nil {
+		return "", err
+	}
+	return token, nil
+}
+
+func (a *AuthController) consumeUserToken(ctx context.Context, token string, purpose db.TokenPurpose) (*db.UserTokenRow, error) {
+	if token == "" {
+		return nil, ErrInvalidToken
+	}
+	t, err := a.authStore.ConsumeUserToken(ctx, hashUserToken(token), purpose)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, ErrInvalidToken
+	}
+	if err != nil {
+		return nil, fmt.Errorf("AuthController.consumeUserToken() error: %v", err)
+	}
+	if t.Expires.Before(time.Now()) {
+		return nil, ErrInvalidToken
+	}
+	return t, nil
+}
+
+// findUserToken returns the token without consuming it
+func (a *AuthController) findUserToken(ctx context.Context, token string, purpose db.TokenPurpose) (*db.UserTokenRow, error) {
+	if token == "" {
+		return nil, ErrInvalidToken
+	}
+	t, err := a.authStore.FindUserToken(ctx, hashUserToken(token), purpose)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, ErrInvalidToken
+	}
+	if err != nil {
+		return nil, fmt.Errorf("AuthController.findUserToken() error: %v", err)
+	}
+	if t.Expires.Before(time.Now()) {
+		return nil, ErrInvalidToken
+	}
+	return t, nil
+}
+
+func hashUserToken(token string) []byte {
+	sum := sha256.Sum256([]byte(token))
+	return sum[:]
+}
+
+// link builds an absolute link to the page with the token
+func (a *AuthController) link(path, token string) string {
+	return a.baseURL + path + "?token=" + url.QueryEscape(token)
+}
@@ -0,0 +1,138 @@
+package auth
+
+import (
+	"context"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/sschwartz96/syncapod-backend/internal/db"
+	"github.com/sschwartz96/syncapod-backend/internal/mail"
+	"github.com/stretchr/testify/require"
+)
+
+// lastToken returns the token of the link last emailed to the address
+func lastToken(t *testing.T, mailer *mail.MemoryMailer, to string) string {
+	msg := mailer.Last(to)
+	require.NotNil(t, msg)
+	i := strings.Index(msg.Body, "token=")
+	require.NotEqual(t, -1, i)
+	token, err := url.QueryUnescape(strings.Fields(msg.Body[i+len("token="):])[0])
+	require.Nil(t, err)
+	return token
+}
+
+func TestAuthController_VerifyEmail(t *testing.T) {
+	mailer := mail.NewMemoryMailer()
+	a := NewAuthController(authStore, oauthStore, mailer, "https://syncapod.com/", Hasher{}, nil)
+	user, err := a.CreateUser(context.Background(), "verify@test.auth", "verifyTestAuth", "password1", time.Unix(0, 0))
+	require.Nil(t, err)
+
+	require.Nil(t, a.SendVerificationEmail(context.Background(), user.ID))
+	require.True(t, strings.Contains(mailer.Last(user.Email).Body, "https://syncapod.com/account/verify?token="))
+	first := lastToken(t, mailer, user.Email)
+	require.Nil(t, a.SendVerificationEmail(context.Background(), user.ID))
+	token := lastToken(t, mailer, user.Email)
+
+	// resending invalidates the previous link
+	require.Equal(t, ErrInvalidToken, a.VerifyEmail(context.Background(), first))
+	require.Equal(t, ErrInvalidToken, a.VerifyEmail(context.Background(), ""))
+	require.Nil(t, a.VerifyEmail(context.Background(), token))
+	require.Equal(t, ErrInvalidToken, a.VerifyEmail(context.Background(), token))
+	verified, err := authStore.GetUserByID(context.Background(), user.ID)
+	require.Nil(t, err)
+	require.True(t, verified.EmailVerified)
+
+	// already verified users are not emailed again
+	sent := len(mailer.Sent())
+	require.Nil(t, a.SendVerificationEmail(context.Background(), user.ID))
+	require.Len(t, mailer.Sent(), sent)
+}
+
+func TestAuthController_ResetPassword(t *testing.T) {
+	mailer := mail.NewMemoryMailer()
+	a := NewAuthController(authStore, oauthStore, mailer, "https://syncapod.com", Hasher{}, nil)
+	user, err := a.CreateUser(context.Background(), "reset@test.auth", "resetTestAuth", "password1", time.Unix(0, 0))
+	require.Nil(t, err)
+	_, session, err := a.Login(context.Background(), user.Username, "password1", "testAgent", "")
+	require.Nil(t, err)
+	authCode, err := a.CreateAuthCode(context.Background(), user.ID, "testClient")
+	require.Nil(t, err)
+	accessToken, err := a.CreateAccessToken(context.Background(), authCode)
+	require.Nil(t, err)
+
+	// unknown emails are silently ignored
+	require.Nil(t, a.RequestPasswordReset(context.Background(), "unknown@test.auth", ""))
+	require.Empty(t, mailer.Sent())
+
+	// expired tokens are rejected
+	require.Nil(t, authStore.InsertUserToken(context.Background(), &db.UserTokenRow{
+		TokenHash: hashUserToken("expired"), UserID: user.ID, Purpose: db.TokenResetPassword,
+		Created: time.Now().Add(-time.Hour * 2), Expires: time.Now().Add(-time.Hour),
+	}))
+	require.Equal(t, ErrInvalidToken, a.ResetPassword(context.Background(), "expired", "newPassword1"))
+
+	require.Nil(t, a.RequestPasswordReset(context.Background(), user.Email, ""))
+	token := lastToken(t, mailer, user.Email)
+	require.Equal(t, ErrWeakPassword, a.ResetPassword(context.Background(), token, "weak"))
+	require.Equal(t, ErrInvalidToken, a.ResetPassword(context.Background(), token[1:], "newPassword1"))
+	require.Nil(t, a.ResetPassword(context.Background(), token, "newPassword1"))
+	require.Equal(t, ErrInvalidToken, a.ResetPassword(context.Background(), token, "newPassword2"))
+
+	// the password changed and the user is signed out everywhere
+	_, err = a.Authenticate(context.Background(), user.Username, "password1", "testAgent", "")
+	require.NotNil(t, err)
+	_, err = a.Authenticate(context.Background(), user.Username, "newPassword1", "testAgent", "")
+	require.Nil(t, err)
+	_, _, err = a.Authorize(context.Background(), session.Key)
+	require.NotNil(t, err)
+	_, err = a.ValidateAccessToken(context.Background(), accessToken.Key)
+	require.NotNil(t, err)
+}
+
+func TestAuthController_ManageAccount(t *testing.T) {
+	mailer := mail.NewMemoryMailer()
+	a := NewAuthController(authStore, oauthStore, mailer, "https://syncapod.com", Hasher{}, nil)
+	user, err := a.CreateUser(context.Background(), "manage@test.auth", "manageTestAuth", "password1", time.Unix(0, 0))
+	require.Nil(t, err)
+
+	// change password
+	require.Equal(t, ErrIncorrectPassword, a.ChangePassword(context.Background(), user.ID, "wrong", "newPassword1"))
+	require.Equal(t, ErrWeakPassword, a.ChangePassword(context.Background(), user.ID, "password1", "manageTestAuth"))
+	require.Nil(t, a.ChangePassword(context.Background(), user.ID, "password1", "newPassword1"))
+	_, err = a.Authenticate(context.Background(), user.Username, "newPassword1", "testAgent", "")
+	require.Nil(t, err)
+
+	// change email, the old address is notified and the new one verified
+	_, err = a.ChangeEmail(context.Background(), user.ID, "newPassword1", "invalid")
+	require.Equal(t, ErrInvalidEmail, err)
+	_, err = a.ChangeEmail(context.Background(), user.ID, "password1", "changed@test.auth")
+	require.Equal(t, ErrIncorrectPassword, err)
+	_, err = a.ChangeEmail(context.Background(), user.ID, "newPassword1", getTestUser.Email)
+	require.Equal(t, ErrEmailTaken, err)
+	changed, err := a.ChangeEmail(context.Background(), user.ID, "newPassword1", "changed@test.auth")
+	require.Nil(t, err)
+	require.Equal(t, "changed@test.auth", changed.Email)
+	require.Empty(t, changed.PasswordHash)
+	require.NotNil(t, mailer.Last("manage@test.auth"))
+	require.Nil(t, a.VerifyEmail(context.Background(), lastToken(t, mailer, "changed@test.auth")))
+
+	// update profile
+	_, err = a.UpdateProfile(context.Background(), user.ID, "no spaces", time.Unix(0, 0))
+	require.Equal(t, ErrInvalidUsername, err)
+	_, err = a.UpdateProfile(context.Background(), user.ID, "manageTestAuth", time.Now())
+	require.Equal(t, ErrTooYoung, err)
+	_, err = a.UpdateProfile(context.Background(), user.ID, getTestUser.Username, time.Unix(0, 0))
+	require.Equal(t, ErrUsernameTaken, err)
+	updated, err := a.UpdateProfile(context.Background(), user.ID, "manageTestAuth2", time.Unix(0, 0))
+	require.Nil(t, err)
+	require.Equal(t, "manageTestAuth2", updated.Username)
+	require.Equal(t, "changed@test.auth", updated.Email)
+
+	// delete account
+	require.Equal(t, ErrIncorrectPassword, a.DeleteAccount(context.Background(), user.ID, "password1"))
+	require.Nil(t, a.DeleteAccount(context.Background(), user.ID, "newPassword1"))
+	_, err = authStore.GetUserByID(context.Background(), user.ID)
+	require.NotNil(t, err)
+}
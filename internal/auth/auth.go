@@ -9,45 +9,95 @@ import (
 
 	"github.com/google/uuid"
 	"github.com/sschwartz96/syncapod-backend/internal/db"
-	"golang.org/x/crypto/bcrypt"
+	"github.com/sschwartz96/syncapod-backend/internal/mail"
 )
 
+// sessionKeyLength is the amount of random bytes of a session key
+const sessionKeyLength = 32
+
 type Auth interface {
 	// Syncapod
-	Login(ctx context.Context, username, password, agent string) (*db.UserRow, *db.SessionRow, error)
-	Authorize(ctx context.Context, sessionID uuid.UUID) (*db.UserRow, error)
-	Logout(ctx context.Context, sessionID uuid.UUID) error
+	Login(ctx context.Context, username, password, agent, ip string) (*db.UserRow, *db.SessionRow, error)
+	Authenticate(ctx context.Context, username, password, agent, ip string) (*db.UserRow, error)
+	AuthenticateSession(ctx context.Context, username, password, agent, ip string) (*db.UserRow, *db.SessionRow, error)
+	Authorize(ctx context.Context, sessionKey string) (*db.UserRow, *db.SessionRow, error)
+	Logout(ctx context.Context, sessionKey string) error
 	CreateUser(ctx context.Context, email, username, pwd string, dob time.Time) (*db.UserRow, error)
+	// Account
+	SendVerificationEmail(ctx context.Context, userID uuid.UUID) error
+	VerifyEmail(ctx context.Context, token string) error
+	RequestPasswordReset(ctx context.Context, email, ip string) error
+	ResetPassword(ctx context.Context, token, password string) error
+	ChangePassword(ctx context.Context, userID uuid.UUID, current, password string) error
+	ChangeEmail(ctx context.Context, userID uuid.UUID, password, email string) (*db.UserRow, error)
+	UpdateProfile(ctx context.Context, userID uuid.UUID, username string, dob time.Time) (*db.UserRow, error)
+	DeleteAccount(ctx context.Context, userID uuid.UUID, password string) error
+	// Two Factor
+	VerifyTwoFactor(ctx context.Context, challenge, code, agent, ip string) (*db.UserRow, *db.SessionRow, error)
+	EnrollTwoFactor(ctx context.Context, userID uuid.UUID) (string, string, error)
+	ConfirmTwoFactor(ctx context.Context, userID uuid.UUID, code string) ([]string, error)
+	DisableTwoFactor(ctx context.Context, userID uuid.UUID, password, code string) error
+	// Sessions
+	ListSessions(ctx context.Context, userID uuid.UUID) ([]db.SessionRow, error)
+	RevokeSession(ctx context.Context, userID uuid.UUID, handle string) error
+	RevokeAllOtherSessions(ctx context.Context, userID, currentID uuid.UUID) (int64, error)
+	ListGrants(ctx context.Context, userID uuid.UUID) ([]db.OAuthGrant, error)
+	RevokeGrant(ctx context.Context, userID uuid.UUID, clientID string) error
 	// OAuth
 	CreateAuthCode(ctx context.Context, userID uuid.UUID, clientID string) (*db.AuthCodeRow, error)
 	CreateAccessToken(ctx context.Context, authCode *db.AuthCodeRow) (*db.AccessTokenRow, error)
 	ValidateAuthCode(ctx context.Context, code string) (*db.AuthCodeRow, error)
 	ValidateAccessToken(ctx context.Context, token string) (*db.UserRow, error)
 	ValidateRefreshToken(ctx context.Context, token string) (*db.AccessTokenRow, error)
+	RefreshAccessToken(ctx context.Context, refreshToken string) (*db.AccessTokenRow, error)
 }
 
 type AuthController struct {
 	authStore  db.AuthStore
 	oauthStore db.OAuthStore
+	mailer     mail.Mailer
+	baseURL    string
+	hasher     Hasher
+	throttler  *Throttler
 }
 
-func NewAuthController(aStore db.AuthStore, oStore db.OAuthStore) *AuthController {
-	return &AuthController{authStore: aStore, oauthStore: oStore}
+// NewAuthController creates the controller, baseURL is used to build the links sent by the mailer,
+// new passwords are hashed with the hasher and failed logins are kept by the limiter
+func NewAuthController(aStore db.AuthStore, oStore db.OAuthStore, mailer mail.Mailer, baseURL string, hasher Hasher, limiter db.LimiterStore) *AuthController {
+	return &AuthController{
+		authStore:  aStore,
+		oauthStore: oStore,
+		mailer:     mailer,
+		baseURL:    strings.TrimSuffix(baseURL, "/"),
+		hasher:     hasher,
+		throttler:  NewThrottler(limiter),
+	}
 }
 
 // Login queries db for user and validates password.
 // On success, it creates session and inserts into db
-// returns error if user not found or password is invalid
-func (a *AuthController) Login(ctx context.Context, username, password, agent string) (*db.UserRow, *db.SessionRow, error) {
-	user, err := a.findUserByEmailOrUsername(ctx, username)
+// returns error if user not found or password is invalid, or a *ThrottledError.
+// Accounts with two factor enabled get a *TwoFactorRequiredError instead of a session
+func (a *AuthController) Login(ctx context.Context, username, password, agent, ip string) (*db.UserRow, *db.SessionRow, error) {
+	user, err := a.authenticate(ctx, username, password, agent, ip)
+	if err != nil {
+		return nil, nil, fmt.Errorf("AuthController.Login() error: %w", err)
+	}
+	enabled, err := a.secondFactorRequired(ctx, user.ID, ip)
 	if err != nil {
-		return nil, nil, fmt.Errorf("AuthController.Login() error finding user: %v", err)
+		return nil, nil, fmt.Errorf("AuthController.Login() error checking two factor: %v", err)
 	}
-	if !compare(user.PasswordHash, password) {
-		return nil, nil, fmt.Errorf("AuthController.Login() error incorrect password")
+	if enabled {
+		challenge, err := a.createUserToken(ctx, user.ID, db.TokenTwoFactor, twoFactorChallengeExpiry)
+		if err != nil {
+			return nil, nil, fmt.Errorf("AuthController.Login() error creating two factor challenge: %v", err)
+		}
+		return nil, nil, &TwoFactorRequiredError{Challenge: challenge}
+	}
+	session, err := createSession(user.ID, agent)
+	if err != nil {
+		return nil, nil, fmt.Errorf("AuthController.Login() error creating session: %v", err)
 	}
-	user.PasswordHash = []byte{}
-	session := createSession(user.ID, agent)
 	err = a.authStore.InsertSession(context.Background(), session)
 	if err != nil {
 		return nil, nil, fmt.Errorf("AuthController.Login() error inserting new session: %v", err)
@@ -55,22 +105,114 @@ func (a *AuthController) Login(ctx context.Context, username, password, agent st
 	return user, session, nil
 }
 
-// Authorize queries db for session via id, validates and returns user info.
+// Authenticate validates the password of the user without creating a session,
+// for clients sending their credentials with every request.
+// Attempts of the account and ip are throttled after failures, which are audited with the agent.
+// Accounts with two factor enabled can't be authenticated by password alone, ErrTwoFactorRequired is returned
+func (a *AuthController) Authenticate(ctx context.Context, username, password, agent, ip string) (*db.UserRow, error) {
+	user, err := a.authenticate(ctx, username, password, agent, ip)
+	if err != nil {
+		return nil, err
+	}
+	enabled, err := a.secondFactorRequired(ctx, user.ID, ip)
+	if err != nil {
+		return nil, fmt.Errorf("AuthController.Authenticate() error checking two factor: %v", err)
+	}
+	if enabled {
+		return nil, ErrTwoFactorRequired
+	}
+	return user, nil
+}
+
+// AuthenticateSession is Authenticate creating a session, for clients sending their credentials
+// with every request. Later requests are authorized by the session instead of verifying the password again
+func (a *AuthController) AuthenticateSession(ctx context.Context, username, password, agent, ip string) (*db.UserRow, *db.SessionRow, error) {
+	user, err := a.Authenticate(ctx, username, password, agent, ip)
+	if err != nil {
+		return nil, nil, err
+	}
+	session, err := createSession(user.ID, agent)
+	if err != nil {
+		return nil, nil, fmt.Errorf("AuthController.AuthenticateSession() error creating session: %v", err)
+	}
+	err = a.authStore.InsertSession(ctx, session)
+	if err != nil {
+		return nil, nil, fmt.Errorf("AuthController.AuthenticateSession() error inserting new session: %v", err)
+	}
+	return user, session, nil
+}
+
+// authenticate validates the password of the user, the first factor. The reservations of an accepted
+// password are left to the caller, see secondFactorRequired().
+// Hashes weaker than the hasher's policy are replaced with a new hash of the password
+func (a *AuthController) authenticate(ctx context.Context, username, password, agent, ip string) (*db.UserRow, error) {
+	attempt := &db.FailedLoginRow{Identifier: username, IP: ip, UserAgent: agent}
+	if err := a.throttler.reserveIP(ctx, ip); err != nil {
+		return nil, err
+	}
+	user, err := a.findUserByEmailOrUsername(ctx, username)
+	if err != nil {
+		a.loginFailed(ctx, attempt, db.FailedLoginUnknownUser)
+		return nil, fmt.Errorf("AuthController.Authenticate() error finding user: %v", err)
+	}
+	attempt.UserID = &user.ID
+	if err = a.throttler.reserveAccount(ctx, user.ID); err != nil {
+		a.throttler.cancel(ctx, nil, ip)
+		return nil, err
+	}
+	if !a.hasher.Compare(user.PasswordHash, password) {
+		a.loginFailed(ctx, attempt, db.FailedLoginIncorrectPassword)
+		return nil, fmt.Errorf("AuthController.Authenticate() error incorrect password")
+	}
+	if a.hasher.NeedsRehash(user.PasswordHash) {
+		a.rehash(ctx, user, password)
+	}
+	user.PasswordHash = []byte{}
+	return user, nil
+}
+
+// secondFactorRequired returns whether the user has two factor enabled after the password was accepted.
+// The failures of the account are only forgotten by a complete login, with two factor enabled they are
+// kept for VerifyTwoFactor() which throttles the codes on the same account, so the reservations are given back
+func (a *AuthController) secondFactorRequired(ctx context.Context, userID uuid.UUID, ip string) (bool, error) {
+	enabled, err := a.twoFactorEnabled(ctx, userID)
+	if err != nil || enabled {
+		a.throttler.cancel(ctx, &userID, ip)
+		return enabled, err
+	}
+	a.throttler.succeed(ctx, userID, ip)
+	return false, nil
+}
+
+// rehash replaces the user's hash with one per the hasher's policy, unless the hash was
+// changed since it was read, ie: by a concurrent login or a password change
+func (a *AuthController) rehash(ctx context.Context, user *db.UserRow, password string) {
+	pwdHash, err := a.hasher.Hash(password)
+	if err != nil {
+		log.Printf("AuthController.rehash() error hashing password: %v\n", err)
+		return
+	}
+	if _, err = a.authStore.UpdateUserPasswordHash(ctx, user.ID, user.PasswordHash, pwdHash); err != nil {
+		log.Printf("AuthController.rehash() error: %v\n", err)
+	}
+}
+
+// Authorize queries db for session via the digest of its key, validates and returns user info.
 // returns error if the session is not found or invalid
-func (a *AuthController) Authorize(ctx context.Context, sessionID uuid.UUID) (*db.UserRow, error) {
-	session, user, err := a.authStore.GetSessionAndUser(ctx, sessionID)
+func (a *AuthController) Authorize(ctx context.Context, sessionKey string) (*db.UserRow, *db.SessionRow, error) {
+	session, user, err := a.authStore.GetSessionAndUser(ctx, hashKey([]byte(sessionKey)))
 	now := time.Now()
 	if err != nil {
-		return nil, fmt.Errorf("AuthController.Authorize() error finding session: %v", err)
+		return nil, nil, fmt.Errorf("AuthController.Authorize() error finding session: %v", err)
 	}
 	if session.Expires.Before(now) {
 		go func() {
-			err := a.authStore.DeleteSession(context.Background(), sessionID)
+			err := a.authStore.DeleteSession(context.Background(), session.ID)
 			if err != nil {
 				log.Printf("AuthController.Authorize() error deleting session: %v\n", err)
 			}
 		}()
-		return nil, fmt.Errorf("AuthController.Authorize() error: session expired")
+		return nil, nil, fmt.Errorf("AuthController.Authorize() error: session expired")
 	}
 	session.LastSeenTime = now
 	session.Expires = now.Add(time.Hour * 168)
@@ -81,30 +223,40 @@ func (a *AuthController) Authorize(ctx context.Context, sessionID uuid.UUID) (*d
 		}
 	}()
 	user.PasswordHash = []byte{}
-	return user, nil
+	return user, session, nil
 }
 
-func (a *AuthController) Logout(ctx context.Context, sessionID uuid.UUID) error {
-	err := a.authStore.DeleteSession(ctx, sessionID)
+func (a *AuthController) Logout(ctx context.Context, sessionKey string) error {
+	err := a.authStore.DeleteSessionByKey(ctx, hashKey([]byte(sessionKey)))
 	if err != nil {
 		return fmt.Errorf("AuthController.Logout() error deleting session: %v", err)
 	}
 	return nil
 }
 func (a *AuthController) CreateUser(ctx context.Context, email, username, pwd string, dob time.Time) (*db.UserRow, error) {
-	pwdHash, err := hash(pwd)
+	pwdHash, err := a.hasher.Hash(pwd)
 	if err != nil {
 		return nil, fmt.Errorf("AuthController.CreateUser() error hashing password: %v", err)
 	}
 
-	newUser := &db.UserRow{ID: uuid.New(), Email: email, Username: username, Birthdate: dob, PasswordHash: pwdHash, Created: time.Now(), LastSeen: time.Now()}
+	newUser := &db.UserRow{ID: uuid.New(), Email: email, Username: username, Birthdate: dob, PasswordHash: pwdHash, Created: time.Now(), LastSeen: time.Now(), Role: db.RoleUser}
 	err = a.authStore.InsertUser(ctx, newUser)
 	if err != nil {
-		return nil, fmt.Errorf("AuthController.CreateUser() error inserting user into db: %v", err)
+		return nil, fmt.Errorf("AuthController.CreateUser() error inserting user into db: %w", err)
 	}
 	return newUser, nil
 }
 
+// loginFailed audits the failed attempt, which already counts towards throttling by its reservations.
+// Attempts rejected by throttling are not audited so they can't flood the audit
+func (a *AuthController) loginFailed(ctx context.Context, attempt *db.FailedLoginRow, reason db.FailedLoginReason) {
+	attempt.Reason = reason
+	attempt.Created = time.Now()
+	if err := a.authStore.InsertFailedLogin(ctx, attempt); err != nil {
+		log.Printf("AuthController.loginFailed() error: %v\n", err)
+	}
+}
+
 // findUserByEmailOrUsername is a helper method for login
 // takes in string u which could either be an email address or username
 // returns UserRow upon success
@@ -122,23 +274,13 @@ func (a *AuthController) findUserByEmailOrUsername(ctx context.Context, u string
 	return user, nil
 }
 
-// Hash takes pwd string and returns hash type string
-func hash(password string) ([]byte, error) {
-	hash, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.MinCost)
+// createSession creates a session with a new key, only its digest is stored
+func createSession(userID uuid.UUID, agent string) (*db.SessionRow, error) {
+	key, err := createKey(sessionKeyLength)
 	if err != nil {
-		fmt.Printf("Hash(), error hashing password: %v", err)
 		return nil, err
 	}
-	return hash, nil
-}
-
-// Compare takes a password and hash compares and returns true for match
-func compare(hash []byte, password string) bool {
-	return bcrypt.CompareHashAndPassword(hash, []byte(password)) == nil
-}
-
-// createSession creates a session
-func createSession(userID uuid.UUID, agent string) *db.SessionRow {
+	sessionKey := EncodeKey(key)
 	now := time.Now()
 	return &db.SessionRow{
 		ID:           uuid.New(),
@@ -147,5 +289,7 @@ func createSession(userID uuid.UUID, agent string) *db.SessionRow {
 		LastSeenTime: now,
 		LoginTime:    now,
 		UserAgent:    agent,
-	}
+		KeyHash:      hashKey([]byte(sessionKey)),
+		Key:          sessionKey,
+	}, nil
 }
@@ -86,7 +86,7 @@ func TestAuthController_Login(t *testing.T) {
 				authStore:  tt.fields.authStore,
 				oauthStore: tt.fields.oauthStore,
 			}
-			got, got1, err := a.Login(tt.args.ctx, tt.args.username, tt.args.password, tt.args.agent)
+			got, got1, err := a.Login(tt.args.ctx, tt.args.username, tt.args.password, tt.args.agent, "")
 			if (err != nil) != tt.wantErr {
 				t.Errorf("AuthController.Login() error = %v, wantErr %v", err, tt.wantErr)
 				return
@@ -108,8 +108,8 @@ func TestAuthController_Authorize(t *testing.T) {
 		oauthStore db.OAuthStore
 	}
 	type args struct {
-		ctx       context.Context
-		sessionID uuid.UUID
+		ctx        context.Context
+		sessionKey string
 	}
 	tests := []struct {
 		name    string
@@ -120,7 +120,7 @@ func TestAuthController_Authorize(t *testing.T) {
 	}{
 		{
 			name:    "valid",
-			args:    args{ctx: context.Background(), sessionID: uuid.MustParse("a813c6e3-9cd0-4aed-9c4e-1d87ae20c111")},
+			args:    args{ctx: context.Background(), sessionKey: "get_session_key"},
 			fields:  fields{authStore: authStore, oauthStore: oauthStore},
 			want:    getTestUser,
 			wantErr: false,
@@ -132,7 +132,7 @@ func TestAuthController_Authorize(t *testing.T) {
 				authStore:  tt.fields.authStore,
 				oauthStore: tt.fields.oauthStore,
 			}
-			got, err := a.Authorize(tt.args.ctx, tt.args.sessionID)
+			got, _, err := a.Authorize(tt.args.ctx, tt.args.sessionKey)
 			if (err != nil) != tt.wantErr {
 				t.Errorf("AuthController.Authorize() error = %v, wantErr %v", err, tt.wantErr)
 				return
@@ -150,8 +150,9 @@ func TestAuthController_Logout(t *testing.T) {
 		oauthStore db.OAuthStore
 	}
 	type args struct {
-		ctx       context.Context
-		sessionID uuid.UUID
+		ctx        context.Context
+		sessionKey string
+		sessionID  uuid.UUID
 	}
 	tests := []struct {
 		name    string
@@ -161,7 +162,7 @@ func TestAuthController_Logout(t *testing.T) {
 	}{
 		{
 			name:    "valid",
-			args:    args{ctx: context.Background(), sessionID: uuid.MustParse("a813c6e3-9cd0-4aed-9c4e-1d87ae20c222")},
+			args:    args{ctx: context.Background(), sessionKey: "delete_session_key", sessionID: uuid.MustParse("a813c6e3-9cd0-4aed-9c4e-1d87ae20c222")},
 			fields:  fields{authStore: authStore, oauthStore: oauthStore},
 			wantErr: false,
 		},
@@ -172,7 +173,7 @@ func TestAuthController_Logout(t *testing.T) {
 				authStore:  tt.fields.authStore,
 				oauthStore: tt.fields.oauthStore,
 			}
-			if err := a.Logout(tt.args.ctx, tt.args.sessionID); (err != nil) != tt.wantErr {
+			if err := a.Logout(tt.args.ctx, tt.args.sessionKey); (err != nil) != tt.wantErr {
 				t.Errorf("AuthController.Logout() error = %v, wantErr %v", err, tt.wantErr)
 			}
 			// make sure session is removed
@@ -260,35 +261,38 @@ func setupAuthDB() {
 
 	// test sessions
 	getSesh := &db.SessionRow{ID: uuid.MustParse("a813c6e3-9cd0-4aed-9c4e-1d87ae20c111"), UserID: uuid.MustParse("a813c6e3-9cd0-4aed-9c4e-1d88ae20c8ba"),
-		Expires: time.Now().Add(time.Hour), LastSeenTime: time.Unix(1000, 0), LoginTime: time.Unix(1000, 0), UserAgent: "testAgent"}
+		Expires: time.Now().Add(time.Hour), LastSeenTime: time.Unix(1000, 0), LoginTime: time.Unix(1000, 0), UserAgent: "testAgent",
+		KeyHash: hashKey([]byte("get_session_key"))}
 	insertSession(a, getSesh)
 	updateSesh := &db.SessionRow{ID: uuid.MustParse("b813c6e3-9cd0-4aed-9c4e-1d87ae20c111"), UserID: uuid.MustParse("a813c6e3-9cd0-4aed-9c4e-1d88ae20c8ba"),
-		Expires: time.Unix(1000, 0), LastSeenTime: time.Unix(1000, 0), LoginTime: time.Unix(1000, 0), UserAgent: "testAgent"}
+		Expires: time.Unix(1000, 0), LastSeenTime: time.Unix(1000, 0), LoginTime: time.Unix(1000, 0), UserAgent: "testAgent",
+		KeyHash: hashKey([]byte("update_session_key"))}
 	insertSession(a, updateSesh)
 	deleteSesh := &db.SessionRow{ID: uuid.MustParse("a813c6e3-9cd0-4aed-9c4e-1d87ae20c222"), UserID: uuid.MustParse("a813c6e3-9cd0-4aed-9c4e-1d88ae20c8ba"),
-		Expires: time.Unix(1000, 0), LastSeenTime: time.Unix(1000, 0), LoginTime: time.Unix(1000, 0), UserAgent: "testAgent"}
+		Expires: time.Unix(1000, 0), LastSeenTime: time.Unix(1000, 0), LoginTime: time.Unix(1000, 0), UserAgent: "testAgent",
+		KeyHash: hashKey([]byte("delete_session_key"))}
 	insertSession(a, deleteSesh)
 
 	o := db.NewOAuthStorePG(dbpg)
-	// test auth codes
+	// test auth codes, only the digests are stored
 	gc, _ := DecodeKey("get_code")
-	getAuth := &db.AuthCodeRow{Code: gc, ClientID: "get_client", Scope: "get_scope", UserID: uuid.MustParse("a813c6e3-9cd0-4aed-9c4e-1d88ae20c8ba"), Expires: time.Now().Add(time.Minute * 5)}
+	getAuth := &db.AuthCodeRow{Code: hashKey(gc), ClientID: "get_client", Scope: "get_scope", UserID: uuid.MustParse("a813c6e3-9cd0-4aed-9c4e-1d88ae20c8ba"), Expires: time.Now().Add(time.Minute * 5)}
 	insertAuthCode(o, getAuth)
 	ec, _ := DecodeKey("expired_code")
-	expiredAuth := &db.AuthCodeRow{Code: ec, ClientID: "client", Scope: "scope", UserID: uuid.MustParse("a813c6e3-9cd0-4aed-9c4e-1d88ae20c8ba"), Expires: time.Now().Add(time.Minute * -5)}
+	expiredAuth := &db.AuthCodeRow{Code: hashKey(ec), ClientID: "client", Scope: "scope", UserID: uuid.MustParse("a813c6e3-9cd0-4aed-9c4e-1d88ae20c8ba"), Expires: time.Now().Add(time.Minute * -5)}
 	insertAuthCode(o, expiredAuth)
 	dc, _ := DecodeKey("delete_code")
-	deleteAuth := &db.AuthCodeRow{Code: dc, ClientID: "client", Scope: "scope", UserID: uuid.MustParse("a813c6e3-9cd0-4aed-9c4e-1d88ae20c8ba"), Expires: time.Now().Add(time.Minute * 5)}
+	deleteAuth := &db.AuthCodeRow{Code: hashKey(dc), ClientID: "client", Scope: "scope", UserID: uuid.MustParse("a813c6e3-9cd0-4aed-9c4e-1d88ae20c8ba"), Expires: time.Now().Add(time.Minute * 5)}
 	insertAuthCode(o, deleteAuth)
 
 	// test access tokens
 	tk, _ := DecodeKey("token")
 	rk, _ := DecodeKey("rftoken")
-	getAccessByRefresh := &db.AccessTokenRow{AuthCode: gc, Created: time.Now(), Expires: 3600, RefreshToken: rk, Token: tk, UserID: uuid.MustParse("a813c6e3-9cd0-4aed-9c4e-1d88ae20c8ba")}
+	getAccessByRefresh := &db.AccessTokenRow{AuthCode: hashKey(gc), Created: time.Now(), Expires: 3600, RefreshToken: hashKey(rk), Token: hashKey(tk), UserID: uuid.MustParse("a813c6e3-9cd0-4aed-9c4e-1d88ae20c8ba")}
 	insertAccessToken(o, getAccessByRefresh)
 	dtk, _ := DecodeKey("del_token")
 	drk, _ := DecodeKey("del_rftoken")
-	deleteToken := &db.AccessTokenRow{AuthCode: gc, Created: time.Unix(1000, 0), Expires: 3600, RefreshToken: drk, Token: dtk, UserID: uuid.MustParse("a813c6e3-9cd0-4aed-9c4e-1d88ae20c8ba")}
+	deleteToken := &db.AccessTokenRow{AuthCode: hashKey(gc), Created: time.Unix(1000, 0), Expires: 3600, RefreshToken: hashKey(drk), Token: hashKey(dtk), UserID: uuid.MustParse("a813c6e3-9cd0-4aed-9c4e-1d88ae20c8ba")}
 	insertAccessToken(o, deleteToken)
 }
 
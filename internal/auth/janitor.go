@@ -0,0 +1,74 @@
+package auth
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/sschwartz96/syncapod-backend/internal/db"
+)
+
+// JanitorBatchSize is the default amount of rows deleted per statement
+const JanitorBatchSize = 1000
+
+// Janitor periodically purges expired sessions, oauth codes and tokens, user tokens
+// and login failures, which are otherwise only deleted when presented, as well as
+// the failed login audits and changes older than their retention
+type Janitor struct {
+	store     db.AuthStore
+	batchSize int
+
+	mu    sync.Mutex
+	stats JanitorStats
+}
+
+// JanitorStats are the totals of every run since the janitor was created
+type JanitorStats struct {
+	Runs    int64
+	Errors  int64
+	LastRun time.Time
+	Purged  map[string]int64 // rows deleted per table
+}
+
+// NewJanitor creates a janitor deleting up to batchSize rows per statement
+func NewJanitor(store db.AuthStore, batchSize int) *Janitor {
+	return &Janitor{
+		store:     store,
+		batchSize: batchSize,
+		stats:     JanitorStats{Purged: map[string]int64{}},
+	}
+}
+
+// Run purges the rows expired by now and adds the deleted counts to the stats,
+// counts of the tables purged before an error are kept
+func (j *Janitor) Run(ctx context.Context) ([]db.PurgeResult, error) {
+	now := time.Now()
+	results, err := j.store.PurgeExpired(ctx, now, j.batchSize)
+	j.mu.Lock()
+	j.stats.Runs++
+	j.stats.LastRun = now
+	for _, r := range results {
+		j.stats.Purged[r.Name] += r.Deleted
+	}
+	if err != nil {
+		j.stats.Errors++
+	}
+	j.mu.Unlock()
+	if err != nil {
+		return results, fmt.Errorf("Janitor.Run() error: %v", err)
+	}
+	return results, nil
+}
+
+// Stats returns a copy of the janitor's stats
+func (j *Janitor) Stats() JanitorStats {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	stats := j.stats
+	stats.Purged = make(map[string]int64, len(j.stats.Purged))
+	for name, deleted := range j.stats.Purged {
+		stats.Purged[name] = deleted
+	}
+	return stats
+}
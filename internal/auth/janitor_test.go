@@ -0,0 +1,45 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/sschwartz96/syncapod-backend/internal/db"
+	"github.com/stretchr/testify/require"
+)
+
+// purgeStore returns fixed results from PurgeExpired, so the test database is left as is
+type purgeStore struct {
+	db.AuthStore
+	results []db.PurgeResult
+	err     error
+}
+
+func (p *purgeStore) PurgeExpired(ctx context.Context, now time.Time, batchSize int) ([]db.PurgeResult, error) {
+	return p.results, p.err
+}
+
+func TestJanitor_Run(t *testing.T) {
+	store := &purgeStore{results: []db.PurgeResult{{Name: "Sessions", Deleted: 3}, {Name: "AuthCodes", Deleted: 1}}}
+	j := NewJanitor(store, JanitorBatchSize)
+	results, err := j.Run(context.Background())
+	require.Nil(t, err)
+	require.Equal(t, store.results, results)
+
+	// counts purged before an error are kept
+	store.results, store.err = []db.PurgeResult{{Name: "Sessions", Deleted: 2}}, errors.New("purge failed")
+	_, err = j.Run(context.Background())
+	require.NotNil(t, err)
+
+	stats := j.Stats()
+	require.Equal(t, int64(2), stats.Runs)
+	require.Equal(t, int64(1), stats.Errors)
+	require.False(t, stats.LastRun.IsZero())
+	require.Equal(t, map[string]int64{"Sessions": 5, "AuthCodes": 1}, stats.Purged)
+
+	// stats are a copy
+	stats.Purged["Sessions"] = 0
+	require.Equal(t, int64(5), j.Stats().Purged["Sessions"])
+}
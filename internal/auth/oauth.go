@@ -3,6 +3,7 @@ package auth
 import (
 	"context"
 	"crypto/rand"
+	"crypto/sha256"
 	"encoding/base64"
 	"errors"
 	"fmt"
@@ -13,18 +14,20 @@ import (
 	"github.com/sschwartz96/syncapod-backend/internal/db"
 )
 
-// CreateAuthCode creates and saves an authorization code with the client & user id
+// CreateAuthCode creates and saves an authorization code with the client & user id,
+// the issued code is returned as Key and only its digest is saved
 func (a *AuthController) CreateAuthCode(ctx context.Context, userID uuid.UUID, clientID string) (*db.AuthCodeRow, error) {
 	key, err := createKey(64)
 	if err != nil {
 		return nil, fmt.Errorf("CreateAuthorizationCode() error creating key: %v", err)
 	}
 	code := &db.AuthCodeRow{
-		Code:     key,
+		Code:     hashKey(key),
 		ClientID: clientID,
 		UserID:   userID,
 		Scope:    db.ReadChange,
 		Expires:  time.Now().Add(time.Minute * 5),
+		Key:      EncodeKey(key),
 	}
 	err = a.oauthStore.InsertAuthCode(ctx, code)
 	if err != nil {
@@ -33,7 +36,8 @@ func (a *AuthController) CreateAuthCode(ctx context.Context, userID uuid.UUID, c
 	return code, nil
 }
 
-// CreateAccessToken creates and saves an access token with a year of validity
+// CreateAccessToken creates and saves an access token with a year of validity,
+// the issued tokens are returned as Key and RefreshKey and only their digests are saved
 func (a *AuthController) CreateAccessToken(ctx context.Context, authCode *db.AuthCodeRow) (*db.AccessTokenRow, error) {
 	tokenString, err := createKey(64)
 	if err != nil {
@@ -45,11 +49,14 @@ func (a *AuthController) CreateAccessToken(ctx context.Context, authCode *db.Aut
 	}
 	token := &db.AccessTokenRow{
 		AuthCode:     authCode.Code,
-		Token:        tokenString,
-		RefreshToken: refreshTokenString,
+		Token:        hashKey(tokenString),
+		RefreshToken: hashKey(refreshTokenString),
 		UserID:       authCode.UserID,
 		Created:      time.Now(),
 		Expires:      3600,
+		ClientID:     authCode.ClientID,
+		Key:          EncodeKey(tokenString),
+		RefreshKey:   EncodeKey(refreshTokenString),
 	}
 	if err := a.oauthStore.InsertAccessToken(ctx, token); err != nil {
 		return nil, fmt.Errorf("AuthController.CreateAccessToken() error inserting access token: %v", err)
@@ -63,7 +70,7 @@ func (a *AuthController) ValidateAuthCode(ctx context.Context, code string) (*db
 	if err != nil {
 		return nil, fmt.Errorf("AuthController.ValidateAuthCode() error decoding code: %v", err)
 	}
-	authCode, err := a.oauthStore.GetAuthCode(ctx, decodedCode)
+	authCode, err := a.oauthStore.GetAuthCode(ctx, hashKey(decodedCode))
 	if err != nil {
 		return nil, fmt.Errorf("AuthController.ValidateAuthCode() error finding auth code: %v", err)
 	}
@@ -79,7 +86,7 @@ func (a *AuthController) ValidateAccessToken(ctx context.Context, token string)
 	if err != nil {
 		return nil, fmt.Errorf("AuthController.ValidateAccessToken() error decoding key: %v", err)
 	}
-	user, tkn, err := a.oauthStore.GetAccessTokenAndUser(ctx, decodedTkn)
+	user, tkn, err := a.oauthStore.GetAccessTokenAndUser(ctx, hashKey(decodedTkn))
 	if err != nil {
 		return nil, fmt.Errorf("AuthController.ValidateAccessToken() error finding token: %v", err)
 	}
@@ -99,7 +106,7 @@ func (a *AuthController) ValidateRefreshToken(ctx context.Context, token string)
 	if err != nil {
 		return nil, fmt.Errorf("AuthController.ValidateRefreshToken() error decoding key: %v", err)
 	}
-	accesTkn, err := a.oauthStore.GetAccessTokenByRefresh(ctx, decodedTkn)
+	accesTkn, err := a.oauthStore.GetAccessTokenByRefresh(ctx, hashKey(decodedTkn))
 	if err != nil {
 		return nil, fmt.Errorf("AuthController.ValidateRefreshToken() error finding access token: %v", err)
 	}
@@ -112,6 +119,23 @@ func (a *AuthController) ValidateRefreshToken(ctx context.Context, token string)
 	return accesTkn, nil
 }
 
+// RefreshAccessToken replaces the access token of the refresh token with a new one,
+// returns error if the refresh token is invalid or its auth code expired
+func (a *AuthController) RefreshAccessToken(ctx context.Context, refreshToken string) (*db.AccessTokenRow, error) {
+	accessTkn, err := a.ValidateRefreshToken(ctx, refreshToken)
+	if err != nil {
+		return nil, fmt.Errorf("AuthController.RefreshAccessToken() error: %v", err)
+	}
+	authCode, err := a.oauthStore.GetAuthCode(ctx, accessTkn.AuthCode)
+	if err != nil {
+		return nil, fmt.Errorf("AuthController.RefreshAccessToken() error finding auth code: %v", err)
+	}
+	if authCode.Expires.Before(time.Now()) {
+		return nil, fmt.Errorf("AuthController.RefreshAccessToken() error auth code expired")
+	}
+	return a.CreateAccessToken(ctx, authCode)
+}
+
 // createKey takes in a key length and returns base64 encoding
 // of a crypo-rand generated byte sequence
 func createKey(l int) ([]byte, error) {
@@ -123,6 +147,12 @@ func createKey(l int) ([]byte, error) {
 	return key, nil
 }
 
+// hashKey returns the digest stored in place of an issued key
+func hashKey(key []byte) []byte {
+	sum := sha256.Sum256(key)
+	return sum[:]
+}
+
 func EncodeKey(key []byte) string {
 	return base64.URLEncoding.EncodeToString(key)
 }
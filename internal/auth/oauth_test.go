@@ -8,6 +8,7 @@ import (
 
 	"github.com/google/uuid"
 	"github.com/sschwartz96/syncapod-backend/internal/db"
+	"github.com/stretchr/testify/require"
 )
 
 func TestAuthController_CreateAuthCode(t *testing.T) {
@@ -117,7 +118,7 @@ func TestAuthController_ValidateAuthCode(t *testing.T) {
 			name:    "valid",
 			args:    args{ctx: context.Background(), code: EncodeKey(gc)},
 			fields:  fields{authStore: authStore, oauthStore: oauthStore},
-			want:    &db.AuthCodeRow{Code: gc, ClientID: "get_client", Scope: "get_scope", UserID: uuid.MustParse("a813c6e3-9cd0-4aed-9c4e-1d88ae20c8ba")},
+			want:    &db.AuthCodeRow{Code: hashKey(gc), ClientID: "get_client", Scope: "get_scope", UserID: uuid.MustParse("a813c6e3-9cd0-4aed-9c4e-1d88ae20c8ba")},
 			wantErr: false,
 		},
 		{
@@ -225,7 +226,7 @@ func TestAuthController_ValidateRefreshToken(t *testing.T) {
 			name:    "valid",
 			args:    args{ctx: context.Background(), token: EncodeKey(rk)},
 			fields:  fields{authStore: authStore, oauthStore: oauthStore},
-			want:    &db.AccessTokenRow{AuthCode: gc, Created: time.Now(), Expires: 3600, RefreshToken: rk, Token: tk, UserID: uuid.MustParse("a813c6e3-9cd0-4aed-9c4e-1d88ae20c8ba")},
+			want:    &db.AccessTokenRow{AuthCode: hashKey(gc), Created: time.Now(), Expires: 3600, RefreshToken: hashKey(rk), Token: hashKey(tk), UserID: uuid.MustParse("a813c6e3-9cd0-4aed-9c4e-1d88ae20c8ba")},
 			wantErr: false,
 		},
 	}
@@ -247,6 +248,36 @@ func TestAuthController_ValidateRefreshToken(t *testing.T) {
 	}
 }
 
+func TestAuthController_RefreshAccessToken(t *testing.T) {
+	ctx := context.Background()
+	a := &AuthController{authStore: authStore, oauthStore: oauthStore}
+	authCode, err := a.CreateAuthCode(ctx, getTestUser.ID, "refreshClient")
+	require.Nil(t, err)
+	token, err := a.CreateAccessToken(ctx, authCode)
+	require.Nil(t, err)
+
+	// only the digests of the issued keys are stored
+	code, _ := DecodeKey(authCode.Key)
+	_, err = oauthStore.GetAuthCode(ctx, code)
+	require.NotNil(t, err)
+	raw, _ := DecodeKey(token.Key)
+	_, _, err = oauthStore.GetAccessTokenAndUser(ctx, raw)
+	require.NotNil(t, err)
+
+	refreshed, err := a.RefreshAccessToken(ctx, token.RefreshKey)
+	require.Nil(t, err)
+	require.NotEqual(t, token.Key, refreshed.Key)
+	require.Equal(t, "refreshClient", refreshed.ClientID)
+	_, err = a.ValidateAccessToken(ctx, refreshed.Key)
+	require.Nil(t, err)
+
+	// the old token is deleted
+	require.Eventually(t, func() bool {
+		_, err := a.ValidateAccessToken(ctx, token.Key)
+		return err != nil
+	}, time.Second, 10*time.Millisecond)
+}
+
 func Test_createKey(t *testing.T) {
 	type args struct {
 		l int
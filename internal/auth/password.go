@@ -0,0 +1,179 @@
+package auth
+
+import (
+	"bytes"
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/base64"
+	"fmt"
+	"strings"
+
+	"golang.org/x/crypto/argon2"
+	"golang.org/x/crypto/bcrypt"
+)
+
+// Password hashing algorithms
+const (
+	Bcrypt   = "bcrypt"
+	Argon2id = "argon2id"
+)
+
+// Defaults of the argon2id parameters, as recommended by RFC 9106
+const (
+	argon2TimeDefault    = 3
+	argon2MemoryDefault  = 64 * 1024
+	argon2ThreadsDefault = 4
+	argon2SaltLength     = 16
+	argon2KeyLength      = 32
+)
+
+// Upper bounds of the argon2id parameters, stored hashes beyond them are rejected
+// so a tampered hash can't make a login exhaust the memory or cpu
+const (
+	argon2TimeMax       = 16
+	argon2MemoryMax     = 1024 * 1024 // KiB
+	argon2ThreadsMax    = 16
+	argon2SaltLengthMax = 64
+	argon2KeyLengthMax  = 64
+)
+
+// argon2Prefix starts the PHC string format of argon2id hashes:
+// $argon2id$v=19$m=<memory KiB>,t=<time>,p=<threads>$<salt>$<key>
+const argon2Prefix = "$" + Argon2id + "$"
+
+// Hasher hashes passwords according to the policy, zero fields use the defaults
+// so the zero value hashes with bcrypt.DefaultCost
+type Hasher struct {
+	Algorithm     string // Bcrypt or Argon2id
+	BcryptCost    int
+	Argon2Time    uint32
+	Argon2Memory  uint32 // KiB
+	Argon2Threads uint8
+}
+
+// argon2Params are the parameters of an argon2id hash
+type argon2Params struct {
+	time    uint32
+	memory  uint32
+	threads uint8
+}
+
+// Validate checks the algorithm and its cost
+func (h Hasher) Validate() error {
+	switch h.Algorithm {
+	case "", Bcrypt:
+		if h.BcryptCost != 0 && (h.BcryptCost < bcrypt.MinCost || h.BcryptCost > bcrypt.MaxCost) {
+			return fmt.Errorf("bcrypt cost must be between %d and %d", bcrypt.MinCost, bcrypt.MaxCost)
+		}
+	case Argon2id:
+		p := h.argon2Params()
+		if p.memory < 8*uint32(p.threads) {
+			return fmt.Errorf("argon2id memory must be at least 8KiB per thread")
+		}
+		if p.time > argon2TimeMax || p.memory > argon2MemoryMax || p.threads > argon2ThreadsMax {
+			return fmt.Errorf("argon2id parameters must be at most t=%d, m=%d, p=%d", argon2TimeMax, argon2MemoryMax, argon2ThreadsMax)
+		}
+	default:
+		return fmt.Errorf("unknown password hashing algorithm: %s", h.Algorithm)
+	}
+	return nil
+}
+
+// Hash hashes the password with a random salt
+func (h Hasher) Hash(password string) ([]byte, error) {
+	if h.Algorithm != Argon2id {
+		hash, err := bcrypt.GenerateFromPassword([]byte(password), h.bcryptCost())
+		if err != nil {
+			return nil, fmt.Errorf("Hasher.Hash() error hashing password: %v", err)
+		}
+		return hash, nil
+	}
+	salt := make([]byte, argon2SaltLength)
+	if _, err := rand.Read(salt); err != nil {
+		return nil, fmt.Errorf("Hasher.Hash() error creating salt: %v", err)
+	}
+	p := h.argon2Params()
+	key := argon2.IDKey([]byte(password), salt, p.time, p.memory, p.threads, argon2KeyLength)
+	return []byte(fmt.Sprintf("%sv=%d$m=%d,t=%d,p=%d$%s$%s", argon2Prefix, argon2.Version, p.memory, p.time, p.threads,
+		base64.RawStdEncoding.EncodeToString(salt), base64.RawStdEncoding.EncodeToString(key))), nil
+}
+
+// Compare returns true if the password matches the hash, which may be
+// of either algorithm regardless of the policy
+func (h Hasher) Compare(hash []byte, password string) bool {
+	if !bytes.HasPrefix(hash, []byte(argon2Prefix)) {
+		return bcrypt.CompareHashAndPassword(hash, []byte(password)) == nil
+	}
+	p, salt, key, err := decodeArgon2(hash)
+	if err != nil {
+		return false
+	}
+	other := argon2.IDKey([]byte(password), salt, p.time, p.memory, p.threads, uint32(len(key)))
+	return subtle.ConstantTimeCompare(key, other) == 1
+}
+
+// NeedsRehash returns true if the hash uses another algorithm or is weaker than the policy
+func (h Hasher) NeedsRehash(hash []byte) bool {
+	if !bytes.HasPrefix(hash, []byte(argon2Prefix)) {
+		if h.Algorithm == Argon2id {
+			return true
+		}
+		cost, err := bcrypt.Cost(hash)
+		return err != nil || cost < h.bcryptCost()
+	}
+	if h.Algorithm != Argon2id {
+		return true
+	}
+	p, _, _, err := decodeArgon2(hash)
+	want := h.argon2Params()
+	return err != nil || p.time < want.time || p.memory < want.memory || p.threads < want.threads
+}
+
+func (h Hasher) bcryptCost() int {
+	if h.BcryptCost == 0 {
+		return bcrypt.DefaultCost
+	}
+	return h.BcryptCost
+}
+
+func (h Hasher) argon2Params() argon2Params {
+	p := argon2Params{time: h.Argon2Time, memory: h.Argon2Memory, threads: h.Argon2Threads}
+	if p.time == 0 {
+		p.time = argon2TimeDefault
+	}
+	if p.memory == 0 {
+		p.memory = argon2MemoryDefault
+	}
+	if p.threads == 0 {
+		p.threads = argon2ThreadsDefault
+	}
+	return p
+}
+
+// decodeArgon2 parses the parameters, salt and key of an argon2id hash
+func decodeArgon2(hash []byte) (argon2Params, []byte, []byte, error) {
+	p := argon2Params{}
+	parts := strings.Split(string(hash), "$")
+	if len(parts) != 6 {
+		return p, nil, nil, fmt.Errorf("decodeArgon2() error: invalid format")
+	}
+	var version int
+	if _, err := fmt.Sscanf(parts[2], "v=%d", &version); err != nil || version != argon2.Version {
+		return p, nil, nil, fmt.Errorf("decodeArgon2() error: unsupported version: %s", parts[2])
+	}
+	if _, err := fmt.Sscanf(parts[3], "m=%d,t=%d,p=%d", &p.memory, &p.time, &p.threads); err != nil {
+		return p, nil, nil, fmt.Errorf("decodeArgon2() error parsing parameters: %v", err)
+	}
+	if p.time == 0 || p.threads == 0 || p.time > argon2TimeMax || p.memory > argon2MemoryMax || p.threads > argon2ThreadsMax {
+		return p, nil, nil, fmt.Errorf("decodeArgon2() error: invalid parameters: %s", parts[3])
+	}
+	salt, err := base64.RawStdEncoding.DecodeString(parts[4])
+	if err != nil || len(salt) > argon2SaltLengthMax {
+		return p, nil, nil, fmt.Errorf("decodeArgon2() error decoding salt: %v", err)
+	}
+	key, err := base64.RawStdEncoding.DecodeString(parts[5])
+	if err != nil || len(key) == 0 || len(key) > argon2KeyLengthMax {
+		return p, nil, nil, fmt.Errorf("decodeArgon2() error decoding key: %v", err)
+	}
+	return p, salt, key, nil
+}
@@ -0,0 +1,113 @@
+package auth
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/bcrypt"
+)
+
+// testArgon2 keeps the argon2id tests fast
+var testArgon2 = Hasher{Algorithm: Argon2id, Argon2Time: 1, Argon2Memory: 1024, Argon2Threads: 1}
+
+func TestHasher_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		hasher  Hasher
+		wantErr bool
+	}{
+		{name: "zero", hasher: Hasher{}},
+		{name: "bcrypt", hasher: Hasher{Algorithm: Bcrypt, BcryptCost: 12}},
+		{name: "bcrypt cost", hasher: Hasher{Algorithm: Bcrypt, BcryptCost: 99}, wantErr: true},
+		{name: "argon2id", hasher: testArgon2},
+		{name: "argon2id memory", hasher: Hasher{Algorithm: Argon2id, Argon2Memory: 8, Argon2Threads: 2}, wantErr: true},
+		{name: "argon2id max", hasher: Hasher{Algorithm: Argon2id, Argon2Memory: 4 * 1024 * 1024}, wantErr: true},
+		{name: "unknown", hasher: Hasher{Algorithm: "md5"}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.wantErr, tt.hasher.Validate() != nil)
+		})
+	}
+}
+
+func TestHasher_bcrypt(t *testing.T) {
+	h := Hasher{BcryptCost: bcrypt.MinCost}
+	hash, err := h.Hash("password1")
+	require.Nil(t, err)
+	require.True(t, h.Compare(hash, "password1"))
+	require.False(t, h.Compare(hash, "password2"))
+	require.False(t, h.NeedsRehash(hash))
+	require.True(t, Hasher{BcryptCost: bcrypt.MinCost + 1}.NeedsRehash(hash))
+	require.True(t, testArgon2.NeedsRehash(hash))
+	// hashes of another algorithm keep working
+	require.True(t, testArgon2.Compare(hash, "password1"))
+}
+
+func TestHasher_argon2id(t *testing.T) {
+	hash, err := testArgon2.Hash("password1")
+	require.Nil(t, err)
+	require.True(t, strings.HasPrefix(string(hash), "$argon2id$v=19$m=1024,t=1,p=1$"))
+	require.True(t, testArgon2.Compare(hash, "password1"))
+	require.False(t, testArgon2.Compare(hash, "password2"))
+	require.False(t, testArgon2.NeedsRehash(hash))
+	stronger := testArgon2
+	stronger.Argon2Time = 2
+	require.True(t, stronger.NeedsRehash(hash))
+	require.True(t, Hasher{}.NeedsRehash(hash))
+	require.True(t, Hasher{}.Compare(hash, "password1"))
+
+	// salted
+	other, err := testArgon2.Hash("password1")
+	require.Nil(t, err)
+	require.NotEqual(t, hash, other)
+}
+
+func Test_decodeArgon2(t *testing.T) {
+	invalid := []string{
+		"$argon2id$",
+		"$argon2id$v=18$m=1024,t=1,p=1$c2FsdA$a2V5",
+		"$argon2id$v=19$m=1024,t=0,p=1$c2FsdA$a2V5",
+		"$argon2id$v=19$m=1024,t=1,p=1$c2FsdA$",
+		"$argon2id$v=19$m=1024,t=1,p=1$!$a2V5",
+		"$argon2id$v=19$m=4294967295,t=1,p=1$c2FsdA$a2V5",
+		"$argon2id$v=19$m=1024,t=4294967295,p=1$c2FsdA$a2V5",
+		"$argon2id$v=19$m=1024,t=1,p=255$c2FsdA$a2V5",
+		"$argon2id$v=19$m=1024,t=1,p=1$c2FsdA$" + strings.Repeat("a2V5", 100),
+	}
+	for _, hash := range invalid {
+		_, _, _, err := decodeArgon2([]byte(hash))
+		require.NotNil(t, err, hash)
+		require.False(t, testArgon2.Compare([]byte(hash), "password1"))
+	}
+	p, salt, key, err := decodeArgon2([]byte("$argon2id$v=19$m=1024,t=2,p=3$c2FsdA$a2V5"))
+	require.Nil(t, err)
+	require.Equal(t, argon2Params{time: 2, memory: 1024, threads: 3}, p)
+	require.Equal(t, []byte("salt"), salt)
+	require.Equal(t, []byte("key"), key)
+}
+
+func TestAuthController_Authenticate_rehash(t *testing.T) {
+	old := NewAuthController(authStore, oauthStore, nil, "", Hasher{BcryptCost: bcrypt.MinCost}, nil)
+	user, err := old.CreateUser(context.Background(), "rehash@test.auth", "rehashTestAuth", "password1", time.Unix(0, 0))
+	require.Nil(t, err)
+
+	a := NewAuthController(authStore, oauthStore, nil, "", testArgon2, nil)
+	_, err = a.Authenticate(context.Background(), user.Username, "password1", "testAgent", "")
+	require.Nil(t, err)
+	rehashed, err := authStore.GetUserByID(context.Background(), user.ID)
+	require.Nil(t, err)
+	require.True(t, strings.HasPrefix(string(rehashed.PasswordHash), "$argon2id$"))
+
+	// the rehash doesn't overwrite a hash changed since it was read
+	updated, err := authStore.UpdateUserPasswordHash(context.Background(), user.ID, []byte("stale"), []byte("other"))
+	require.Nil(t, err)
+	require.False(t, updated)
+	_, err = a.Authenticate(context.Background(), user.Username, "password1", "testAgent", "")
+	require.Nil(t, err)
+	_, err = old.Authenticate(context.Background(), user.Username, "password1", "testAgent", "")
+	require.Nil(t, err)
+}
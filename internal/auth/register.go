@@ -0,0 +1,95 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"net/mail"
+	"regexp"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/sschwartz96/syncapod-backend/internal/db"
+)
+
+const (
+	minPasswordLength = 8
+	// maxPasswordLength is the limit of bcrypt
+	maxPasswordLength = 72
+	minAgeYears       = 13
+)
+
+var usernameRegex = regexp.MustCompile(`^[A-Za-z0-9_.-]{3,32}$`)
+
+// Errors of Register(), returned as is so they may be shown to the user
+var (
+	ErrInvalidEmail    = errors.New("invalid email address")
+	ErrInvalidUsername = errors.New("username must be 3 to 32 letters, digits, '_', '.' or '-'")
+	ErrWeakPassword    = fmt.Errorf("password must be %d to %d characters containing a letter and a digit and differ from the username", minPasswordLength, maxPasswordLength)
+	ErrTooYoung        = fmt.Errorf("user must be at least %d years old", minAgeYears)
+	ErrEmailTaken      = errors.New("email is already registered")
+	ErrUsernameTaken   = errors.New("username is already taken")
+)
+
+// Register validates the new user's details and creates the user
+func (a *AuthController) Register(ctx context.Context, email, username, password string, dob time.Time) (*db.UserRow, error) {
+	email = strings.TrimSpace(email)
+	if err := validateRegistration(email, username, password, dob, time.Now()); err != nil {
+		return nil, err
+	}
+	user, err := a.CreateUser(ctx, email, username, password, dob)
+	switch {
+	case errors.Is(err, db.ErrDuplicateEmail):
+		return nil, ErrEmailTaken
+	case errors.Is(err, db.ErrDuplicateUsername):
+		return nil, ErrUsernameTaken
+	case err != nil:
+		return nil, fmt.Errorf("AuthController.Register() error: %v", err)
+	}
+	if err = a.SendVerificationEmail(ctx, user.ID); err != nil {
+		log.Printf("AuthController.Register() error: %v\n", err)
+	}
+	user.PasswordHash = []byte{}
+	return user, nil
+}
+
+func validateRegistration(email, username, password string, dob, now time.Time) error {
+	if !validEmail(email) {
+		return ErrInvalidEmail
+	}
+	if !usernameRegex.MatchString(username) {
+		return ErrInvalidUsername
+	}
+	if !strongPassword(password) || strings.EqualFold(password, username) {
+		return ErrWeakPassword
+	}
+	if !oldEnough(dob, now) {
+		return ErrTooYoung
+	}
+	return nil
+}
+
+// validEmail checks that email is a bare address with a dot in its domain
+func validEmail(email string) bool {
+	addr, err := mail.ParseAddress(email)
+	return err == nil && addr.Address == email && strings.Contains(email[strings.LastIndex(email, "@"):], ".")
+}
+
+func oldEnough(dob, now time.Time) bool {
+	return !dob.AddDate(minAgeYears, 0, 0).After(now)
+}
+
+// strongPassword checks the length and that both letters and digits are used
+func strongPassword(password string) bool {
+	if len(password) < minPasswordLength || len(password) > maxPasswordLength {
+		return false
+	}
+	var letter, digit bool
+	for _, r := range password {
+		letter = letter || unicode.IsLetter(r)
+		digit = digit || unicode.IsDigit(r)
+	}
+	return letter && digit
+}
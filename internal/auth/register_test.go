@@ -0,0 +1,54 @@
+package auth
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/sschwartz96/syncapod-backend/internal/mail"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_validateRegistration(t *testing.T) {
+	now := time.Date(2021, 6, 1, 0, 0, 0, 0, time.UTC)
+	adult := now.AddDate(-30, 0, 0)
+	tests := []struct {
+		name     string
+		email    string
+		username string
+		password string
+		dob      time.Time
+		want     error
+	}{
+		{name: "valid", email: "user@syncapod.com", username: "user_1", password: "password123", dob: adult, want: nil},
+		{name: "email_no_domain", email: "user@syncapod", username: "user_1", password: "password123", dob: adult, want: ErrInvalidEmail},
+		{name: "email_with_name", email: "User <user@syncapod.com>", username: "user_1", password: "password123", dob: adult, want: ErrInvalidEmail},
+		{name: "username_short", email: "user@syncapod.com", username: "us", password: "password123", dob: adult, want: ErrInvalidUsername},
+		{name: "username_charset", email: "user@syncapod.com", username: "user@home", password: "password123", dob: adult, want: ErrInvalidUsername},
+		{name: "password_short", email: "user@syncapod.com", username: "user_1", password: "pass1", dob: adult, want: ErrWeakPassword},
+		{name: "password_no_digit", email: "user@syncapod.com", username: "user_1", password: "password", dob: adult, want: ErrWeakPassword},
+		{name: "password_username", email: "user@syncapod.com", username: "username1", password: "Username1", dob: adult, want: ErrWeakPassword},
+		{name: "too_young", email: "user@syncapod.com", username: "user_1", password: "password123", dob: now.AddDate(-13, 0, 1), want: ErrTooYoung},
+		{name: "minimum_age", email: "user@syncapod.com", username: "user_1", password: "password123", dob: now.AddDate(-13, 0, 0), want: nil},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.want, validateRegistration(tt.email, tt.username, tt.password, tt.dob, now))
+		})
+	}
+}
+
+func TestAuthController_Register(t *testing.T) {
+	mailer := mail.NewMemoryMailer()
+	a := NewAuthController(authStore, oauthStore, mailer, "https://syncapod.com", Hasher{}, nil)
+	dob := time.Now().AddDate(-20, 0, 0)
+	u, err := a.Register(context.Background(), "testRegister@syncapod.com", "testRegister", "password123", dob)
+	require.Nil(t, err)
+	require.Empty(t, u.PasswordHash)
+	require.NotNil(t, mailer.Last("testRegister@syncapod.com"))
+	_, err = a.Register(context.Background(), "testRegister@syncapod.com", "testRegister2", "password123", dob)
+	require.Equal(t, ErrEmailTaken, err)
+	_, err = a.Register(context.Background(), "testRegister2@syncapod.com", "testRegister", "password123", dob)
+	require.Equal(t, ErrUsernameTaken, err)
+}
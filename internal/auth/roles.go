@@ -0,0 +1,49 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/sschwartz96/syncapod-backend/internal/db"
+)
+
+// ErrInvalidRole is returned when parsing a name which is not a role
+var ErrInvalidRole = errors.New("role must be user, moderator or admin")
+
+// roleRanks orders the roles, a role includes every role of a lower rank
+var roleRanks = map[db.Role]int{
+	db.RoleUser:      1,
+	db.RoleModerator: 2,
+	db.RoleAdmin:     3,
+}
+
+// ParseRole returns the role with the name
+func ParseRole(name string) (db.Role, error) {
+	role := db.Role(name)
+	if _, ok := roleRanks[role]; !ok {
+		return "", ErrInvalidRole
+	}
+	return role, nil
+}
+
+// HasRole returns whether the role of the user includes the role
+func HasRole(user *db.UserRow, role db.Role) bool {
+	rank, ok := roleRanks[user.Role]
+	required, known := roleRanks[role]
+	return ok && known && rank >= required
+}
+
+// SetRole changes the role of the user with the username or email, used to bootstrap the first admin
+func (a *AuthController) SetRole(ctx context.Context, username string, role db.Role) (*db.UserRow, error) {
+	user, err := a.findUserByEmailOrUsername(ctx, username)
+	if err != nil {
+		return nil, fmt.Errorf("AuthController.SetRole() error finding user: %v", err)
+	}
+	if err = a.authStore.SetUserRole(ctx, user.ID, role); err != nil {
+		return nil, fmt.Errorf("AuthController.SetRole() error: %v", err)
+	}
+	user.Role = role
+	user.PasswordHash = []byte{}
+	return user, nil
+}
@@ -0,0 +1,64 @@
+package auth
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/sschwartz96/syncapod-backend/internal/db"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseRole(t *testing.T) {
+	role, err := ParseRole("moderator")
+	require.Nil(t, err)
+	require.Equal(t, db.RoleModerator, role)
+	_, err = ParseRole("owner")
+	require.Equal(t, ErrInvalidRole, err)
+	_, err = ParseRole("")
+	require.Equal(t, ErrInvalidRole, err)
+}
+
+func TestHasRole(t *testing.T) {
+	tests := []struct {
+		name string
+		user db.Role
+		role db.Role
+		want bool
+	}{
+		{name: "user", user: db.RoleUser, role: db.RoleUser, want: true},
+		{name: "user moderator", user: db.RoleUser, role: db.RoleModerator},
+		{name: "moderator", user: db.RoleModerator, role: db.RoleModerator, want: true},
+		{name: "moderator admin", user: db.RoleModerator, role: db.RoleAdmin},
+		{name: "admin user", user: db.RoleAdmin, role: db.RoleUser, want: true},
+		{name: "admin", user: db.RoleAdmin, role: db.RoleAdmin, want: true},
+		{name: "no role", user: "", role: db.RoleUser},
+		{name: "unknown role", user: db.RoleAdmin, role: "owner"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.want, HasRole(&db.UserRow{Role: tt.user}, tt.role))
+		})
+	}
+}
+
+func TestAuthController_SetRole(t *testing.T) {
+	ctx := context.Background()
+	a := NewAuthController(authStore, oauthStore, nil, "", Hasher{}, nil)
+	user, err := a.CreateUser(ctx, "roles@test.auth", "rolesTestAuth", "password1", time.Unix(0, 0))
+	require.Nil(t, err)
+	require.Equal(t, db.RoleUser, user.Role)
+
+	_, err = a.SetRole(ctx, "unknownRolesTestAuth", db.RoleAdmin)
+	require.NotNil(t, err)
+	admin, err := a.SetRole(ctx, "roles@test.auth", db.RoleAdmin)
+	require.Nil(t, err)
+	require.Equal(t, db.RoleAdmin, admin.Role)
+
+	// the role is loaded with the session
+	_, session, err := a.Login(ctx, user.Username, "password1", "testAgent", "")
+	require.Nil(t, err)
+	authorized, _, err := a.Authorize(ctx, session.Key)
+	require.Nil(t, err)
+	require.Equal(t, db.RoleAdmin, authorized.Role)
+}
@@ -0,0 +1,89 @@
+package auth
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/sschwartz96/syncapod-backend/internal/db"
+)
+
+// Errors of the session methods, returned as is so they may be shown to the user
+var (
+	// ErrSessionNotFound is returned when revoking a session which is unknown or not the user's
+	ErrSessionNotFound = errors.New("session not found")
+	// ErrGrantNotFound is returned when revoking the grant of a client without access
+	ErrGrantNotFound = errors.New("no access was granted to the client")
+)
+
+// SessionHandle identifies a session when listed, so the internal session id
+// is never shown to the user
+func SessionHandle(id uuid.UUID) string {
+	sum := sha256.Sum256(id[:])
+	return hex.EncodeToString(sum[:16])
+}
+
+// ListSessions returns the unexpired sessions of the user, most recently seen first
+func (a *AuthController) ListSessions(ctx context.Context, userID uuid.UUID) ([]db.SessionRow, error) {
+	sessions, err := a.authStore.FindUserSessions(ctx, userID, time.Now())
+	if err != nil {
+		return nil, fmt.Errorf("AuthController.ListSessions() error: %v", err)
+	}
+	return sessions, nil
+}
+
+// RevokeSession deletes the session of the user with the handle, see SessionHandle()
+func (a *AuthController) RevokeSession(ctx context.Context, userID uuid.UUID, handle string) error {
+	sessions, err := a.authStore.FindUserSessions(ctx, userID, time.Time{})
+	if err != nil {
+		return fmt.Errorf("AuthController.RevokeSession() error: %v", err)
+	}
+	for i := range sessions {
+		if SessionHandle(sessions[i].ID) == handle {
+			deleted, err := a.authStore.DeleteUserSession(ctx, userID, sessions[i].ID)
+			if err != nil {
+				return fmt.Errorf("AuthController.RevokeSession() error: %v", err)
+			}
+			if deleted {
+				return nil
+			}
+		}
+	}
+	return ErrSessionNotFound
+}
+
+// RevokeAllOtherSessions deletes every session of the user except the current one,
+// returns the amount revoked
+func (a *AuthController) RevokeAllOtherSessions(ctx context.Context, userID, currentID uuid.UUID) (int64, error) {
+	revoked, err := a.authStore.DeleteOtherUserSessions(ctx, userID, currentID)
+	if err != nil {
+		return 0, fmt.Errorf("AuthController.RevokeAllOtherSessions() error: %v", err)
+	}
+	return revoked, nil
+}
+
+// ListGrants returns the oauth clients, such as voice assistants, the user granted access to
+func (a *AuthController) ListGrants(ctx context.Context, userID uuid.UUID) ([]db.OAuthGrant, error) {
+	grants, err := a.oauthStore.FindUserGrants(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("AuthController.ListGrants() error: %v", err)
+	}
+	return grants, nil
+}
+
+// RevokeGrant unlinks the oauth client by deleting its tokens and codes of the user,
+// the client must be authorized again to regain access
+func (a *AuthController) RevokeGrant(ctx context.Context, userID uuid.UUID, clientID string) error {
+	deleted, err := a.oauthStore.DeleteUserGrant(ctx, userID, clientID)
+	if err != nil {
+		return fmt.Errorf("AuthController.RevokeGrant() error: %v", err)
+	}
+	if !deleted {
+		return ErrGrantNotFound
+	}
+	return nil
+}
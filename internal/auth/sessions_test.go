@@ -0,0 +1,71 @@
+package auth
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAuthController_Sessions(t *testing.T) {
+	ctx := context.Background()
+	a := NewAuthController(authStore, oauthStore, nil, "", Hasher{}, nil)
+	user, err := a.CreateUser(ctx, "sessions@test.auth", "sessionsTestAuth", "password1", time.Unix(0, 0))
+	require.Nil(t, err)
+	_, phone, err := a.Login(ctx, user.Username, "password1", "phone", "")
+	require.Nil(t, err)
+	_, laptop, err := a.Login(ctx, user.Username, "password1", "laptop", "")
+	require.Nil(t, err)
+	_, tablet, err := a.Login(ctx, user.Username, "password1", "tablet", "")
+	require.Nil(t, err)
+
+	sessions, err := a.ListSessions(ctx, user.ID)
+	require.Nil(t, err)
+	require.Len(t, sessions, 3)
+	require.NotEqual(t, SessionHandle(phone.ID), SessionHandle(laptop.ID))
+	require.NotContains(t, SessionHandle(phone.ID), phone.ID.String())
+
+	// sessions are revoked by handle, only by their user
+	require.Equal(t, ErrSessionNotFound, a.RevokeSession(ctx, user.ID, "unknown"))
+	require.Equal(t, ErrSessionNotFound, a.RevokeSession(ctx, uuid.New(), SessionHandle(phone.ID)))
+	require.Nil(t, a.RevokeSession(ctx, user.ID, SessionHandle(phone.ID)))
+	_, _, err = a.Authorize(ctx, phone.Key)
+	require.NotNil(t, err)
+
+	revoked, err := a.RevokeAllOtherSessions(ctx, user.ID, laptop.ID)
+	require.Nil(t, err)
+	require.Equal(t, int64(1), revoked)
+	_, _, err = a.Authorize(ctx, tablet.Key)
+	require.NotNil(t, err)
+	_, _, err = a.Authorize(ctx, laptop.Key)
+	require.Nil(t, err)
+}
+
+func TestAuthController_Grants(t *testing.T) {
+	ctx := context.Background()
+	a := NewAuthController(authStore, oauthStore, nil, "", Hasher{}, nil)
+	user, err := a.CreateUser(ctx, "grants@test.auth", "grantsTestAuth", "password1", time.Unix(0, 0))
+	require.Nil(t, err)
+	authCode, err := a.CreateAuthCode(ctx, user.ID, "alexaClient")
+	require.Nil(t, err)
+	token, err := a.CreateAccessToken(ctx, authCode)
+	require.Nil(t, err)
+	require.Equal(t, "alexaClient", token.ClientID)
+
+	grants, err := a.ListGrants(ctx, user.ID)
+	require.Nil(t, err)
+	require.Len(t, grants, 1)
+	require.Equal(t, "alexaClient", grants[0].ClientID)
+
+	require.Equal(t, ErrGrantNotFound, a.RevokeGrant(ctx, user.ID, "googleClient"))
+	require.Nil(t, a.RevokeGrant(ctx, user.ID, "alexaClient"))
+	_, err = a.ValidateAccessToken(ctx, token.Key)
+	require.NotNil(t, err)
+	_, err = a.ValidateRefreshToken(ctx, token.RefreshKey)
+	require.NotNil(t, err)
+	grants, err = a.ListGrants(ctx, user.ID)
+	require.Nil(t, err)
+	require.Empty(t, grants)
+}
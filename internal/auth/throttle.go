@@ -0,0 +1,201 @@
+package auth
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/sschwartz96/syncapod-backend/internal/db"
+)
+
+// ThrottlePolicy delays the logins of a key with exponentially growing delays
+// after repeated failures and locks it out after too many
+type ThrottlePolicy struct {
+	FreeAttempts    int           // failures before delays start
+	BaseDelay       time.Duration // delay after the first counted failure, doubled by each following one
+	MaxDelay        time.Duration
+	LockoutFailures int // failures locking the key out
+	LockoutDuration time.Duration
+	Window          time.Duration // failures are forgotten after this long without one
+}
+
+// Default policies, an IP may be shared by many users
+var (
+	AccountThrottlePolicy = ThrottlePolicy{FreeAttempts: 3, BaseDelay: time.Second, MaxDelay: time.Minute,
+		LockoutFailures: 10, LockoutDuration: time.Minute * 15, Window: time.Hour}
+	IPThrottlePolicy = ThrottlePolicy{FreeAttempts: 20, BaseDelay: time.Second, MaxDelay: time.Minute,
+		LockoutFailures: 100, LockoutDuration: time.Minute * 15, Window: time.Hour}
+)
+
+// Password reset policies, every request counts as it sends an email
+var (
+	ResetAddressThrottlePolicy = ThrottlePolicy{FreeAttempts: 3, BaseDelay: time.Minute, MaxDelay: time.Minute * 15,
+		LockoutFailures: 10, LockoutDuration: time.Hour, Window: time.Hour}
+	ResetIPThrottlePolicy = ThrottlePolicy{FreeAttempts: 10, BaseDelay: time.Second * 10, MaxDelay: time.Minute * 15,
+		LockoutFailures: 50, LockoutDuration: time.Hour, Window: time.Hour}
+)
+
+// ThrottledError is returned when a login is attempted too soon after failed ones,
+// or a password reset too soon after previous ones
+type ThrottledError struct {
+	RetryAfter time.Duration
+	Locked     bool
+}
+
+func (e *ThrottledError) Error() string {
+	if e.Locked {
+		return fmt.Sprintf("too many attempts, locked out for %v", e.RetryAfter.Round(time.Second))
+	}
+	return fmt.Sprintf("too many attempts, retry in %v", e.RetryAfter.Round(time.Second))
+}
+
+// wait returns how long the key must wait after its failures before the next attempt
+func (p ThrottlePolicy) wait(f *db.LoginFailures, now time.Time) *ThrottledError {
+	if f.Count < p.FreeAttempts || now.Sub(f.LastFailure) > p.Window {
+		return nil
+	}
+	locked := f.Count >= p.LockoutFailures
+	delay := p.LockoutDuration
+	if !locked {
+		delay = p.BaseDelay
+		for i := p.FreeAttempts; i < f.Count && delay < p.MaxDelay; i++ {
+			delay *= 2
+		}
+		if delay > p.MaxDelay {
+			delay = p.MaxDelay
+		}
+	}
+	until := f.LastFailure.Add(delay)
+	if !until.After(now) {
+		return nil
+	}
+	return &ThrottledError{RetryAfter: until.Sub(now), Locked: locked}
+}
+
+// Throttler limits the login attempts per account and per IP and the
+// password reset requests per address and per IP, a nil Throttler never throttles
+type Throttler struct {
+	store        db.LimiterStore
+	account      ThrottlePolicy
+	ip           ThrottlePolicy
+	resetAddress ThrottlePolicy
+	resetIP      ThrottlePolicy
+}
+
+// NewThrottler creates a throttler with the default policies, nil if store is nil
+func NewThrottler(store db.LimiterStore) *Throttler {
+	if store == nil {
+		return nil
+	}
+	return &Throttler{store: store, account: AccountThrottlePolicy, ip: IPThrottlePolicy,
+		resetAddress: ResetAddressThrottlePolicy, resetIP: ResetIPThrottlePolicy}
+}
+
+func accountKey(userID uuid.UUID) string {
+	return "account:" + userID.String()
+}
+
+func ipKey(ip string) string {
+	return "ip:" + ip
+}
+
+func resetAddressKey(email string) string {
+	return "reset:" + strings.ToLower(email)
+}
+
+func resetIPKey(ip string) string {
+	return "reset-ip:" + ip
+}
+
+// reserveIP reserves a login attempt of the ip, returns a *ThrottledError if it must wait.
+// An empty ip is never throttled
+func (t *Throttler) reserveIP(ctx context.Context, ip string) error {
+	if t == nil || ip == "" {
+		return nil
+	}
+	return t.reserve(ctx, ipKey(ip), t.ip)
+}
+
+// reserveAccount reserves a login attempt of the account, returns a *ThrottledError if it must wait
+func (t *Throttler) reserveAccount(ctx context.Context, userID uuid.UUID) error {
+	if t == nil {
+		return nil
+	}
+	return t.reserve(ctx, accountKey(userID), t.account)
+}
+
+// reserve counts the attempt of the key as a failure before it is made, so concurrent attempts
+// can't pass the check together. Only returns a *ThrottledError, in which case nothing is counted,
+// attempts are not blocked by errors of the store
+func (t *Throttler) reserve(ctx context.Context, key string, p ThrottlePolicy) error {
+	now := time.Now()
+	var throttled *ThrottledError
+	_, _, err := t.store.ReserveLoginAttempt(ctx, key, now, now.Add(-p.Window), func(f *db.LoginFailures) bool {
+		throttled = p.wait(f, now)
+		return throttled != nil
+	})
+	if err != nil {
+		log.Printf("Throttler.reserve() error: %v\n", err)
+		return nil
+	}
+	if throttled != nil {
+		return throttled
+	}
+	return nil
+}
+
+// refund gives back a reservation of an attempt that was not made
+func (t *Throttler) refund(ctx context.Context, key string) {
+	if err := t.store.RefundLoginAttempt(ctx, key); err != nil {
+		log.Printf("Throttler.refund() error: %v\n", err)
+	}
+}
+
+// cancel gives back the reservations of the account, if known, and the ip when the
+// attempt was not made, ie: it was throttled by the other key or failed with an error
+func (t *Throttler) cancel(ctx context.Context, userID *uuid.UUID, ip string) {
+	if t == nil {
+		return
+	}
+	if userID != nil {
+		t.refund(ctx, accountKey(*userID))
+	}
+	if ip != "" {
+		t.refund(ctx, ipKey(ip))
+	}
+}
+
+// succeed forgets the failures of the account after a successful login and gives back the
+// reservation of the ip, whose failures are kept so a valid account can't be used to reset them
+func (t *Throttler) succeed(ctx context.Context, userID uuid.UUID, ip string) {
+	if t == nil {
+		return
+	}
+	if err := t.store.ResetLoginFailures(ctx, accountKey(userID)); err != nil {
+		log.Printf("Throttler.succeed() error: %v\n", err)
+	}
+	if ip != "" {
+		t.refund(ctx, ipKey(ip))
+	}
+}
+
+// requestReset counts a password reset request of the address and the ip, an empty ip
+// is never throttled. Returns a *ThrottledError without counting it if either must wait
+func (t *Throttler) requestReset(ctx context.Context, email, ip string) error {
+	if t == nil {
+		return nil
+	}
+	if err := t.reserve(ctx, resetAddressKey(email), t.resetAddress); err != nil {
+		return err
+	}
+	if ip != "" {
+		if err := t.reserve(ctx, resetIPKey(ip), t.resetIP); err != nil {
+			t.refund(ctx, resetAddressKey(email))
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,168 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/sschwartz96/syncapod-backend/internal/db"
+	"github.com/sschwartz96/syncapod-backend/internal/mail"
+	"github.com/stretchr/testify/require"
+)
+
+func TestThrottlePolicy_wait(t *testing.T) {
+	p := ThrottlePolicy{FreeAttempts: 2, BaseDelay: time.Second, MaxDelay: time.Second * 5,
+		LockoutFailures: 6, LockoutDuration: time.Minute, Window: time.Hour}
+	now := time.Unix(10000, 0)
+	tests := []struct {
+		name        string
+		count       int
+		lastFailure time.Time
+		want        *ThrottledError
+	}{
+		{name: "none", count: 0, lastFailure: now},
+		{name: "free", count: 1, lastFailure: now},
+		{name: "first delay", count: 2, lastFailure: now, want: &ThrottledError{RetryAfter: time.Second}},
+		{name: "doubled", count: 3, lastFailure: now, want: &ThrottledError{RetryAfter: time.Second * 2}},
+		{name: "partly waited", count: 3, lastFailure: now.Add(-time.Second), want: &ThrottledError{RetryAfter: time.Second}},
+		{name: "waited", count: 3, lastFailure: now.Add(-time.Second * 2)},
+		{name: "max delay", count: 5, lastFailure: now, want: &ThrottledError{RetryAfter: time.Second * 5}},
+		{name: "locked", count: 6, lastFailure: now, want: &ThrottledError{RetryAfter: time.Minute, Locked: true}},
+		{name: "lockout over", count: 6, lastFailure: now.Add(-time.Minute)},
+		{name: "forgotten", count: 100, lastFailure: now.Add(-time.Hour * 2)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.want, p.wait(&db.LoginFailures{Count: tt.count, LastFailure: tt.lastFailure}, now))
+		})
+	}
+}
+
+func TestAuthController_throttling(t *testing.T) {
+	a := NewAuthController(authStore, oauthStore, nil, "", Hasher{}, db.NewMemoryLimiterStore())
+	user, err := a.CreateUser(context.Background(), "throttle@test.auth", "throttleTestAuth", "password1", time.Unix(0, 0))
+	require.Nil(t, err)
+
+	// success resets the failures of the account
+	for i := 0; i < AccountThrottlePolicy.FreeAttempts-1; i++ {
+		_, err = a.Authenticate(context.Background(), user.Username, "wrong", "testAgent", "10.0.0.1")
+		require.NotNil(t, err)
+	}
+	_, err = a.Authenticate(context.Background(), user.Email, "password1", "testAgent", "10.0.0.1")
+	require.Nil(t, err)
+
+	// throttled even with the correct password, whether by username or email
+	for i := 0; i < AccountThrottlePolicy.FreeAttempts; i++ {
+		_, err = a.Authenticate(context.Background(), user.Username, "wrong", "testAgent", "10.0.0.2")
+		require.NotNil(t, err)
+	}
+	var throttled *ThrottledError
+	_, _, err = a.Login(context.Background(), user.Email, "password1", "testAgent", "10.0.0.3")
+	require.True(t, errors.As(err, &throttled))
+	require.False(t, throttled.Locked)
+
+	// failures are audited, throttled attempts are not
+	var count int
+	err = dbpg.QueryRow(context.Background(),
+		"SELECT COUNT(*) FROM FailedLogins WHERE user_id=$1 AND reason=$2", user.ID, string(db.FailedLoginIncorrectPassword)).Scan(&count)
+	require.Nil(t, err)
+	require.Equal(t, AccountThrottlePolicy.FreeAttempts*2-1, count)
+	err = dbpg.QueryRow(context.Background(), "SELECT COUNT(*) FROM FailedLogins WHERE user_id=$1", user.ID).Scan(&count)
+	require.Nil(t, err)
+	require.Equal(t, AccountThrottlePolicy.FreeAttempts*2-1, count)
+
+	// unknown accounts count towards the ip
+	for i := 0; i < IPThrottlePolicy.FreeAttempts; i++ {
+		_, err = a.Authenticate(context.Background(), "unknownThrottle", "wrong", "testAgent", "10.0.0.4")
+		require.NotNil(t, err)
+	}
+	_, err = a.Authenticate(context.Background(), getTestUser.Username, "pass", "testAgent", "10.0.0.4")
+	require.True(t, errors.As(err, &throttled))
+	_, err = a.Authenticate(context.Background(), getTestUser.Username, "pass", "testAgent", "10.0.0.5")
+	require.Nil(t, err)
+}
+
+func TestAuthController_resetThrottling(t *testing.T) {
+	mailer := mail.NewMemoryMailer()
+	a := NewAuthController(authStore, oauthStore, mailer, "", Hasher{}, db.NewMemoryLimiterStore())
+
+	// per address, whether registered or not and regardless of case
+	for i := 0; i < ResetAddressThrottlePolicy.FreeAttempts; i++ {
+		require.Nil(t, a.RequestPasswordReset(context.Background(), "resetThrottle@test.auth", "10.0.1.1"))
+	}
+	var throttled *ThrottledError
+	err := a.RequestPasswordReset(context.Background(), "RESETTHROTTLE@test.auth", "10.0.1.2")
+	require.True(t, errors.As(err, &throttled))
+
+	// per ip
+	for i := 0; i < ResetIPThrottlePolicy.FreeAttempts; i++ {
+		require.Nil(t, a.RequestPasswordReset(context.Background(), fmt.Sprintf("reset%d@test.auth", i), "10.0.1.3"))
+	}
+	err = a.RequestPasswordReset(context.Background(), "resetOther@test.auth", "10.0.1.3")
+	require.True(t, errors.As(err, &throttled))
+	require.Nil(t, a.RequestPasswordReset(context.Background(), "resetOther@test.auth", "10.0.1.4"))
+}
+
+func TestAuthController_concurrentThrottling(t *testing.T) {
+	a := NewAuthController(authStore, oauthStore, nil, "", Hasher{}, db.NewMemoryLimiterStore())
+	user, err := a.CreateUser(context.Background(), "concurrent@test.auth", "concurrentTestAuth", "password1", time.Unix(0, 0))
+	require.Nil(t, err)
+
+	// concurrent attempts are checked against each other, only the free ones are made
+	attempts := AccountThrottlePolicy.FreeAttempts * 3
+	errs := make(chan error, attempts)
+	for i := 0; i < attempts; i++ {
+		go func(i int) {
+			_, err := a.Authenticate(context.Background(), user.Username, "wrong", "testAgent", fmt.Sprintf("10.0.2.%d", i))
+			errs <- err
+		}(i)
+	}
+	throttledCount := 0
+	for i := 0; i < attempts; i++ {
+		var throttled *ThrottledError
+		if errors.As(<-errs, &throttled) {
+			throttledCount++
+		}
+	}
+	require.Equal(t, attempts-AccountThrottlePolicy.FreeAttempts, throttledCount)
+}
+
+func TestAuthController_reauthenticateThrottling(t *testing.T) {
+	a := NewAuthController(authStore, oauthStore, nil, "", Hasher{}, db.NewMemoryLimiterStore())
+	user, err := a.CreateUser(context.Background(), "reauth@test.auth", "reauthTestAuth", "password1", time.Unix(0, 0))
+	require.Nil(t, err)
+
+	for i := 0; i < AccountThrottlePolicy.FreeAttempts; i++ {
+		require.Equal(t, ErrIncorrectPassword, a.ChangePassword(context.Background(), user.ID, "wrong", "newPassword1"))
+	}
+	var throttled *ThrottledError
+	require.True(t, errors.As(a.ChangePassword(context.Background(), user.ID, "password1", "newPassword1"), &throttled))
+	require.True(t, errors.As(a.DeleteAccount(context.Background(), user.ID, "password1"), &throttled))
+}
+
+func TestAuthController_twoFactorThrottling(t *testing.T) {
+	ctx := context.Background()
+	a := NewAuthController(authStore, oauthStore, nil, "", Hasher{}, db.NewMemoryLimiterStore())
+	user, err := a.CreateUser(ctx, "throttle2fa@test.auth", "throttleTwoFactorTestAuth", "password1", time.Unix(0, 0))
+	require.Nil(t, err)
+	encoded, _, err := a.EnrollTwoFactor(ctx, user.ID)
+	require.Nil(t, err)
+	secret, err := base32NoPadding.DecodeString(encoded)
+	require.Nil(t, err)
+	_, err = a.ConfirmTwoFactor(ctx, user.ID, totpCode(secret, totpStep(time.Now())))
+	require.Nil(t, err)
+
+	// a correct password doesn't forget the incorrect codes
+	var required *TwoFactorRequiredError
+	for i := 0; i < AccountThrottlePolicy.FreeAttempts; i++ {
+		_, _, err = a.Login(ctx, user.Username, "password1", "testAgent", "")
+		require.True(t, errors.As(err, &required))
+		_, _, err = a.VerifyTwoFactor(ctx, required.Challenge, "not a code", "testAgent", "")
+		require.Equal(t, ErrIncorrectCode, err)
+	}
+	var throttled *ThrottledError
+	_, _, err = a.Login(ctx, user.Username, "password1", "testAgent", "")
+	require.True(t, errors.As(err, &throttled))
+}
@@ -0,0 +1,74 @@
+package auth
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_totpCode(t *testing.T) {
+	// test vectors of RFC 6238 appendix B, truncated to 6 digits
+	secret := []byte("12345678901234567890")
+	tests := []struct {
+		unix int64
+		code string
+	}{
+		{59, "287082"},
+		{1111111109, "081804"},
+		{1111111111, "050471"},
+		{1234567890, "005924"},
+		{2000000000, "279037"},
+	}
+	for _, tt := range tests {
+		require.Equal(t, tt.code, totpCode(secret, totpStep(time.Unix(tt.unix, 0))), tt.unix)
+	}
+}
+
+func Test_validateTOTP(t *testing.T) {
+	secret := []byte("12345678901234567890")
+	now := time.Unix(1111111109, 0)
+	step := totpStep(now)
+
+	got, ok := validateTOTP(secret, "081804", now)
+	require.True(t, ok)
+	require.Equal(t, step, got)
+	got, ok = validateTOTP(secret, "081 804", now)
+	require.True(t, ok)
+	require.Equal(t, step, got)
+
+	// one step of skew either way
+	got, ok = validateTOTP(secret, totpCode(secret, step-1), now)
+	require.True(t, ok)
+	require.Equal(t, step-1, got)
+	_, ok = validateTOTP(secret, totpCode(secret, step+1), now)
+	require.True(t, ok)
+	_, ok = validateTOTP(secret, totpCode(secret, step+2), now)
+	require.False(t, ok)
+
+	for _, code := range []string{"", "08180", "0818045", "abcdef", "000000"} {
+		_, ok = validateTOTP(secret, code, now)
+		require.False(t, ok, code)
+	}
+}
+
+func Test_provisioningURI(t *testing.T) {
+	uri := provisioningURI([]byte("12345678901234567890"), "some user")
+	require.Equal(t, "otpauth://totp/syncapod:some%20user?algorithm=SHA1&digits=6&issuer=syncapod&period=30&secret=GEZDGNBVGY3TQOJQGEZDGNBVGY3TQOJQ", uri)
+}
+
+func Test_recoveryCode(t *testing.T) {
+	code, err := createRecoveryCode()
+	require.Nil(t, err)
+	require.Len(t, code, recoveryCodeLength+1)
+	require.Equal(t, "-", code[recoveryCodeLength/2:recoveryCodeLength/2+1])
+	other, err := createRecoveryCode()
+	require.Nil(t, err)
+	require.NotEqual(t, code, other)
+
+	// case, dashes and spaces are ignored
+	require.Equal(t, hashRecoveryCode(code), hashRecoveryCode(strings.ToUpper(code)))
+	require.Equal(t, hashRecoveryCode(code), hashRecoveryCode(strings.Replace(code, "-", " ", 1)))
+	require.NotEqual(t, hashRecoveryCode(code), hashRecoveryCode(other))
+}
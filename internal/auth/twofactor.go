@@ -0,0 +1,216 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v4"
+	"github.com/sschwartz96/syncapod-backend/internal/db"
+)
+
+const twoFactorChallengeExpiry = time.Minute * 10
+
+// Errors of the two factor methods, returned as is so they may be shown to the user
+var (
+	// ErrTwoFactorRequired is returned by Authenticate when the account requires a second factor
+	ErrTwoFactorRequired = errors.New("two factor authentication is required")
+	// ErrTwoFactorEnabled is returned when enrolling an account which already has two factor enabled
+	ErrTwoFactorEnabled = errors.New("two factor authentication is already enabled")
+	// ErrTwoFactorNotEnabled is returned when confirming or disabling two factor which was not set up
+	ErrTwoFactorNotEnabled = errors.New("two factor authentication is not enabled")
+	// ErrInvalidChallenge is returned when the login challenge is unknown, already completed or expired
+	ErrInvalidChallenge = errors.New("the login has expired, please sign in again")
+	// ErrIncorrectCode is returned when the TOTP or recovery code is invalid or already used
+	ErrIncorrectCode = errors.New("incorrect code")
+)
+
+// TwoFactorRequiredError is returned by Login when the password is correct but the account
+// requires a second factor, the challenge is passed to VerifyTwoFactor along with the code
+type TwoFactorRequiredError struct {
+	Challenge string
+}
+
+func (e *TwoFactorRequiredError) Error() string {
+	return ErrTwoFactorRequired.Error()
+}
+
+func (e *TwoFactorRequiredError) Is(target error) bool {
+	return target == ErrTwoFactorRequired
+}
+
+// EnrollTwoFactor creates a new TOTP secret for the user, returned base32 encoded and as a
+// provisioning URI. It is only enabled once confirmed with a code by ConfirmTwoFactor
+func (a *AuthController) EnrollTwoFactor(ctx context.Context, userID uuid.UUID) (string, string, error) {
+	user, err := a.authStore.GetUserByID(ctx, userID)
+	if err != nil {
+		return "", "", fmt.Errorf("AuthController.EnrollTwoFactor() error finding user: %v", err)
+	}
+	enabled, err := a.twoFactorEnabled(ctx, userID)
+	if err != nil {
+		return "", "", fmt.Errorf("AuthController.EnrollTwoFactor() error: %v", err)
+	}
+	if enabled {
+		return "", "", ErrTwoFactorEnabled
+	}
+	secret, err := createKey(totpSecretLength)
+	if err != nil {
+		return "", "", fmt.Errorf("AuthController.EnrollTwoFactor() error creating secret: %v", err)
+	}
+	err = a.authStore.UpsertTwoFactor(ctx, &db.TwoFactorRow{UserID: userID, Secret: secret, Created: time.Now()})
+	if err != nil {
+		return "", "", fmt.Errorf("AuthController.EnrollTwoFactor() error: %v", err)
+	}
+	return base32NoPadding.EncodeToString(secret), provisioningURI(secret, user.Username), nil
+}
+
+// ConfirmTwoFactor enables the enrolled secret if the code is valid,
+// returns the recovery codes which are only stored hashed
+func (a *AuthController) ConfirmTwoFactor(ctx context.Context, userID uuid.UUID, code string) ([]string, error) {
+	tf, err := a.authStore.FindTwoFactor(ctx, userID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, ErrTwoFactorNotEnabled
+	}
+	if err != nil {
+		return nil, fmt.Errorf("AuthController.ConfirmTwoFactor() error: %v", err)
+	}
+	if tf.Enabled {
+		return nil, ErrTwoFactorEnabled
+	}
+	step, ok := validateTOTP(tf.Secret, code, time.Now())
+	if !ok {
+		return nil, ErrIncorrectCode
+	}
+	if _, err = a.authStore.UseTwoFactorStep(ctx, userID, step); err != nil {
+		return nil, fmt.Errorf("AuthController.ConfirmTwoFactor() error: %v", err)
+	}
+	codes := make([]string, recoveryCodeCount)
+	hashes := make([][]byte, recoveryCodeCount)
+	for i := range codes {
+		if codes[i], err = createRecoveryCode(); err != nil {
+			return nil, fmt.Errorf("AuthController.ConfirmTwoFactor() error creating recovery code: %v", err)
+		}
+		hashes[i] = hashRecoveryCode(codes[i])
+	}
+	if err = a.authStore.EnableTwoFactor(ctx, userID, hashes); err != nil {
+		return nil, fmt.Errorf("AuthController.ConfirmTwoFactor() error: %v", err)
+	}
+	return codes, nil
+}
+
+// DisableTwoFactor removes the secret and recovery codes of the user,
+// requires the password and a TOTP or recovery code
+func (a *AuthController) DisableTwoFactor(ctx context.Context, userID uuid.UUID, password, code string) error {
+	if _, err := a.reauthenticate(ctx, userID, password); err != nil {
+		return err
+	}
+	tf, err := a.authStore.FindTwoFactor(ctx, userID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return ErrTwoFactorNotEnabled
+	}
+	if err != nil {
+		return fmt.Errorf("AuthController.DisableTwoFactor() error: %v", err)
+	}
+	if tf.Enabled {
+		ok, err := a.checkSecondFactor(ctx, tf, code)
+		if err != nil {
+			return fmt.Errorf("AuthController.DisableTwoFactor() error: %v", err)
+		}
+		if !ok {
+			return ErrIncorrectCode
+		}
+	}
+	if err = a.authStore.DeleteTwoFactor(ctx, userID); err != nil {
+		return fmt.Errorf("AuthController.DisableTwoFactor() error: %v", err)
+	}
+	return nil
+}
+
+// VerifyTwoFactor completes a login challenged by a *TwoFactorRequiredError with a TOTP or recovery code
+// and creates the session. Incorrect codes are throttled like incorrect passwords,
+// the challenge can be retried until it expires
+func (a *AuthController) VerifyTwoFactor(ctx context.Context, challenge, code, agent, ip string) (*db.UserRow, *db.SessionRow, error) {
+	attempt := &db.FailedLoginRow{IP: ip, UserAgent: agent}
+	if err := a.throttler.reserveIP(ctx, ip); err != nil {
+		return nil, nil, err
+	}
+	t, err := a.findUserToken(ctx, challenge, db.TokenTwoFactor)
+	if err != nil {
+		a.throttler.cancel(ctx, nil, ip)
+		if errors.Is(err, ErrInvalidToken) {
+			return nil, nil, ErrInvalidChallenge
+		}
+		return nil, nil, err
+	}
+	user, err := a.authStore.GetUserByID(ctx, t.UserID)
+	if err != nil {
+		a.throttler.cancel(ctx, nil, ip)
+		return nil, nil, fmt.Errorf("AuthController.VerifyTwoFactor() error finding user: %v", err)
+	}
+	attempt.UserID, attempt.Identifier = &user.ID, user.Username
+	if err = a.throttler.reserveAccount(ctx, user.ID); err != nil {
+		a.throttler.cancel(ctx, nil, ip)
+		return nil, nil, err
+	}
+	tf, err := a.authStore.FindTwoFactor(ctx, user.ID)
+	if err != nil {
+		a.throttler.cancel(ctx, &user.ID, ip)
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil, ErrInvalidChallenge
+		}
+		return nil, nil, fmt.Errorf("AuthController.VerifyTwoFactor() error: %v", err)
+	}
+	ok, err := a.checkSecondFactor(ctx, tf, code)
+	if err != nil {
+		a.throttler.cancel(ctx, &user.ID, ip)
+		return nil, nil, fmt.Errorf("AuthController.VerifyTwoFactor() error: %v", err)
+	}
+	if !ok {
+		a.loginFailed(ctx, attempt, db.FailedLoginIncorrectCode)
+		return nil, nil, ErrIncorrectCode
+	}
+	// the challenge may only complete one login
+	if _, err = a.consumeUserToken(ctx, challenge, db.TokenTwoFactor); err != nil {
+		a.throttler.cancel(ctx, &user.ID, ip)
+		if errors.Is(err, ErrInvalidToken) {
+			return nil, nil, ErrInvalidChallenge
+		}
+		return nil, nil, err
+	}
+	a.throttler.succeed(ctx, user.ID, ip)
+	session, err := createSession(user.ID, agent)
+	if err != nil {
+		return nil, nil, fmt.Errorf("AuthController.VerifyTwoFactor() error creating session: %v", err)
+	}
+	if err = a.authStore.InsertSession(ctx, session); err != nil {
+		return nil, nil, fmt.Errorf("AuthController.VerifyTwoFactor() error inserting new session: %v", err)
+	}
+	user.PasswordHash = []byte{}
+	return user, session, nil
+}
+
+// twoFactorEnabled returns whether the user has a confirmed TOTP secret
+func (a *AuthController) twoFactorEnabled(ctx context.Context, userID uuid.UUID) (bool, error) {
+	tf, err := a.authStore.FindTwoFactor(ctx, userID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return tf.Enabled, nil
+}
+
+// checkSecondFactor validates the TOTP code, which may only be used once,
+// or consumes the recovery code
+func (a *AuthController) checkSecondFactor(ctx context.Context, tf *db.TwoFactorRow, code string) (bool, error) {
+	if step, ok := validateTOTP(tf.Secret, code, time.Now()); ok {
+		return a.authStore.UseTwoFactorStep(ctx, tf.UserID, step)
+	}
+	if code == "" {
+		return false, nil
+	}
+	return a.authStore.ConsumeRecoveryCode(ctx, tf.UserID, hashRecoveryCode(code))
+}
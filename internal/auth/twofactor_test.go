@@ -0,0 +1,80 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestAuthController_TwoFactor(t *testing.T) {
+	ctx := context.Background()
+	a := NewAuthController(authStore, oauthStore, nil, "", Hasher{}, nil)
+	user, err := a.CreateUser(ctx, "twofactor@test.auth", "twoFactorTestAuth", "password1", time.Unix(0, 0))
+	require.Nil(t, err)
+
+	// enrollment is pending until confirmed
+	_, err = a.ConfirmTwoFactor(ctx, user.ID, "000000")
+	require.Equal(t, ErrTwoFactorNotEnabled, err)
+	encoded, uri, err := a.EnrollTwoFactor(ctx, user.ID)
+	require.Nil(t, err)
+	require.True(t, strings.HasPrefix(uri, "otpauth://totp/syncapod:twoFactorTestAuth?"))
+	require.True(t, strings.Contains(uri, "secret="+encoded))
+	secret, err := base32NoPadding.DecodeString(encoded)
+	require.Nil(t, err)
+	_, err = a.Authenticate(ctx, user.Username, "password1", "testAgent", "")
+	require.Nil(t, err)
+
+	_, err = a.ConfirmTwoFactor(ctx, user.ID, "not a code")
+	require.Equal(t, ErrIncorrectCode, err)
+	step := totpStep(time.Now())
+	recoveryCodes, err := a.ConfirmTwoFactor(ctx, user.ID, totpCode(secret, step))
+	require.Nil(t, err)
+	require.Len(t, recoveryCodes, recoveryCodeCount)
+	_, _, err = a.EnrollTwoFactor(ctx, user.ID)
+	require.Equal(t, ErrTwoFactorEnabled, err)
+
+	// the password alone no longer creates a session
+	_, err = a.Authenticate(ctx, user.Username, "password1", "testAgent", "")
+	require.True(t, errors.Is(err, ErrTwoFactorRequired))
+	_, session, err := a.Login(ctx, user.Username, "password1", "testAgent", "")
+	require.Nil(t, session)
+	var required *TwoFactorRequiredError
+	require.True(t, errors.As(err, &required))
+	require.NotEmpty(t, required.Challenge)
+
+	// codes can't be replayed, incorrect codes may be retried
+	_, _, err = a.VerifyTwoFactor(ctx, required.Challenge, totpCode(secret, step), "testAgent", "")
+	require.Equal(t, ErrIncorrectCode, err)
+	_, _, err = a.VerifyTwoFactor(ctx, "unknown", totpCode(secret, step+1), "testAgent", "")
+	require.Equal(t, ErrInvalidChallenge, err)
+	verified, session, err := a.VerifyTwoFactor(ctx, required.Challenge, totpCode(secret, step+1), "testAgent", "")
+	require.Nil(t, err)
+	require.Equal(t, user.ID, verified.ID)
+	require.Equal(t, user.ID, session.UserID)
+	_, _, err = a.VerifyTwoFactor(ctx, required.Challenge, recoveryCodes[0], "testAgent", "")
+	require.Equal(t, ErrInvalidChallenge, err)
+
+	// recovery codes may only be used once
+	_, _, err = a.Login(ctx, user.Username, "password1", "testAgent", "")
+	require.True(t, errors.As(err, &required))
+	_, session, err = a.VerifyTwoFactor(ctx, required.Challenge, strings.ToUpper(recoveryCodes[0]), "testAgent", "")
+	require.Nil(t, err)
+	require.NotNil(t, session)
+	_, _, err = a.Login(ctx, user.Username, "password1", "testAgent", "")
+	require.True(t, errors.As(err, &required))
+	_, _, err = a.VerifyTwoFactor(ctx, required.Challenge, recoveryCodes[0], "testAgent", "")
+	require.Equal(t, ErrIncorrectCode, err)
+
+	// disabling requires the password and a code
+	require.Equal(t, ErrIncorrectPassword, a.DisableTwoFactor(ctx, user.ID, "wrong", recoveryCodes[1]))
+	require.Equal(t, ErrIncorrectCode, a.DisableTwoFactor(ctx, user.ID, "password1", recoveryCodes[0]))
+	require.Nil(t, a.DisableTwoFactor(ctx, user.ID, "password1", recoveryCodes[1]))
+	require.Equal(t, ErrTwoFactorNotEnabled, a.DisableTwoFactor(ctx, user.ID, "password1", recoveryCodes[2]))
+	_, session, err = a.Login(ctx, user.Username, "password1", "testAgent", "")
+	require.Nil(t, err)
+	require.NotNil(t, session)
+}
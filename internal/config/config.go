@@ -19,6 +19,10 @@ const (
 	portDefault            = 3030
 	grpcPortDefault        = 50051
 	grpcGatewayPortDefault = 50052
+	smtpPortDefault        = 587
+	baseURLDefault         = "https://syncapod.com"
+	passwordHasherDefault  = "bcrypt"
+	bcryptCostDefault      = 12
 )
 
 // Config holds variables for our server
@@ -39,6 +43,17 @@ type Config struct {
 	Production      bool   `json:"production"`
 	CertDir         string `json:"cert_dir"` // only used if production=true
 	Debug           bool   `json:"debug"`
+	BaseURL         string `json:"base_url"`  // used to build links sent by email
+	SMTPHost        string `json:"smtp_host"` // emails are not sent if empty
+	SMTPPort        int    `json:"smtp_port"`
+	SMTPUser        string `json:"smtp_user,omitempty"`
+	SMTPPass        string `json:"smtp_pass,omitempty"` // env:SMTP_PASS
+	SMTPFrom        string `json:"smtp_from"`
+	PasswordHasher  string `json:"password_hasher"` // bcrypt or argon2id
+	BcryptCost      int    `json:"bcrypt_cost"`
+	Argon2Time      uint32 `json:"argon2_time"`    // 0 uses the default of 3
+	Argon2Memory    uint32 `json:"argon2_memory"`  // KiB, 0 uses the default of 64MiB
+	Argon2Threads   uint8  `json:"argon2_threads"` // 0 uses the default of 4
 }
 
 // ReadConfig reads the config file encoded in JSON
@@ -52,6 +67,10 @@ func ReadConfig(r io.Reader) (*Config, error) {
 		Port:            portDefault,
 		GRPCPort:        grpcPortDefault,
 		GRPCGatewayPort: grpcGatewayPortDefault,
+		SMTPPort:        smtpPortDefault,
+		BaseURL:         baseURLDefault,
+		PasswordHasher:  passwordHasherDefault,
+		BcryptCost:      bcryptCostDefault,
 	}
 	// Unmarshal into config var
 	err := json.NewDecoder(r).Decode(config)
@@ -83,4 +102,8 @@ func readEnv(cfg *Config) {
 	if len(dbName) > 0 {
 		cfg.DbName = dbName
 	}
+	smtpPass := os.Getenv("SMTP_PASS")
+	if len(smtpPass) > 0 {
+		cfg.SMTPPass = smtpPass
+	}
 }
@@ -33,6 +33,10 @@ var mockConfigObj = &Config{
 	DbPort:          5432,
 	Production:      false,
 	MigrationsDir:   "/syncapod/migrations",
+	SMTPPort:        587,
+	BaseURL:         "https://syncapod.com",
+	PasswordHasher:  "bcrypt",
+	BcryptCost:      12,
 }
 
 func TestReadConfig(t *testing.T) {
@@ -2,12 +2,25 @@ package db
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"time"
 
 	"github.com/google/uuid"
+	"github.com/jackc/pgconn"
+	"github.com/jackc/pgx/v4"
 	"github.com/jackc/pgx/v4/pgxpool"
 )
 
+// uniqueViolation is the postgres error code of a unique constraint violation
+const uniqueViolation = "23505"
+
+// Errors of InsertUser(), UpdateUser() and UpdateUserEmail() when the email or username is already taken
+var (
+	ErrDuplicateEmail    = errors.New("email already exists")
+	ErrDuplicateUsername = errors.New("username already exists")
+)
+
 type AuthStorePG struct {
 	db *pgxpool.Pool
 }
@@ -16,22 +29,48 @@ func NewAuthStorePG(db *pgxpool.Pool) *AuthStorePG {
 	return &AuthStorePG{db: db}
 }
 
+// userColumns are the columns of a user row aliased u, see scanUserRow()
+const userColumns = "u.id,u.email,u.username,u.birthdate,u.password_hash,u.created,u.last_seen,u.email_verified,u.role"
+
+// scanUserRow is a helper method to scan a row selected with userColumns into a user struct
+func scanUserRow(row scanner, u *UserRow) error {
+	return row.Scan(&u.ID, &u.Email, &u.Username, &u.Birthdate, &u.PasswordHash, &u.Created, &u.LastSeen, &u.EmailVerified, &u.Role)
+}
+
 // User
+// InsertUser inserts the user with RoleUser, see SetUserRole()
 func (a *AuthStorePG) InsertUser(ctx context.Context, u *UserRow) error {
 	_, err := a.db.Exec(ctx,
 		"INSERT INTO Users (id,email,username,birthdate,password_hash, created, last_seen) VALUES($1,$2,$3,$4,$5,$6,$7)",
 		&u.ID, &u.Email, &u.Username, &u.Birthdate, &u.PasswordHash, &u.Created, &u.LastSeen)
+	if dupErr := duplicateUserError(err); dupErr != nil {
+		return fmt.Errorf("InsertUser() error: %w", dupErr)
+	}
 	if err != nil {
 		return fmt.Errorf("InsertUser() error: %v", err)
 	}
 	return nil
 }
 
+// duplicateUserError returns ErrDuplicateEmail or ErrDuplicateUsername if err
+// violates a unique constraint of the column, either exact or case insensitive, otherwise nil
+func duplicateUserError(err error) error {
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) && pgErr.Code == uniqueViolation {
+		switch pgErr.ConstraintName {
+		case "users_email_key", "users_email_lower_key":
+			return ErrDuplicateEmail
+		case "users_username_key", "users_username_lower_key":
+			return ErrDuplicateUsername
+		}
+	}
+	return nil
+}
+
 func (a *AuthStorePG) GetUserByID(ctx context.Context, id uuid.UUID) (*UserRow, error) {
 	u := &UserRow{}
-	row := a.db.QueryRow(ctx, "SELECT * FROM Users WHERE id=$1", id)
-	err := row.Scan(&u.ID, &u.Email, &u.Username, &u.Birthdate, &u.PasswordHash, &u.Created, &u.LastSeen)
-	if err != nil {
+	row := a.db.QueryRow(ctx, "SELECT "+userColumns+" FROM Users u WHERE u.id=$1", id)
+	if err := scanUserRow(row, u); err != nil {
 		return nil, fmt.Errorf("GetUserByID() error: %v", err)
 	}
 	return u, nil
@@ -39,9 +78,8 @@ func (a *AuthStorePG) GetUserByID(ctx context.Context, id uuid.UUID) (*UserRow,
 
 func (a *AuthStorePG) GetUserByEmail(ctx context.Context, email string) (*UserRow, error) {
 	u := &UserRow{}
-	row := a.db.QueryRow(ctx, "SELECT * FROM Users WHERE LOWER(email)=LOWER($1)", email)
-	err := row.Scan(&u.ID, &u.Email, &u.Username, &u.Birthdate, &u.PasswordHash, &u.Created, &u.LastSeen)
-	if err != nil {
+	row := a.db.QueryRow(ctx, "SELECT "+userColumns+" FROM Users u WHERE LOWER(u.email)=LOWER($1)", email)
+	if err := scanUserRow(row, u); err != nil {
 		return nil, fmt.Errorf("GetUserByEmail() error: %v", err)
 	}
 	return u, nil
@@ -49,9 +87,8 @@ func (a *AuthStorePG) GetUserByEmail(ctx context.Context, email string) (*UserRo
 
 func (a *AuthStorePG) GetUserByUsername(ctx context.Context, username string) (*UserRow, error) {
 	u := &UserRow{}
-	row := a.db.QueryRow(ctx, "SELECT * FROM Users WHERE LOWER(username)=LOWER($1)", username)
-	err := row.Scan(&u.ID, &u.Email, &u.Username, &u.Birthdate, &u.PasswordHash, &u.Created, &u.LastSeen)
-	if err != nil {
+	row := a.db.QueryRow(ctx, "SELECT "+userColumns+" FROM Users u WHERE LOWER(u.username)=LOWER($1)", username)
+	if err := scanUserRow(row, u); err != nil {
 		return nil, fmt.Errorf("GetUserByUsername() error: %v", err)
 	}
 	return u, nil
@@ -61,12 +98,27 @@ func (a *AuthStorePG) UpdateUser(ctx context.Context, u *UserRow) error {
 	_, err := a.db.Exec(ctx,
 		"UPDATE Users SET email=$2,username=$3,birthdate=$4,last_seen=$5 WHERE id=$1",
 		&u.ID, &u.Email, &u.Username, &u.Birthdate, &u.LastSeen)
+	if dupErr := duplicateUserError(err); dupErr != nil {
+		return fmt.Errorf("UpdateUser() error: %w", dupErr)
+	}
 	if err != nil {
 		return fmt.Errorf("UpdateUser() error: %v", err)
 	}
 	return nil
 }
 
+// UpdateUserEmail changes the email of the user, which then needs to be verified again
+func (a *AuthStorePG) UpdateUserEmail(ctx context.Context, id uuid.UUID, email string) error {
+	_, err := a.db.Exec(ctx, "UPDATE Users SET email=$2,email_verified=FALSE WHERE id=$1", id, email)
+	if dupErr := duplicateUserError(err); dupErr != nil {
+		return fmt.Errorf("UpdateUserEmail() error: %w", dupErr)
+	}
+	if err != nil {
+		return fmt.Errorf("UpdateUserEmail() error: %v", err)
+	}
+	return nil
+}
+
 func (a AuthStorePG) UpdateUserPassword(ctx context.Context, id uuid.UUID, password_hash []byte) error {
 	_, err := a.db.Exec(ctx,
 		"UPDATE Users SET password_hash=$1 WHERE id=$2",
@@ -77,6 +129,39 @@ func (a AuthStorePG) UpdateUserPassword(ctx context.Context, id uuid.UUID, passw
 	return nil
 }
 
+// UpdateUserPasswordHash replaces the password hash of the user only if it is still old,
+// returns false if it was changed in the meantime
+func (a *AuthStorePG) UpdateUserPasswordHash(ctx context.Context, id uuid.UUID, old, new []byte) (bool, error) {
+	tag, err := a.db.Exec(ctx,
+		"UPDATE Users SET password_hash=$3 WHERE id=$1 AND password_hash=$2",
+		id, old, new)
+	if err != nil {
+		return false, fmt.Errorf("UpdateUserPasswordHash() error: %v", err)
+	}
+	return tag.RowsAffected() == 1, nil
+}
+
+// SetEmailVerified marks the email of the user as verified
+func (a *AuthStorePG) SetEmailVerified(ctx context.Context, id uuid.UUID) error {
+	_, err := a.db.Exec(ctx, "UPDATE Users SET email_verified=TRUE WHERE id=$1", id)
+	if err != nil {
+		return fmt.Errorf("SetEmailVerified() error: %v", err)
+	}
+	return nil
+}
+
+// SetUserRole changes the role of the user, returns pgx.ErrNoRows if the user doesn't exist
+func (a *AuthStorePG) SetUserRole(ctx context.Context, id uuid.UUID, role Role) error {
+	tag, err := a.db.Exec(ctx, "UPDATE Users SET role=$2 WHERE id=$1", id, string(role))
+	if err != nil {
+		return fmt.Errorf("SetUserRole() error: %v", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return fmt.Errorf("SetUserRole() error: %w", pgx.ErrNoRows)
+	}
+	return nil
+}
+
 func (a *AuthStorePG) DeleteUser(ctx context.Context, id uuid.UUID) error {
 	_, err := a.db.Exec(ctx, "DELETE FROM Users WHERE id=$1", id)
 	if err != nil {
@@ -85,11 +170,14 @@ func (a *AuthStorePG) DeleteUser(ctx context.Context, id uuid.UUID) error {
 	return nil
 }
 
+// sessionColumns are the columns of a session row aliased s, see scanSessionRow()
+const sessionColumns = "s.id,s.user_id,s.login_time,s.last_seen_time,s.expires,s.user_agent,s.key_hash"
+
 // Session
 func (a *AuthStorePG) InsertSession(ctx context.Context, s *SessionRow) error {
 	_, err := a.db.Exec(ctx,
-		"INSERT INTO Sessions (id,user_id,login_time,last_seen_time,expires,user_agent) VALUES($1,$2,$3,$4,$5,$6)",
-		s.ID, s.UserID, s.LoginTime, s.LastSeenTime, s.Expires, s.UserAgent)
+		"INSERT INTO Sessions (id,user_id,login_time,last_seen_time,expires,user_agent,key_hash) VALUES($1,$2,$3,$4,$5,$6,$7)",
+		s.ID, s.UserID, s.LoginTime, s.LastSeenTime, s.Expires, s.UserAgent, s.KeyHash)
 	if err != nil {
 		return fmt.Errorf("InsertSession() error: %v", err)
 	}
@@ -98,8 +186,8 @@ func (a *AuthStorePG) InsertSession(ctx context.Context, s *SessionRow) error {
 
 func (a *AuthStorePG) GetSession(ctx context.Context, id uuid.UUID) (*SessionRow, error) {
 	s := &SessionRow{}
-	row := a.db.QueryRow(ctx, "SELECT * FROM Sessions WHERE id=$1", id)
-	err := row.Scan(&s.ID, &s.UserID, &s.LoginTime, &s.LastSeenTime, &s.Expires, &s.UserAgent)
+	row := a.db.QueryRow(ctx, "SELECT "+sessionColumns+" FROM Sessions s WHERE s.id=$1", id)
+	err := row.Scan(&s.ID, &s.UserID, &s.LoginTime, &s.LastSeenTime, &s.Expires, &s.UserAgent, &s.KeyHash)
 	if err != nil {
 		return nil, fmt.Errorf("GetSession() error: %v", err)
 	}
@@ -125,19 +213,171 @@ func (a *AuthStorePG) DeleteSession(ctx context.Context, id uuid.UUID) error {
 	return nil
 }
 
-func (a *AuthStorePG) GetSessionAndUser(ctx context.Context, sessionID uuid.UUID) (*SessionRow, *UserRow, error) {
+// DeleteSessionByKey deletes the session with the digest of its key
+func (a *AuthStorePG) DeleteSessionByKey(ctx context.Context, keyHash []byte) error {
+	_, err := a.db.Exec(ctx, "DELETE FROM Sessions WHERE key_hash=$1", keyHash)
+	if err != nil {
+		return fmt.Errorf("DeleteSessionByKey() error: %v", err)
+	}
+	return nil
+}
+
+// DeleteUserSessions deletes every session of the user
+func (a *AuthStorePG) DeleteUserSessions(ctx context.Context, userID uuid.UUID) error {
+	_, err := a.db.Exec(ctx, "DELETE FROM Sessions WHERE user_id=$1", userID)
+	if err != nil {
+		return fmt.Errorf("DeleteUserSessions() error: %v", err)
+	}
+	return nil
+}
+
+// FindUserSessions returns the sessions of the user not expired by now, most recently seen first
+func (a *AuthStorePG) FindUserSessions(ctx context.Context, userID uuid.UUID, now time.Time) ([]SessionRow, error) {
+	rows, err := a.db.Query(ctx,
+		"SELECT id,user_id,login_time,last_seen_time,expires,user_agent FROM Sessions WHERE user_id=$1 AND expires > $2 ORDER BY last_seen_time DESC",
+		userID, now)
+	if err != nil {
+		return nil, fmt.Errorf("FindUserSessions() error: %v", err)
+	}
+	defer rows.Close()
+	sessions := []SessionRow{}
+	for rows.Next() {
+		s := SessionRow{}
+		if err = rows.Scan(&s.ID, &s.UserID, &s.LoginTime, &s.LastSeenTime, &s.Expires, &s.UserAgent); err != nil {
+			return nil, fmt.Errorf("FindUserSessions() error scanning row: %v", err)
+		}
+		sessions = append(sessions, s)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("FindUserSessions() error while reading: %v", err)
+	}
+	return sessions, nil
+}
+
+// DeleteUserSession deletes the session if it belongs to the user, returns false if it doesn't exist
+func (a *AuthStorePG) DeleteUserSession(ctx context.Context, userID, id uuid.UUID) (bool, error) {
+	tag, err := a.db.Exec(ctx, "DELETE FROM Sessions WHERE user_id=$1 AND id=$2", userID, id)
+	if err != nil {
+		return false, fmt.Errorf("DeleteUserSession() error: %v", err)
+	}
+	return tag.RowsAffected() == 1, nil
+}
+
+// DeleteOtherUserSessions deletes every session of the user except keepID, returns the amount deleted
+func (a *AuthStorePG) DeleteOtherUserSessions(ctx context.Context, userID, keepID uuid.UUID) (int64, error) {
+	tag, err := a.db.Exec(ctx, "DELETE FROM Sessions WHERE user_id=$1 AND id<>$2", userID, keepID)
+	if err != nil {
+		return 0, fmt.Errorf("DeleteOtherUserSessions() error: %v", err)
+	}
+	return tag.RowsAffected(), nil
+}
+
+// GetSessionAndUser finds the session with the digest of its key and its user
+func (a *AuthStorePG) GetSessionAndUser(ctx context.Context, keyHash []byte) (*SessionRow, *UserRow, error) {
 	s := &SessionRow{}
 	u := &UserRow{}
 	result := a.db.QueryRow(ctx,
-		"SELECT * FROM Sessions s JOIN Users u ON s.user_id=u.id WHERE s.id=$1",
-		&sessionID,
+		"SELECT "+sessionColumns+","+userColumns+" FROM Sessions s JOIN Users u ON s.user_id=u.id WHERE s.key_hash=$1",
+		keyHash,
 	)
 	err := result.Scan(
-		&s.ID, &s.UserID, &s.LoginTime, &s.LastSeenTime, &s.Expires, &s.UserAgent,
-		&u.ID, &u.Email, &u.Username, &u.Birthdate, &u.PasswordHash, &u.Created, &u.LastSeen,
+		&s.ID, &s.UserID, &s.LoginTime, &s.LastSeenTime, &s.Expires, &s.UserAgent, &s.KeyHash,
+		&u.ID, &u.Email, &u.Username, &u.Birthdate, &u.PasswordHash, &u.Created, &u.LastSeen, &u.EmailVerified, &u.Role,
 	)
 	if err != nil {
 		return nil, nil, fmt.Errorf("GetSessionAndUser() error: %v", err)
 	}
 	return s, u, nil
 }
+
+// User Token
+func (a *AuthStorePG) InsertUserToken(ctx context.Context, t *UserTokenRow) error {
+	_, err := a.db.Exec(ctx,
+		"INSERT INTO UserTokens (token_hash,user_id,purpose,created,expires) VALUES($1,$2,$3,$4,$5)",
+		t.TokenHash, t.UserID, string(t.Purpose), t.Created, t.Expires)
+	if err != nil {
+		return fmt.Errorf("InsertUserToken() error: %v", err)
+	}
+	return nil
+}
+
+// ConsumeUserToken deletes and returns the token of the purpose, so it may only be used once,
+// the caller checks whether it expired
+func (a *AuthStorePG) ConsumeUserToken(ctx context.Context, tokenHash []byte, purpose TokenPurpose) (*UserTokenRow, error) {
+	t := &UserTokenRow{TokenHash: tokenHash, Purpose: purpose}
+	err := a.db.QueryRow(ctx,
+		"DELETE FROM UserTokens WHERE token_hash=$1 AND purpose=$2 RETURNING user_id,created,expires",
+		tokenHash, string(purpose),
+	).Scan(&t.UserID, &t.Created, &t.Expires)
+	if err != nil {
+		return nil, fmt.Errorf("ConsumeUserToken() error: %w", err)
+	}
+	return t, nil
+}
+
+// FindUserToken returns the token of the purpose without consuming it,
+// the caller checks whether it expired
+func (a *AuthStorePG) FindUserToken(ctx context.Context, tokenHash []byte, purpose TokenPurpose) (*UserTokenRow, error) {
+	t := &UserTokenRow{TokenHash: tokenHash, Purpose: purpose}
+	err := a.db.QueryRow(ctx,
+		"SELECT user_id,created,expires FROM UserTokens WHERE token_hash=$1 AND purpose=$2",
+		tokenHash, string(purpose),
+	).Scan(&t.UserID, &t.Created, &t.Expires)
+	if err != nil {
+		return nil, fmt.Errorf("FindUserToken() error: %w", err)
+	}
+	return t, nil
+}
+
+// DeleteUserTokens deletes the user's tokens of the purpose
+func (a *AuthStorePG) DeleteUserTokens(ctx context.Context, userID uuid.UUID, purpose TokenPurpose) error {
+	_, err := a.db.Exec(ctx, "DELETE FROM UserTokens WHERE user_id=$1 AND purpose=$2", userID, string(purpose))
+	if err != nil {
+		return fmt.Errorf("DeleteUserTokens() error: %v", err)
+	}
+	return nil
+}
+
+// ResetUserPassword consumes the reset password token, sets the password of its user and
+// deletes the user's sessions and access tokens in one transaction. Returns the user's id,
+// or pgx.ErrNoRows if the token doesn't exist or expired by now
+func (a *AuthStorePG) ResetUserPassword(ctx context.Context, tokenHash, passwordHash []byte, now time.Time) (uuid.UUID, error) {
+	tx, err := a.db.Begin(ctx)
+	if err != nil {
+		return uuid.Nil, fmt.Errorf("ResetUserPassword() error beginning transaction: %v", err)
+	}
+	defer tx.Rollback(ctx)
+	var userID uuid.UUID
+	err = tx.QueryRow(ctx,
+		"DELETE FROM UserTokens WHERE token_hash=$1 AND purpose=$2 AND expires > $3 RETURNING user_id",
+		tokenHash, string(TokenResetPassword), now,
+	).Scan(&userID)
+	if err != nil {
+		return uuid.Nil, fmt.Errorf("ResetUserPassword() error consuming token: %w", err)
+	}
+	if _, err = tx.Exec(ctx, "UPDATE Users SET password_hash=$1 WHERE id=$2", passwordHash, userID); err != nil {
+		return uuid.Nil, fmt.Errorf("ResetUserPassword() error updating password: %v", err)
+	}
+	if _, err = tx.Exec(ctx, "DELETE FROM Sessions WHERE user_id=$1", userID); err != nil {
+		return uuid.Nil, fmt.Errorf("ResetUserPassword() error deleting sessions: %v", err)
+	}
+	if _, err = tx.Exec(ctx, "DELETE FROM AccessTokens WHERE user_id=$1", userID); err != nil {
+		return uuid.Nil, fmt.Errorf("ResetUserPassword() error deleting access tokens: %v", err)
+	}
+	if err = tx.Commit(ctx); err != nil {
+		return uuid.Nil, fmt.Errorf("ResetUserPassword() error committing: %v", err)
+	}
+	return userID, nil
+}
+
+// Failed Login
+func (a *AuthStorePG) InsertFailedLogin(ctx context.Context, f *FailedLoginRow) error {
+	err := a.db.QueryRow(ctx,
+		"INSERT INTO FailedLogins (user_id,identifier,ip,user_agent,reason,created) VALUES($1,$2,$3,$4,$5,$6) RETURNING id",
+		f.UserID, f.Identifier, f.IP, f.UserAgent, string(f.Reason), f.Created,
+	).Scan(&f.ID)
+	if err != nil {
+		return fmt.Errorf("InsertFailedLogin() error: %v", err)
+	}
+	return nil
+}
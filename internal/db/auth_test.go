@@ -2,6 +2,7 @@ package db
 
 import (
 	"context"
+	"errors"
 	"log"
 	"os"
 	"reflect"
@@ -9,8 +10,10 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/jackc/pgx/v4"
 	"github.com/jackc/pgx/v4/pgxpool"
 	"github.com/sschwartz96/syncapod-backend/internal"
+	"github.com/stretchr/testify/require"
 )
 
 var (
@@ -99,7 +102,7 @@ func TestAuthStorePG_GetUserByID(t *testing.T) {
 			name:    "valid",
 			args:    args{ctx: context.Background(), id: getUserID},
 			fields:  fields{db: dbpg},
-			want:    &UserRow{ID: getUserID, Email: "get@test.test", Username: "get", Birthdate: time.Unix(0, 0).UTC(), PasswordHash: []byte("pass"), Created: time.Unix(0, 0), LastSeen: time.Unix(0, 0)},
+			want:    &UserRow{ID: getUserID, Email: "get@test.test", Username: "get", Birthdate: time.Unix(0, 0).UTC(), PasswordHash: []byte("pass"), Created: time.Unix(0, 0), LastSeen: time.Unix(0, 0), Role: RoleUser},
 			wantErr: false,
 		},
 	}
@@ -142,7 +145,7 @@ func TestAuthStorePG_GetUserByEmail(t *testing.T) {
 				email: "get@test.test",
 			},
 			fields:  fields{db: dbpg},
-			want:    &UserRow{ID: getUserID, Email: "get@test.test", Username: "get", Birthdate: time.Unix(0, 0).UTC(), PasswordHash: []byte("pass"), Created: time.Unix(0, 0), LastSeen: time.Unix(0, 0)},
+			want:    &UserRow{ID: getUserID, Email: "get@test.test", Username: "get", Birthdate: time.Unix(0, 0).UTC(), PasswordHash: []byte("pass"), Created: time.Unix(0, 0), LastSeen: time.Unix(0, 0), Role: RoleUser},
 			wantErr: false,
 		},
 	}
@@ -185,7 +188,7 @@ func TestAuthStorePG_GetUserByUsername(t *testing.T) {
 				username: "get",
 			},
 			fields:  fields{db: dbpg},
-			want:    &UserRow{ID: getUserID, Email: "get@test.test", Username: "get", Birthdate: time.Unix(0, 0).UTC(), PasswordHash: []byte("pass"), Created: time.Unix(0, 0), LastSeen: time.Unix(0, 0)},
+			want:    &UserRow{ID: getUserID, Email: "get@test.test", Username: "get", Birthdate: time.Unix(0, 0).UTC(), PasswordHash: []byte("pass"), Created: time.Unix(0, 0), LastSeen: time.Unix(0, 0), Role: RoleUser},
 			wantErr: false,
 		},
 	}
@@ -224,7 +227,7 @@ func TestAuthStorePG_UpdateUser(t *testing.T) {
 			name: "valid",
 			args: args{
 				ctx: context.Background(),
-				u:   &UserRow{ID: uuid.MustParse("b813c6e3-9cd0-4aed-9c4e-1d88ae20c777"), Email: "update@updated.test", Username: "updated", Birthdate: time.Unix(0, 0).UTC(), PasswordHash: []byte("pass"), Created: time.Unix(0, 0), LastSeen: time.Unix(0, 0)},
+				u:   &UserRow{ID: uuid.MustParse("b813c6e3-9cd0-4aed-9c4e-1d88ae20c777"), Email: "update@updated.test", Username: "updated", Birthdate: time.Unix(0, 0).UTC(), PasswordHash: []byte("pass"), Created: time.Unix(0, 0), LastSeen: time.Unix(0, 0), Role: RoleUser},
 			},
 			wantErr: false,
 			fields:  fields{db: dbpg},
@@ -354,7 +357,7 @@ func TestAuthStorePG_InsertSession(t *testing.T) {
 			args: args{
 				ctx: context.Background(),
 				s: &SessionRow{ID: uuid.MustParse("a113c6e3-9cd0-4aed-9c4e-1d87ae20c8ba"), UserID: getUserID,
-					Expires: time.Now(), LastSeenTime: time.Now(), LoginTime: time.Now(), UserAgent: "testAgent"},
+					Expires: time.Now(), LastSeenTime: time.Now(), LoginTime: time.Now(), UserAgent: "testAgent", KeyHash: []byte("insert_key")},
 			},
 			fields:  fields{db: dbpg},
 			wantErr: false,
@@ -392,7 +395,7 @@ func TestAuthStorePG_GetSession(t *testing.T) {
 			args:   args{ctx: context.Background(), id: uuid.MustParse("a813c6e3-9cd0-4aed-9c4e-1d87ae20c8ba")},
 			fields: fields{db: dbpg},
 			want: &SessionRow{ID: uuid.MustParse("a813c6e3-9cd0-4aed-9c4e-1d87ae20c8ba"), UserID: getUserID,
-				Expires: time.Unix(1000, 0), LastSeenTime: time.Unix(1000, 0), LoginTime: time.Unix(1000, 0), UserAgent: "testAgent"},
+				Expires: time.Unix(1000, 0), LastSeenTime: time.Unix(1000, 0), LoginTime: time.Unix(1000, 0), UserAgent: "testAgent", KeyHash: []byte("get_key")},
 			wantErr: false,
 		},
 	}
@@ -431,7 +434,7 @@ func TestAuthStorePG_UpdateSession(t *testing.T) {
 			name: "valid",
 			args: args{ctx: context.Background(),
 				s: &SessionRow{ID: uuid.MustParse("a813c6e3-9cd0-4aed-9c4e-1d87ae20c8bb"), UserID: getUserID,
-					Expires: time.Unix(1000, 0), LastSeenTime: time.Unix(1000, 0), LoginTime: time.Unix(1000, 0), UserAgent: "testAgentUpdated"},
+					Expires: time.Unix(1000, 0), LastSeenTime: time.Unix(1000, 0), LoginTime: time.Unix(1000, 0), UserAgent: "testAgentUpdated", KeyHash: []byte("update_key")},
 			},
 			fields:  fields{db: dbpg},
 			wantErr: false,
@@ -493,13 +496,24 @@ func TestAuthStorePG_DeleteSession(t *testing.T) {
 	}
 }
 
+func TestAuthStorePG_DeleteSessionByKey(t *testing.T) {
+	ctx := context.Background()
+	a := NewAuthStorePG(dbpg)
+	s := &SessionRow{ID: uuid.New(), UserID: getUserID, Expires: time.Now().Add(time.Hour), KeyHash: []byte("delete_by_key")}
+	insertSession(a, s)
+
+	require.Nil(t, a.DeleteSessionByKey(ctx, []byte("delete_by_key")))
+	_, err := a.GetSession(ctx, s.ID)
+	require.NotNil(t, err)
+}
+
 func TestAuthStorePG_GetSessionAndUser(t *testing.T) {
 	type fields struct {
 		db *pgxpool.Pool
 	}
 	type args struct {
-		ctx       context.Context
-		sessionID uuid.UUID
+		ctx     context.Context
+		keyHash []byte
 	}
 	tests := []struct {
 		name    string
@@ -512,8 +526,8 @@ func TestAuthStorePG_GetSessionAndUser(t *testing.T) {
 		{
 			name: "valid",
 			args: args{
-				ctx:       context.Background(),
-				sessionID: uuid.MustParse("a813c6e3-9cd0-4aed-9c4e-1d87ae20c8ba")},
+				ctx:     context.Background(),
+				keyHash: []byte("get_key")},
 			fields: fields{db: dbpg},
 			want: &SessionRow{
 				ID:           uuid.MustParse("a813c6e3-9cd0-4aed-9c4e-1d87ae20c8ba"),
@@ -522,12 +536,14 @@ func TestAuthStorePG_GetSessionAndUser(t *testing.T) {
 				LastSeenTime: time.Unix(1000, 0),
 				LoginTime:    time.Unix(1000, 0),
 				UserAgent:    "testAgent",
+				KeyHash:      []byte("get_key"),
 			},
 			want1: &UserRow{ID: getUserID,
 				Email: "get@test.test", Username: "get",
 				Birthdate: time.Unix(0, 0).UTC(), PasswordHash: []byte("pass"),
 				Created:  time.Unix(0, 0),
 				LastSeen: time.Unix(0, 0),
+				Role:     RoleUser,
 			},
 			wantErr: false,
 		},
@@ -537,7 +553,7 @@ func TestAuthStorePG_GetSessionAndUser(t *testing.T) {
 			a := &AuthStorePG{
 				db: tt.fields.db,
 			}
-			got, got1, err := a.GetSessionAndUser(tt.args.ctx, tt.args.sessionID)
+			got, got1, err := a.GetSessionAndUser(tt.args.ctx, tt.args.keyHash)
 			if (err != nil) != tt.wantErr {
 				t.Errorf("AuthStorePG.GetSessionAndUser() error = %v, wantErr %v", err, tt.wantErr)
 				return
@@ -569,13 +585,13 @@ func setupAuthDB() {
 
 	// test sessions
 	getSesh := &SessionRow{ID: uuid.MustParse("a813c6e3-9cd0-4aed-9c4e-1d87ae20c8ba"), UserID: getUserID,
-		Expires: time.Unix(1000, 0), LastSeenTime: time.Unix(1000, 0), LoginTime: time.Unix(1000, 0), UserAgent: "testAgent"}
+		Expires: time.Unix(1000, 0), LastSeenTime: time.Unix(1000, 0), LoginTime: time.Unix(1000, 0), UserAgent: "testAgent", KeyHash: []byte("get_key")}
 	insertSession(a, getSesh)
 	updateSesh := &SessionRow{ID: uuid.MustParse("a813c6e3-9cd0-4aed-9c4e-1d87ae20c8bb"), UserID: getUserID,
-		Expires: time.Unix(1000, 0), LastSeenTime: time.Unix(1000, 0), LoginTime: time.Unix(1000, 0), UserAgent: "testAgent"}
+		Expires: time.Unix(1000, 0), LastSeenTime: time.Unix(1000, 0), LoginTime: time.Unix(1000, 0), UserAgent: "testAgent", KeyHash: []byte("update_key")}
 	insertSession(a, updateSesh)
 	deleteSesh := &SessionRow{ID: uuid.MustParse("a813c6e3-9cd0-4aed-9c4e-1d87ae20c8bc"), UserID: getUserID,
-		Expires: time.Unix(1000, 0), LastSeenTime: time.Unix(1000, 0), LoginTime: time.Unix(1000, 0), UserAgent: "testAgent"}
+		Expires: time.Unix(1000, 0), LastSeenTime: time.Unix(1000, 0), LoginTime: time.Unix(1000, 0), UserAgent: "testAgent", KeyHash: []byte("delete_key")}
 	insertSession(a, deleteSesh)
 
 	o := &OAuthStorePG{db: dbpg}
@@ -593,6 +609,103 @@ func setupAuthDB() {
 	insertAccessToken(o, deleteToken)
 }
 
+func TestAuthStorePG_UpdateUserEmail(t *testing.T) {
+	a := NewAuthStorePG(dbpg)
+	user := &UserRow{ID: uuid.New(), Email: "email@test.test", Username: "emailUser", PasswordHash: []byte("shouldbehash")}
+	insertUser(a, user)
+	require.Nil(t, a.SetEmailVerified(context.Background(), user.ID))
+
+	require.Nil(t, a.UpdateUserEmail(context.Background(), user.ID, "changed@test.test"))
+	changed, err := a.GetUserByID(context.Background(), user.ID)
+	require.Nil(t, err)
+	require.Equal(t, "changed@test.test", changed.Email)
+	require.False(t, changed.EmailVerified)
+
+	err = a.UpdateUserEmail(context.Background(), user.ID, "get@test.test")
+	require.True(t, errors.Is(err, ErrDuplicateEmail))
+	err = a.UpdateUserEmail(context.Background(), user.ID, "GET@test.test")
+	require.True(t, errors.Is(err, ErrDuplicateEmail))
+	err = a.InsertUser(context.Background(), &UserRow{ID: uuid.New(), Email: "other@test.test", Username: "EMAILUSER", PasswordHash: []byte("shouldbehash")})
+	require.True(t, errors.Is(err, ErrDuplicateUsername))
+}
+
+func TestAuthStorePG_SetUserRole(t *testing.T) {
+	ctx := context.Background()
+	a := NewAuthStorePG(dbpg)
+	user := &UserRow{ID: uuid.New(), Email: "role@test.test", Username: "roleUser", PasswordHash: []byte("shouldbehash")}
+	insertUser(a, user)
+	found, err := a.GetUserByID(ctx, user.ID)
+	require.Nil(t, err)
+	require.Equal(t, RoleUser, found.Role)
+
+	require.Nil(t, a.SetUserRole(ctx, user.ID, RoleAdmin))
+	found, err = a.GetUserByID(ctx, user.ID)
+	require.Nil(t, err)
+	require.Equal(t, RoleAdmin, found.Role)
+
+	require.NotNil(t, a.SetUserRole(ctx, user.ID, Role("owner")))
+	require.True(t, errors.Is(a.SetUserRole(ctx, uuid.New(), RoleAdmin), pgx.ErrNoRows))
+}
+
+func TestAuthStorePG_UserSessions(t *testing.T) {
+	ctx := context.Background()
+	a := NewAuthStorePG(dbpg)
+	user := &UserRow{ID: uuid.New(), Email: "sessions@test.test", Username: "sessionsUser", PasswordHash: []byte("shouldbehash")}
+	insertUser(a, user)
+	now := time.Now()
+	phone := &SessionRow{ID: uuid.New(), UserID: user.ID, LastSeenTime: now.Add(-time.Hour), Expires: now.Add(time.Hour), UserAgent: "phone"}
+	laptop := &SessionRow{ID: uuid.New(), UserID: user.ID, LastSeenTime: now, Expires: now.Add(time.Hour), UserAgent: "laptop"}
+	expired := &SessionRow{ID: uuid.New(), UserID: user.ID, LastSeenTime: now, Expires: now.Add(-time.Hour), UserAgent: "expired"}
+	for _, s := range []*SessionRow{phone, laptop, expired} {
+		insertSession(a, s)
+	}
+
+	sessions, err := a.FindUserSessions(ctx, user.ID, now)
+	require.Nil(t, err)
+	require.Len(t, sessions, 2)
+	require.Equal(t, "laptop", sessions[0].UserAgent)
+	require.Equal(t, "phone", sessions[1].UserAgent)
+
+	// sessions of other users are not deleted
+	deleted, err := a.DeleteUserSession(ctx, getUserID, phone.ID)
+	require.Nil(t, err)
+	require.False(t, deleted)
+	deleted, err = a.DeleteUserSession(ctx, user.ID, phone.ID)
+	require.Nil(t, err)
+	require.True(t, deleted)
+
+	revoked, err := a.DeleteOtherUserSessions(ctx, user.ID, laptop.ID)
+	require.Nil(t, err)
+	require.Equal(t, int64(1), revoked)
+	sessions, err = a.FindUserSessions(ctx, user.ID, time.Time{})
+	require.Nil(t, err)
+	require.Len(t, sessions, 1)
+	require.Equal(t, laptop.ID, sessions[0].ID)
+}
+
+func Test_DeleteUserCascade(t *testing.T) {
+	ctx := context.Background()
+	a := NewAuthStorePG(dbpg)
+	podStore := NewPodcastStore(dbpg)
+	user := &UserRow{ID: uuid.New(), Email: "cascade@test.test", Username: "cascadeUser", PasswordHash: []byte("shouldbehash")}
+	pod := &Podcast{ID: uuid.New(), Title: "Cascade Test", Category: []int{}, RSSURL: "https://syncapod.com/cascade_test.rss"}
+	epi := &Episode{ID: uuid.New(), PodcastID: pod.ID, Title: "Cascade 1", PubDate: time.Unix(1, 0)}
+	insertUser(a, user)
+	insertPodcastOrFail(podStore, pod)
+	insertEpisodeOrFail(podStore, epi)
+	insertSubOrFail(podStore, &Subscription{UserID: user.ID, PodcastID: pod.ID})
+	insertSession(a, &SessionRow{ID: uuid.New(), UserID: user.ID, Expires: time.Now().Add(time.Hour)})
+	_, err := podStore.UpsertUserEpisodes(ctx, user.ID, []UserEpisode{{UserID: user.ID, EpisodeID: epi.ID, OffsetMillis: 1000, LastSeen: time.Now()}}, []bool{false})
+	require.Nil(t, err)
+
+	require.Nil(t, a.DeleteUser(ctx, user.ID))
+	for _, table := range []string{"Sessions", "Subscriptions", "UserEpisodes", "ChangeLog"} {
+		var count int
+		require.Nil(t, dbpg.QueryRow(ctx, "SELECT COUNT(*) FROM "+table+" WHERE user_id=$1", user.ID).Scan(&count))
+		require.Zero(t, count, table)
+	}
+}
+
 func insertUser(a *AuthStorePG, u *UserRow) {
 	err := a.InsertUser(context.Background(), u)
 	if err != nil {
@@ -601,7 +714,11 @@ func insertUser(a *AuthStorePG, u *UserRow) {
 	}
 }
 
+// insertSession inserts the session, sessions without a key hash get one from their id
 func insertSession(a *AuthStorePG, s *SessionRow) {
+	if s.KeyHash == nil {
+		s.KeyHash = s.ID[:]
+	}
 	err := a.InsertSession(context.Background(), s)
 	if err != nil {
 		log.Fatalln("db.auth_test.insertSession() error:", err)
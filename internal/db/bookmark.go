@@ -0,0 +1,117 @@
+package db
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+)
+
+// InsertBookmark inserts the user's bookmark
+func (ps *PodcastStore) InsertBookmark(ctx context.Context, b *Bookmark) error {
+	_, err := ps.db.Exec(ctx,
+		"INSERT INTO Bookmarks(id,user_id,episode_id,offset_millis,note,created) VALUES($1,$2,$3,$4,$5,$6)",
+		b.ID, b.UserID, b.EpisodeID, b.OffsetMillis, b.Note, b.Created)
+	if err != nil {
+		return fmt.Errorf("InsertBookmark() error: %v", err)
+	}
+	return nil
+}
+
+// FindBookmarks returns the user's bookmarks of the episode in order of offset,
+// every bookmark of the user newest first if epiID is uuid.Nil
+func (ps *PodcastStore) FindBookmarks(ctx context.Context, userID, epiID uuid.UUID) ([]Bookmark, error) {
+	rows, err := ps.db.Query(ctx,
+		`SELECT id,user_id,episode_id,offset_millis,note,created FROM Bookmarks
+		 WHERE user_id=$1 AND ($2=$3 OR episode_id=$2)
+		 ORDER BY CASE WHEN $2=$3 THEN 0 ELSE offset_millis END, created DESC`,
+		userID, epiID, uuid.Nil)
+	if err != nil {
+		return nil, fmt.Errorf("FindBookmarks() error: %v", err)
+	}
+	defer rows.Close()
+	bookmarks := []Bookmark{}
+	for rows.Next() {
+		b := Bookmark{}
+		if err = rows.Scan(&b.ID, &b.UserID, &b.EpisodeID, &b.OffsetMillis, &b.Note, &b.Created); err != nil {
+			return nil, fmt.Errorf("FindBookmarks() error scanning row: %v", err)
+		}
+		bookmarks = append(bookmarks, b)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("FindBookmarks() error while reading: %v", err)
+	}
+	return bookmarks, nil
+}
+
+// DeleteBookmark deletes the user's bookmark
+func (ps *PodcastStore) DeleteBookmark(ctx context.Context, userID, id uuid.UUID) error {
+	tag, err := ps.db.Exec(ctx, "DELETE FROM Bookmarks WHERE user_id=$1 AND id=$2", userID, id)
+	if err != nil {
+		return fmt.Errorf("DeleteBookmark() error: %v", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return fmt.Errorf("DeleteBookmark() error: bookmark not found")
+	}
+	return nil
+}
+
+// InsertClip inserts the user's clip
+func (ps *PodcastStore) InsertClip(ctx context.Context, c *Clip) error {
+	_, err := ps.db.Exec(ctx,
+		"INSERT INTO Clips(id,user_id,episode_id,start_millis,end_millis,title,created) VALUES($1,$2,$3,$4,$5,$6,$7)",
+		c.ID, c.UserID, c.EpisodeID, c.StartMillis, c.EndMillis, c.Title, c.Created)
+	if err != nil {
+		return fmt.Errorf("InsertClip() error: %v", err)
+	}
+	return nil
+}
+
+// FindClips returns the user's clips of the episode, every clip of the user
+// if epiID is uuid.Nil, newest first
+func (ps *PodcastStore) FindClips(ctx context.Context, userID, epiID uuid.UUID) ([]Clip, error) {
+	rows, err := ps.db.Query(ctx,
+		`SELECT id,user_id,episode_id,start_millis,end_millis,title,created FROM Clips
+		 WHERE user_id=$1 AND ($2=$3 OR episode_id=$2) ORDER BY created DESC`,
+		userID, epiID, uuid.Nil)
+	if err != nil {
+		return nil, fmt.Errorf("FindClips() error: %v", err)
+	}
+	defer rows.Close()
+	clips := []Clip{}
+	for rows.Next() {
+		c := Clip{}
+		if err = rows.Scan(&c.ID, &c.UserID, &c.EpisodeID, &c.StartMillis, &c.EndMillis, &c.Title, &c.Created); err != nil {
+			return nil, fmt.Errorf("FindClips() error scanning row: %v", err)
+		}
+		clips = append(clips, c)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("FindClips() error while reading: %v", err)
+	}
+	return clips, nil
+}
+
+// FindClip returns the clip of any user, clips are public
+func (ps *PodcastStore) FindClip(ctx context.Context, id uuid.UUID) (*Clip, error) {
+	c := &Clip{}
+	err := ps.db.QueryRow(ctx,
+		"SELECT id,user_id,episode_id,start_millis,end_millis,title,created FROM Clips WHERE id=$1", id,
+	).Scan(&c.ID, &c.UserID, &c.EpisodeID, &c.StartMillis, &c.EndMillis, &c.Title, &c.Created)
+	if err != nil {
+		return nil, fmt.Errorf("FindClip() error: %v", err)
+	}
+	return c, nil
+}
+
+// DeleteClip deletes the user's clip
+func (ps *PodcastStore) DeleteClip(ctx context.Context, userID, id uuid.UUID) error {
+	tag, err := ps.db.Exec(ctx, "DELETE FROM Clips WHERE user_id=$1 AND id=$2", userID, id)
+	if err != nil {
+		return fmt.Errorf("DeleteClip() error: %v", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return fmt.Errorf("DeleteClip() error: clip not found")
+	}
+	return nil
+}
@@ -0,0 +1,61 @@
+package db
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_BookmarksAndClips(t *testing.T) {
+	ctx := context.Background()
+	podStore := NewPodcastStore(dbpg)
+	user := &UserRow{ID: uuid.New(), Email: "bookmark@test.test", Username: "bookmarkUser", PasswordHash: []byte("shouldbehash")}
+	insertUser(NewAuthStorePG(dbpg), user)
+
+	// bookmarks of an episode are ordered by offset
+	late := &Bookmark{ID: uuid.New(), UserID: user.ID, EpisodeID: testEpi.ID, OffsetMillis: 1395000, Note: "23:15", Created: time.Now()}
+	early := &Bookmark{ID: uuid.New(), UserID: user.ID, EpisodeID: testEpi.ID, OffsetMillis: 1000, Created: time.Now()}
+	other := &Bookmark{ID: uuid.New(), UserID: user.ID, EpisodeID: testEpi2.ID, OffsetMillis: 5000, Created: time.Now()}
+	for _, b := range []*Bookmark{late, early, other} {
+		if err := podStore.InsertBookmark(ctx, b); err != nil {
+			t.Fatalf("Test_BookmarksAndClips() error inserting bookmark: %v", err)
+		}
+	}
+	bookmarks, err := podStore.FindBookmarks(ctx, user.ID, testEpi.ID)
+	if err != nil {
+		t.Fatalf("Test_BookmarksAndClips() error finding bookmarks: %v", err)
+	}
+	require.Len(t, bookmarks, 2)
+	require.Equal(t, early.ID, bookmarks[0].ID)
+	require.Equal(t, "23:15", bookmarks[1].Note)
+	bookmarks, err = podStore.FindBookmarks(ctx, user.ID, uuid.Nil)
+	if err != nil {
+		t.Fatalf("Test_BookmarksAndClips() error finding all bookmarks: %v", err)
+	}
+	require.Len(t, bookmarks, 3)
+	require.Nil(t, podStore.DeleteBookmark(ctx, user.ID, other.ID))
+	require.NotNil(t, podStore.DeleteBookmark(ctx, testUser.ID, late.ID))
+
+	// clips
+	clip := &Clip{ID: uuid.New(), UserID: user.ID, EpisodeID: testEpi.ID, StartMillis: 1000, EndMillis: 31000, Title: "clip", Created: time.Now()}
+	if err = podStore.InsertClip(ctx, clip); err != nil {
+		t.Fatalf("Test_BookmarksAndClips() error inserting clip: %v", err)
+	}
+	require.NotNil(t, podStore.InsertClip(ctx, &Clip{ID: uuid.New(), UserID: user.ID, EpisodeID: testEpi.ID, StartMillis: 2000, EndMillis: 1000}))
+	clips, err := podStore.FindClips(ctx, user.ID, uuid.Nil)
+	if err != nil {
+		t.Fatalf("Test_BookmarksAndClips() error finding clips: %v", err)
+	}
+	require.Len(t, clips, 1)
+	found, err := podStore.FindClip(ctx, clip.ID)
+	if err != nil {
+		t.Fatalf("Test_BookmarksAndClips() error finding clip: %v", err)
+	}
+	require.Equal(t, int64(31000), found.EndMillis)
+	require.Nil(t, podStore.DeleteClip(ctx, user.ID, clip.ID))
+	_, err = podStore.FindClip(ctx, clip.ID)
+	require.NotNil(t, err)
+}
@@ -0,0 +1,64 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+)
+
+// ErrChangesExpired is returned for positions older than the pruned changes
+var ErrChangesExpired = errors.New("changes since position expired")
+
+// FindChanges returns the user's changes from position since up to position until,
+// see FindChangePosition(). Repeated changes are collapsed into the latest one and
+// returned in order of seq, new episodes are returned for the user's subscriptions
+func (ps *PodcastStore) FindChanges(ctx context.Context, userID uuid.UUID, since, until int64) ([]Change, error) {
+	var pruned int64
+	err := ps.db.QueryRow(ctx, "SELECT txid FROM ChangeLogPruned").Scan(&pruned)
+	if err != nil {
+		return nil, fmt.Errorf("FindChanges() error finding pruned position: %v", err)
+	}
+	if since <= pruned {
+		return nil, ErrChangesExpired
+	}
+	rows, err := ps.db.Query(ctx,
+		`SELECT MAX(seq), kind, COALESCE(podcast_id,$4), COALESCE(episode_id,$4) FROM (
+			SELECT seq, kind, podcast_id, episode_id FROM ChangeLog
+			WHERE user_id=$1 AND txid>=$2 AND txid<$3
+			UNION ALL
+			SELECT c.seq, c.kind, c.podcast_id, c.episode_id FROM ChangeLog c
+			INNER JOIN Subscriptions s ON s.podcast_id=c.podcast_id AND s.user_id=$1
+			WHERE c.user_id IS NULL AND c.txid>=$2 AND c.txid<$3
+		) u GROUP BY kind, podcast_id, episode_id ORDER BY 1`,
+		userID, since, until, uuid.Nil)
+	if err != nil {
+		return nil, fmt.Errorf("FindChanges() error: %v", err)
+	}
+	defer rows.Close()
+	changes := []Change{}
+	for rows.Next() {
+		c := Change{}
+		if err = rows.Scan(&c.Seq, &c.Kind, &c.PodcastID, &c.EpisodeID); err != nil {
+			return nil, fmt.Errorf("FindChanges() error scanning row: %v", err)
+		}
+		changes = append(changes, c)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("FindChanges() error while reading: %v", err)
+	}
+	return changes, nil
+}
+
+// FindChangePosition returns the current position of the ChangeLog, the txid of the oldest
+// transaction still running. Every change before it is committed, so reading the changes
+// from one position up to the next never misses a change committed out of seq order
+func (ps *PodcastStore) FindChangePosition(ctx context.Context) (int64, error) {
+	var position int64
+	err := ps.db.QueryRow(ctx, "SELECT txid_snapshot_xmin(txid_current_snapshot())").Scan(&position)
+	if err != nil {
+		return 0, fmt.Errorf("FindChangePosition() error: %v", err)
+	}
+	return position, nil
+}
@@ -0,0 +1,114 @@
+package db
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_ChangeLog(t *testing.T) {
+	ctx := context.Background()
+	podStore := NewPodcastStore(dbpg)
+	user := &UserRow{ID: uuid.New(), Email: "changelog@test.test", Username: "changelogUser", PasswordHash: []byte("shouldbehash")}
+	pod := &Podcast{ID: uuid.New(), Title: "ChangeLog Test", Category: []int{}, RSSURL: "https://syncapod.com/changelog_test.rss"}
+	insertUser(NewAuthStorePG(dbpg), user)
+	insertPodcastOrFail(podStore, pod)
+
+	since, err := podStore.FindChangePosition(ctx)
+	if err != nil {
+		t.Fatalf("Test_ChangeLog() error finding position: %v", err)
+	}
+	require.Greater(t, since, int64(0))
+
+	// subscribing, new episodes, progress & queue are logged
+	insertSubOrFail(podStore, &Subscription{UserID: user.ID, PodcastID: pod.ID})
+	epi := &Episode{ID: uuid.New(), PodcastID: pod.ID, Title: "ChangeLog Episode", PubDate: time.Now()}
+	insertEpisodeOrFail(podStore, epi)
+	_, err = podStore.UpsertUserEpisode(ctx, &UserEpisode{UserID: user.ID, EpisodeID: epi.ID, OffsetMillis: 1, LastSeen: time.Now()}, false)
+	if err != nil {
+		t.Fatalf("Test_ChangeLog() error upserting user episode: %v", err)
+	}
+	_, err = podStore.UpsertUserEpisode(ctx, &UserEpisode{UserID: user.ID, EpisodeID: epi.ID, OffsetMillis: 2, LastSeen: time.Now()}, false)
+	if err != nil {
+		t.Fatalf("Test_ChangeLog() error upserting user episode: %v", err)
+	}
+	require.Nil(t, podStore.InsertQueueItem(ctx, user.ID, epi.ID, false))
+
+	until, err := podStore.FindChangePosition(ctx)
+	if err != nil {
+		t.Fatalf("Test_ChangeLog() error finding position: %v", err)
+	}
+	changes, err := podStore.FindChanges(ctx, user.ID, since, until)
+	if err != nil {
+		t.Fatalf("Test_ChangeLog() error finding changes: %v", err)
+	}
+	kinds := []ChangeKind{}
+	for _, c := range changes {
+		kinds = append(kinds, c.Kind)
+	}
+	// the repeated user episode change is collapsed
+	require.Equal(t, []ChangeKind{ChangeSubscribed, ChangeNewEpisode, ChangeUserEpisode, ChangeQueue}, kinds)
+	require.Equal(t, pod.ID, changes[2].PodcastID)
+	require.Equal(t, epi.ID, changes[2].EpisodeID)
+	require.Equal(t, uuid.Nil, changes[0].EpisodeID)
+
+	// the new episode is logged once rather than per subscriber
+	var logged int
+	err = dbpg.QueryRow(ctx, "SELECT COUNT(*) FROM ChangeLog WHERE episode_id=$1 AND kind='new_episode' AND user_id IS NULL", epi.ID).Scan(&logged)
+	require.Nil(t, err)
+	require.Equal(t, 1, logged)
+
+	// only changes since the position are returned
+	since = until
+	require.Nil(t, podStore.DeleteSubscription(ctx, user.ID, pod.ID))
+	until, err = podStore.FindChangePosition(ctx)
+	if err != nil {
+		t.Fatalf("Test_ChangeLog() error finding position: %v", err)
+	}
+	changes, err = podStore.FindChanges(ctx, user.ID, since, until)
+	if err != nil {
+		t.Fatalf("Test_ChangeLog() error finding changes: %v", err)
+	}
+	require.Len(t, changes, 1)
+	require.Equal(t, ChangeUnsubscribed, changes[0].Kind)
+
+	// changes of a transaction still running are left for the next position
+	tx, err := dbpg.Begin(ctx)
+	require.Nil(t, err)
+	_, err = tx.Exec(ctx, "INSERT INTO Subscriptions(user_id,podcast_id) VALUES($1,$2)", user.ID, pod.ID)
+	require.Nil(t, err)
+	since = until
+	require.Nil(t, podStore.InsertQueueItem(ctx, user.ID, epi.ID, false))
+	until, err = podStore.FindChangePosition(ctx)
+	require.Nil(t, err)
+	changes, err = podStore.FindChanges(ctx, user.ID, since, until)
+	require.Nil(t, err)
+	require.Empty(t, changes)
+	require.Nil(t, tx.Commit(ctx))
+	next, err := podStore.FindChangePosition(ctx)
+	require.Nil(t, err)
+	changes, err = podStore.FindChanges(ctx, user.ID, until, next)
+	require.Nil(t, err)
+	kinds = []ChangeKind{}
+	for _, c := range changes {
+		kinds = append(kinds, c.Kind)
+	}
+	require.Equal(t, []ChangeKind{ChangeSubscribed, ChangeQueue}, kinds)
+
+	// positions older than the pruned changes have expired
+	_, err = dbpg.Exec(ctx, "UPDATE ChangeLog SET created='epoch' WHERE user_id=$1", user.ID)
+	require.Nil(t, err)
+	for _, purge := range expiryPurges {
+		if purge.name == "ChangeLog" {
+			_, err = dbpg.Exec(ctx, purge.query, time.Unix(0, 0).Add(time.Hour), 1000)
+			require.Nil(t, err)
+		}
+	}
+	_, err = podStore.FindChanges(ctx, user.ID, since, next)
+	require.Equal(t, ErrChangesExpired, err)
+	_, err = podStore.FindChanges(ctx, user.ID, next, next)
+	require.Nil(t, err)
+}
@@ -0,0 +1,104 @@
+package db
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// refreshChartsQuery ranks every podcast by its total subscribers (top) and
+// its new subscribers plus plays since $1 (trending). Each podcast is ranked
+// within the global chart (category 0) and within each of its categories,
+// both across all languages (empty language) and within its own language,
+// podcasts without a language are only ranked across all languages.
+const refreshChartsQuery = `
+WITH subs AS (
+	SELECT podcast_id, COUNT(*) AS total, COUNT(*) FILTER (WHERE created > $1) AS recent
+	FROM Subscriptions GROUP BY podcast_id
+), plays AS (
+	SELECT e.podcast_id, COUNT(*) AS recent
+	FROM UserEpisodes u INNER JOIN Episodes e ON u.episode_id=e.id
+	WHERE u.last_seen > $1 GROUP BY e.podcast_id
+), scores AS (
+	SELECT p.id, p.category, LOWER(TRIM(p.language)) AS language,
+		COALESCE(s.total,0) AS top, COALESCE(s.recent,0)+COALESCE(pl.recent,0) AS trending
+	FROM Podcasts p
+	LEFT JOIN subs s ON s.podcast_id=p.id
+	LEFT JOIN plays pl ON pl.podcast_id=p.id
+), categorized AS (
+	SELECT id, 0 AS category, language, top, trending FROM scores
+	UNION ALL
+	SELECT DISTINCT id, c, language, top, trending FROM scores, unnest(category) c WHERE c <> 0
+), charts AS (
+	SELECT 'top' AS chart, category, language, id, top AS score FROM categorized WHERE top > 0
+	UNION ALL
+	SELECT 'trending', category, language, id, trending FROM categorized WHERE trending > 0
+), languages AS (
+	SELECT chart, category, language, id, score FROM charts WHERE language <> ''
+	UNION ALL
+	SELECT chart, category, '', id, score FROM charts
+), ranked AS (
+	SELECT chart, category, language, id, score,
+		ROW_NUMBER() OVER (PARTITION BY chart,category,language ORDER BY score DESC, id) AS rank
+	FROM languages
+)
+INSERT INTO Charts(chart,category,language,rank,podcast_id,score,computed)
+SELECT chart, category, language, rank, id, score, $3 FROM ranked WHERE rank <= $2`
+
+// RefreshCharts recomputes every chart, trending is based on activity within window
+// size is the maximum amount of podcasts kept within each chart
+func (ps *PodcastStore) RefreshCharts(ctx context.Context, window time.Duration, size int) error {
+	now := time.Now()
+	tx, err := ps.db.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("RefreshCharts() error beginning transaction: %v", err)
+	}
+	defer tx.Rollback(ctx)
+	_, err = tx.Exec(ctx, "DELETE FROM Charts")
+	if err != nil {
+		return fmt.Errorf("RefreshCharts() error clearing charts: %v", err)
+	}
+	_, err = tx.Exec(ctx, refreshChartsQuery, now.Add(-window), size, now)
+	if err != nil {
+		return fmt.Errorf("RefreshCharts() error computing charts: %v", err)
+	}
+	if err = tx.Commit(ctx); err != nil {
+		return fmt.Errorf("RefreshCharts() error committing: %v", err)
+	}
+	return nil
+}
+
+// FindChart returns the ranked podcasts of a chart, category 0 and an empty
+// language represent all categories and languages respectively
+func (ps *PodcastStore) FindChart(ctx context.Context, chart ChartType, category int, language string, start, end int64) ([]Podcast, error) {
+	limit := end - start
+	offset := start
+	rows, err := ps.db.Query(ctx,
+		`SELECT p.* FROM Charts c INNER JOIN Podcasts p ON c.podcast_id=p.id
+		 WHERE c.chart=$1 AND c.category=$2 AND c.language=$3
+		 ORDER BY c.rank LIMIT $4 OFFSET $5`,
+		string(chart), category, language, limit, offset)
+	if err != nil {
+		return nil, fmt.Errorf("FindChart() error: %v", err)
+	}
+	return scanPodcastRows(rows, []Podcast{})
+}
+
+// FindUserChart returns the ranked podcasts of a chart across all categories and languages,
+// without the podcasts the user subscribes to
+func (ps *PodcastStore) FindUserChart(ctx context.Context, chart ChartType, userID uuid.UUID, start, end int64) ([]Podcast, error) {
+	limit := end - start
+	offset := start
+	rows, err := ps.db.Query(ctx,
+		`SELECT p.* FROM Charts c INNER JOIN Podcasts p ON c.podcast_id=p.id
+		 WHERE c.chart=$1 AND c.category=0 AND c.language=''
+		 AND NOT EXISTS (SELECT 1 FROM Subscriptions s WHERE s.user_id=$2 AND s.podcast_id=c.podcast_id)
+		 ORDER BY c.rank LIMIT $3 OFFSET $4`,
+		string(chart), userID, limit, offset)
+	if err != nil {
+		return nil, fmt.Errorf("FindUserChart() error: %v", err)
+	}
+	return scanPodcastRows(rows, []Podcast{})
+}
@@ -0,0 +1,76 @@
+package db
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_RefreshCharts(t *testing.T) {
+	podStore := NewPodcastStore(dbpg)
+	pod := &Podcast{ID: uuid.New(), Title: "Chart Test", Language: "XX", Category: []int{70}, RSSURL: "https://syncapod.com/chart_test.rss"}
+	user := &UserRow{ID: uuid.New(), Email: "chart@test.test", Username: "chartUser", PasswordHash: []byte("shouldbehash")}
+	insertUser(NewAuthStorePG(dbpg), user)
+	insertPodcastOrFail(podStore, pod)
+	insertSubOrFail(podStore, &Subscription{UserID: user.ID, PodcastID: pod.ID})
+
+	err := podStore.RefreshCharts(context.Background(), time.Hour*24*7, 100)
+	if err != nil {
+		t.Fatalf("Test_RefreshCharts() error: %v", err)
+	}
+
+	for _, chart := range []ChartType{ChartTop, ChartTrending} {
+		// category & language specific
+		pods, err := podStore.FindChart(context.Background(), chart, 70, "xx", 0, 10)
+		if err != nil {
+			t.Fatalf("Test_RefreshCharts() error finding chart: %v", err)
+		}
+		require.Len(t, pods, 1)
+		require.Equal(t, pod.ID, pods[0].ID)
+		// global
+		pods, err = podStore.FindChart(context.Background(), chart, 0, "", 0, 100)
+		if err != nil {
+			t.Fatalf("Test_RefreshCharts() error finding chart: %v", err)
+		}
+		require.NotEmpty(t, pods)
+	}
+
+	// recomputing replaces the previous charts
+	err = podStore.RefreshCharts(context.Background(), time.Hour*24*7, 100)
+	if err != nil {
+		t.Fatalf("Test_RefreshCharts() error refreshing again: %v", err)
+	}
+	pods, err := podStore.FindChart(context.Background(), ChartTop, 70, "", 0, 10)
+	if err != nil {
+		t.Fatalf("Test_RefreshCharts() error finding chart: %v", err)
+	}
+	require.Len(t, pods, 1)
+
+	// podcasts without a language are ranked once across all languages
+	noLang := &Podcast{ID: uuid.New(), Title: "Chart No Language", Language: " ", Category: []int{71}, RSSURL: "https://syncapod.com/chart_no_lang.rss"}
+	insertPodcastOrFail(podStore, noLang)
+	insertSubOrFail(podStore, &Subscription{UserID: user.ID, PodcastID: noLang.ID})
+	err = podStore.RefreshCharts(context.Background(), time.Hour*24*7, 100)
+	if err != nil {
+		t.Fatalf("Test_RefreshCharts() error refreshing with empty language: %v", err)
+	}
+	pods, err = podStore.FindChart(context.Background(), ChartTop, 71, "", 0, 10)
+	if err != nil {
+		t.Fatalf("Test_RefreshCharts() error finding chart: %v", err)
+	}
+	require.Len(t, pods, 1)
+	require.Equal(t, noLang.ID, pods[0].ID)
+
+	// the user chart leaves out the podcasts the user subscribes to
+	pods, err = podStore.FindUserChart(context.Background(), ChartTop, user.ID, 0, 100)
+	if err != nil {
+		t.Fatalf("Test_RefreshCharts() error finding user chart: %v", err)
+	}
+	for _, p := range pods {
+		require.NotEqual(t, pod.ID, p.ID)
+		require.NotEqual(t, noLang.ID, p.ID)
+	}
+}
@@ -0,0 +1,66 @@
+package db
+
+import (
+	"context"
+	"fmt"
+)
+
+// consistencyCheck finds rows referencing data that no longer exists
+// count returns the amount of bad rows, repair deletes or fixes them
+type consistencyCheck struct {
+	name   string
+	count  string
+	repair string
+}
+
+// consistencyChecks guard the references the schema can't enforce itself
+// (arrays) as well as the ones it does, in case a constraint was ever dropped
+var consistencyChecks = []consistencyCheck{
+	{
+		name:   "UserEpisodes without user",
+		count:  "SELECT COUNT(*) FROM UserEpisodes u WHERE NOT EXISTS (SELECT 1 FROM Users x WHERE x.id=u.user_id)",
+		repair: "DELETE FROM UserEpisodes u WHERE NOT EXISTS (SELECT 1 FROM Users x WHERE x.id=u.user_id)",
+	},
+	{
+		name:   "UserEpisodes without episode",
+		count:  "SELECT COUNT(*) FROM UserEpisodes u WHERE NOT EXISTS (SELECT 1 FROM Episodes x WHERE x.id=u.episode_id)",
+		repair: "DELETE FROM UserEpisodes u WHERE NOT EXISTS (SELECT 1 FROM Episodes x WHERE x.id=u.episode_id)",
+	},
+	{
+		name: "Podcasts with unknown categories",
+		count: `SELECT COUNT(*) FROM Podcasts p WHERE EXISTS
+			(SELECT 1 FROM unnest(p.category) c WHERE NOT EXISTS (SELECT 1 FROM Categories x WHERE x.id=c))`,
+		repair: `UPDATE Podcasts p SET category=ARRAY(
+				SELECT c FROM unnest(p.category) c WHERE EXISTS (SELECT 1 FROM Categories x WHERE x.id=c))
+			WHERE EXISTS
+			(SELECT 1 FROM unnest(p.category) c WHERE NOT EXISTS (SELECT 1 FROM Categories x WHERE x.id=c))`,
+	},
+}
+
+// ConsistencyResult is the outcome of a single consistency check
+type ConsistencyResult struct {
+	Name     string
+	Found    int64
+	Repaired int64
+}
+
+// CheckConsistency reports rows with dangling references, repairs them if repair is true
+func (ps *PodcastStore) CheckConsistency(ctx context.Context, repair bool) ([]ConsistencyResult, error) {
+	results := []ConsistencyResult{}
+	for _, check := range consistencyChecks {
+		result := ConsistencyResult{Name: check.name}
+		err := ps.db.QueryRow(ctx, check.count).Scan(&result.Found)
+		if err != nil {
+			return results, fmt.Errorf("CheckConsistency() error checking %s: %v", check.name, err)
+		}
+		if repair && result.Found > 0 {
+			tag, err := ps.db.Exec(ctx, check.repair)
+			if err != nil {
+				return results, fmt.Errorf("CheckConsistency() error repairing %s: %v", check.name, err)
+			}
+			result.Repaired = tag.RowsAffected()
+		}
+		results = append(results, result)
+	}
+	return results, nil
+}
@@ -0,0 +1,77 @@
+package db
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_CheckConsistency(t *testing.T) {
+	ctx := context.Background()
+	podStore := NewPodcastStore(dbpg)
+	pod := &Podcast{ID: uuid.New(), Title: "Consistency Test", Category: []int{1, 9999}, RSSURL: "https://syncapod.com/consistency_test.rss"}
+	insertPodcastOrFail(podStore, pod)
+	epi := &Episode{ID: uuid.New(), PodcastID: pod.ID, Title: "Consistency Test Episode", PubDate: time.Now()}
+	insertEpisodeOrFail(podStore, epi)
+	user := &UserRow{ID: uuid.New(), Email: "consistency@test.test", Username: "consistencyUser", PasswordHash: []byte("shouldbehash")}
+	insertUser(NewAuthStorePG(dbpg), user)
+
+	// the foreign keys would prevent the orphans, bypass them like a dropped constraint would
+	conn, err := dbpg.Acquire(ctx)
+	if err != nil {
+		t.Fatalf("Test_CheckConsistency() error acquiring connection: %v", err)
+	}
+	_, err = conn.Exec(ctx, "SET session_replication_role = replica")
+	require.Nil(t, err)
+	_, err = conn.Exec(ctx, "INSERT INTO UserEpisodes(user_id,episode_id) VALUES($1,$2),($3,$4)",
+		uuid.New(), epi.ID, user.ID, uuid.New())
+	require.Nil(t, err)
+	_, err = conn.Exec(ctx, "SET session_replication_role = DEFAULT")
+	require.Nil(t, err)
+	conn.Release()
+
+	// report only
+	results, err := podStore.CheckConsistency(ctx, false)
+	if err != nil {
+		t.Fatalf("Test_CheckConsistency() error: %v", err)
+	}
+	require.Equal(t, len(consistencyChecks), len(results))
+	require.Equal(t, ConsistencyResult{Name: "UserEpisodes without user", Found: 1}, findConsistencyResult(t, results, "UserEpisodes without user"))
+	require.Equal(t, ConsistencyResult{Name: "UserEpisodes without episode", Found: 1}, findConsistencyResult(t, results, "UserEpisodes without episode"))
+	require.Equal(t, ConsistencyResult{Name: "Podcasts with unknown categories", Found: 1}, findConsistencyResult(t, results, "Podcasts with unknown categories"))
+
+	// repair
+	results, err = podStore.CheckConsistency(ctx, true)
+	if err != nil {
+		t.Fatalf("Test_CheckConsistency() error repairing: %v", err)
+	}
+	require.Equal(t, ConsistencyResult{Name: "UserEpisodes without user", Found: 1, Repaired: 1}, findConsistencyResult(t, results, "UserEpisodes without user"))
+	require.Equal(t, ConsistencyResult{Name: "UserEpisodes without episode", Found: 1, Repaired: 1}, findConsistencyResult(t, results, "UserEpisodes without episode"))
+	require.Equal(t, ConsistencyResult{Name: "Podcasts with unknown categories", Found: 1, Repaired: 1}, findConsistencyResult(t, results, "Podcasts with unknown categories"))
+	repaired, err := podStore.FindPodcastByID(ctx, pod.ID)
+	if err != nil {
+		t.Fatalf("Test_CheckConsistency() error finding podcast: %v", err)
+	}
+	require.Equal(t, []int{1}, repaired.Category)
+
+	results, err = podStore.CheckConsistency(ctx, false)
+	if err != nil {
+		t.Fatalf("Test_CheckConsistency() error: %v", err)
+	}
+	for _, result := range results {
+		require.Zero(t, result.Found, result.Name)
+	}
+}
+
+func findConsistencyResult(t *testing.T, results []ConsistencyResult, name string) ConsistencyResult {
+	for _, result := range results {
+		if result.Name == name {
+			return result
+		}
+	}
+	t.Fatalf("findConsistencyResult() no result for check: %s", name)
+	return ConsistencyResult{}
+}
@@ -0,0 +1,54 @@
+package db
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+)
+
+// UpsertGpodderDevice inserts or updates the caption and type of the user's device
+func (ps *PodcastStore) UpsertGpodderDevice(ctx context.Context, d *GpodderDevice) error {
+	_, err := ps.db.Exec(ctx,
+		`INSERT INTO GpodderDevices(user_id,id,caption,type,updated) VALUES($1,$2,$3,$4,$5)
+		 ON CONFLICT(user_id,id) DO UPDATE SET caption=$3,type=$4,updated=$5`,
+		d.UserID, d.ID, d.Caption, d.Type, d.Updated)
+	if err != nil {
+		return fmt.Errorf("UpsertGpodderDevice() error: %v", err)
+	}
+	return nil
+}
+
+// InsertGpodderDeviceIfMissing registers the device of the user unless it already exists
+func (ps *PodcastStore) InsertGpodderDeviceIfMissing(ctx context.Context, d *GpodderDevice) error {
+	_, err := ps.db.Exec(ctx,
+		`INSERT INTO GpodderDevices(user_id,id,caption,type,updated) VALUES($1,$2,$3,$4,$5)
+		 ON CONFLICT(user_id,id) DO NOTHING`,
+		d.UserID, d.ID, d.Caption, d.Type, d.Updated)
+	if err != nil {
+		return fmt.Errorf("InsertGpodderDeviceIfMissing() error: %v", err)
+	}
+	return nil
+}
+
+// FindGpodderDevices returns the user's devices in order of id
+func (ps *PodcastStore) FindGpodderDevices(ctx context.Context, userID uuid.UUID) ([]GpodderDevice, error) {
+	rows, err := ps.db.Query(ctx,
+		"SELECT user_id,id,caption,type,updated FROM GpodderDevices WHERE user_id=$1 ORDER BY id", userID)
+	if err != nil {
+		return nil, fmt.Errorf("FindGpodderDevices() error: %v", err)
+	}
+	defer rows.Close()
+	devices := []GpodderDevice{}
+	for rows.Next() {
+		d := GpodderDevice{}
+		if err = rows.Scan(&d.UserID, &d.ID, &d.Caption, &d.Type, &d.Updated); err != nil {
+			return nil, fmt.Errorf("FindGpodderDevices() error scanning row: %v", err)
+		}
+		devices = append(devices, d)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("FindGpodderDevices() error while reading: %v", err)
+	}
+	return devices, nil
+}
@@ -0,0 +1,133 @@
+package db
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// listenedMillis is the listening time of a session row, seeking backwards does not count
+const listenedMillis = "GREATEST(l.end_offset_millis-l.start_offset_millis,0)"
+
+// extraScanner scans the columns following those of a scan helper into extra
+type extraScanner struct {
+	row   scanner
+	extra []interface{}
+}
+
+func (s extraScanner) Scan(dest ...interface{}) error {
+	return s.row.Scan(append(dest, s.extra...)...)
+}
+
+// InsertListeningSession appends the session to the user's history and sets its id
+func (ps *PodcastStore) InsertListeningSession(ctx context.Context, s *ListeningSession) error {
+	err := ps.db.QueryRow(ctx,
+		`INSERT INTO ListeningSessions(user_id,episode_id,start_offset_millis,end_offset_millis,started,ended,device)
+		 VALUES($1,$2,$3,$4,$5,$6,$7) RETURNING id`,
+		s.UserID, s.EpisodeID, s.StartOffsetMillis, s.EndOffsetMillis, s.Started, s.Ended, s.Device,
+	).Scan(&s.ID)
+	if err != nil {
+		return fmt.Errorf("InsertListeningSession() error: %v", err)
+	}
+	return nil
+}
+
+// FindHistory returns the user's listening sessions, most recent first
+func (ps *PodcastStore) FindHistory(ctx context.Context, userID uuid.UUID, start, end int64) ([]ListeningSession, error) {
+	limit := end - start
+	offset := start
+	rows, err := ps.db.Query(ctx,
+		`SELECT id,user_id,episode_id,start_offset_millis,end_offset_millis,started,ended,device
+		 FROM ListeningSessions WHERE user_id=$1 ORDER BY started DESC, id DESC LIMIT $2 OFFSET $3`,
+		userID, limit, offset)
+	if err != nil {
+		return nil, fmt.Errorf("FindHistory() error: %v", err)
+	}
+	defer rows.Close()
+	sessions := []ListeningSession{}
+	for rows.Next() {
+		s := ListeningSession{}
+		err = rows.Scan(&s.ID, &s.UserID, &s.EpisodeID, &s.StartOffsetMillis, &s.EndOffsetMillis, &s.Started, &s.Ended, &s.Device)
+		if err != nil {
+			return nil, fmt.Errorf("FindHistory() error scanning row: %v", err)
+		}
+		sessions = append(sessions, s)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("FindHistory() error while reading: %v", err)
+	}
+	return sessions, nil
+}
+
+// FindListeningTotals returns the user's listening time of sessions started within [from,to)
+// per bucket, buckets are truncated within the IANA timezone and empty ones are omitted
+func (ps *PodcastStore) FindListeningTotals(ctx context.Context, userID uuid.UUID, bucket StatsBucket, from, to time.Time, timezone string) ([]ListeningTotal, error) {
+	rows, err := ps.db.Query(ctx,
+		`SELECT date_trunc($2::text, l.started AT TIME ZONE $5::text) AT TIME ZONE $5::text AS bucket, SUM(`+listenedMillis+`)
+		 FROM ListeningSessions l WHERE l.user_id=$1 AND l.started >= $3 AND l.started < $4
+		 GROUP BY bucket ORDER BY bucket`,
+		userID, string(bucket), from, to, timezone)
+	if err != nil {
+		return nil, fmt.Errorf("FindListeningTotals() error: %v", err)
+	}
+	defer rows.Close()
+	totals := []ListeningTotal{}
+	for rows.Next() {
+		t := ListeningTotal{}
+		if err = rows.Scan(&t.Start, &t.Millis); err != nil {
+			return nil, fmt.Errorf("FindListeningTotals() error scanning row: %v", err)
+		}
+		totals = append(totals, t)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("FindListeningTotals() error while reading: %v", err)
+	}
+	return totals, nil
+}
+
+// FindTopListenedPodcasts returns the podcasts the user listened to the most within [from,to)
+func (ps *PodcastStore) FindTopListenedPodcasts(ctx context.Context, userID uuid.UUID, from, to time.Time, limit int) ([]PodcastListening, error) {
+	rows, err := ps.db.Query(ctx,
+		`SELECT p.*, t.millis FROM (
+			SELECT e.podcast_id, SUM(`+listenedMillis+`) AS millis
+			FROM ListeningSessions l INNER JOIN Episodes e ON l.episode_id=e.id
+			WHERE l.user_id=$1 AND l.started >= $2 AND l.started < $3
+			GROUP BY e.podcast_id
+		) t INNER JOIN Podcasts p ON p.id=t.podcast_id
+		ORDER BY t.millis DESC, p.id LIMIT $4`,
+		userID, from, to, limit)
+	if err != nil {
+		return nil, fmt.Errorf("FindTopListenedPodcasts() error: %v", err)
+	}
+	defer rows.Close()
+	top := []PodcastListening{}
+	for rows.Next() {
+		pl := PodcastListening{}
+		if err = scanPodcastRow(extraScanner{rows, []interface{}{&pl.Millis}}, &pl.Podcast); err != nil {
+			return nil, fmt.Errorf("FindTopListenedPodcasts() error scanning row: %v", err)
+		}
+		top = append(top, pl)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("FindTopListenedPodcasts() error while reading: %v", err)
+	}
+	return top, nil
+}
+
+// FindCompletion counts the episodes listened to within [from,to) and how many of them are played
+func (ps *PodcastStore) FindCompletion(ctx context.Context, userID uuid.UUID, from, to time.Time) (*Completion, error) {
+	c := &Completion{}
+	err := ps.db.QueryRow(ctx,
+		`SELECT COUNT(DISTINCT l.episode_id), COUNT(DISTINCT l.episode_id) FILTER (WHERE u.played)
+		 FROM ListeningSessions l
+		 LEFT JOIN UserEpisodes u ON u.user_id=l.user_id AND u.episode_id=l.episode_id
+		 WHERE l.user_id=$1 AND l.started >= $2 AND l.started < $3`,
+		userID, from, to,
+	).Scan(&c.Started, &c.Completed)
+	if err != nil {
+		return nil, fmt.Errorf("FindCompletion() error: %v", err)
+	}
+	return c, nil
+}
@@ -0,0 +1,96 @@
+package db
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// expiryPurge deletes a batch of expired rows of a table,
+// the query takes the cutoff and the batch size
+type expiryPurge struct {
+	name      string
+	query     string
+	retention time.Duration // rows are kept this long past the cutoff
+}
+
+// loginFailureRetention outlasts the windows and lockouts of every throttle policy
+const loginFailureRetention = time.Hour * 24
+
+// failedLoginRetention is how long the audit of failed logins is kept
+const failedLoginRetention = time.Hour * 24 * 90
+
+// changeLogRetention is how long clients may go without syncing before
+// they have to fetch their entire state again
+const changeLogRetention = time.Hour * 24 * 30
+
+var expiryPurges = []expiryPurge{
+	{
+		name:  "Sessions",
+		query: "DELETE FROM Sessions WHERE id IN (SELECT id FROM Sessions WHERE expires < $1 LIMIT $2)",
+	},
+	{
+		name:  "AuthCodes",
+		query: "DELETE FROM AuthCodes WHERE code IN (SELECT code FROM AuthCodes WHERE expires < $1 LIMIT $2)",
+	},
+	{
+		// refreshing requires the auth code of the token to be valid,
+		// so once both expired the token can't be used anymore
+		name: "AccessTokens",
+		query: `DELETE FROM AccessTokens WHERE token IN (
+			SELECT a.token FROM AccessTokens a WHERE access_token_expiry(a.created,a.expires) < $1
+			AND NOT EXISTS (SELECT 1 FROM AuthCodes c WHERE c.code=a.auth_code AND c.expires >= $1) LIMIT $2)`,
+	},
+	{
+		name:  "UserTokens",
+		query: "DELETE FROM UserTokens WHERE token_hash IN (SELECT token_hash FROM UserTokens WHERE expires < $1 LIMIT $2)",
+	},
+	{
+		name:      "LoginFailures",
+		query:     "DELETE FROM LoginFailures WHERE key IN (SELECT key FROM LoginFailures WHERE last_failure < $1 LIMIT $2)",
+		retention: loginFailureRetention,
+	},
+	{
+		name:      "FailedLogins",
+		query:     "DELETE FROM FailedLogins WHERE id IN (SELECT id FROM FailedLogins WHERE created < $1 LIMIT $2)",
+		retention: failedLoginRetention,
+	},
+	{
+		// the pruned position is raised along, older positions have to sync everything
+		name: "ChangeLog",
+		query: `WITH pruned AS (SELECT seq, txid FROM ChangeLog WHERE created < $1 LIMIT $2),
+			horizon AS (UPDATE ChangeLogPruned SET txid=GREATEST(txid,(SELECT MAX(txid) FROM pruned))
+				WHERE EXISTS (SELECT 1 FROM pruned))
+			DELETE FROM ChangeLog WHERE seq IN (SELECT seq FROM pruned)`,
+		retention: changeLogRetention,
+	},
+}
+
+// PurgeResult is the amount of expired rows deleted from a table
+type PurgeResult struct {
+	Name    string
+	Deleted int64
+}
+
+// PurgeExpired deletes the sessions, oauth codes and tokens, user tokens, login failures, failed login audits
+// and changes expired by now. Rows are deleted in batches of batchSize so locks are held briefly, until none are left
+func (a *AuthStorePG) PurgeExpired(ctx context.Context, now time.Time, batchSize int) ([]PurgeResult, error) {
+	results := []PurgeResult{}
+	for _, purge := range expiryPurges {
+		result := PurgeResult{Name: purge.name}
+		cutoff := now.Add(-purge.retention)
+		for {
+			tag, err := a.db.Exec(ctx, purge.query, cutoff, batchSize)
+			if err != nil {
+				results = append(results, result)
+				return results, fmt.Errorf("PurgeExpired() error purging %s: %v", purge.name, err)
+			}
+			result.Deleted += tag.RowsAffected()
+			if tag.RowsAffected() < int64(batchSize) {
+				break
+			}
+		}
+		results = append(results, result)
+	}
+	return results, nil
+}
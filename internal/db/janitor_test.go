@@ -0,0 +1,71 @@
+package db
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAuthStorePG_PurgeExpired(t *testing.T) {
+	ctx := context.Background()
+	a := NewAuthStorePG(dbpg)
+	o := NewOAuthStorePG(dbpg)
+	l := NewLimiterStorePG(dbpg)
+	user := &UserRow{ID: uuid.New(), Email: "janitor@test.test", Username: "janitorUser", PasswordHash: []byte("shouldbehash")}
+	insertUser(a, user)
+
+	// purge as of long before the other fixtures expire
+	now := time.Unix(-5000000, 0)
+	expired := time.Unix(-10000000, 0)
+	valid := time.Unix(-1000000, 0)
+	insertSession(a, &SessionRow{ID: uuid.New(), UserID: user.ID, Expires: expired})
+	insertSession(a, &SessionRow{ID: uuid.New(), UserID: user.ID, Expires: expired})
+	validSession := &SessionRow{ID: uuid.New(), UserID: user.ID, Expires: valid}
+	insertSession(a, validSession)
+	insertAuthCode(o, &AuthCodeRow{Code: []byte("janitor_expired"), ClientID: "client", UserID: user.ID, Scope: ReadChange, Expires: expired})
+	insertAuthCode(o, &AuthCodeRow{Code: []byte("janitor_valid"), ClientID: "client", UserID: user.ID, Scope: ReadChange, Expires: valid})
+	// both tokens expired, only the one with a valid auth code may still be refreshed
+	insertAccessToken(o, &AccessTokenRow{Token: []byte("janitor_token1"), AuthCode: []byte("janitor_expired"), RefreshToken: []byte("janitor_refresh1"),
+		UserID: user.ID, Created: expired, Expires: 3600, ClientID: "client"})
+	insertAccessToken(o, &AccessTokenRow{Token: []byte("janitor_token2"), AuthCode: []byte("janitor_valid"), RefreshToken: []byte("janitor_refresh2"),
+		UserID: user.ID, Created: expired, Expires: 3600, ClientID: "client"})
+	require.Nil(t, a.InsertUserToken(ctx, &UserTokenRow{TokenHash: []byte("janitor_token"), UserID: user.ID, Purpose: TokenVerifyEmail, Created: expired, Expires: expired}))
+	never := func(*LoginFailures) bool { return false }
+	_, _, err := l.ReserveLoginAttempt(ctx, "janitor:stale", expired, expired, never)
+	require.Nil(t, err)
+	_, _, err = l.ReserveLoginAttempt(ctx, "janitor:recent", now.Add(-time.Hour), now.Add(-time.Hour), never)
+	require.Nil(t, err)
+	require.Nil(t, a.InsertFailedLogin(ctx, &FailedLoginRow{UserID: &user.ID, Identifier: user.Username,
+		Reason: FailedLoginIncorrectPassword, Created: now.Add(-failedLoginRetention - time.Hour)}))
+	require.Nil(t, a.InsertFailedLogin(ctx, &FailedLoginRow{UserID: &user.ID, Identifier: user.Username,
+		Reason: FailedLoginIncorrectPassword, Created: now.Add(-time.Hour)}))
+
+	results, err := a.PurgeExpired(ctx, now, 1)
+	require.Nil(t, err)
+	require.Equal(t, []PurgeResult{
+		{Name: "Sessions", Deleted: 2},
+		{Name: "AuthCodes", Deleted: 1},
+		{Name: "AccessTokens", Deleted: 1},
+		{Name: "UserTokens", Deleted: 1},
+		{Name: "LoginFailures", Deleted: 1},
+		{Name: "FailedLogins", Deleted: 1},
+		{Name: "ChangeLog", Deleted: 0},
+	}, results)
+
+	sessions, err := a.FindUserSessions(ctx, user.ID, time.Time{})
+	require.Nil(t, err)
+	require.Len(t, sessions, 1)
+	require.Equal(t, validSession.ID, sessions[0].ID)
+	_, err = o.GetAuthCode(ctx, []byte("janitor_valid"))
+	require.Nil(t, err)
+	_, err = o.GetAccessTokenByRefresh(ctx, []byte("janitor_refresh1"))
+	require.NotNil(t, err)
+	_, err = o.GetAccessTokenByRefresh(ctx, []byte("janitor_refresh2"))
+	require.Nil(t, err)
+	failures, err := l.FindLoginFailures(ctx, "janitor:recent")
+	require.Nil(t, err)
+	require.Equal(t, 1, failures.Count)
+}
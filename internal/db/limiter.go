@@ -0,0 +1,142 @@
+package db
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/jackc/pgx/v4"
+	"github.com/jackc/pgx/v4/pgxpool"
+)
+
+// LimiterStorePG keeps the failed logins in postgres, shared by every instance of the server
+type LimiterStorePG struct {
+	db *pgxpool.Pool
+}
+
+func NewLimiterStorePG(db *pgxpool.Pool) *LimiterStorePG {
+	return &LimiterStorePG{db: db}
+}
+
+func (l *LimiterStorePG) FindLoginFailures(ctx context.Context, key string) (*LoginFailures, error) {
+	f := &LoginFailures{Key: key}
+	err := l.db.QueryRow(ctx, "SELECT count,last_failure FROM LoginFailures WHERE key=$1", key).Scan(&f.Count, &f.LastFailure)
+	if err == pgx.ErrNoRows {
+		return f, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("FindLoginFailures() error: %v", err)
+	}
+	return f, nil
+}
+
+// ReserveLoginAttempt locks the row of the key until the attempt is counted,
+// so concurrent reservations are checked against each other
+func (l *LimiterStorePG) ReserveLoginAttempt(ctx context.Context, key string, now, forgetBefore time.Time, throttled func(*LoginFailures) bool) (*LoginFailures, bool, error) {
+	tx, err := l.db.Begin(ctx)
+	if err != nil {
+		return nil, false, fmt.Errorf("ReserveLoginAttempt() error beginning transaction: %v", err)
+	}
+	defer tx.Rollback(ctx)
+	// a new key has a row to lock without any failures, removed by the rollback if throttled
+	_, err = tx.Exec(ctx,
+		"INSERT INTO LoginFailures (key,count,last_failure) VALUES($1,0,$2) ON CONFLICT (key) DO NOTHING",
+		key, now)
+	if err != nil {
+		return nil, false, fmt.Errorf("ReserveLoginAttempt() error inserting key: %v", err)
+	}
+	f := &LoginFailures{Key: key}
+	err = tx.QueryRow(ctx, "SELECT count,last_failure FROM LoginFailures WHERE key=$1 FOR UPDATE", key).
+		Scan(&f.Count, &f.LastFailure)
+	if err != nil {
+		return nil, false, fmt.Errorf("ReserveLoginAttempt() error: %v", err)
+	}
+	if f.LastFailure.Before(forgetBefore) {
+		f.Count = 0
+	}
+	if throttled(f) {
+		return f, false, nil
+	}
+	_, err = tx.Exec(ctx,
+		"UPDATE LoginFailures SET count=$2, last_failure=GREATEST(last_failure,$3) WHERE key=$1",
+		key, f.Count+1, now)
+	if err != nil {
+		return nil, false, fmt.Errorf("ReserveLoginAttempt() error updating: %v", err)
+	}
+	if err = tx.Commit(ctx); err != nil {
+		return nil, false, fmt.Errorf("ReserveLoginAttempt() error committing: %v", err)
+	}
+	return f, true, nil
+}
+
+func (l *LimiterStorePG) RefundLoginAttempt(ctx context.Context, key string) error {
+	_, err := l.db.Exec(ctx, "UPDATE LoginFailures SET count=count-1 WHERE key=$1 AND count > 0", key)
+	if err != nil {
+		return fmt.Errorf("RefundLoginAttempt() error: %v", err)
+	}
+	return nil
+}
+
+func (l *LimiterStorePG) ResetLoginFailures(ctx context.Context, key string) error {
+	_, err := l.db.Exec(ctx, "DELETE FROM LoginFailures WHERE key=$1", key)
+	if err != nil {
+		return fmt.Errorf("ResetLoginFailures() error: %v", err)
+	}
+	return nil
+}
+
+// MemoryLimiterStore keeps the failed logins in memory, for a single instance and tests
+type MemoryLimiterStore struct {
+	mutex    sync.Mutex
+	failures map[string]LoginFailures
+}
+
+func NewMemoryLimiterStore() *MemoryLimiterStore {
+	return &MemoryLimiterStore{failures: make(map[string]LoginFailures)}
+}
+
+func (m *MemoryLimiterStore) FindLoginFailures(ctx context.Context, key string) (*LoginFailures, error) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	f := m.failures[key]
+	f.Key = key
+	return &f, nil
+}
+
+func (m *MemoryLimiterStore) ReserveLoginAttempt(ctx context.Context, key string, now, forgetBefore time.Time, throttled func(*LoginFailures) bool) (*LoginFailures, bool, error) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	f, ok := m.failures[key]
+	if !ok || f.LastFailure.Before(forgetBefore) {
+		f.Count = 0
+	}
+	f.Key = key
+	before := f
+	if throttled(&before) {
+		return &before, false, nil
+	}
+	f.Count++
+	if now.After(f.LastFailure) {
+		f.LastFailure = now
+	}
+	m.failures[key] = f
+	return &before, true, nil
+}
+
+func (m *MemoryLimiterStore) RefundLoginAttempt(ctx context.Context, key string) error {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	if f, ok := m.failures[key]; ok && f.Count > 0 {
+		f.Count--
+		m.failures[key] = f
+	}
+	return nil
+}
+
+func (m *MemoryLimiterStore) ResetLoginFailures(ctx context.Context, key string) error {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	delete(m.failures, key)
+	return nil
+}
@@ -0,0 +1,65 @@
+package db
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_LimiterStores(t *testing.T) {
+	stores := map[string]LimiterStore{"pg": NewLimiterStorePG(dbpg), "memory": NewMemoryLimiterStore()}
+	for name, store := range stores {
+		t.Run(name, func(t *testing.T) {
+			ctx := context.Background()
+			key := "ip:" + name
+			now := time.Now().Truncate(time.Millisecond)
+			never := func(*LoginFailures) bool { return false }
+
+			f, err := store.FindLoginFailures(ctx, key)
+			require.Nil(t, err)
+			require.Zero(t, f.Count)
+
+			// the failures before each reservation are returned
+			for i := 0; i < 3; i++ {
+				f, reserved, err := store.ReserveLoginAttempt(ctx, key, now, now.Add(-time.Hour), never)
+				require.Nil(t, err)
+				require.True(t, reserved)
+				require.Equal(t, i, f.Count)
+			}
+			f, err = store.FindLoginFailures(ctx, key)
+			require.Nil(t, err)
+			require.Equal(t, 3, f.Count)
+			require.True(t, now.Equal(f.LastFailure))
+
+			// throttled attempts are not counted
+			f, reserved, err := store.ReserveLoginAttempt(ctx, key, now, now.Add(-time.Hour),
+				func(f *LoginFailures) bool { return f.Count >= 3 })
+			require.Nil(t, err)
+			require.False(t, reserved)
+			require.Equal(t, 3, f.Count)
+
+			// refunded attempts are not counted
+			require.Nil(t, store.RefundLoginAttempt(ctx, key))
+			f, err = store.FindLoginFailures(ctx, key)
+			require.Nil(t, err)
+			require.Equal(t, 2, f.Count)
+
+			// failures before forgetBefore are forgotten
+			later := now.Add(time.Hour * 2)
+			f, _, err = store.ReserveLoginAttempt(ctx, key, later, later.Add(-time.Hour), never)
+			require.Nil(t, err)
+			require.Zero(t, f.Count)
+			f, err = store.FindLoginFailures(ctx, key)
+			require.Nil(t, err)
+			require.Equal(t, 1, f.Count)
+			require.True(t, later.Equal(f.LastFailure))
+
+			require.Nil(t, store.ResetLoginFailures(ctx, key))
+			f, err = store.FindLoginFailures(ctx, key)
+			require.Nil(t, err)
+			require.Zero(t, f.Count)
+		})
+	}
+}
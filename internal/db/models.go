@@ -15,6 +15,10 @@ type AuthStore interface {
 	GetUserByUsername(ctx context.Context, username string) (*UserRow, error)
 	UpdateUser(ctx context.Context, u *UserRow) error
 	UpdateUserPassword(ctx context.Context, id uuid.UUID, password_hash []byte) error
+	UpdateUserPasswordHash(ctx context.Context, id uuid.UUID, old, new []byte) (bool, error)
+	UpdateUserEmail(ctx context.Context, id uuid.UUID, email string) error
+	SetEmailVerified(ctx context.Context, id uuid.UUID) error
+	SetUserRole(ctx context.Context, id uuid.UUID, role Role) error
 	DeleteUser(ctx context.Context, id uuid.UUID) error
 
 	// Session
@@ -22,38 +26,152 @@ type AuthStore interface {
 	GetSession(ctx context.Context, id uuid.UUID) (*SessionRow, error)
 	UpdateSession(ctx context.Context, s *SessionRow) error
 	DeleteSession(ctx context.Context, id uuid.UUID) error
+	DeleteSessionByKey(ctx context.Context, keyHash []byte) error
+	DeleteUserSessions(ctx context.Context, userID uuid.UUID) error
+	FindUserSessions(ctx context.Context, userID uuid.UUID, now time.Time) ([]SessionRow, error)
+	DeleteUserSession(ctx context.Context, userID, id uuid.UUID) (bool, error)
+	DeleteOtherUserSessions(ctx context.Context, userID, keepID uuid.UUID) (int64, error)
+
+	// User Token
+	InsertUserToken(ctx context.Context, t *UserTokenRow) error
+	ConsumeUserToken(ctx context.Context, tokenHash []byte, purpose TokenPurpose) (*UserTokenRow, error)
+	FindUserToken(ctx context.Context, tokenHash []byte, purpose TokenPurpose) (*UserTokenRow, error)
+	DeleteUserTokens(ctx context.Context, userID uuid.UUID, purpose TokenPurpose) error
+	ResetUserPassword(ctx context.Context, tokenHash, passwordHash []byte, now time.Time) (uuid.UUID, error)
+
+	// Failed Login
+	InsertFailedLogin(ctx context.Context, f *FailedLoginRow) error
+
+	// Two Factor
+	UpsertTwoFactor(ctx context.Context, tf *TwoFactorRow) error
+	FindTwoFactor(ctx context.Context, userID uuid.UUID) (*TwoFactorRow, error)
+	EnableTwoFactor(ctx context.Context, userID uuid.UUID, recoveryCodeHashes [][]byte) error
+	DeleteTwoFactor(ctx context.Context, userID uuid.UUID) error
+	UseTwoFactorStep(ctx context.Context, userID uuid.UUID, step int64) (bool, error)
+	ConsumeRecoveryCode(ctx context.Context, userID uuid.UUID, codeHash []byte) (bool, error)
 
 	// Both
-	GetSessionAndUser(ctx context.Context, sessionID uuid.UUID) (*SessionRow, *UserRow, error)
+	GetSessionAndUser(ctx context.Context, keyHash []byte) (*SessionRow, *UserRow, error)
+
+	// Janitor
+	PurgeExpired(ctx context.Context, now time.Time, batchSize int) ([]PurgeResult, error)
+}
+
+// LimiterStore keeps the recent failed logins per key, an account or an IP
+type LimiterStore interface {
+	// FindLoginFailures returns zero failures if there are none
+	FindLoginFailures(ctx context.Context, key string) (*LoginFailures, error)
+	// ReserveLoginAttempt counts an attempt at now as a failure before it is made, unless throttled
+	// returns true for the failures so far. Failures before forgetBefore are forgotten and the
+	// reservations of a key are serialized. Returns the failures before the attempt and whether it was reserved
+	ReserveLoginAttempt(ctx context.Context, key string, now, forgetBefore time.Time, throttled func(*LoginFailures) bool) (*LoginFailures, bool, error)
+	// RefundLoginAttempt gives back a reserved attempt which was not made or succeeded
+	RefundLoginAttempt(ctx context.Context, key string) error
+	ResetLoginFailures(ctx context.Context, key string) error
 }
 
 type OAuthStore interface {
 	// Auth Code
 	InsertAuthCode(ctx context.Context, a *AuthCodeRow) error
-	GetAuthCode(ctx context.Context, code []byte) (*AuthCodeRow, error)
+	GetAuthCode(ctx context.Context, codeHash []byte) (*AuthCodeRow, error)
 	// UpdateAuthCode(ctx context.Context, a *AuthCodeRow) error
-	DeleteAuthCode(ctx context.Context, code []byte) error
+	DeleteAuthCode(ctx context.Context, codeHash []byte) error
 
 	// Access Token
 	InsertAccessToken(ctx context.Context, a *AccessTokenRow) error
-	GetAccessTokenByRefresh(ctx context.Context, refreshToken []byte) (*AccessTokenRow, error)
-	DeleteAccessToken(ctx context.Context, token []byte) error
+	GetAccessTokenByRefresh(ctx context.Context, refreshHash []byte) (*AccessTokenRow, error)
+	DeleteAccessToken(ctx context.Context, tokenHash []byte) error
+	DeleteUserAccessTokens(ctx context.Context, userID uuid.UUID) error
 
-	GetAccessTokenAndUser(ctx context.Context, token []byte) (*UserRow, *AccessTokenRow, error)
+	// Grant
+	FindUserGrants(ctx context.Context, userID uuid.UUID) ([]OAuthGrant, error)
+	DeleteUserGrant(ctx context.Context, userID uuid.UUID, clientID string) (bool, error)
+
+	GetAccessTokenAndUser(ctx context.Context, tokenHash []byte) (*UserRow, *AccessTokenRow, error)
 }
 
 // UserRow contains all user specific information
 type UserRow struct {
-	ID           uuid.UUID
-	Email        string
-	Username     string
-	Birthdate    time.Time
-	PasswordHash []byte
-	Created      time.Time
-	LastSeen     time.Time
+	ID            uuid.UUID
+	Email         string
+	Username      string
+	Birthdate     time.Time
+	PasswordHash  []byte
+	Created       time.Time
+	LastSeen      time.Time
+	EmailVerified bool
+	Role          Role
+}
+
+// Role grants a user access to the methods requiring it, each role includes the ones before it
+type Role string
+
+// Roles of users, new users are RoleUser
+const (
+	RoleUser      Role = "user"
+	RoleModerator Role = "moderator"
+	RoleAdmin     Role = "admin"
+)
+
+// TokenPurpose is what a mailed user token may be used for
+type TokenPurpose string
+
+// Purposes of user tokens
+const (
+	TokenVerifyEmail   TokenPurpose = "verify_email"
+	TokenResetPassword TokenPurpose = "reset_password"
+	TokenTwoFactor     TokenPurpose = "two_factor"
+)
+
+// UserTokenRow is a single-use token mailed to a user or completing a two factor login,
+// only its hash is stored
+type UserTokenRow struct {
+	TokenHash []byte
+	UserID    uuid.UUID
+	Purpose   TokenPurpose
+	Created   time.Time
+	Expires   time.Time
+}
+
+// LoginFailures is the count of recent failed logins of a key
+type LoginFailures struct {
+	Key         string
+	Count       int
+	LastFailure time.Time
+}
+
+// FailedLoginReason is why a login failed
+type FailedLoginReason string
+
+// FailedLoginReasons
+const (
+	FailedLoginUnknownUser       FailedLoginReason = "unknown_user"
+	FailedLoginIncorrectPassword FailedLoginReason = "incorrect_password"
+	FailedLoginIncorrectCode     FailedLoginReason = "incorrect_two_factor"
+)
+
+// FailedLoginRow audits a failed login, UserID is nil if the account is unknown
+type FailedLoginRow struct {
+	ID         int64
+	UserID     *uuid.UUID
+	Identifier string
+	IP         string
+	UserAgent  string
+	Reason     FailedLoginReason
+	Created    time.Time
+}
+
+// TwoFactorRow is the TOTP secret of a user, enabled once confirmed
+type TwoFactorRow struct {
+	UserID   uuid.UUID
+	Secret   []byte
+	Enabled  bool
+	LastStep int64
+	Created  time.Time
 }
 
 // SessionRow contains all session information
+// SessionRow is a login session, the client holds the key and only its digest is stored
 type SessionRow struct {
 	ID           uuid.UUID
 	UserID       uuid.UUID
@@ -61,19 +179,25 @@ type SessionRow struct {
 	LastSeenTime time.Time
 	Expires      time.Time
 	UserAgent    string
+	KeyHash      []byte
+	// Key is only set when the session is created, it is never stored
+	Key string
 }
 
 // AuthCode is the authorization code of oauth2.0
-// code is the primary key
+// code is the primary key, the digest of the issued code
 type AuthCodeRow struct {
 	Code     []byte    `json:"code"`
 	ClientID string    `json:"client_id"`
 	UserID   uuid.UUID `json:"user_id"`
 	Scope    Scope     `json:"scope"`
 	Expires  time.Time `json:"expires"`
+	// Key is the issued code, only set when it is created
+	Key string `json:"-"`
 }
 
-// AccessToken contains the information to provide user access within oAuth scope
+// AccessToken contains the information to provide user access within oAuth scope.
+// Token, AuthCode and RefreshToken are digests of the issued keys
 type AccessTokenRow struct {
 	Token        []byte    `json:"token"`
 	AuthCode     []byte    `json:"auth_code"`
@@ -81,8 +205,23 @@ type AccessTokenRow struct {
 	UserID       uuid.UUID `json:"user_id"`
 	Created      time.Time `json:"created"`
 	Expires      int       `json:"expires"`
+	ClientID     string    `json:"client_id"`
+	// Key and RefreshKey are the issued tokens, only set when they are created
+	Key        string `json:"-"`
+	RefreshKey string `json:"-"`
 }
 
+// OAuthGrant summarizes the access tokens of a user for an oauth client
+type OAuthGrant struct {
+	ClientID   string    // LegacyClientID for tokens issued before their client was recorded
+	Authorized time.Time // creation of the oldest token
+	LastUsed   time.Time // creation of the newest token, refreshing creates a new one
+}
+
+// LegacyClientID is the client of the access tokens issued before the client of a token was
+// recorded, whose auth code was gone so it couldn't be attributed
+const LegacyClientID = "legacy"
+
 // Scope contains identifiers to oAuth permissions
 type Scope string
 
@@ -153,11 +292,63 @@ type Category struct {
 	ParentID int
 }
 
+// PodcastSort is the order in which podcast listings are returned
+type PodcastSort int
+
+// Sort orders of podcast listings
+const (
+	SortBySubscribers PodcastSort = iota
+	SortByLatestEpisode
+	SortByTitle
+)
+
+// ChartType identifies a popularity chart
+type ChartType string
+
+// Types of charts
+const (
+	// ChartTop ranks podcasts by their total subscribers
+	ChartTop ChartType = "top"
+	// ChartTrending ranks podcasts by their new subscribers and plays within a window
+	ChartTrending ChartType = "trending"
+)
+
+// Subscription of a user to a podcast, the episode counts are computed
+// from UserEpisodes by FindSubscriptions()
 type Subscription struct {
-	UserID        uuid.UUID
-	PodcastID     uuid.UUID
-	CompletedIDs  []uuid.UUID
-	InProgressIDs []uuid.UUID
+	UserID     uuid.UUID
+	PodcastID  uuid.UUID
+	Unplayed   int64
+	InProgress int64
+	Completed  int64
+	AutoQueue  bool
+	Settings   PodcastSettings
+}
+
+// EpisodeSort is the order a user lists the episodes of a podcast in
+type EpisodeSort string
+
+// Episode orders of the podcast settings
+const (
+	EpisodeSortNewest EpisodeSort = "newest"
+	EpisodeSortOldest EpisodeSort = "oldest"
+)
+
+// PodcastSettings are the user's preferences of a podcast
+type PodcastSettings struct {
+	UserID            uuid.UUID
+	PodcastID         uuid.UUID
+	PlaybackSpeed     float32
+	SkipIntroSeconds  int32
+	SkipOutroSeconds  int32
+	AutoDownload      bool
+	NotifyNewEpisodes bool
+	EpisodeSort       EpisodeSort
+}
+
+// DefaultPodcastSettings returns the settings of a podcast the user has not changed
+func DefaultPodcastSettings(userID, podID uuid.UUID) *PodcastSettings {
+	return &PodcastSettings{UserID: userID, PodcastID: podID, PlaybackSpeed: 1, EpisodeSort: EpisodeSortNewest}
 }
 
 type UserEpisode struct {
@@ -167,3 +358,118 @@ type UserEpisode struct {
 	LastSeen     time.Time
 	Played       bool
 }
+
+// Playlist is a named list of episodes, the episodes of a smart playlist
+// are resolved from its rules instead of EpisodeIDs
+type Playlist struct {
+	ID         uuid.UUID
+	UserID     uuid.UUID
+	Name       string
+	Smart      bool
+	Rules      *PlaylistRules
+	EpisodeIDs []uuid.UUID
+	Created    time.Time
+	Updated    time.Time
+}
+
+// PlaylistRules filter the episodes of the user's subscriptions, zero values are ignored
+type PlaylistRules struct {
+	Categories            []int       `json:"categories,omitempty"`
+	PodcastIDs            []uuid.UUID `json:"podcastIDs,omitempty"`
+	UnplayedOnly          bool        `json:"unplayedOnly,omitempty"`
+	MinDurationMillis     int64       `json:"minDurationMillis,omitempty"`
+	MaxDurationMillis     int64       `json:"maxDurationMillis,omitempty"`
+	PublishedWithinMillis int64       `json:"publishedWithinMillis,omitempty"`
+	Limit                 int64       `json:"limit,omitempty"`
+}
+
+// ChangeKind is the kind of change recorded within the ChangeLog
+type ChangeKind string
+
+// Kinds of changes
+const (
+	ChangeSubscribed   ChangeKind = "subscribed"
+	ChangeUnsubscribed ChangeKind = "unsubscribed"
+	ChangeUserEpisode  ChangeKind = "user_episode"
+	ChangeQueue        ChangeKind = "queue"
+	ChangeNewEpisode   ChangeKind = "new_episode"
+)
+
+// Change is an entry of the user's ChangeLog, PodcastID and EpisodeID
+// are uuid.Nil when they do not apply to the kind
+type Change struct {
+	Seq       int64
+	Kind      ChangeKind
+	PodcastID uuid.UUID
+	EpisodeID uuid.UUID
+}
+
+// ListeningSession is a continuous playback of an episode
+type ListeningSession struct {
+	ID                int64
+	UserID            uuid.UUID
+	EpisodeID         uuid.UUID
+	StartOffsetMillis int64
+	EndOffsetMillis   int64
+	Started           time.Time
+	Ended             time.Time
+	Device            string
+}
+
+// StatsBucket is the period listening time is totaled by
+type StatsBucket string
+
+// Periods of listening statistics, values are date_trunc fields
+const (
+	BucketDay   StatsBucket = "day"
+	BucketWeek  StatsBucket = "week"
+	BucketMonth StatsBucket = "month"
+)
+
+// ListeningTotal is the listening time within the bucket beginning at Start
+type ListeningTotal struct {
+	Start  time.Time
+	Millis int64
+}
+
+// PodcastListening is the listening time of a podcast
+type PodcastListening struct {
+	Podcast Podcast
+	Millis  int64
+}
+
+// Completion counts the episodes listened to and how many of them were played
+type Completion struct {
+	Started   int64
+	Completed int64
+}
+
+// Bookmark marks a moment within an episode
+type Bookmark struct {
+	ID           uuid.UUID
+	UserID       uuid.UUID
+	EpisodeID    uuid.UUID
+	OffsetMillis int64
+	Note         string
+	Created      time.Time
+}
+
+// Clip is a shareable part of an episode
+type Clip struct {
+	ID          uuid.UUID
+	UserID      uuid.UUID
+	EpisodeID   uuid.UUID
+	StartMillis int64
+	EndMillis   int64
+	Title       string
+	Created     time.Time
+}
+
+// GpodderDevice is a device of a gpodder.net client, ID is chosen by the client
+type GpodderDevice struct {
+	UserID  uuid.UUID
+	ID      string
+	Caption string
+	Type    string
+	Updated time.Time
+}
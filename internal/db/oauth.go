@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/google/uuid"
 	"github.com/jackc/pgx/v4/pgxpool"
 )
 
@@ -25,9 +26,9 @@ func (o *OAuthStorePG) InsertAuthCode(ctx context.Context, a *AuthCodeRow) error
 	return nil
 }
 
-func (o *OAuthStorePG) GetAuthCode(ctx context.Context, code []byte) (*AuthCodeRow, error) {
+func (o *OAuthStorePG) GetAuthCode(ctx context.Context, codeHash []byte) (*AuthCodeRow, error) {
 	a := &AuthCodeRow{}
-	row := o.db.QueryRow(ctx, "SELECT * FROM AuthCodes WHERE code=$1", &code)
+	row := o.db.QueryRow(ctx, "SELECT * FROM AuthCodes WHERE code=$1", &codeHash)
 	err := row.Scan(&a.Code, &a.ClientID, &a.UserID, &a.Scope, &a.Expires)
 	if err != nil {
 		return nil, fmt.Errorf("GetAuthCode() error scanning row: %v", err)
@@ -35,8 +36,8 @@ func (o *OAuthStorePG) GetAuthCode(ctx context.Context, code []byte) (*AuthCodeR
 	return a, nil
 }
 
-func (o *OAuthStorePG) DeleteAuthCode(ctx context.Context, code []byte) error {
-	_, err := o.db.Exec(ctx, "DELETE FROM AuthCodes WHERE code=$1", &code)
+func (o *OAuthStorePG) DeleteAuthCode(ctx context.Context, codeHash []byte) error {
+	_, err := o.db.Exec(ctx, "DELETE FROM AuthCodes WHERE code=$1", &codeHash)
 	if err != nil {
 		return fmt.Errorf("DeleteAuthCode() error deleting: %v", err)
 	}
@@ -45,42 +46,95 @@ func (o *OAuthStorePG) DeleteAuthCode(ctx context.Context, code []byte) error {
 
 func (o *OAuthStorePG) InsertAccessToken(ctx context.Context, a *AccessTokenRow) error {
 	_, err := o.db.Exec(ctx,
-		"INSERT INTO AccessTokens (token,auth_code,refresh_token,user_id,created,expires) VALUES($1,$2,$3,$4,$5,$6)",
-		&a.Token, &a.AuthCode, &a.RefreshToken, &a.UserID, &a.Created, &a.Expires)
+		"INSERT INTO AccessTokens (token,auth_code,refresh_token,user_id,created,expires,client_id) VALUES($1,$2,$3,$4,$5,$6,$7)",
+		&a.Token, &a.AuthCode, &a.RefreshToken, &a.UserID, &a.Created, &a.Expires, &a.ClientID)
 	if err != nil {
 		return fmt.Errorf("InsertAccessToken() error: %v", err)
 	}
 	return nil
 }
 
-func (o *OAuthStorePG) GetAccessTokenByRefresh(ctx context.Context, refreshToken []byte) (*AccessTokenRow, error) {
+func (o *OAuthStorePG) GetAccessTokenByRefresh(ctx context.Context, refreshHash []byte) (*AccessTokenRow, error) {
 	a := &AccessTokenRow{}
-	row := o.db.QueryRow(ctx, "SELECT * FROM AccessTokens WHERE refresh_token=$1", &refreshToken)
-	err := row.Scan(&a.Token, &a.AuthCode, &a.RefreshToken, &a.UserID, &a.Created, &a.Expires)
+	row := o.db.QueryRow(ctx, "SELECT * FROM AccessTokens WHERE refresh_token=$1", &refreshHash)
+	err := row.Scan(&a.Token, &a.AuthCode, &a.RefreshToken, &a.UserID, &a.Created, &a.Expires, &a.ClientID)
 	if err != nil {
 		return nil, fmt.Errorf("GetAccessTokenByRefresh() error scanning row: %v", err)
 	}
 	return a, nil
 }
 
-func (o *OAuthStorePG) DeleteAccessToken(ctx context.Context, token []byte) error {
-	_, err := o.db.Exec(ctx, "DELETE FROM AccessTokens WHERE token=$1", &token)
+func (o *OAuthStorePG) DeleteAccessToken(ctx context.Context, tokenHash []byte) error {
+	_, err := o.db.Exec(ctx, "DELETE FROM AccessTokens WHERE token=$1", &tokenHash)
 	if err != nil {
 		return fmt.Errorf("DeleteAccessToken() error deleting: %v", err)
 	}
 	return nil
 }
 
-func (o *OAuthStorePG) GetAccessTokenAndUser(ctx context.Context, token []byte) (*UserRow, *AccessTokenRow, error) {
+// DeleteUserAccessTokens deletes every access token of the user
+func (o *OAuthStorePG) DeleteUserAccessTokens(ctx context.Context, userID uuid.UUID) error {
+	_, err := o.db.Exec(ctx, "DELETE FROM AccessTokens WHERE user_id=$1", userID)
+	if err != nil {
+		return fmt.Errorf("DeleteUserAccessTokens() error: %v", err)
+	}
+	return nil
+}
+
+// FindUserGrants returns the clients the user granted access to
+func (o *OAuthStorePG) FindUserGrants(ctx context.Context, userID uuid.UUID) ([]OAuthGrant, error) {
+	rows, err := o.db.Query(ctx,
+		"SELECT client_id,MIN(created),MAX(created) FROM AccessTokens WHERE user_id=$1 GROUP BY client_id ORDER BY client_id",
+		userID)
+	if err != nil {
+		return nil, fmt.Errorf("FindUserGrants() error: %v", err)
+	}
+	defer rows.Close()
+	grants := []OAuthGrant{}
+	for rows.Next() {
+		g := OAuthGrant{}
+		if err = rows.Scan(&g.ClientID, &g.Authorized, &g.LastUsed); err != nil {
+			return nil, fmt.Errorf("FindUserGrants() error scanning row: %v", err)
+		}
+		grants = append(grants, g)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("FindUserGrants() error while reading: %v", err)
+	}
+	return grants, nil
+}
+
+// DeleteUserGrant deletes the access tokens and auth codes of the user for the client,
+// returns false if the user had not granted the client access
+func (o *OAuthStorePG) DeleteUserGrant(ctx context.Context, userID uuid.UUID, clientID string) (bool, error) {
+	tx, err := o.db.Begin(ctx)
+	if err != nil {
+		return false, fmt.Errorf("DeleteUserGrant() error beginning transaction: %v", err)
+	}
+	defer tx.Rollback(ctx)
+	tag, err := tx.Exec(ctx, "DELETE FROM AccessTokens WHERE user_id=$1 AND client_id=$2", userID, clientID)
+	if err != nil {
+		return false, fmt.Errorf("DeleteUserGrant() error deleting access tokens: %v", err)
+	}
+	if _, err = tx.Exec(ctx, "DELETE FROM AuthCodes WHERE user_id=$1 AND client_id=$2", userID, clientID); err != nil {
+		return false, fmt.Errorf("DeleteUserGrant() error deleting auth codes: %v", err)
+	}
+	if err = tx.Commit(ctx); err != nil {
+		return false, fmt.Errorf("DeleteUserGrant() error committing: %v", err)
+	}
+	return tag.RowsAffected() > 0, nil
+}
+
+func (o *OAuthStorePG) GetAccessTokenAndUser(ctx context.Context, tokenHash []byte) (*UserRow, *AccessTokenRow, error) {
 	a := &AccessTokenRow{}
 	u := &UserRow{}
 	result := o.db.QueryRow(ctx,
-		"SELECT * FROM AccessTokens a JOIN Users u ON a.user_id=u.id WHERE a.token=$1",
-		&token,
+		"SELECT a.*,"+userColumns+" FROM AccessTokens a JOIN Users u ON a.user_id=u.id WHERE a.token=$1",
+		&tokenHash,
 	)
 	err := result.Scan(
-		&a.Token, &a.AuthCode, &a.RefreshToken, &a.UserID, &a.Created, &a.Expires,
-		&u.ID, &u.Email, &u.Username, &u.Birthdate, &u.PasswordHash, &u.Created, &u.LastSeen,
+		&a.Token, &a.AuthCode, &a.RefreshToken, &a.UserID, &a.Created, &a.Expires, &a.ClientID,
+		&u.ID, &u.Email, &u.Username, &u.Birthdate, &u.PasswordHash, &u.Created, &u.LastSeen, &u.EmailVerified, &u.Role,
 	)
 	if err != nil {
 		return nil, nil, fmt.Errorf("GetAccessTokenAndUser() error: %v", err)
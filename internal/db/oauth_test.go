@@ -6,7 +6,9 @@ import (
 	"testing"
 	"time"
 
+	"github.com/google/uuid"
 	"github.com/jackc/pgx/v4/pgxpool"
+	"github.com/stretchr/testify/require"
 )
 
 func TestOAuthStorePG_InsertAuthCode(t *testing.T) {
@@ -264,6 +266,7 @@ func TestOAuthStorePG_GetAccessTokenAndUser(t *testing.T) {
 				PasswordHash: []byte("pass"),
 				Created:      time.Unix(0, 0),
 				LastSeen:     time.Unix(0, 0),
+				Role:         RoleUser,
 			},
 			want1: &AccessTokenRow{
 				AuthCode:     []byte("get_code"),
@@ -295,3 +298,39 @@ func TestOAuthStorePG_GetAccessTokenAndUser(t *testing.T) {
 		})
 	}
 }
+
+func TestOAuthStorePG_UserGrants(t *testing.T) {
+	ctx := context.Background()
+	a := NewAuthStorePG(dbpg)
+	o := NewOAuthStorePG(dbpg)
+	user := &UserRow{ID: uuid.New(), Email: "grants@test.test", Username: "grantsUser", PasswordHash: []byte("shouldbehash")}
+	insertUser(a, user)
+	insertAuthCode(o, &AuthCodeRow{Code: []byte("grants_code"), ClientID: "alexa", UserID: user.ID, Scope: ReadChange, Expires: time.Now()})
+	insertAccessToken(o, &AccessTokenRow{Token: []byte("grants_token1"), AuthCode: []byte("grants_code"), RefreshToken: []byte("grants_refresh1"),
+		UserID: user.ID, Created: time.Unix(1000, 0), Expires: 3600, ClientID: "alexa"})
+	insertAccessToken(o, &AccessTokenRow{Token: []byte("grants_token2"), AuthCode: []byte("grants_code"), RefreshToken: []byte("grants_refresh2"),
+		UserID: user.ID, Created: time.Unix(2000, 0), Expires: 3600, ClientID: "alexa"})
+	insertAccessToken(o, &AccessTokenRow{Token: []byte("grants_token3"), AuthCode: []byte("grants_code"), RefreshToken: []byte("grants_refresh3"),
+		UserID: user.ID, Created: time.Unix(3000, 0), Expires: 3600, ClientID: "google"})
+
+	grants, err := o.FindUserGrants(ctx, user.ID)
+	require.Nil(t, err)
+	require.Len(t, grants, 2)
+	require.Equal(t, "alexa", grants[0].ClientID)
+	require.Equal(t, int64(1000), grants[0].Authorized.Unix())
+	require.Equal(t, int64(2000), grants[0].LastUsed.Unix())
+	require.Equal(t, "google", grants[1].ClientID)
+
+	deleted, err := o.DeleteUserGrant(ctx, user.ID, "alexa")
+	require.Nil(t, err)
+	require.True(t, deleted)
+	deleted, err = o.DeleteUserGrant(ctx, user.ID, "alexa")
+	require.Nil(t, err)
+	require.False(t, deleted)
+	_, err = o.GetAuthCode(ctx, []byte("grants_code"))
+	require.NotNil(t, err)
+	grants, err = o.FindUserGrants(ctx, user.ID)
+	require.Nil(t, err)
+	require.Len(t, grants, 1)
+	require.Equal(t, "google", grants[0].ClientID)
+}
@@ -0,0 +1,208 @@
+package db
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v4"
+)
+
+const playlistColumns = `p.id,p.user_id,p.name,p.smart,p.rules,p.created,p.updated,
+	COALESCE(array_agg(pe.episode_id ORDER BY pe.position) FILTER (WHERE pe.episode_id IS NOT NULL), '{}')`
+
+// smartPlaylistQuery resolves the rules of a smart playlist against the episodes
+// of the user's subscriptions, newest first
+const smartPlaylistQuery = `
+SELECT e.* FROM Episodes e
+INNER JOIN Subscriptions s ON s.podcast_id=e.podcast_id AND s.user_id=$1
+INNER JOIN Podcasts p ON p.id=e.podcast_id
+LEFT JOIN UserEpisodes u ON u.episode_id=e.id AND u.user_id=$1
+WHERE (COALESCE(cardinality($2::int[]),0)=0 OR p.category && $2::int[])
+	AND (COALESCE(cardinality($3::uuid[]),0)=0 OR e.podcast_id=ANY($3::uuid[]))
+	AND (NOT $4::boolean OR NOT COALESCE(u.played,false))
+	AND ($5::bigint=0 OR e.duration >= $5::bigint)
+	AND ($6::bigint=0 OR e.duration <= $6::bigint)
+	AND ($7::timestamptz IS NULL OR e.pub_date >= $7::timestamptz)
+ORDER BY e.pub_date DESC, e.id LIMIT $8 OFFSET $9`
+
+// scanPlaylistRow is a helper method to scan row selected with playlistColumns into a playlist struct
+func scanPlaylistRow(row scanner, p *Playlist) error {
+	var rules []byte
+	err := row.Scan(&p.ID, &p.UserID, &p.Name, &p.Smart, &rules, &p.Created, &p.Updated, &p.EpisodeIDs)
+	if err != nil {
+		return err
+	}
+	if rules != nil {
+		p.Rules = &PlaylistRules{}
+		if err = json.Unmarshal(rules, p.Rules); err != nil {
+			return fmt.Errorf("scanPlaylistRow() error unmarshalling rules: %v", err)
+		}
+	}
+	return nil
+}
+
+func marshalRules(r *PlaylistRules) ([]byte, error) {
+	if r == nil {
+		return nil, nil
+	}
+	return json.Marshal(r)
+}
+
+// insertPlaylistEpisodes sets the episodes of the playlist in order of epiIDs
+func insertPlaylistEpisodes(ctx context.Context, tx pgx.Tx, playlistID uuid.UUID, epiIDs []uuid.UUID) error {
+	_, err := tx.Exec(ctx, "DELETE FROM PlaylistEpisodes WHERE playlist_id=$1", playlistID)
+	if err != nil {
+		return err
+	}
+	_, err = tx.Exec(ctx,
+		`INSERT INTO PlaylistEpisodes(playlist_id,episode_id,position)
+		 SELECT $1::uuid, id, MIN(ord) FROM unnest($2::uuid[]) WITH ORDINALITY o(id,ord) GROUP BY id`,
+		playlistID, epiIDs)
+	return err
+}
+
+// InsertPlaylist inserts the playlist along with its episodes
+func (ps *PodcastStore) InsertPlaylist(ctx context.Context, p *Playlist) error {
+	rules, err := marshalRules(p.Rules)
+	if err != nil {
+		return fmt.Errorf("InsertPlaylist() error marshalling rules: %v", err)
+	}
+	tx, err := ps.db.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("InsertPlaylist() error beginning transaction: %v", err)
+	}
+	defer tx.Rollback(ctx)
+	_, err = tx.Exec(ctx,
+		"INSERT INTO Playlists(id,user_id,name,smart,rules,created,updated) VALUES($1,$2,$3,$4,$5,$6,$7)",
+		p.ID, p.UserID, p.Name, p.Smart, rules, p.Created, p.Updated)
+	if err != nil {
+		return fmt.Errorf("InsertPlaylist() error: %v", err)
+	}
+	if err = insertPlaylistEpisodes(ctx, tx, p.ID, p.EpisodeIDs); err != nil {
+		return fmt.Errorf("InsertPlaylist() error inserting episodes: %v", err)
+	}
+	if err = tx.Commit(ctx); err != nil {
+		return fmt.Errorf("InsertPlaylist() error committing: %v", err)
+	}
+	return nil
+}
+
+// FindPlaylists returns the user's playlists in order of creation
+func (ps *PodcastStore) FindPlaylists(ctx context.Context, userID uuid.UUID) ([]Playlist, error) {
+	rows, err := ps.db.Query(ctx,
+		`SELECT `+playlistColumns+` FROM Playlists p
+		 LEFT JOIN PlaylistEpisodes pe ON pe.playlist_id=p.id
+		 WHERE p.user_id=$1 GROUP BY p.id ORDER BY p.created, p.id`,
+		userID)
+	if err != nil {
+		return nil, fmt.Errorf("FindPlaylists() error: %v", err)
+	}
+	defer rows.Close()
+	playlists := []Playlist{}
+	for rows.Next() {
+		p := Playlist{}
+		if err = scanPlaylistRow(rows, &p); err != nil {
+			return nil, fmt.Errorf("FindPlaylists() error scanning row: %v", err)
+		}
+		playlists = append(playlists, p)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("FindPlaylists() error while reading: %v", err)
+	}
+	return playlists, nil
+}
+
+// FindPlaylist returns the user's playlist
+func (ps *PodcastStore) FindPlaylist(ctx context.Context, userID, id uuid.UUID) (*Playlist, error) {
+	p := &Playlist{}
+	row := ps.db.QueryRow(ctx,
+		`SELECT `+playlistColumns+` FROM Playlists p
+		 LEFT JOIN PlaylistEpisodes pe ON pe.playlist_id=p.id
+		 WHERE p.user_id=$1 AND p.id=$2 GROUP BY p.id`,
+		userID, id)
+	if err := scanPlaylistRow(row, p); err != nil {
+		return nil, fmt.Errorf("FindPlaylist() error: %v", err)
+	}
+	return p, nil
+}
+
+// UpdatePlaylist replaces the name, rules and episodes of the user's playlist
+func (ps *PodcastStore) UpdatePlaylist(ctx context.Context, p *Playlist) error {
+	rules, err := marshalRules(p.Rules)
+	if err != nil {
+		return fmt.Errorf("UpdatePlaylist() error marshalling rules: %v", err)
+	}
+	tx, err := ps.db.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("UpdatePlaylist() error beginning transaction: %v", err)
+	}
+	defer tx.Rollback(ctx)
+	tag, err := tx.Exec(ctx,
+		"UPDATE Playlists SET name=$3,rules=$4,updated=$5 WHERE user_id=$1 AND id=$2",
+		p.UserID, p.ID, p.Name, rules, p.Updated)
+	if err != nil {
+		return fmt.Errorf("UpdatePlaylist() error: %v", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return fmt.Errorf("UpdatePlaylist() error: playlist not found")
+	}
+	if err = insertPlaylistEpisodes(ctx, tx, p.ID, p.EpisodeIDs); err != nil {
+		return fmt.Errorf("UpdatePlaylist() error updating episodes: %v", err)
+	}
+	if err = tx.Commit(ctx); err != nil {
+		return fmt.Errorf("UpdatePlaylist() error committing: %v", err)
+	}
+	return nil
+}
+
+// DeletePlaylist deletes the user's playlist
+func (ps *PodcastStore) DeletePlaylist(ctx context.Context, userID, id uuid.UUID) error {
+	tag, err := ps.db.Exec(ctx, "DELETE FROM Playlists WHERE user_id=$1 AND id=$2", userID, id)
+	if err != nil {
+		return fmt.Errorf("DeletePlaylist() error: %v", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return fmt.Errorf("DeletePlaylist() error: playlist not found")
+	}
+	return nil
+}
+
+// FindPlaylistEpisodes returns the episodes of a manual playlist in order
+func (ps *PodcastStore) FindPlaylistEpisodes(ctx context.Context, playlistID uuid.UUID, start, end int64) ([]Episode, error) {
+	limit := end - start
+	offset := start
+	rows, err := ps.db.Query(ctx,
+		`SELECT e.* FROM PlaylistEpisodes pe INNER JOIN Episodes e ON pe.episode_id=e.id
+		 WHERE pe.playlist_id=$1 ORDER BY pe.position LIMIT $2 OFFSET $3`,
+		playlistID, limit, offset)
+	if err != nil {
+		return nil, fmt.Errorf("FindPlaylistEpisodes() error: %v", err)
+	}
+	return scanEpisodeRows(rows, []Episode{})
+}
+
+// FindSmartPlaylistEpisodes returns the episodes of the user's subscriptions matching the rules,
+// the categories of the rules are matched as is so subcategories must be included by the caller
+func (ps *PodcastStore) FindSmartPlaylistEpisodes(ctx context.Context, userID uuid.UUID, r *PlaylistRules, start, end int64) ([]Episode, error) {
+	if r.Limit > 0 && end > r.Limit {
+		end = r.Limit
+	}
+	if end <= start {
+		return []Episode{}, nil
+	}
+	var after *time.Time
+	if r.PublishedWithinMillis > 0 {
+		t := time.Now().Add(-time.Duration(r.PublishedWithinMillis) * time.Millisecond)
+		after = &t
+	}
+	rows, err := ps.db.Query(ctx, smartPlaylistQuery,
+		userID, r.Categories, r.PodcastIDs, r.UnplayedOnly, r.MinDurationMillis, r.MaxDurationMillis, after,
+		end-start, start)
+	if err != nil {
+		return nil, fmt.Errorf("FindSmartPlaylistEpisodes() error: %v", err)
+	}
+	return scanEpisodeRows(rows, []Episode{})
+}
@@ -0,0 +1,89 @@
+package db
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Playlists(t *testing.T) {
+	ctx := context.Background()
+	podStore := NewPodcastStore(dbpg)
+	user := &UserRow{ID: uuid.New(), Email: "playlist@test.test", Username: "playlistUser", PasswordHash: []byte("shouldbehash")}
+	pod := &Podcast{ID: uuid.New(), Title: "Playlist Test", Category: []int{71}, RSSURL: "https://syncapod.com/playlist_test.rss"}
+	short := &Episode{ID: uuid.New(), PodcastID: pod.ID, Title: "Short", Duration: 10 * 60 * 1000, PubDate: time.Now().Add(-time.Hour)}
+	long := &Episode{ID: uuid.New(), PodcastID: pod.ID, Title: "Long", Duration: 90 * 60 * 1000, PubDate: time.Now().Add(-2 * time.Hour)}
+	old := &Episode{ID: uuid.New(), PodcastID: pod.ID, Title: "Old", Duration: 10 * 60 * 1000, PubDate: time.Now().Add(-30 * 24 * time.Hour)}
+	played := &Episode{ID: uuid.New(), PodcastID: pod.ID, Title: "Played", Duration: 10 * 60 * 1000, PubDate: time.Now().Add(-3 * time.Hour)}
+	insertUser(NewAuthStorePG(dbpg), user)
+	insertPodcastOrFail(podStore, pod)
+	for _, e := range []*Episode{short, long, old, played} {
+		insertEpisodeOrFail(podStore, e)
+	}
+	insertSubOrFail(podStore, &Subscription{UserID: user.ID, PodcastID: pod.ID})
+	_, err := podStore.UpsertUserEpisode(ctx, &UserEpisode{UserID: user.ID, EpisodeID: played.ID, LastSeen: time.Now(), Played: true}, false)
+	if err != nil {
+		t.Fatalf("Test_Playlists() error upserting user episode: %v", err)
+	}
+
+	// manual
+	manual := &Playlist{ID: uuid.New(), UserID: user.ID, Name: "Manual", EpisodeIDs: []uuid.UUID{long.ID, short.ID}, Created: time.Now(), Updated: time.Now()}
+	if err = podStore.InsertPlaylist(ctx, manual); err != nil {
+		t.Fatalf("Test_Playlists() error inserting manual playlist: %v", err)
+	}
+	epis, err := podStore.FindPlaylistEpisodes(ctx, manual.ID, 0, 10)
+	if err != nil {
+		t.Fatalf("Test_Playlists() error finding manual episodes: %v", err)
+	}
+	require.Len(t, epis, 2)
+	require.Equal(t, long.ID, epis[0].ID)
+
+	manual.Name = "Renamed"
+	manual.EpisodeIDs = []uuid.UUID{short.ID}
+	if err = podStore.UpdatePlaylist(ctx, manual); err != nil {
+		t.Fatalf("Test_Playlists() error updating playlist: %v", err)
+	}
+	found, err := podStore.FindPlaylist(ctx, user.ID, manual.ID)
+	if err != nil {
+		t.Fatalf("Test_Playlists() error finding playlist: %v", err)
+	}
+	require.Equal(t, "Renamed", found.Name)
+	require.Equal(t, []uuid.UUID{short.ID}, found.EpisodeIDs)
+	require.Nil(t, found.Rules)
+
+	// smart: unplayed, shorter than 30 minutes, within the last 2 weeks
+	rules := &PlaylistRules{Categories: []int{70, 71}, UnplayedOnly: true,
+		MaxDurationMillis: 30 * 60 * 1000, PublishedWithinMillis: (14 * 24 * time.Hour).Milliseconds()}
+	smart := &Playlist{ID: uuid.New(), UserID: user.ID, Name: "Smart", Smart: true, Rules: rules, Created: time.Now(), Updated: time.Now()}
+	if err = podStore.InsertPlaylist(ctx, smart); err != nil {
+		t.Fatalf("Test_Playlists() error inserting smart playlist: %v", err)
+	}
+	playlists, err := podStore.FindPlaylists(ctx, user.ID)
+	if err != nil {
+		t.Fatalf("Test_Playlists() error finding playlists: %v", err)
+	}
+	require.Len(t, playlists, 2)
+	require.Equal(t, rules, playlists[1].Rules)
+
+	epis, err = podStore.FindSmartPlaylistEpisodes(ctx, user.ID, rules, 0, 10)
+	if err != nil {
+		t.Fatalf("Test_Playlists() error finding smart episodes: %v", err)
+	}
+	require.Len(t, epis, 1)
+	require.Equal(t, short.ID, epis[0].ID)
+
+	// no rules matches every episode of the subscriptions, newest first
+	epis, err = podStore.FindSmartPlaylistEpisodes(ctx, user.ID, &PlaylistRules{Limit: 3}, 0, 10)
+	if err != nil {
+		t.Fatalf("Test_Playlists() error finding smart episodes: %v", err)
+	}
+	require.Len(t, epis, 3)
+	require.Equal(t, short.ID, epis[0].ID)
+
+	// delete
+	require.Nil(t, podStore.DeletePlaylist(ctx, user.ID, smart.ID))
+	require.NotNil(t, podStore.DeletePlaylist(ctx, user.ID, smart.ID))
+}
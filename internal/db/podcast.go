@@ -78,7 +78,7 @@ func (ps *PodcastStore) FindPodcastByID(ctx context.Context, id uuid.UUID) (*Pod
 	row := ps.db.QueryRow(ctx, "SELECT * FROM Podcasts WHERE id=$1", id)
 	err := scanPodcastRow(row, p)
 	if err != nil {
-		return nil, fmt.Errorf("FindPodcastByID() error: %v", err)
+		return nil, fmt.Errorf("FindPodcastByID() error: %w", err)
 	}
 	return p, nil
 }
@@ -117,6 +117,31 @@ func (ps *PodcastStore) SearchPodcasts(ctx context.Context, search string) ([]Po
 	return scanPodcastRows(rows, []Podcast{})
 }
 
+// podcastSortClauses maps each PodcastSort to its ORDER BY clause
+var podcastSortClauses = map[PodcastSort]string{
+	SortBySubscribers:   "(SELECT COUNT(*) FROM Subscriptions s WHERE s.podcast_id=p.id) DESC, p.title",
+	SortByLatestEpisode: "(SELECT MAX(e.pub_date) FROM Episodes e WHERE e.podcast_id=p.id) DESC NULLS LAST, p.title",
+	SortByTitle:         "p.title",
+}
+
+// FindPodcastsByCategories returns the podcasts within any of the category ids
+// ordered by sortBy, start & end represent the range of podcasts
+func (ps *PodcastStore) FindPodcastsByCategories(ctx context.Context, catIDs []int, sortBy PodcastSort, start, end int64) ([]Podcast, error) {
+	orderBy, ok := podcastSortClauses[sortBy]
+	if !ok {
+		return nil, fmt.Errorf("FindPodcastsByCategories() error: unknown sort order %d", sortBy)
+	}
+	limit := end - start
+	offset := start
+	rows, err := ps.db.Query(ctx,
+		"SELECT p.* FROM Podcasts p WHERE p.category && $1 ORDER BY "+orderBy+" LIMIT $2 OFFSET $3",
+		catIDs, limit, offset)
+	if err != nil {
+		return nil, fmt.Errorf("FindPodcastsByCategories() error: %v", err)
+	}
+	return scanPodcastRows(rows, []Podcast{})
+}
+
 // Episode stuff
 
 func (p *PodcastStore) InsertEpisode(ctx context.Context, e *Episode) error {
@@ -129,6 +154,34 @@ func (p *PodcastStore) InsertEpisode(ctx context.Context, e *Episode) error {
 	return nil
 }
 
+// FindEpisodesByIDs returns the episodes of ids, newest first
+func (p *PodcastStore) FindEpisodesByIDs(ctx context.Context, ids []uuid.UUID) ([]Episode, error) {
+	rows, err := p.db.Query(ctx, "SELECT * FROM Episodes WHERE id=ANY($1::uuid[]) ORDER BY pub_date DESC", ids)
+	if err != nil {
+		return nil, fmt.Errorf("FindEpisodesByIDs() error: %v", err)
+	}
+	return scanEpisodeRows(rows, []Episode{})
+}
+
+// FindMissingEpisodes returns the ids which do not belong to any episode
+func (p *PodcastStore) FindMissingEpisodes(ctx context.Context, ids []uuid.UUID) ([]uuid.UUID, error) {
+	rows, err := p.db.Query(ctx,
+		"SELECT u.id FROM unnest($1::uuid[]) AS u(id) WHERE NOT EXISTS (SELECT 1 FROM Episodes e WHERE e.id=u.id)", ids)
+	if err != nil {
+		return nil, fmt.Errorf("FindMissingEpisodes() error: %v", err)
+	}
+	defer rows.Close()
+	missing := []uuid.UUID{}
+	for rows.Next() {
+		var id uuid.UUID
+		if err = rows.Scan(&id); err != nil {
+			return nil, fmt.Errorf("FindMissingEpisodes() error scanning: %v", err)
+		}
+		missing = append(missing, id)
+	}
+	return missing, rows.Err()
+}
+
 func (p *PodcastStore) FindEpisodeByID(ctx context.Context, epiID uuid.UUID) (*Episode, error) {
 	row := p.db.QueryRow(ctx, "SELECT * FROM Episodes WHERE id=$1", &epiID)
 	epi := &Episode{}
@@ -211,19 +264,28 @@ func (p *PodcastStore) FindAllCategories(ctx context.Context) ([]Category, error
 	return cats, nil
 }
 
-func (p *PodcastStore) UpsertUserEpisode(ctx context.Context, userEpi *UserEpisode) error {
-	_, err := p.db.Exec(ctx,
-		`INSERT INTO UserEpisodes
+// UpsertUserEpisode inserts or updates the user's playback state of the episode,
+// updates older (last_seen) than the stored state keep the stored offset and
+// played is only unset via resetPlayed, last_seen is clamped to now so a client clock
+// ahead can't lock the state, returns whether the update was applied and sets
+// userEpi to the stored state
+func (p *PodcastStore) UpsertUserEpisode(ctx context.Context, userEpi *UserEpisode, resetPlayed bool) (bool, error) {
+	var applied bool
+	err := p.db.QueryRow(ctx,
+		`INSERT INTO UserEpisodes AS u
 		(user_id,episode_id,offset_millis,last_seen,played)
-		VALUES($1,$2,$3,$4,$5)
-		ON CONFLICT (user_id,episode_id) DO UPDATE
-		SET offset_millis=$3,last_seen=$4,played=$5`,
-		&userEpi.UserID, &userEpi.EpisodeID, &userEpi.OffsetMillis, &userEpi.LastSeen, &userEpi.Played,
-	)
+		VALUES($1,$2,$3,LEAST($4::timestamptz,now()),$5)
+		ON CONFLICT (user_id,episode_id) DO UPDATE SET
+		offset_millis=CASE WHEN u.last_seen <= EXCLUDED.last_seen THEN EXCLUDED.offset_millis ELSE u.offset_millis END,
+		played=CASE WHEN $6 AND u.last_seen <= EXCLUDED.last_seen THEN EXCLUDED.played ELSE u.played OR EXCLUDED.played END,
+		last_seen=GREATEST(u.last_seen,EXCLUDED.last_seen)
+		RETURNING offset_millis,last_seen,played,last_seen=LEAST($4::timestamptz,now())`,
+		&userEpi.UserID, &userEpi.EpisodeID, &userEpi.OffsetMillis, &userEpi.LastSeen, &userEpi.Played, resetPlayed,
+	).Scan(&userEpi.OffsetMillis, &userEpi.LastSeen, &userEpi.Played, &applied)
 	if err != nil {
-		return fmt.Errorf("UpsertUserEpisode() error: %v", err)
+		return false, fmt.Errorf("UpsertUserEpisode() error: %v", err)
 	}
-	return nil
+	return applied, nil
 }
 
 func (p *PodcastStore) FindUserEpisode(ctx context.Context, userID, epiID uuid.UUID) (*UserEpisode, error) {
@@ -238,6 +300,30 @@ func (p *PodcastStore) FindUserEpisode(ctx context.Context, userID, epiID uuid.U
 	return userEpi, nil
 }
 
+// FindUserEpisodes returns the user's playback state of the episodes, all of them if epiIDs is nil
+func (p *PodcastStore) FindUserEpisodes(ctx context.Context, userID uuid.UUID, epiIDs []uuid.UUID) ([]UserEpisode, error) {
+	rows, err := p.db.Query(ctx,
+		`SELECT episode_id,offset_millis,last_seen,played FROM UserEpisodes
+		 WHERE user_id=$1 AND ($2::uuid[] IS NULL OR episode_id=ANY($2::uuid[]))`,
+		userID, epiIDs)
+	if err != nil {
+		return nil, fmt.Errorf("FindUserEpisodes() error: %v", err)
+	}
+	defer rows.Close()
+	userEpis := []UserEpisode{}
+	for rows.Next() {
+		u := UserEpisode{UserID: userID}
+		if err = rows.Scan(&u.EpisodeID, &u.OffsetMillis, &u.LastSeen, &u.Played); err != nil {
+			return nil, fmt.Errorf("FindUserEpisodes() error scanning row: %v", err)
+		}
+		userEpis = append(userEpis, u)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("FindUserEpisodes() error while reading: %v", err)
+	}
+	return userEpis, nil
+}
+
 func (p *PodcastStore) FindLastUserEpi(ctx context.Context, userID uuid.UUID) (*UserEpisode, error) {
 	userEpi := &UserEpisode{UserID: userID}
 	row := p.db.QueryRow(ctx,
@@ -287,14 +373,18 @@ func scanSubRows(rows pgx.Rows, s []Subscription) ([]Subscription, error) {
 	return s, nil
 }
 
-// scanPodcastRow is a helper method to scan row into a podcast struct
+// scanSubRow is a helper method to scan row into a subscription struct
 func scanSubRow(row scanner, s *Subscription) error {
-	return row.Scan(&s.UserID, &s.PodcastID, &s.CompletedIDs, &s.InProgressIDs)
+	err := row.Scan(&s.UserID, &s.PodcastID, &s.Unplayed, &s.InProgress, &s.Completed, &s.AutoQueue,
+		&s.Settings.PlaybackSpeed, &s.Settings.SkipIntroSeconds, &s.Settings.SkipOutroSeconds,
+		&s.Settings.AutoDownload, &s.Settings.NotifyNewEpisodes, &s.Settings.EpisodeSort)
+	s.Settings.UserID, s.Settings.PodcastID = s.UserID, s.PodcastID
+	return err
 }
 
 func (ps *PodcastStore) InsertSubscription(ctx context.Context, sub *Subscription) error {
-	_, err := ps.db.Exec(ctx, "INSERT INTO Subscriptions(user_id,podcast_id,completed_ids,in_progress_ids) VALUES($1,$2,$3,$4)",
-		&sub.UserID, &sub.PodcastID, &sub.CompletedIDs, &sub.InProgressIDs)
+	_, err := ps.db.Exec(ctx, "INSERT INTO Subscriptions(user_id,podcast_id) VALUES($1,$2)",
+		&sub.UserID, &sub.PodcastID)
 	if err != nil {
 		return fmt.Errorf("InsertSubscription() error inserting subscription: %v", err)
 	}
@@ -309,9 +399,30 @@ func (ps *PodcastStore) DeleteSubscription(ctx context.Context, uID uuid.UUID, p
 	return nil
 }
 
+// findSubscriptionsQuery counts the episodes of each subscription by play state
+// unplayed: no UserEpisode or not yet started
+// in progress: started (offset > 0) but not played
+// completed: played
+const findSubscriptionsQuery = `
+SELECT s.user_id, s.podcast_id,
+	COUNT(e.id) FILTER (WHERE NOT COALESCE(u.played,FALSE) AND COALESCE(u.offset_millis,0)=0),
+	COUNT(e.id) FILTER (WHERE NOT COALESCE(u.played,FALSE) AND u.offset_millis>0),
+	COUNT(e.id) FILTER (WHERE u.played),
+	s.auto_queue,
+	COALESCE(ps.playback_speed,1), COALESCE(ps.skip_intro_seconds,0), COALESCE(ps.skip_outro_seconds,0),
+	COALESCE(ps.auto_download,FALSE), COALESCE(ps.notify_new_episodes,FALSE), COALESCE(ps.episode_sort,'newest')
+FROM Subscriptions s
+LEFT JOIN PodcastSettings ps ON ps.user_id=s.user_id AND ps.podcast_id=s.podcast_id
+LEFT JOIN Episodes e ON e.podcast_id=s.podcast_id
+LEFT JOIN UserEpisodes u ON u.user_id=s.user_id AND u.episode_id=e.id
+WHERE s.user_id=$1
+GROUP BY s.user_id, s.podcast_id, ps.user_id, ps.podcast_id
+ORDER BY s.created, s.podcast_id`
+
+// FindSubscriptions returns the user's subscriptions along with their episode counts
 func (ps *PodcastStore) FindSubscriptions(ctx context.Context, userID uuid.UUID) ([]Subscription, error) {
 	subs := []Subscription{}
-	rows, err := ps.db.Query(ctx, "SELECT * FROM Subscriptions WHERE user_id=$1", userID)
+	rows, err := ps.db.Query(ctx, findSubscriptionsQuery, userID)
 	if err != nil {
 		return nil, fmt.Errorf("FindSubscriptions() error querying db")
 	}
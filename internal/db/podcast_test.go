@@ -18,8 +18,8 @@ var (
 	testEpi2    = &Episode{ID: uuid.New(), PodcastID: testPod.ID, Title: "Test Episode 2", Episode: 124, PubDate: time.Unix(1001, 0)}
 	testUser    = &UserRow{ID: uuid.New(), Username: "dbTestUser", PasswordHash: []byte("shouldbehash")}
 	testUserEpi = &UserEpisode{EpisodeID: testEpi.ID, UserID: testUser.ID, LastSeen: time.Now(), OffsetMillis: 123456, Played: false}
-	testSub     = &Subscription{UserID: testUser.ID, PodcastID: testPod.ID, CompletedIDs: []uuid.UUID{testEpi.ID}, InProgressIDs: []uuid.UUID{testEpi2.ID}}
-	testSub2    = &Subscription{UserID: testUser.ID, PodcastID: testPod2.ID, CompletedIDs: []uuid.UUID{}, InProgressIDs: []uuid.UUID{}}
+	testSub     = &Subscription{UserID: testUser.ID, PodcastID: testPod.ID}
+	testSub2    = &Subscription{UserID: testUser.ID, PodcastID: testPod2.ID}
 )
 
 func setupPodcastDB() {
@@ -33,7 +33,7 @@ func setupPodcastDB() {
 	if err != nil {
 		log.Fatalf("db.setupPodcastDB() error: %v", err)
 	}
-	err = podStore.UpsertUserEpisode(context.Background(), testUserEpi)
+	_, err = podStore.UpsertUserEpisode(context.Background(), testUserEpi, false)
 	if err != nil {
 		log.Fatalf("db.setupPodcastDB() error: %v", err)
 	}
@@ -91,6 +91,25 @@ func Test_FindPodcastsByRange(t *testing.T) {
 	require.NotEmpty(t, pods)
 }
 
+func Test_FindPodcastsByCategories(t *testing.T) {
+	podStore := NewPodcastStore(dbpg)
+	pod := &Podcast{ID: uuid.New(), Title: "Category Test", Category: []int{60, 61}, RSSURL: "https://syncapod.com/category_test.rss"}
+	insertPodcastOrFail(podStore, pod)
+	for _, sortBy := range []PodcastSort{SortBySubscribers, SortByLatestEpisode, SortByTitle} {
+		pods, err := podStore.FindPodcastsByCategories(context.Background(), []int{61, 100}, sortBy, 0, 10)
+		if err != nil {
+			t.Fatalf("Test_FindPodcastsByCategories() error: %v", err)
+		}
+		require.Len(t, pods, 1)
+		require.Equal(t, pod.ID, pods[0].ID)
+	}
+	pods, err := podStore.FindPodcastsByCategories(context.Background(), []int{1}, SortBySubscribers, 0, 10)
+	if err != nil {
+		t.Fatalf("Test_FindPodcastsByCategories() error: %v", err)
+	}
+	require.GreaterOrEqual(t, len(pods), 2)
+}
+
 func Test_InsertPodcast(t *testing.T) {
 	podStore := NewPodcastStore(dbpg)
 	pod := &Podcast{ID: uuid.New(), Author: "Sam Schwartz", Description: "Test Insert Podcast", LinkURL: "https://syncapod.com/podcast", ImageURL: "http://syncapod.com/logo.png", Language: "en", Category: []int{1, 2, 3}, Explicit: "clean", RSSURL: "https://syncapod.com/podcast_test.rss"}
@@ -196,15 +215,56 @@ func Test_UpsertUserEpisode(t *testing.T) {
 	podStore := NewPodcastStore(dbpg)
 	upsertUserEpi := *testUserEpi
 	upsertUserEpi.OffsetMillis = 654321
-	err := podStore.UpsertUserEpisode(context.Background(), &upsertUserEpi)
+	applied, err := podStore.UpsertUserEpisode(context.Background(), &upsertUserEpi, false)
 	if err != nil {
 		t.Fatalf("Test_UpsertUserEpisode() error: %v", err)
 	}
+	require.True(t, applied)
 	upsertUserEpi2, err := podStore.FindUserEpisode(context.Background(), upsertUserEpi.UserID, upsertUserEpi.EpisodeID)
 	if err != nil {
 		t.Fatalf("Test_UpsertUserEpisode() error finding user epi: %v", err)
 	}
 	require.Equal(t, upsertUserEpi.OffsetMillis, upsertUserEpi2.OffsetMillis)
+
+	// played is sticky, a stale update is not applied but still marks it played
+	stale := UserEpisode{UserID: testUser.ID, EpisodeID: testEpi.ID, OffsetMillis: 1,
+		LastSeen: upsertUserEpi2.LastSeen.Add(-time.Hour), Played: true}
+	applied, err = podStore.UpsertUserEpisode(context.Background(), &stale, false)
+	if err != nil {
+		t.Fatalf("Test_UpsertUserEpisode() error upserting stale: %v", err)
+	}
+	require.False(t, applied)
+	require.Equal(t, int64(654321), stale.OffsetMillis)
+	require.True(t, stale.Played)
+
+	// a newer update cannot unset played without reset
+	newer := UserEpisode{UserID: testUser.ID, EpisodeID: testEpi.ID, OffsetMillis: 2, LastSeen: time.Now()}
+	applied, err = podStore.UpsertUserEpisode(context.Background(), &newer, false)
+	if err != nil {
+		t.Fatalf("Test_UpsertUserEpisode() error upserting newer: %v", err)
+	}
+	require.True(t, applied)
+	require.Equal(t, int64(2), newer.OffsetMillis)
+	require.True(t, newer.Played)
+
+	// a future last_seen is clamped to now and doesn't lock out later updates
+	future := UserEpisode{UserID: testUser.ID, EpisodeID: testEpi.ID, OffsetMillis: 3, LastSeen: time.Now().Add(24 * time.Hour)}
+	applied, err = podStore.UpsertUserEpisode(context.Background(), &future, false)
+	if err != nil {
+		t.Fatalf("Test_UpsertUserEpisode() error upserting future: %v", err)
+	}
+	require.True(t, applied)
+	require.False(t, future.LastSeen.After(time.Now()))
+
+	// restore the original state
+	reset := *testUserEpi
+	reset.LastSeen = time.Now()
+	applied, err = podStore.UpsertUserEpisode(context.Background(), &reset, true)
+	if err != nil {
+		t.Fatalf("Test_UpsertUserEpisode() error resetting: %v", err)
+	}
+	require.True(t, applied)
+	require.False(t, reset.Played)
 }
 
 func Test_FindEpisodesByRange(t *testing.T) {
@@ -238,7 +298,10 @@ func Test_FindSubscriptions(t *testing.T) {
 	if err != nil {
 		t.Fatalf("Test_FindSubscriptions() error finding subscriptions: %v", err)
 	}
-	require.Equal(t, []Subscription{*testSub, *testSub2}, subs)
+	// testEpi is in progress (testUserEpi), testEpi2 has not been started
+	sub, sub2 := *testSub, *testSub2
+	sub.Unplayed, sub.InProgress = 1, 1
+	require.Equal(t, []Subscription{sub, sub2}, subs)
 }
 
 func TestPodcastStore_InsertCategory(t *testing.T) {
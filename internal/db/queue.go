@@ -0,0 +1,117 @@
+package db
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+)
+
+// FindQueue returns the episodes within the user's queue in order
+func (ps *PodcastStore) FindQueue(ctx context.Context, userID uuid.UUID) ([]Episode, error) {
+	rows, err := ps.db.Query(ctx,
+		`SELECT e.* FROM QueueItems q INNER JOIN Episodes e ON q.episode_id=e.id
+		 WHERE q.user_id=$1 ORDER BY q.position`,
+		userID)
+	if err != nil {
+		return nil, fmt.Errorf("FindQueue() error: %v", err)
+	}
+	return scanEpisodeRows(rows, []Episode{})
+}
+
+// FindNextInQueue returns the first episode within the queue other than the current episode
+func (ps *PodcastStore) FindNextInQueue(ctx context.Context, userID, currentEpiID uuid.UUID) (*Episode, error) {
+	e := &Episode{}
+	row := ps.db.QueryRow(ctx,
+		`SELECT e.* FROM QueueItems q INNER JOIN Episodes e ON q.episode_id=e.id
+		 WHERE q.user_id=$1 AND q.episode_id<>$2 ORDER BY q.position LIMIT 1`,
+		userID, currentEpiID)
+	err := scanEpisodeRow(row, e)
+	if err != nil {
+		return nil, fmt.Errorf("FindNextInQueue() error: %v", err)
+	}
+	return e, nil
+}
+
+// InsertQueueItem appends the episode to the end of the user's queue, or the front if next
+// an episode already within the queue is moved
+func (ps *PodcastStore) InsertQueueItem(ctx context.Context, userID, epiID uuid.UUID, next bool) error {
+	position := "COALESCE(MAX(position),0)+1"
+	if next {
+		position = "COALESCE(MIN(position),0)-1"
+	}
+	_, err := ps.db.Exec(ctx,
+		`INSERT INTO QueueItems(user_id,episode_id,position)
+		 SELECT $1::uuid,$2::uuid,`+position+` FROM QueueItems WHERE user_id=$1
+		 ON CONFLICT (user_id,episode_id) DO UPDATE SET position=EXCLUDED.position`,
+		userID, epiID)
+	if err != nil {
+		return fmt.Errorf("InsertQueueItem() error: %v", err)
+	}
+	return nil
+}
+
+// DeleteQueueItem removes the episode from the user's queue
+func (ps *PodcastStore) DeleteQueueItem(ctx context.Context, userID, epiID uuid.UUID) error {
+	_, err := ps.db.Exec(ctx, "DELETE FROM QueueItems WHERE user_id=$1 AND episode_id=$2", userID, epiID)
+	if err != nil {
+		return fmt.Errorf("DeleteQueueItem() error: %v", err)
+	}
+	return nil
+}
+
+// ReorderQueue orders the user's queue by epiIDs, episodes missing from
+// epiIDs keep their relative order after the listed ones
+func (ps *PodcastStore) ReorderQueue(ctx context.Context, userID uuid.UUID, epiIDs []uuid.UUID) error {
+	_, err := ps.db.Exec(ctx,
+		`WITH ordered AS (
+			SELECT q.episode_id, ROW_NUMBER() OVER (ORDER BY o.ord NULLS LAST, q.position) AS position
+			FROM QueueItems q
+			LEFT JOIN unnest($2::uuid[]) WITH ORDINALITY o(id,ord) ON o.id=q.episode_id
+			WHERE q.user_id=$1
+		)
+		UPDATE QueueItems q SET position=ordered.position FROM ordered
+		WHERE q.user_id=$1 AND q.episode_id=ordered.episode_id`,
+		userID, epiIDs)
+	if err != nil {
+		return fmt.Errorf("ReorderQueue() error: %v", err)
+	}
+	return nil
+}
+
+// ClearQueue removes every episode from the user's queue
+func (ps *PodcastStore) ClearQueue(ctx context.Context, userID uuid.UUID) error {
+	_, err := ps.db.Exec(ctx, "DELETE FROM QueueItems WHERE user_id=$1", userID)
+	if err != nil {
+		return fmt.Errorf("ClearQueue() error: %v", err)
+	}
+	return nil
+}
+
+// UpdateAutoQueue sets whether new episodes of the subscribed podcast are queued
+func (ps *PodcastStore) UpdateAutoQueue(ctx context.Context, userID, podID uuid.UUID, enabled bool) error {
+	tag, err := ps.db.Exec(ctx,
+		"UPDATE Subscriptions SET auto_queue=$3 WHERE user_id=$1 AND podcast_id=$2",
+		userID, podID, enabled)
+	if err != nil {
+		return fmt.Errorf("UpdateAutoQueue() error: %v", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return fmt.Errorf("UpdateAutoQueue() error: subscription not found")
+	}
+	return nil
+}
+
+// QueueNewEpisode appends the episode to the queues of the podcast's subscribers with auto queue enabled
+func (ps *PodcastStore) QueueNewEpisode(ctx context.Context, podID, epiID uuid.UUID) error {
+	_, err := ps.db.Exec(ctx,
+		`INSERT INTO QueueItems(user_id,episode_id,position)
+		 SELECT s.user_id, $2::uuid, COALESCE((SELECT MAX(q.position) FROM QueueItems q WHERE q.user_id=s.user_id),0)+1
+		 FROM Subscriptions s WHERE s.podcast_id=$1 AND s.auto_queue
+		 ON CONFLICT (user_id,episode_id) DO NOTHING`,
+		podID, epiID)
+	if err != nil {
+		return fmt.Errorf("QueueNewEpisode() error: %v", err)
+	}
+	return nil
+}
@@ -0,0 +1,68 @@
+package db
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Queue(t *testing.T) {
+	ctx := context.Background()
+	podStore := NewPodcastStore(dbpg)
+	user := &UserRow{ID: uuid.New(), Email: "queue@test.test", Username: "queueUser", PasswordHash: []byte("shouldbehash")}
+	pod := &Podcast{ID: uuid.New(), Title: "Queue Test", Category: []int{}, RSSURL: "https://syncapod.com/queue_test.rss"}
+	epi1 := &Episode{ID: uuid.New(), PodcastID: pod.ID, Title: "Queue 1", PubDate: time.Unix(1, 0)}
+	epi2 := &Episode{ID: uuid.New(), PodcastID: pod.ID, Title: "Queue 2", PubDate: time.Unix(2, 0)}
+	epi3 := &Episode{ID: uuid.New(), PodcastID: pod.ID, Title: "Queue 3", PubDate: time.Unix(3, 0)}
+	insertUser(NewAuthStorePG(dbpg), user)
+	insertPodcastOrFail(podStore, pod)
+	insertEpisodeOrFail(podStore, epi1)
+	insertEpisodeOrFail(podStore, epi2)
+	insertSubOrFail(podStore, &Subscription{UserID: user.ID, PodcastID: pod.ID})
+
+	queueIDs := func() []uuid.UUID {
+		epis, err := podStore.FindQueue(ctx, user.ID)
+		if err != nil {
+			t.Fatalf("Test_Queue() error finding queue: %v", err)
+		}
+		ids := make([]uuid.UUID, len(epis))
+		for i := range epis {
+			ids[i] = epis[i].ID
+		}
+		return ids
+	}
+
+	// add, epi2 to the front
+	require.Nil(t, podStore.InsertQueueItem(ctx, user.ID, epi1.ID, false))
+	require.Nil(t, podStore.InsertQueueItem(ctx, user.ID, epi2.ID, true))
+	require.Equal(t, []uuid.UUID{epi2.ID, epi1.ID}, queueIDs())
+
+	// next
+	next, err := podStore.FindNextInQueue(ctx, user.ID, epi2.ID)
+	if err != nil {
+		t.Fatalf("Test_Queue() error finding next: %v", err)
+	}
+	require.Equal(t, epi1.ID, next.ID)
+
+	// reorder
+	require.Nil(t, podStore.ReorderQueue(ctx, user.ID, []uuid.UUID{epi1.ID}))
+	require.Equal(t, []uuid.UUID{epi1.ID, epi2.ID}, queueIDs())
+
+	// remove
+	require.Nil(t, podStore.DeleteQueueItem(ctx, user.ID, epi1.ID))
+	require.Equal(t, []uuid.UUID{epi2.ID}, queueIDs())
+
+	// auto queue new episodes
+	require.Nil(t, podStore.UpdateAutoQueue(ctx, user.ID, pod.ID, true))
+	insertEpisodeOrFail(podStore, epi3)
+	require.Nil(t, podStore.QueueNewEpisode(ctx, pod.ID, epi3.ID))
+	require.Equal(t, []uuid.UUID{epi2.ID, epi3.ID}, queueIDs())
+	require.NotNil(t, podStore.UpdateAutoQueue(ctx, user.ID, uuid.New(), true))
+
+	// clear
+	require.Nil(t, podStore.ClearQueue(ctx, user.ID))
+	require.Empty(t, queueIDs())
+}
@@ -0,0 +1,136 @@
+package db
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+)
+
+// refreshSimilarQuery scores every pair of podcasts by the cosine similarity of
+// their subscribers (co-occurrence) weighted with the overlap of their categories.
+// Only pairs sharing a subscriber or a subcategory are considered, within each
+// subcategory podcasts are only paired with its $3 most subscribed podcasts
+// so the amount of pairs grows linearly with the catalog.
+const refreshSimilarQuery = `
+WITH counts AS (
+	SELECT podcast_id, COUNT(*) AS subs FROM Subscriptions GROUP BY podcast_id
+), co AS (
+	SELECT a.podcast_id, b.podcast_id AS similar_id,
+		COUNT(*) / sqrt(MAX(ca.subs) * MAX(cb.subs)) AS cosine
+	FROM Subscriptions a
+	INNER JOIN Subscriptions b ON a.user_id=b.user_id AND a.podcast_id<>b.podcast_id
+	INNER JOIN counts ca ON ca.podcast_id=a.podcast_id
+	INNER JOIN counts cb ON cb.podcast_id=b.podcast_id
+	GROUP BY a.podcast_id, b.podcast_id
+), subcats AS (
+	SELECT p.id, ARRAY(SELECT c.id FROM Categories c WHERE c.id = ANY(p.category) AND c.parent_id<>0) AS category
+	FROM Podcasts p
+), members AS (
+	SELECT s.id, c FROM subcats s, unnest(s.category) c
+), candidates AS (
+	SELECT id, c FROM (
+		SELECT m.id, m.c, ROW_NUMBER() OVER (PARTITION BY m.c ORDER BY COALESCE(n.subs,0) DESC, m.id) AS rank
+		FROM members m LEFT JOIN counts n ON n.podcast_id=m.id
+	) r WHERE rank <= $3
+), pairs AS (
+	SELECT DISTINCT m.id AS podcast_id, k.id AS similar_id
+	FROM members m INNER JOIN candidates k ON k.c=m.c AND k.id<>m.id
+), cats AS (
+	SELECT p.podcast_id, p.similar_id,
+		cardinality(ARRAY(SELECT unnest(a.category) INTERSECT SELECT unnest(b.category)))::float8
+		/ cardinality(ARRAY(SELECT unnest(a.category) UNION SELECT unnest(b.category))) AS overlap
+	FROM pairs p
+	INNER JOIN subcats a ON a.id=p.podcast_id
+	INNER JOIN subcats b ON b.id=p.similar_id
+), scores AS (
+	SELECT COALESCE(co.podcast_id,cats.podcast_id) AS podcast_id,
+		COALESCE(co.similar_id,cats.similar_id) AS similar_id,
+		$2::float8 * COALESCE(co.cosine,0) + (1 - $2::float8) * COALESCE(cats.overlap,0) AS score
+	FROM co FULL OUTER JOIN cats ON co.podcast_id=cats.podcast_id AND co.similar_id=cats.similar_id
+), ranked AS (
+	SELECT podcast_id, similar_id, score,
+		ROW_NUMBER() OVER (PARTITION BY podcast_id ORDER BY score DESC, similar_id) AS rank
+	FROM scores
+)
+INSERT INTO SimilarPodcasts(podcast_id,similar_id,score,rank)
+SELECT podcast_id, similar_id, score, rank FROM ranked WHERE rank <= $1`
+
+// refreshRecommendationsQuery sums the similarity scores of the podcasts similar
+// to each of a user's subscriptions, excluding the ones they already subscribe to
+const refreshRecommendationsQuery = `
+WITH scores AS (
+	SELECT s.user_id, sp.similar_id AS podcast_id, SUM(sp.score) AS score
+	FROM Subscriptions s INNER JOIN SimilarPodcasts sp ON sp.podcast_id=s.podcast_id
+	WHERE NOT EXISTS (SELECT 1 FROM Subscriptions x WHERE x.user_id=s.user_id AND x.podcast_id=sp.similar_id)
+	GROUP BY s.user_id, sp.similar_id
+), ranked AS (
+	SELECT user_id, podcast_id, score,
+		ROW_NUMBER() OVER (PARTITION BY user_id ORDER BY score DESC, podcast_id) AS rank
+	FROM scores
+)
+INSERT INTO Recommendations(user_id,podcast_id,score,rank)
+SELECT user_id, podcast_id, score, rank FROM ranked WHERE rank <= $1`
+
+// RefreshRecommendations recomputes the similar podcasts & the per user recommendations
+// size is the maximum amount of podcasts kept per podcast & per user
+// subWeight (0-1) is the weight given to subscriptions over category overlap
+// candidates is the amount of podcasts per subcategory other podcasts are compared with
+func (ps *PodcastStore) RefreshRecommendations(ctx context.Context, size int, subWeight float64, candidates int) error {
+	tx, err := ps.db.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("RefreshRecommendations() error beginning transaction: %v", err)
+	}
+	defer tx.Rollback(ctx)
+	_, err = tx.Exec(ctx, "DELETE FROM SimilarPodcasts")
+	if err != nil {
+		return fmt.Errorf("RefreshRecommendations() error clearing similar podcasts: %v", err)
+	}
+	_, err = tx.Exec(ctx, "DELETE FROM Recommendations")
+	if err != nil {
+		return fmt.Errorf("RefreshRecommendations() error clearing recommendations: %v", err)
+	}
+	_, err = tx.Exec(ctx, refreshSimilarQuery, size, subWeight, candidates)
+	if err != nil {
+		return fmt.Errorf("RefreshRecommendations() error computing similar podcasts: %v", err)
+	}
+	_, err = tx.Exec(ctx, refreshRecommendationsQuery, size)
+	if err != nil {
+		return fmt.Errorf("RefreshRecommendations() error computing recommendations: %v", err)
+	}
+	if err = tx.Commit(ctx); err != nil {
+		return fmt.Errorf("RefreshRecommendations() error committing: %v", err)
+	}
+	return nil
+}
+
+// FindSimilarPodcasts returns the podcasts most similar to podID
+func (ps *PodcastStore) FindSimilarPodcasts(ctx context.Context, podID uuid.UUID, start, end int64) ([]Podcast, error) {
+	limit := end - start
+	offset := start
+	rows, err := ps.db.Query(ctx,
+		`SELECT p.* FROM SimilarPodcasts s INNER JOIN Podcasts p ON s.similar_id=p.id
+		 WHERE s.podcast_id=$1 ORDER BY s.rank LIMIT $2 OFFSET $3`,
+		podID, limit, offset)
+	if err != nil {
+		return nil, fmt.Errorf("FindSimilarPodcasts() error: %v", err)
+	}
+	return scanPodcastRows(rows, []Podcast{})
+}
+
+// FindRecommendations returns the podcasts recommended to the user, podcasts
+// subscribed to since the last refresh are excluded
+func (ps *PodcastStore) FindRecommendations(ctx context.Context, userID uuid.UUID, start, end int64) ([]Podcast, error) {
+	limit := end - start
+	offset := start
+	rows, err := ps.db.Query(ctx,
+		`SELECT p.* FROM Recommendations r INNER JOIN Podcasts p ON r.podcast_id=p.id
+		 WHERE r.user_id=$1
+		 AND NOT EXISTS (SELECT 1 FROM Subscriptions s WHERE s.user_id=r.user_id AND s.podcast_id=r.podcast_id)
+		 ORDER BY r.rank LIMIT $2 OFFSET $3`,
+		userID, limit, offset)
+	if err != nil {
+		return nil, fmt.Errorf("FindRecommendations() error: %v", err)
+	}
+	return scanPodcastRows(rows, []Podcast{})
+}
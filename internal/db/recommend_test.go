@@ -0,0 +1,61 @@
+package db
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_RefreshRecommendations(t *testing.T) {
+	podStore := NewPodcastStore(dbpg)
+	authStore := NewAuthStorePG(dbpg)
+	// 9 = Careers, 10 = Entrepreneurship; subcategories of Business
+	podA := &Podcast{ID: uuid.New(), Title: "Recommend A", Category: []int{8, 9}, RSSURL: "https://syncapod.com/recommend_a.rss"}
+	podB := &Podcast{ID: uuid.New(), Title: "Recommend B", Category: []int{80}, RSSURL: "https://syncapod.com/recommend_b.rss"}
+	podC := &Podcast{ID: uuid.New(), Title: "Recommend C", Category: []int{8, 9, 10}, RSSURL: "https://syncapod.com/recommend_c.rss"}
+	userA := &UserRow{ID: uuid.New(), Email: "recommend_a@test.test", Username: "recommendA", PasswordHash: []byte("shouldbehash")}
+	userB := &UserRow{ID: uuid.New(), Email: "recommend_b@test.test", Username: "recommendB", PasswordHash: []byte("shouldbehash")}
+	insertPodcastOrFail(podStore, podA)
+	insertPodcastOrFail(podStore, podB)
+	insertPodcastOrFail(podStore, podC)
+	insertUser(authStore, userA)
+	insertUser(authStore, userB)
+	// userA & userB both subscribe to podA, only userB subscribes to podB
+	insertSubOrFail(podStore, &Subscription{UserID: userA.ID, PodcastID: podA.ID})
+	insertSubOrFail(podStore, &Subscription{UserID: userB.ID, PodcastID: podA.ID})
+	insertSubOrFail(podStore, &Subscription{UserID: userB.ID, PodcastID: podB.ID})
+
+	err := podStore.RefreshRecommendations(context.Background(), 50, 0.8, 100)
+	if err != nil {
+		t.Fatalf("Test_RefreshRecommendations() error: %v", err)
+	}
+
+	// podB shares subscribers with podA, podC shares a subcategory
+	similar, err := podStore.FindSimilarPodcasts(context.Background(), podA.ID, 0, 10)
+	if err != nil {
+		t.Fatalf("Test_RefreshRecommendations() error finding similar: %v", err)
+	}
+	require.Len(t, similar, 2)
+	require.Equal(t, podB.ID, similar[0].ID)
+	require.Equal(t, podC.ID, similar[1].ID)
+
+	// userA is recommended podB & podC but not podA which they subscribe to
+	recs, err := podStore.FindRecommendations(context.Background(), userA.ID, 0, 10)
+	if err != nil {
+		t.Fatalf("Test_RefreshRecommendations() error finding recommendations: %v", err)
+	}
+	require.Len(t, recs, 2)
+	require.Equal(t, podB.ID, recs[0].ID)
+	require.Equal(t, podC.ID, recs[1].ID)
+
+	// recommendations exclude new subscriptions before the next refresh
+	insertSubOrFail(podStore, &Subscription{UserID: userA.ID, PodcastID: podB.ID})
+	recs, err = podStore.FindRecommendations(context.Background(), userA.ID, 0, 10)
+	if err != nil {
+		t.Fatalf("Test_RefreshRecommendations() error finding recommendations: %v", err)
+	}
+	require.Len(t, recs, 1)
+	require.Equal(t, podC.ID, recs[0].ID)
+}
@@ -0,0 +1,41 @@
+package db
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v4"
+)
+
+// FindPodcastSettings returns the user's settings of the podcast, the defaults if never changed
+func (ps *PodcastStore) FindPodcastSettings(ctx context.Context, userID, podID uuid.UUID) (*PodcastSettings, error) {
+	s := &PodcastSettings{UserID: userID, PodcastID: podID}
+	err := ps.db.QueryRow(ctx,
+		`SELECT playback_speed,skip_intro_seconds,skip_outro_seconds,auto_download,notify_new_episodes,episode_sort
+		 FROM PodcastSettings WHERE user_id=$1 AND podcast_id=$2`,
+		userID, podID,
+	).Scan(&s.PlaybackSpeed, &s.SkipIntroSeconds, &s.SkipOutroSeconds, &s.AutoDownload, &s.NotifyNewEpisodes, &s.EpisodeSort)
+	if err == pgx.ErrNoRows {
+		return DefaultPodcastSettings(userID, podID), nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("FindPodcastSettings() error: %v", err)
+	}
+	return s, nil
+}
+
+// UpsertPodcastSettings replaces the user's settings of the podcast
+func (ps *PodcastStore) UpsertPodcastSettings(ctx context.Context, s *PodcastSettings) error {
+	_, err := ps.db.Exec(ctx,
+		`INSERT INTO PodcastSettings(user_id,podcast_id,playback_speed,skip_intro_seconds,skip_outro_seconds,auto_download,notify_new_episodes,episode_sort)
+		 VALUES($1,$2,$3,$4,$5,$6,$7,$8)
+		 ON CONFLICT(user_id,podcast_id) DO UPDATE SET playback_speed=$3,skip_intro_seconds=$4,skip_outro_seconds=$5,
+		 auto_download=$6,notify_new_episodes=$7,episode_sort=$8`,
+		s.UserID, s.PodcastID, s.PlaybackSpeed, s.SkipIntroSeconds, s.SkipOutroSeconds,
+		s.AutoDownload, s.NotifyNewEpisodes, string(s.EpisodeSort))
+	if err != nil {
+		return fmt.Errorf("UpsertPodcastSettings() error: %v", err)
+	}
+	return nil
+}
@@ -0,0 +1,45 @@
+package db
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_PodcastSettings(t *testing.T) {
+	ctx := context.Background()
+	podStore := NewPodcastStore(dbpg)
+	user := &UserRow{ID: uuid.New(), Email: "settings@test.test", Username: "settingsUser", PasswordHash: []byte("shouldbehash")}
+	pod := &Podcast{ID: uuid.New(), Title: "Settings Test", Category: []int{}, RSSURL: "https://syncapod.com/settings_test.rss"}
+	insertUser(NewAuthStorePG(dbpg), user)
+	insertPodcastOrFail(podStore, pod)
+	insertSubOrFail(podStore, &Subscription{UserID: user.ID, PodcastID: pod.ID})
+
+	// defaults until changed
+	settings, err := podStore.FindPodcastSettings(ctx, user.ID, pod.ID)
+	if err != nil {
+		t.Fatalf("Test_PodcastSettings() error finding default settings: %v", err)
+	}
+	require.Equal(t, DefaultPodcastSettings(user.ID, pod.ID), settings)
+
+	settings.PlaybackSpeed = 1.5
+	settings.SkipIntroSeconds = 30
+	settings.NotifyNewEpisodes = true
+	settings.EpisodeSort = EpisodeSortOldest
+	require.Nil(t, podStore.UpsertPodcastSettings(ctx, settings))
+	found, err := podStore.FindPodcastSettings(ctx, user.ID, pod.ID)
+	if err != nil {
+		t.Fatalf("Test_PodcastSettings() error finding settings: %v", err)
+	}
+	require.Equal(t, settings, found)
+
+	// embedded within the subscription
+	subs, err := podStore.FindSubscriptions(ctx, user.ID)
+	if err != nil {
+		t.Fatalf("Test_PodcastSettings() error finding subscriptions: %v", err)
+	}
+	require.Len(t, subs, 1)
+	require.Equal(t, *settings, subs[0].Settings)
+}
@@ -0,0 +1,104 @@
+package db
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+)
+
+// UpsertTwoFactor stores the pending secret of the user, an enabled secret is never replaced
+func (a *AuthStorePG) UpsertTwoFactor(ctx context.Context, tf *TwoFactorRow) error {
+	tag, err := a.db.Exec(ctx,
+		`INSERT INTO TwoFactor (user_id,secret,enabled,last_step,created) VALUES($1,$2,FALSE,0,$3)
+		 ON CONFLICT (user_id) DO UPDATE SET secret=$2,last_step=0,created=$3 WHERE NOT TwoFactor.enabled`,
+		tf.UserID, tf.Secret, tf.Created)
+	if err != nil {
+		return fmt.Errorf("UpsertTwoFactor() error: %v", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return fmt.Errorf("UpsertTwoFactor() error: two factor already enabled")
+	}
+	return nil
+}
+
+// FindTwoFactor returns the secret of the user, wraps pgx.ErrNoRows if the user has none
+func (a *AuthStorePG) FindTwoFactor(ctx context.Context, userID uuid.UUID) (*TwoFactorRow, error) {
+	tf := &TwoFactorRow{}
+	err := a.db.QueryRow(ctx,
+		"SELECT user_id,secret,enabled,last_step,created FROM TwoFactor WHERE user_id=$1", userID,
+	).Scan(&tf.UserID, &tf.Secret, &tf.Enabled, &tf.LastStep, &tf.Created)
+	if err != nil {
+		return nil, fmt.Errorf("FindTwoFactor() error: %w", err)
+	}
+	return tf, nil
+}
+
+// EnableTwoFactor enables the pending secret of the user and replaces the recovery codes
+func (a *AuthStorePG) EnableTwoFactor(ctx context.Context, userID uuid.UUID, recoveryCodeHashes [][]byte) error {
+	tx, err := a.db.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("EnableTwoFactor() error beginning transaction: %v", err)
+	}
+	defer tx.Rollback(ctx)
+	tag, err := tx.Exec(ctx, "UPDATE TwoFactor SET enabled=TRUE WHERE user_id=$1", userID)
+	if err != nil {
+		return fmt.Errorf("EnableTwoFactor() error: %v", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return fmt.Errorf("EnableTwoFactor() error: two factor not found")
+	}
+	if _, err = tx.Exec(ctx, "DELETE FROM RecoveryCodes WHERE user_id=$1", userID); err != nil {
+		return fmt.Errorf("EnableTwoFactor() error deleting recovery codes: %v", err)
+	}
+	for _, h := range recoveryCodeHashes {
+		_, err = tx.Exec(ctx, "INSERT INTO RecoveryCodes (user_id,code_hash) VALUES($1,$2)", userID, h)
+		if err != nil {
+			return fmt.Errorf("EnableTwoFactor() error inserting recovery code: %v", err)
+		}
+	}
+	if err = tx.Commit(ctx); err != nil {
+		return fmt.Errorf("EnableTwoFactor() error committing: %v", err)
+	}
+	return nil
+}
+
+// DeleteTwoFactor deletes the secret and recovery codes of the user
+func (a *AuthStorePG) DeleteTwoFactor(ctx context.Context, userID uuid.UUID) error {
+	tx, err := a.db.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("DeleteTwoFactor() error beginning transaction: %v", err)
+	}
+	defer tx.Rollback(ctx)
+	if _, err = tx.Exec(ctx, "DELETE FROM RecoveryCodes WHERE user_id=$1", userID); err != nil {
+		return fmt.Errorf("DeleteTwoFactor() error deleting recovery codes: %v", err)
+	}
+	if _, err = tx.Exec(ctx, "DELETE FROM TwoFactor WHERE user_id=$1", userID); err != nil {
+		return fmt.Errorf("DeleteTwoFactor() error: %v", err)
+	}
+	if err = tx.Commit(ctx); err != nil {
+		return fmt.Errorf("DeleteTwoFactor() error committing: %v", err)
+	}
+	return nil
+}
+
+// UseTwoFactorStep records the time step of a valid code, returns false if
+// the step or a later one was already used so codes can't be replayed
+func (a *AuthStorePG) UseTwoFactorStep(ctx context.Context, userID uuid.UUID, step int64) (bool, error) {
+	tag, err := a.db.Exec(ctx,
+		"UPDATE TwoFactor SET last_step=$2 WHERE user_id=$1 AND last_step < $2", userID, step)
+	if err != nil {
+		return false, fmt.Errorf("UseTwoFactorStep() error: %v", err)
+	}
+	return tag.RowsAffected() == 1, nil
+}
+
+// ConsumeRecoveryCode deletes the recovery code of the user, returns false if it doesn't exist
+func (a *AuthStorePG) ConsumeRecoveryCode(ctx context.Context, userID uuid.UUID, codeHash []byte) (bool, error) {
+	tag, err := a.db.Exec(ctx,
+		"DELETE FROM RecoveryCodes WHERE user_id=$1 AND code_hash=$2", userID, codeHash)
+	if err != nil {
+		return false, fmt.Errorf("ConsumeRecoveryCode() error: %v", err)
+	}
+	return tag.RowsAffected() == 1, nil
+}
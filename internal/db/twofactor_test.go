@@ -0,0 +1,57 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v4"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAuthStorePG_TwoFactor(t *testing.T) {
+	ctx := context.Background()
+	a := NewAuthStorePG(dbpg)
+	user := &UserRow{ID: uuid.New(), Email: "twofactor@test.test", Username: "twoFactorUser", PasswordHash: []byte("shouldbehash")}
+	insertUser(a, user)
+
+	_, err := a.FindTwoFactor(ctx, user.ID)
+	require.True(t, errors.Is(err, pgx.ErrNoRows))
+	require.NotNil(t, a.EnableTwoFactor(ctx, user.ID, nil))
+
+	// pending secrets are replaced until enabled
+	require.Nil(t, a.UpsertTwoFactor(ctx, &TwoFactorRow{UserID: user.ID, Secret: []byte("first"), Created: time.Now()}))
+	require.Nil(t, a.UpsertTwoFactor(ctx, &TwoFactorRow{UserID: user.ID, Secret: []byte("second"), Created: time.Now()}))
+	require.Nil(t, a.EnableTwoFactor(ctx, user.ID, [][]byte{[]byte("code1"), []byte("code2")}))
+	require.NotNil(t, a.UpsertTwoFactor(ctx, &TwoFactorRow{UserID: user.ID, Secret: []byte("third"), Created: time.Now()}))
+	tf, err := a.FindTwoFactor(ctx, user.ID)
+	require.Nil(t, err)
+	require.Equal(t, []byte("second"), tf.Secret)
+	require.True(t, tf.Enabled)
+
+	// steps may only be used once and in order
+	used, err := a.UseTwoFactorStep(ctx, user.ID, 10)
+	require.Nil(t, err)
+	require.True(t, used)
+	for _, step := range []int64{10, 9} {
+		used, err = a.UseTwoFactorStep(ctx, user.ID, step)
+		require.Nil(t, err)
+		require.False(t, used)
+	}
+
+	used, err = a.ConsumeRecoveryCode(ctx, user.ID, []byte("code1"))
+	require.Nil(t, err)
+	require.True(t, used)
+	used, err = a.ConsumeRecoveryCode(ctx, user.ID, []byte("code1"))
+	require.Nil(t, err)
+	require.False(t, used)
+
+	require.Nil(t, a.DeleteTwoFactor(ctx, user.ID))
+	_, err = a.FindTwoFactor(ctx, user.ID)
+	require.True(t, errors.Is(err, pgx.ErrNoRows))
+	used, err = a.ConsumeRecoveryCode(ctx, user.ID, []byte("code2"))
+	require.Nil(t, err)
+	require.False(t, used)
+}
@@ -0,0 +1,87 @@
+package db
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// MarkPodcastPlayed marks the episodes of the podcast published before the given time as played,
+// every episode if before is nil, returns the number of episodes that were not yet played
+func (ps *PodcastStore) MarkPodcastPlayed(ctx context.Context, userID, podID uuid.UUID, before *time.Time, lastSeen time.Time) (int64, error) {
+	tag, err := ps.db.Exec(ctx,
+		`INSERT INTO UserEpisodes AS u (user_id,episode_id,offset_millis,last_seen,played)
+		 SELECT $1, e.id, 0, $4, TRUE FROM Episodes e
+		 WHERE e.podcast_id=$2 AND ($3::timestamptz IS NULL OR e.pub_date < $3::timestamptz)
+		 ON CONFLICT (user_id,episode_id) DO UPDATE SET
+		 played=TRUE, last_seen=GREATEST(u.last_seen,EXCLUDED.last_seen)
+		 WHERE NOT u.played`,
+		userID, podID, before, lastSeen)
+	if err != nil {
+		return 0, fmt.Errorf("MarkPodcastPlayed() error: %v", err)
+	}
+	return tag.RowsAffected(), nil
+}
+
+// MarkEpisodeUnplayed resets the user's playback state of the episode to the beginning,
+// an episode without playback state is already unplayed
+func (ps *PodcastStore) MarkEpisodeUnplayed(ctx context.Context, userID, epiID uuid.UUID, lastSeen time.Time) error {
+	_, err := ps.db.Exec(ctx,
+		`UPDATE UserEpisodes SET played=FALSE, offset_millis=0, last_seen=GREATEST(last_seen,$3)
+		 WHERE user_id=$1 AND episode_id=$2`,
+		userID, epiID, lastSeen)
+	if err != nil {
+		return fmt.Errorf("MarkEpisodeUnplayed() error: %v", err)
+	}
+	return nil
+}
+
+// UpsertUserEpisodes upserts the playback state of many episodes of the user per the rules
+// of UpsertUserEpisode(), the episodes must be distinct, returns whether each update was
+// applied and sets userEpis to the stored states
+func (ps *PodcastStore) UpsertUserEpisodes(ctx context.Context, userID uuid.UUID, userEpis []UserEpisode, resetPlayed []bool) ([]bool, error) {
+	epiIDs := make([]uuid.UUID, len(userEpis))
+	offsets := make([]int64, len(userEpis))
+	lastSeen := make([]time.Time, len(userEpis))
+	played := make([]bool, len(userEpis))
+	index := make(map[uuid.UUID]int, len(userEpis))
+	for i := range userEpis {
+		epiIDs[i] = userEpis[i].EpisodeID
+		offsets[i] = userEpis[i].OffsetMillis
+		lastSeen[i] = userEpis[i].LastSeen
+		played[i] = userEpis[i].Played
+		index[userEpis[i].EpisodeID] = i
+	}
+	rows, err := ps.db.Query(ctx,
+		`INSERT INTO UserEpisodes AS u (user_id,episode_id,offset_millis,last_seen,played)
+		 SELECT $1, r.episode_id, r.offset_millis, LEAST(r.last_seen,now()), r.played
+		 FROM unnest($2::uuid[],$3::bigint[],$4::timestamptz[],$5::boolean[]) AS r(episode_id,offset_millis,last_seen,played)
+		 ON CONFLICT (user_id,episode_id) DO UPDATE SET
+		 offset_millis=CASE WHEN u.last_seen <= EXCLUDED.last_seen THEN EXCLUDED.offset_millis ELSE u.offset_millis END,
+		 played=CASE WHEN ($6::boolean[])[array_position($2::uuid[],EXCLUDED.episode_id)] AND u.last_seen <= EXCLUDED.last_seen
+		 THEN EXCLUDED.played ELSE u.played OR EXCLUDED.played END,
+		 last_seen=GREATEST(u.last_seen,EXCLUDED.last_seen)
+		 RETURNING episode_id,offset_millis,last_seen,played,
+		 last_seen=LEAST(($4::timestamptz[])[array_position($2::uuid[],episode_id)],now())`,
+		userID, epiIDs, offsets, lastSeen, played, resetPlayed)
+	if err != nil {
+		return nil, fmt.Errorf("UpsertUserEpisodes() error: %v", err)
+	}
+	defer rows.Close()
+	applied := make([]bool, len(userEpis))
+	for rows.Next() {
+		u := UserEpisode{UserID: userID}
+		var a bool
+		if err = rows.Scan(&u.EpisodeID, &u.OffsetMillis, &u.LastSeen, &u.Played, &a); err != nil {
+			return nil, fmt.Errorf("UpsertUserEpisodes() error scanning row: %v", err)
+		}
+		i := index[u.EpisodeID]
+		userEpis[i], applied[i] = u, a
+	}
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("UpsertUserEpisodes() error while reading: %v", err)
+	}
+	return applied, nil
+}
@@ -0,0 +1,95 @@
+package db
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_BulkUserEpisodes(t *testing.T) {
+	ctx := context.Background()
+	podStore := NewPodcastStore(dbpg)
+	user := &UserRow{ID: uuid.New(), Email: "bulk@test.test", Username: "bulkUser", PasswordHash: []byte("shouldbehash")}
+	pod := &Podcast{ID: uuid.New(), Title: "Bulk Test", Category: []int{}, RSSURL: "https://syncapod.com/bulk_test.rss"}
+	epi1 := &Episode{ID: uuid.New(), PodcastID: pod.ID, Title: "Bulk 1", PubDate: time.Unix(1, 0)}
+	epi2 := &Episode{ID: uuid.New(), PodcastID: pod.ID, Title: "Bulk 2", PubDate: time.Unix(2, 0)}
+	epi3 := &Episode{ID: uuid.New(), PodcastID: pod.ID, Title: "Bulk 3", PubDate: time.Unix(3, 0)}
+	insertUser(NewAuthStorePG(dbpg), user)
+	insertPodcastOrFail(podStore, pod)
+	insertEpisodeOrFail(podStore, epi1)
+	insertEpisodeOrFail(podStore, epi2)
+	insertEpisodeOrFail(podStore, epi3)
+	// in the past, updates from the future are clamped to now
+	now := time.Now().Add(-time.Minute)
+
+	// epi1 in progress, epi2 played
+	userEpis := []UserEpisode{
+		{UserID: user.ID, EpisodeID: epi1.ID, OffsetMillis: 1000, LastSeen: now},
+		{UserID: user.ID, EpisodeID: epi2.ID, OffsetMillis: 2000, LastSeen: now, Played: true},
+	}
+	applied, err := podStore.UpsertUserEpisodes(ctx, user.ID, userEpis, []bool{false, false})
+	if err != nil {
+		t.Fatalf("Test_BulkUserEpisodes() error upserting: %v", err)
+	}
+	require.Equal(t, []bool{true, true}, applied)
+
+	// stale update of epi1 is not applied, played of epi2 is only unset with reset
+	userEpis = []UserEpisode{
+		{UserID: user.ID, EpisodeID: epi1.ID, OffsetMillis: 5, LastSeen: now.Add(-time.Hour)},
+		{UserID: user.ID, EpisodeID: epi2.ID, OffsetMillis: 0, LastSeen: now.Add(time.Second)},
+	}
+	applied, err = podStore.UpsertUserEpisodes(ctx, user.ID, userEpis, []bool{false, false})
+	if err != nil {
+		t.Fatalf("Test_BulkUserEpisodes() error upserting: %v", err)
+	}
+	require.Equal(t, []bool{false, true}, applied)
+	require.Equal(t, int64(1000), userEpis[0].OffsetMillis)
+	require.True(t, userEpis[1].Played)
+	userEpis = []UserEpisode{{UserID: user.ID, EpisodeID: epi2.ID, LastSeen: now.Add(2 * time.Second)}}
+	_, err = podStore.UpsertUserEpisodes(ctx, user.ID, userEpis, []bool{true})
+	if err != nil {
+		t.Fatalf("Test_BulkUserEpisodes() error upserting: %v", err)
+	}
+	require.False(t, userEpis[0].Played)
+
+	// mark played before epi3
+	before := time.Unix(3, 0)
+	updated, err := podStore.MarkPodcastPlayed(ctx, user.ID, pod.ID, &before, now.Add(3*time.Second))
+	if err != nil {
+		t.Fatalf("Test_BulkUserEpisodes() error marking played: %v", err)
+	}
+	require.Equal(t, int64(2), updated)
+	userEpi, err := podStore.FindUserEpisode(ctx, user.ID, epi1.ID)
+	if err != nil {
+		t.Fatalf("Test_BulkUserEpisodes() error finding user episode: %v", err)
+	}
+	require.True(t, userEpi.Played)
+	_, err = podStore.FindUserEpisode(ctx, user.ID, epi3.ID)
+	require.NotNil(t, err)
+
+	// mark all played, then epi1 unplayed
+	updated, err = podStore.MarkPodcastPlayed(ctx, user.ID, pod.ID, nil, now.Add(4*time.Second))
+	if err != nil {
+		t.Fatalf("Test_BulkUserEpisodes() error marking played: %v", err)
+	}
+	require.Equal(t, int64(1), updated)
+	require.Nil(t, podStore.MarkEpisodeUnplayed(ctx, user.ID, epi1.ID, now.Add(5*time.Second)))
+	userEpi, err = podStore.FindUserEpisode(ctx, user.ID, epi1.ID)
+	if err != nil {
+		t.Fatalf("Test_BulkUserEpisodes() error finding user episode: %v", err)
+	}
+	require.False(t, userEpi.Played)
+	require.Equal(t, int64(0), userEpi.OffsetMillis)
+
+	// future update is clamped to now
+	userEpis = []UserEpisode{{UserID: user.ID, EpisodeID: epi3.ID, OffsetMillis: 3000, LastSeen: time.Now().Add(time.Hour)}}
+	applied, err = podStore.UpsertUserEpisodes(ctx, user.ID, userEpis, []bool{false})
+	if err != nil {
+		t.Fatalf("Test_BulkUserEpisodes() error upserting: %v", err)
+	}
+	require.Equal(t, []bool{true}, applied)
+	require.WithinDuration(t, time.Now(), userEpis[0].LastSeen, time.Minute)
+}
@@ -0,0 +1,88 @@
+package events
+
+import (
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Kind is the kind of a playback event
+type Kind string
+
+// Kinds of playback events
+const (
+	Started      Kind = "started"
+	Paused       Kind = "paused"
+	Finished     Kind = "finished"
+	QueueChanged Kind = "queue_changed"
+	// PlaybackChanged is published for bulk changes of the playback state, devices should sync
+	// the episode if EpisodeID is set and every episode otherwise
+	PlaybackChanged Kind = "playback_changed"
+)
+
+// subscriberBuffer is the amount of events buffered per subscriber before events are dropped
+const subscriberBuffer = 16
+
+// Event is pushed to all of a user's connected devices
+type Event struct {
+	Kind         Kind      `json:"kind"`
+	EpisodeID    string    `json:"episodeID,omitempty"`
+	OffsetMillis int64     `json:"offsetMillis,omitempty"`
+	Time         time.Time `json:"time"`
+}
+
+// Broker delivers the events published for a user to each of their subscribers
+type Broker interface {
+	// Publish must not block, events are dropped for subscribers that fall behind
+	Publish(userID uuid.UUID, e Event)
+	// Subscribe returns the channel of the user's events and the func to unsubscribe
+	Subscribe(userID uuid.UUID) (<-chan Event, func())
+}
+
+// MemoryBroker is an in process Broker
+type MemoryBroker struct {
+	mutex sync.RWMutex
+	subs  map[uuid.UUID]map[chan Event]struct{}
+}
+
+// NewMemoryBroker creates a new *MemoryBroker
+func NewMemoryBroker() *MemoryBroker {
+	return &MemoryBroker{subs: map[uuid.UUID]map[chan Event]struct{}{}}
+}
+
+// Publish sends the event to each of the user's subscribers
+func (b *MemoryBroker) Publish(userID uuid.UUID, e Event) {
+	b.mutex.RLock()
+	defer b.mutex.RUnlock()
+	for ch := range b.subs[userID] {
+		select {
+		case ch <- e:
+		default:
+		}
+	}
+}
+
+// Subscribe adds a subscriber for the user's events
+func (b *MemoryBroker) Subscribe(userID uuid.UUID) (<-chan Event, func()) {
+	ch := make(chan Event, subscriberBuffer)
+	b.mutex.Lock()
+	if b.subs[userID] == nil {
+		b.subs[userID] = map[chan Event]struct{}{}
+	}
+	b.subs[userID][ch] = struct{}{}
+	b.mutex.Unlock()
+
+	var once sync.Once
+	return ch, func() {
+		once.Do(func() {
+			b.mutex.Lock()
+			delete(b.subs[userID], ch)
+			if len(b.subs[userID]) == 0 {
+				delete(b.subs, userID)
+			}
+			b.mutex.Unlock()
+			close(ch)
+		})
+	}
+}
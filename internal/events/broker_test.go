@@ -0,0 +1,39 @@
+package events
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMemoryBroker(t *testing.T) {
+	b := NewMemoryBroker()
+	userID := uuid.New()
+	phone, unsubPhone := b.Subscribe(userID)
+	alexa, unsubAlexa := b.Subscribe(userID)
+	other, unsubOther := b.Subscribe(uuid.New())
+	defer unsubAlexa()
+	defer unsubOther()
+
+	e := Event{Kind: Paused, EpisodeID: uuid.New().String(), OffsetMillis: 1234, Time: time.Now()}
+	b.Publish(userID, e)
+	require.Equal(t, e, <-phone)
+	require.Equal(t, e, <-alexa)
+	require.Len(t, other, 0)
+
+	// unsubscribing closes the channel and is safe to repeat
+	unsubPhone()
+	unsubPhone()
+	_, ok := <-phone
+	require.False(t, ok)
+	b.Publish(userID, Event{Kind: QueueChanged})
+	require.Equal(t, QueueChanged, (<-alexa).Kind)
+
+	// publishing never blocks on a full subscriber
+	for i := 0; i < subscriberBuffer*2; i++ {
+		b.Publish(userID, e)
+	}
+	require.Len(t, alexa, subscriberBuffer)
+}
@@ -37,6 +37,9 @@ type Admin interface {
 	AddPodcast(context.Context, *AddPodReq) (*AddPodRes, error)
 
 	RefreshPodcast(context.Context, *RefPodReq) (*RefPodRes, error)
+
+	// Maintenance
+	GetJanitorStats(context.Context, *GetJanitorStatsReq) (*GetJanitorStatsRes, error)
 }
 
 // =====================
@@ -45,7 +48,7 @@ type Admin interface {
 
 type adminProtobufClient struct {
 	client      HTTPClient
-	urls        [2]string
+	urls        [3]string
 	interceptor twirp.Interceptor
 	opts        twirp.ClientOptions
 }
@@ -73,9 +76,10 @@ func NewAdminProtobufClient(baseURL string, client HTTPClient, opts ...twirp.Cli
 	// Build method URLs: <baseURL>[<prefix>]/<package>.<Service>/<Method>
 	serviceURL := sanitizeBaseURL(baseURL)
 	serviceURL += baseServicePath(pathPrefix, "protos", "Admin")
-	urls := [2]string{
+	urls := [3]string{
 		serviceURL + "AddPodcast",
 		serviceURL + "RefreshPodcast",
+		serviceURL + "GetJanitorStats",
 	}
 
 	return &adminProtobufClient{
@@ -178,13 +182,59 @@ func (c *adminProtobufClient) callRefreshPodcast(ctx context.Context, in *RefPod
 	return out, nil
 }
 
+func (c *adminProtobufClient) GetJanitorStats(ctx context.Context, in *GetJanitorStatsReq) (*GetJanitorStatsRes, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "protos")
+	ctx = ctxsetters.WithServiceName(ctx, "Admin")
+	ctx = ctxsetters.WithMethodName(ctx, "GetJanitorStats")
+	caller := c.callGetJanitorStats
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *GetJanitorStatsReq) (*GetJanitorStatsRes, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*GetJanitorStatsReq)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*GetJanitorStatsReq) when calling interceptor")
+					}
+					return c.callGetJanitorStats(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*GetJanitorStatsRes)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*GetJanitorStatsRes) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *adminProtobufClient) callGetJanitorStats(ctx context.Context, in *GetJanitorStatsReq) (*GetJanitorStatsRes, error) {
+	out := new(GetJanitorStatsRes)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[2], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
 // =================
 // Admin JSON Client
 // =================
 
 type adminJSONClient struct {
 	client      HTTPClient
-	urls        [2]string
+	urls        [3]string
 	interceptor twirp.Interceptor
 	opts        twirp.ClientOptions
 }
@@ -212,9 +262,10 @@ func NewAdminJSONClient(baseURL string, client HTTPClient, opts ...twirp.ClientO
 	// Build method URLs: <baseURL>[<prefix>]/<package>.<Service>/<Method>
 	serviceURL := sanitizeBaseURL(baseURL)
 	serviceURL += baseServicePath(pathPrefix, "protos", "Admin")
-	urls := [2]string{
+	urls := [3]string{
 		serviceURL + "AddPodcast",
 		serviceURL + "RefreshPodcast",
+		serviceURL + "GetJanitorStats",
 	}
 
 	return &adminJSONClient{
@@ -317,6 +368,52 @@ func (c *adminJSONClient) callRefreshPodcast(ctx context.Context, in *RefPodReq)
 	return out, nil
 }
 
+func (c *adminJSONClient) GetJanitorStats(ctx context.Context, in *GetJanitorStatsReq) (*GetJanitorStatsRes, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "protos")
+	ctx = ctxsetters.WithServiceName(ctx, "Admin")
+	ctx = ctxsetters.WithMethodName(ctx, "GetJanitorStats")
+	caller := c.callGetJanitorStats
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *GetJanitorStatsReq) (*GetJanitorStatsRes, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*GetJanitorStatsReq)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*GetJanitorStatsReq) when calling interceptor")
+					}
+					return c.callGetJanitorStats(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*GetJanitorStatsRes)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*GetJanitorStatsRes) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *adminJSONClient) callGetJanitorStats(ctx context.Context, in *GetJanitorStatsReq) (*GetJanitorStatsRes, error) {
+	out := new(GetJanitorStatsRes)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[2], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
 // ====================
 // Admin Server Handler
 // ====================
@@ -420,6 +517,9 @@ func (s *adminServer) ServeHTTP(resp http.ResponseWriter, req *http.Request) {
 	case "RefreshPodcast":
 		s.serveRefreshPodcast(ctx, resp, req)
 		return
+	case "GetJanitorStats":
+		s.serveGetJanitorStats(ctx, resp, req)
+		return
 	default:
 		msg := fmt.Sprintf("no handler for path %q", req.URL.Path)
 		s.writeError(ctx, resp, badRouteError(msg, req.Method, req.URL.Path))
@@ -787,6 +887,186 @@ func (s *adminServer) serveRefreshPodcastProtobuf(ctx context.Context, resp http
 	callResponseSent(ctx, s.hooks)
 }
 
+func (s *adminServer) serveGetJanitorStats(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveGetJanitorStatsJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveGetJanitorStatsProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *adminServer) serveGetJanitorStatsJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "GetJanitorStats")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(GetJanitorStatsReq)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.Admin.GetJanitorStats
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *GetJanitorStatsReq) (*GetJanitorStatsRes, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*GetJanitorStatsReq)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*GetJanitorStatsReq) when calling interceptor")
+					}
+					return s.Admin.GetJanitorStats(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*GetJanitorStatsRes)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*GetJanitorStatsRes) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *GetJanitorStatsRes
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *GetJanitorStatsRes and nil error while calling GetJanitorStats. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *adminServer) serveGetJanitorStatsProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "GetJanitorStats")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(GetJanitorStatsReq)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.Admin.GetJanitorStats
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *GetJanitorStatsReq) (*GetJanitorStatsRes, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*GetJanitorStatsReq)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*GetJanitorStatsReq) when calling interceptor")
+					}
+					return s.Admin.GetJanitorStats(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*GetJanitorStatsRes)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*GetJanitorStatsRes) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *GetJanitorStatsRes
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *GetJanitorStatsRes and nil error while calling GetJanitorStats. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
 func (s *adminServer) ServiceDescriptor() ([]byte, int) {
 	return twirpFileDescriptor0, 0
 }
@@ -925,9 +1205,12 @@ func sanitizeBaseURL(baseURL string) string {
 
 // baseServicePath composes the path prefix for the service (without <Method>).
 // e.g.: baseServicePath("/twirp", "my.pkg", "MyService")
-//       returns => "/twirp/my.pkg.MyService/"
+//
+//	returns => "/twirp/my.pkg.MyService/"
+//
 // e.g.: baseServicePath("", "", "MyService")
-//       returns => "/MyService/"
+//
+//	returns => "/MyService/"
 func baseServicePath(prefix, pkg, service string) string {
 	fullServiceName := service
 	if pkg != "" {
@@ -1365,21 +1648,33 @@ func callClientError(ctx context.Context, h *twirp.ClientHooks, err twirp.Error)
 }
 
 var twirpFileDescriptor0 = []byte{
-	// 245 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0xe2, 0x4e, 0x4c, 0xc9, 0xcd,
-	0xcc, 0xd3, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x62, 0x03, 0x53, 0xc5, 0x52, 0x32, 0xe9, 0xf9,
-	0xf9, 0xe9, 0x39, 0xa9, 0xfa, 0x89, 0x05, 0x99, 0xfa, 0x89, 0x79, 0x79, 0xf9, 0x25, 0x89, 0x25,
-	0x99, 0xf9, 0x79, 0xc5, 0x10, 0x55, 0x52, 0xbc, 0x05, 0xf9, 0x29, 0xc9, 0x89, 0xc5, 0x25, 0x10,
-	0xae, 0x92, 0x2c, 0x17, 0xa7, 0x63, 0x4a, 0x4a, 0x40, 0x7e, 0x4a, 0x50, 0x6a, 0xa1, 0x90, 0x00,
-	0x17, 0x73, 0x69, 0x51, 0x8e, 0x04, 0xa3, 0x02, 0xa3, 0x06, 0x67, 0x10, 0x88, 0xa9, 0x64, 0x86,
-	0x90, 0x2e, 0x16, 0xd2, 0xe4, 0x62, 0x87, 0x6a, 0x06, 0x2b, 0xe1, 0x36, 0xe2, 0x87, 0x18, 0x52,
-	0xac, 0x17, 0x00, 0x11, 0x0e, 0x82, 0xc9, 0x2b, 0x71, 0x73, 0x71, 0x06, 0xa5, 0xa6, 0x41, 0x8c,
-	0x45, 0xe6, 0x14, 0x1b, 0xed, 0x66, 0xe4, 0x62, 0x75, 0x04, 0xb9, 0x5a, 0x28, 0x84, 0x8b, 0x0b,
-	0x62, 0x36, 0x48, 0x87, 0x90, 0x20, 0xcc, 0x2c, 0xb8, 0x73, 0xa4, 0x30, 0x84, 0x8a, 0x95, 0x14,
-	0x9a, 0x2e, 0x3f, 0x99, 0xcc, 0x24, 0xa5, 0x24, 0xaa, 0x5f, 0x66, 0xa8, 0x0f, 0xf6, 0xbc, 0x7e,
-	0x62, 0x4a, 0x4a, 0x3c, 0xd4, 0x5a, 0x2b, 0x46, 0x2d, 0xa1, 0x18, 0x2e, 0xbe, 0xa0, 0xd4, 0xb4,
-	0xa2, 0xd4, 0xe2, 0x0c, 0x0c, 0x93, 0xe1, 0x2e, 0x92, 0xc2, 0x10, 0x2a, 0x56, 0x52, 0x01, 0x9b,
-	0x2c, 0xa7, 0x24, 0x89, 0x30, 0xb9, 0x08, 0x62, 0x0e, 0x92, 0xe9, 0x4e, 0x5c, 0x51, 0x1c, 0x7a,
-	0xd6, 0x10, 0xbd, 0x49, 0x90, 0xf0, 0x36, 0x06, 0x04, 0x00, 0x00, 0xff, 0xff, 0x70, 0xe5, 0xcb,
-	0x40, 0x85, 0x01, 0x00, 0x00,
+	// 437 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x53, 0xdd, 0x6e, 0xd3, 0x30,
+	0x14, 0x56, 0xda, 0xad, 0xa3, 0x27, 0x82, 0x81, 0x35, 0x50, 0x31, 0x7f, 0x95, 0x85, 0xa6, 0xb1,
+	0x8b, 0x44, 0x14, 0x84, 0xa0, 0x48, 0x48, 0x43, 0x42, 0x48, 0x5c, 0x55, 0x66, 0x57, 0x08, 0xa9,
+	0xf2, 0xb0, 0x1b, 0xc2, 0x52, 0x3b, 0xf5, 0x71, 0x26, 0xf5, 0x96, 0x57, 0xe0, 0x3d, 0x78, 0x19,
+	0x5e, 0x81, 0x17, 0xe0, 0x0d, 0x50, 0xec, 0x64, 0xad, 0x08, 0xbb, 0x8a, 0xcf, 0x77, 0x3e, 0x7f,
+	0xe7, 0xcb, 0x77, 0x12, 0x88, 0x85, 0x5c, 0xe6, 0x3a, 0x29, 0xad, 0x71, 0x86, 0x0c, 0xfc, 0x03,
+	0xe9, 0xfd, 0xcc, 0x98, 0xac, 0x50, 0xa9, 0x28, 0xf3, 0x54, 0x68, 0x6d, 0x9c, 0x70, 0xb9, 0xd1,
+	0x18, 0x58, 0xf4, 0x51, 0xd3, 0xf5, 0xd5, 0x59, 0xb5, 0x48, 0x5d, 0xbe, 0x54, 0xe8, 0xc4, 0xb2,
+	0x6c, 0x08, 0xd7, 0x4b, 0x23, 0xbf, 0x08, 0x74, 0xa1, 0x64, 0x0f, 0x60, 0x78, 0x22, 0xe5, 0xcc,
+	0x48, 0xae, 0x56, 0xe4, 0x26, 0xf4, 0x2b, 0x5b, 0x8c, 0xa2, 0x71, 0x74, 0x34, 0xe4, 0xf5, 0x91,
+	0xbd, 0xd8, 0xb4, 0x91, 0x3c, 0x81, 0xbd, 0xe6, 0xb2, 0xa7, 0xc4, 0x93, 0xfd, 0x20, 0x82, 0xc9,
+	0x2c, 0xc0, 0xbc, 0xed, 0xb3, 0x18, 0x86, 0x5c, 0x2d, 0x82, 0xec, 0x76, 0x81, 0xec, 0x00, 0xc8,
+	0x7b, 0xe5, 0x3e, 0x08, 0x9d, 0x3b, 0x63, 0x3f, 0x3a, 0xe1, 0xb0, 0xa6, 0xfc, 0x89, 0xfe, 0x03,
+	0x23, 0x21, 0xb0, 0x63, 0x2b, 0x8d, 0x7e, 0x5c, 0x9f, 0xfb, 0x33, 0xb9, 0x03, 0x03, 0x65, 0xad,
+	0xb1, 0x38, 0xea, 0x79, 0xb4, 0xa9, 0xc8, 0x73, 0xd8, 0x2b, 0x6a, 0x0f, 0x95, 0x1e, 0xf5, 0xbd,
+	0x3b, 0x9a, 0x84, 0x2c, 0x92, 0x36, 0x8b, 0xe4, 0xb4, 0xcd, 0x82, 0xb7, 0x54, 0xf2, 0x06, 0x06,
+	0x65, 0x65, 0x33, 0x25, 0x47, 0x3b, 0xe3, 0xfe, 0x51, 0x3c, 0x39, 0x6c, 0x5f, 0xa9, 0xeb, 0x26,
+	0x99, 0x79, 0xe2, 0x3b, 0xed, 0xec, 0x9a, 0x37, 0xb7, 0xe8, 0x2b, 0x88, 0xb7, 0xe0, 0x3a, 0xc1,
+	0x73, 0xb5, 0x6e, 0x13, 0x3c, 0x57, 0x6b, 0x72, 0x00, 0xbb, 0x17, 0xa2, 0xa8, 0x54, 0xe3, 0x36,
+	0x14, 0xd3, 0xde, 0xcb, 0x68, 0xf2, 0xb3, 0x07, 0xbb, 0x27, 0xf5, 0x82, 0xc9, 0x29, 0x40, 0x48,
+	0xb9, 0xce, 0x8e, 0xdc, 0x6a, 0x2d, 0x5c, 0x2e, 0x86, 0x76, 0x20, 0x64, 0xe3, 0xef, 0xbf, 0x7e,
+	0xff, 0xe8, 0x51, 0x76, 0x3b, 0xbd, 0x78, 0x9a, 0xfa, 0xef, 0x24, 0x15, 0x52, 0xce, 0x9b, 0x05,
+	0x4c, 0xa3, 0x63, 0xf2, 0x19, 0x6e, 0x70, 0xb5, 0xb0, 0x0a, 0xbf, 0x76, 0x94, 0x2f, 0x77, 0x43,
+	0x3b, 0x10, 0xb2, 0xc7, 0x5e, 0xf9, 0x21, 0xbb, 0xbb, 0x51, 0xb6, 0x41, 0x67, 0x5b, 0x7d, 0x05,
+	0xfb, 0xff, 0x44, 0x44, 0xe8, 0x95, 0xd9, 0xad, 0xe8, 0xd5, 0x3d, 0x64, 0x87, 0x7e, 0xe0, 0x98,
+	0xdd, 0xdb, 0x0c, 0xcc, 0x94, 0x9b, 0x7f, 0x0b, 0xb4, 0x39, 0xd6, 0xbc, 0x69, 0x74, 0xfc, 0x16,
+	0x3e, 0x5d, 0x4b, 0x5e, 0x07, 0x99, 0xb3, 0xf0, 0x37, 0x3c, 0xfb, 0x3b, 0x00, 0x9e, 0x56, 0x56,
+	0xd9, 0x23, 0x03, 0x00, 0x00,
 }
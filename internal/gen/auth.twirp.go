@@ -30,6 +30,42 @@ type Auth interface {
 	Authenticate(context.Context, *AuthenticateReq) (*AuthenticateRes, error)
 
 	// }
+	Register(context.Context, *RegisterReq) (*RegisterRes, error)
+
+	SendVerificationEmail(context.Context, *SendVerificationEmailReq) (*SendVerificationEmailRes, error)
+
+	VerifyEmail(context.Context, *VerifyEmailReq) (*VerifyEmailRes, error)
+
+	RequestPasswordReset(context.Context, *RequestPasswordResetReq) (*RequestPasswordResetRes, error)
+
+	ResetPassword(context.Context, *ResetPasswordReq) (*ResetPasswordRes, error)
+
+	ChangePassword(context.Context, *ChangePasswordReq) (*ChangePasswordRes, error)
+
+	ChangeEmail(context.Context, *ChangeEmailReq) (*ChangeEmailRes, error)
+
+	UpdateProfile(context.Context, *UpdateProfileReq) (*UpdateProfileRes, error)
+
+	DeleteAccount(context.Context, *DeleteAccountReq) (*DeleteAccountRes, error)
+
+	VerifyTwoFactor(context.Context, *VerifyTwoFactorReq) (*VerifyTwoFactorRes, error)
+
+	EnrollTwoFactor(context.Context, *EnrollTwoFactorReq) (*EnrollTwoFactorRes, error)
+
+	ConfirmTwoFactor(context.Context, *ConfirmTwoFactorReq) (*ConfirmTwoFactorRes, error)
+
+	DisableTwoFactor(context.Context, *DisableTwoFactorReq) (*DisableTwoFactorRes, error)
+
+	ListSessions(context.Context, *ListSessionsReq) (*ListSessionsRes, error)
+
+	RevokeSession(context.Context, *RevokeSessionReq) (*RevokeSessionRes, error)
+
+	RevokeAllOtherSessions(context.Context, *RevokeAllOtherSessionsReq) (*RevokeAllOtherSessionsRes, error)
+
+	ListGrants(context.Context, *ListGrantsReq) (*ListGrantsRes, error)
+
+	RevokeGrant(context.Context, *RevokeGrantReq) (*RevokeGrantRes, error)
+
 	Logout(context.Context, *LogoutReq) (*LogoutRes, error)
 }
 
@@ -39,7 +75,7 @@ type Auth interface {
 
 type authProtobufClient struct {
 	client      HTTPClient
-	urls        [2]string
+	urls        [20]string
 	interceptor twirp.Interceptor
 	opts        twirp.ClientOptions
 }
@@ -67,8 +103,26 @@ func NewAuthProtobufClient(baseURL string, client HTTPClient, opts ...twirp.Clie
 	// Build method URLs: <baseURL>[<prefix>]/<package>.<Service>/<Method>
 	serviceURL := sanitizeBaseURL(baseURL)
 	serviceURL += baseServicePath(pathPrefix, "protos", "Auth")
-	urls := [2]string{
+	urls := [20]string{
 		serviceURL + "Authenticate",
+		serviceURL + "Register",
+		serviceURL + "SendVerificationEmail",
+		serviceURL + "VerifyEmail",
+		serviceURL + "RequestPasswordReset",
+		serviceURL + "ResetPassword",
+		serviceURL + "ChangePassword",
+		serviceURL + "ChangeEmail",
+		serviceURL + "UpdateProfile",
+		serviceURL + "DeleteAccount",
+		serviceURL + "VerifyTwoFactor",
+		serviceURL + "EnrollTwoFactor",
+		serviceURL + "ConfirmTwoFactor",
+		serviceURL + "DisableTwoFactor",
+		serviceURL + "ListSessions",
+		serviceURL + "RevokeSession",
+		serviceURL + "RevokeAllOtherSessions",
+		serviceURL + "ListGrants",
+		serviceURL + "RevokeGrant",
 		serviceURL + "Logout",
 	}
 
@@ -126,26 +180,26 @@ func (c *authProtobufClient) callAuthenticate(ctx context.Context, in *Authentic
 	return out, nil
 }
 
-func (c *authProtobufClient) Logout(ctx context.Context, in *LogoutReq) (*LogoutRes, error) {
+func (c *authProtobufClient) Register(ctx context.Context, in *RegisterReq) (*RegisterRes, error) {
 	ctx = ctxsetters.WithPackageName(ctx, "protos")
 	ctx = ctxsetters.WithServiceName(ctx, "Auth")
-	ctx = ctxsetters.WithMethodName(ctx, "Logout")
-	caller := c.callLogout
+	ctx = ctxsetters.WithMethodName(ctx, "Register")
+	caller := c.callRegister
 	if c.interceptor != nil {
-		caller = func(ctx context.Context, req *LogoutReq) (*LogoutRes, error) {
+		caller = func(ctx context.Context, req *RegisterReq) (*RegisterRes, error) {
 			resp, err := c.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*LogoutReq)
+					typedReq, ok := req.(*RegisterReq)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*LogoutReq) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*RegisterReq) when calling interceptor")
 					}
-					return c.callLogout(ctx, typedReq)
+					return c.callRegister(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*LogoutRes)
+				typedResp, ok := resp.(*RegisterRes)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*LogoutRes) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*RegisterRes) when calling interceptor")
 				}
 				return typedResp, err
 			}
@@ -155,8 +209,8 @@ func (c *authProtobufClient) Logout(ctx context.Context, in *LogoutReq) (*Logout
 	return caller(ctx, in)
 }
 
-func (c *authProtobufClient) callLogout(ctx context.Context, in *LogoutReq) (*LogoutRes, error) {
-	out := new(LogoutRes)
+func (c *authProtobufClient) callRegister(ctx context.Context, in *RegisterReq) (*RegisterRes, error) {
+	out := new(RegisterRes)
 	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[1], in, out)
 	if err != nil {
 		twerr, ok := err.(twirp.Error)
@@ -172,73 +226,118 @@ func (c *authProtobufClient) callLogout(ctx context.Context, in *LogoutReq) (*Lo
 	return out, nil
 }
 
-// ================
-// Auth JSON Client
-// ================
-
-type authJSONClient struct {
-	client      HTTPClient
-	urls        [2]string
-	interceptor twirp.Interceptor
-	opts        twirp.ClientOptions
+func (c *authProtobufClient) SendVerificationEmail(ctx context.Context, in *SendVerificationEmailReq) (*SendVerificationEmailRes, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "protos")
+	ctx = ctxsetters.WithServiceName(ctx, "Auth")
+	ctx = ctxsetters.WithMethodName(ctx, "SendVerificationEmail")
+	caller := c.callSendVerificationEmail
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *SendVerificationEmailReq) (*SendVerificationEmailRes, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*SendVerificationEmailReq)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*SendVerificationEmailReq) when calling interceptor")
+					}
+					return c.callSendVerificationEmail(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*SendVerificationEmailRes)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*SendVerificationEmailRes) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
 }
 
-// NewAuthJSONClient creates a JSON client that implements the Auth interface.
-// It communicates using JSON and can be configured with a custom HTTPClient.
-func NewAuthJSONClient(baseURL string, client HTTPClient, opts ...twirp.ClientOption) Auth {
-	if c, ok := client.(*http.Client); ok {
-		client = withoutRedirects(c)
+func (c *authProtobufClient) callSendVerificationEmail(ctx context.Context, in *SendVerificationEmailReq) (*SendVerificationEmailRes, error) {
+	out := new(SendVerificationEmailRes)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[2], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
 	}
 
-	clientOpts := twirp.ClientOptions{}
-	for _, o := range opts {
-		o(&clientOpts)
-	}
+	callClientResponseReceived(ctx, c.opts.Hooks)
 
-	// Using ReadOpt allows backwards and forwads compatibility with new options in the future
-	literalURLs := false
-	_ = clientOpts.ReadOpt("literalURLs", &literalURLs)
-	var pathPrefix string
-	if ok := clientOpts.ReadOpt("pathPrefix", &pathPrefix); !ok {
-		pathPrefix = "/twirp" // default prefix
-	}
+	return out, nil
+}
 
-	// Build method URLs: <baseURL>[<prefix>]/<package>.<Service>/<Method>
-	serviceURL := sanitizeBaseURL(baseURL)
-	serviceURL += baseServicePath(pathPrefix, "protos", "Auth")
-	urls := [2]string{
-		serviceURL + "Authenticate",
-		serviceURL + "Logout",
+func (c *authProtobufClient) VerifyEmail(ctx context.Context, in *VerifyEmailReq) (*VerifyEmailRes, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "protos")
+	ctx = ctxsetters.WithServiceName(ctx, "Auth")
+	ctx = ctxsetters.WithMethodName(ctx, "VerifyEmail")
+	caller := c.callVerifyEmail
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *VerifyEmailReq) (*VerifyEmailRes, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*VerifyEmailReq)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*VerifyEmailReq) when calling interceptor")
+					}
+					return c.callVerifyEmail(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*VerifyEmailRes)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*VerifyEmailRes) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
 	}
+	return caller(ctx, in)
+}
 
-	return &authJSONClient{
-		client:      client,
-		urls:        urls,
-		interceptor: twirp.ChainInterceptors(clientOpts.Interceptors...),
-		opts:        clientOpts,
+func (c *authProtobufClient) callVerifyEmail(ctx context.Context, in *VerifyEmailReq) (*VerifyEmailRes, error) {
+	out := new(VerifyEmailRes)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[3], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
 	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
 }
 
-func (c *authJSONClient) Authenticate(ctx context.Context, in *AuthenticateReq) (*AuthenticateRes, error) {
+func (c *authProtobufClient) RequestPasswordReset(ctx context.Context, in *RequestPasswordResetReq) (*RequestPasswordResetRes, error) {
 	ctx = ctxsetters.WithPackageName(ctx, "protos")
 	ctx = ctxsetters.WithServiceName(ctx, "Auth")
-	ctx = ctxsetters.WithMethodName(ctx, "Authenticate")
-	caller := c.callAuthenticate
+	ctx = ctxsetters.WithMethodName(ctx, "RequestPasswordReset")
+	caller := c.callRequestPasswordReset
 	if c.interceptor != nil {
-		caller = func(ctx context.Context, req *AuthenticateReq) (*AuthenticateRes, error) {
+		caller = func(ctx context.Context, req *RequestPasswordResetReq) (*RequestPasswordResetRes, error) {
 			resp, err := c.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*AuthenticateReq)
+					typedReq, ok := req.(*RequestPasswordResetReq)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*AuthenticateReq) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*RequestPasswordResetReq) when calling interceptor")
 					}
-					return c.callAuthenticate(ctx, typedReq)
+					return c.callRequestPasswordReset(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*AuthenticateRes)
+				typedResp, ok := resp.(*RequestPasswordResetRes)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*AuthenticateRes) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*RequestPasswordResetRes) when calling interceptor")
 				}
 				return typedResp, err
 			}
@@ -248,9 +347,9 @@ func (c *authJSONClient) Authenticate(ctx context.Context, in *AuthenticateReq)
 	return caller(ctx, in)
 }
 
-func (c *authJSONClient) callAuthenticate(ctx context.Context, in *AuthenticateReq) (*AuthenticateRes, error) {
-	out := new(AuthenticateRes)
-	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[0], in, out)
+func (c *authProtobufClient) callRequestPasswordReset(ctx context.Context, in *RequestPasswordResetReq) (*RequestPasswordResetRes, error) {
+	out := new(RequestPasswordResetRes)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[4], in, out)
 	if err != nil {
 		twerr, ok := err.(twirp.Error)
 		if !ok {
@@ -265,26 +364,26 @@ func (c *authJSONClient) callAuthenticate(ctx context.Context, in *AuthenticateR
 	return out, nil
 }
 
-func (c *authJSONClient) Logout(ctx context.Context, in *LogoutReq) (*LogoutRes, error) {
+func (c *authProtobufClient) ResetPassword(ctx context.Context, in *ResetPasswordReq) (*ResetPasswordRes, error) {
 	ctx = ctxsetters.WithPackageName(ctx, "protos")
 	ctx = ctxsetters.WithServiceName(ctx, "Auth")
-	ctx = ctxsetters.WithMethodName(ctx, "Logout")
-	caller := c.callLogout
+	ctx = ctxsetters.WithMethodName(ctx, "ResetPassword")
+	caller := c.callResetPassword
 	if c.interceptor != nil {
-		caller = func(ctx context.Context, req *LogoutReq) (*LogoutRes, error) {
+		caller = func(ctx context.Context, req *ResetPasswordReq) (*ResetPasswordRes, error) {
 			resp, err := c.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*LogoutReq)
+					typedReq, ok := req.(*ResetPasswordReq)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*LogoutReq) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*ResetPasswordReq) when calling interceptor")
 					}
-					return c.callLogout(ctx, typedReq)
+					return c.callResetPassword(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*LogoutRes)
+				typedResp, ok := resp.(*ResetPasswordRes)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*LogoutRes) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*ResetPasswordRes) when calling interceptor")
 				}
 				return typedResp, err
 			}
@@ -294,9 +393,9 @@ func (c *authJSONClient) Logout(ctx context.Context, in *LogoutReq) (*LogoutRes,
 	return caller(ctx, in)
 }
 
-func (c *authJSONClient) callLogout(ctx context.Context, in *LogoutReq) (*LogoutRes, error) {
-	out := new(LogoutRes)
-	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[1], in, out)
+func (c *authProtobufClient) callResetPassword(ctx context.Context, in *ResetPasswordReq) (*ResetPasswordRes, error) {
+	out := new(ResetPasswordRes)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[5], in, out)
 	if err != nil {
 		twerr, ok := err.(twirp.Error)
 		if !ok {
@@ -311,117 +410,5040 @@ func (c *authJSONClient) callLogout(ctx context.Context, in *LogoutReq) (*Logout
 	return out, nil
 }
 
-// ===================
-// Auth Server Handler
-// ===================
-
-type authServer struct {
-	Auth
-	interceptor      twirp.Interceptor
-	hooks            *twirp.ServerHooks
-	pathPrefix       string // prefix for routing
-	jsonSkipDefaults bool   // do not include unpopulated fields (default values) in the response
-	jsonCamelCase    bool   // JSON fields are serialized as lowerCamelCase rather than keeping the original proto names
+func (c *authProtobufClient) ChangePassword(ctx context.Context, in *ChangePasswordReq) (*ChangePasswordRes, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "protos")
+	ctx = ctxsetters.WithServiceName(ctx, "Auth")
+	ctx = ctxsetters.WithMethodName(ctx, "ChangePassword")
+	caller := c.callChangePassword
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *ChangePasswordReq) (*ChangePasswordRes, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ChangePasswordReq)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ChangePasswordReq) when calling interceptor")
+					}
+					return c.callChangePassword(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ChangePasswordRes)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ChangePasswordRes) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
 }
 
-// NewAuthServer builds a TwirpServer that can be used as an http.Handler to handle
-// HTTP requests that are routed to the right method in the provided svc implementation.
-// The opts are twirp.ServerOption modifiers, for example twirp.WithServerHooks(hooks).
-func NewAuthServer(svc Auth, opts ...interface{}) TwirpServer {
-	serverOpts := newServerOpts(opts)
-
-	// Using ReadOpt allows backwards and forwads compatibility with new options in the future
-	jsonSkipDefaults := false
-	_ = serverOpts.ReadOpt("jsonSkipDefaults", &jsonSkipDefaults)
-	jsonCamelCase := false
-	_ = serverOpts.ReadOpt("jsonCamelCase", &jsonCamelCase)
-	var pathPrefix string
-	if ok := serverOpts.ReadOpt("pathPrefix", &pathPrefix); !ok {
-		pathPrefix = "/twirp" // default prefix
+func (c *authProtobufClient) callChangePassword(ctx context.Context, in *ChangePasswordReq) (*ChangePasswordRes, error) {
+	out := new(ChangePasswordRes)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[6], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
 	}
 
-	return &authServer{
-		Auth:             svc,
-		hooks:            serverOpts.Hooks,
-		interceptor:      twirp.ChainInterceptors(serverOpts.Interceptors...),
-		pathPrefix:       pathPrefix,
-		jsonSkipDefaults: jsonSkipDefaults,
-		jsonCamelCase:    jsonCamelCase,
-	}
-}
+	callClientResponseReceived(ctx, c.opts.Hooks)
 
-// writeError writes an HTTP response with a valid Twirp error format, and triggers hooks.
-// If err is not a twirp.Error, it will get wrapped with twirp.InternalErrorWith(err)
-func (s *authServer) writeError(ctx context.Context, resp http.ResponseWriter, err error) {
-	writeError(ctx, resp, err, s.hooks)
+	return out, nil
 }
 
-// handleRequestBodyError is used to handle error when the twirp server cannot read request
-func (s *authServer) handleRequestBodyError(ctx context.Context, resp http.ResponseWriter, msg string, err error) {
-	if context.Canceled == ctx.Err() {
-		s.writeError(ctx, resp, twirp.NewError(twirp.Canceled, "failed to read request: context canceled"))
-		return
-	}
-	if context.DeadlineExceeded == ctx.Err() {
-		s.writeError(ctx, resp, twirp.NewError(twirp.DeadlineExceeded, "failed to read request: deadline exceeded"))
-		return
+func (c *authProtobufClient) ChangeEmail(ctx context.Context, in *ChangeEmailReq) (*ChangeEmailRes, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "protos")
+	ctx = ctxsetters.WithServiceName(ctx, "Auth")
+	ctx = ctxsetters.WithMethodName(ctx, "ChangeEmail")
+	caller := c.callChangeEmail
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *ChangeEmailReq) (*ChangeEmailRes, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ChangeEmailReq)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ChangeEmailReq) when calling interceptor")
+					}
+					return c.callChangeEmail(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ChangeEmailRes)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ChangeEmailRes) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
 	}
-	s.writeError(ctx, resp, twirp.WrapError(malformedRequestError(msg), err))
+	return caller(ctx, in)
 }
 
-// AuthPathPrefix is a convenience constant that may identify URL paths.
-// Should be used with caution, it only matches routes generated by Twirp Go clients,
-// with the default "/twirp" prefix and default CamelCase service and method names.
-// More info: https://twitchtv.github.io/twirp/docs/routing.html
-const AuthPathPrefix = "/twirp/protos.Auth/"
+func (c *authProtobufClient) callChangeEmail(ctx context.Context, in *ChangeEmailReq) (*ChangeEmailRes, error) {
+	out := new(ChangeEmailRes)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[7], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
 
-func (s *authServer) ServeHTTP(resp http.ResponseWriter, req *http.Request) {
-	ctx := req.Context()
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *authProtobufClient) UpdateProfile(ctx context.Context, in *UpdateProfileReq) (*UpdateProfileRes, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "protos")
+	ctx = ctxsetters.WithServiceName(ctx, "Auth")
+	ctx = ctxsetters.WithMethodName(ctx, "UpdateProfile")
+	caller := c.callUpdateProfile
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *UpdateProfileReq) (*UpdateProfileRes, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*UpdateProfileReq)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*UpdateProfileReq) when calling interceptor")
+					}
+					return c.callUpdateProfile(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*UpdateProfileRes)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*UpdateProfileRes) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *authProtobufClient) callUpdateProfile(ctx context.Context, in *UpdateProfileReq) (*UpdateProfileRes, error) {
+	out := new(UpdateProfileRes)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[8], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *authProtobufClient) DeleteAccount(ctx context.Context, in *DeleteAccountReq) (*DeleteAccountRes, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "protos")
+	ctx = ctxsetters.WithServiceName(ctx, "Auth")
+	ctx = ctxsetters.WithMethodName(ctx, "DeleteAccount")
+	caller := c.callDeleteAccount
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *DeleteAccountReq) (*DeleteAccountRes, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*DeleteAccountReq)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*DeleteAccountReq) when calling interceptor")
+					}
+					return c.callDeleteAccount(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*DeleteAccountRes)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*DeleteAccountRes) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *authProtobufClient) callDeleteAccount(ctx context.Context, in *DeleteAccountReq) (*DeleteAccountRes, error) {
+	out := new(DeleteAccountRes)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[9], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *authProtobufClient) VerifyTwoFactor(ctx context.Context, in *VerifyTwoFactorReq) (*VerifyTwoFactorRes, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "protos")
+	ctx = ctxsetters.WithServiceName(ctx, "Auth")
+	ctx = ctxsetters.WithMethodName(ctx, "VerifyTwoFactor")
+	caller := c.callVerifyTwoFactor
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *VerifyTwoFactorReq) (*VerifyTwoFactorRes, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*VerifyTwoFactorReq)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*VerifyTwoFactorReq) when calling interceptor")
+					}
+					return c.callVerifyTwoFactor(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*VerifyTwoFactorRes)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*VerifyTwoFactorRes) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *authProtobufClient) callVerifyTwoFactor(ctx context.Context, in *VerifyTwoFactorReq) (*VerifyTwoFactorRes, error) {
+	out := new(VerifyTwoFactorRes)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[10], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *authProtobufClient) EnrollTwoFactor(ctx context.Context, in *EnrollTwoFactorReq) (*EnrollTwoFactorRes, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "protos")
+	ctx = ctxsetters.WithServiceName(ctx, "Auth")
+	ctx = ctxsetters.WithMethodName(ctx, "EnrollTwoFactor")
+	caller := c.callEnrollTwoFactor
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *EnrollTwoFactorReq) (*EnrollTwoFactorRes, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*EnrollTwoFactorReq)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*EnrollTwoFactorReq) when calling interceptor")
+					}
+					return c.callEnrollTwoFactor(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*EnrollTwoFactorRes)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*EnrollTwoFactorRes) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *authProtobufClient) callEnrollTwoFactor(ctx context.Context, in *EnrollTwoFactorReq) (*EnrollTwoFactorRes, error) {
+	out := new(EnrollTwoFactorRes)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[11], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *authProtobufClient) ConfirmTwoFactor(ctx context.Context, in *ConfirmTwoFactorReq) (*ConfirmTwoFactorRes, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "protos")
+	ctx = ctxsetters.WithServiceName(ctx, "Auth")
+	ctx = ctxsetters.WithMethodName(ctx, "ConfirmTwoFactor")
+	caller := c.callConfirmTwoFactor
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *ConfirmTwoFactorReq) (*ConfirmTwoFactorRes, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ConfirmTwoFactorReq)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ConfirmTwoFactorReq) when calling interceptor")
+					}
+					return c.callConfirmTwoFactor(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ConfirmTwoFactorRes)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ConfirmTwoFactorRes) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *authProtobufClient) callConfirmTwoFactor(ctx context.Context, in *ConfirmTwoFactorReq) (*ConfirmTwoFactorRes, error) {
+	out := new(ConfirmTwoFactorRes)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[12], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *authProtobufClient) DisableTwoFactor(ctx context.Context, in *DisableTwoFactorReq) (*DisableTwoFactorRes, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "protos")
+	ctx = ctxsetters.WithServiceName(ctx, "Auth")
+	ctx = ctxsetters.WithMethodName(ctx, "DisableTwoFactor")
+	caller := c.callDisableTwoFactor
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *DisableTwoFactorReq) (*DisableTwoFactorRes, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*DisableTwoFactorReq)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*DisableTwoFactorReq) when calling interceptor")
+					}
+					return c.callDisableTwoFactor(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*DisableTwoFactorRes)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*DisableTwoFactorRes) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *authProtobufClient) callDisableTwoFactor(ctx context.Context, in *DisableTwoFactorReq) (*DisableTwoFactorRes, error) {
+	out := new(DisableTwoFactorRes)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[13], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *authProtobufClient) ListSessions(ctx context.Context, in *ListSessionsReq) (*ListSessionsRes, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "protos")
+	ctx = ctxsetters.WithServiceName(ctx, "Auth")
+	ctx = ctxsetters.WithMethodName(ctx, "ListSessions")
+	caller := c.callListSessions
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *ListSessionsReq) (*ListSessionsRes, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ListSessionsReq)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ListSessionsReq) when calling interceptor")
+					}
+					return c.callListSessions(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ListSessionsRes)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ListSessionsRes) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *authProtobufClient) callListSessions(ctx context.Context, in *ListSessionsReq) (*ListSessionsRes, error) {
+	out := new(ListSessionsRes)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[14], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *authProtobufClient) RevokeSession(ctx context.Context, in *RevokeSessionReq) (*RevokeSessionRes, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "protos")
+	ctx = ctxsetters.WithServiceName(ctx, "Auth")
+	ctx = ctxsetters.WithMethodName(ctx, "RevokeSession")
+	caller := c.callRevokeSession
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *RevokeSessionReq) (*RevokeSessionRes, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*RevokeSessionReq)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*RevokeSessionReq) when calling interceptor")
+					}
+					return c.callRevokeSession(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*RevokeSessionRes)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*RevokeSessionRes) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *authProtobufClient) callRevokeSession(ctx context.Context, in *RevokeSessionReq) (*RevokeSessionRes, error) {
+	out := new(RevokeSessionRes)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[15], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *authProtobufClient) RevokeAllOtherSessions(ctx context.Context, in *RevokeAllOtherSessionsReq) (*RevokeAllOtherSessionsRes, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "protos")
+	ctx = ctxsetters.WithServiceName(ctx, "Auth")
+	ctx = ctxsetters.WithMethodName(ctx, "RevokeAllOtherSessions")
+	caller := c.callRevokeAllOtherSessions
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *RevokeAllOtherSessionsReq) (*RevokeAllOtherSessionsRes, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*RevokeAllOtherSessionsReq)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*RevokeAllOtherSessionsReq) when calling interceptor")
+					}
+					return c.callRevokeAllOtherSessions(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*RevokeAllOtherSessionsRes)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*RevokeAllOtherSessionsRes) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *authProtobufClient) callRevokeAllOtherSessions(ctx context.Context, in *RevokeAllOtherSessionsReq) (*RevokeAllOtherSessionsRes, error) {
+	out := new(RevokeAllOtherSessionsRes)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[16], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *authProtobufClient) ListGrants(ctx context.Context, in *ListGrantsReq) (*ListGrantsRes, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "protos")
+	ctx = ctxsetters.WithServiceName(ctx, "Auth")
+	ctx = ctxsetters.WithMethodName(ctx, "ListGrants")
+	caller := c.callListGrants
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *ListGrantsReq) (*ListGrantsRes, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ListGrantsReq)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ListGrantsReq) when calling interceptor")
+					}
+					return c.callListGrants(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ListGrantsRes)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ListGrantsRes) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *authProtobufClient) callListGrants(ctx context.Context, in *ListGrantsReq) (*ListGrantsRes, error) {
+	out := new(ListGrantsRes)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[17], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *authProtobufClient) RevokeGrant(ctx context.Context, in *RevokeGrantReq) (*RevokeGrantRes, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "protos")
+	ctx = ctxsetters.WithServiceName(ctx, "Auth")
+	ctx = ctxsetters.WithMethodName(ctx, "RevokeGrant")
+	caller := c.callRevokeGrant
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *RevokeGrantReq) (*RevokeGrantRes, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*RevokeGrantReq)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*RevokeGrantReq) when calling interceptor")
+					}
+					return c.callRevokeGrant(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*RevokeGrantRes)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*RevokeGrantRes) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *authProtobufClient) callRevokeGrant(ctx context.Context, in *RevokeGrantReq) (*RevokeGrantRes, error) {
+	out := new(RevokeGrantRes)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[18], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *authProtobufClient) Logout(ctx context.Context, in *LogoutReq) (*LogoutRes, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "protos")
+	ctx = ctxsetters.WithServiceName(ctx, "Auth")
+	ctx = ctxsetters.WithMethodName(ctx, "Logout")
+	caller := c.callLogout
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *LogoutReq) (*LogoutRes, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*LogoutReq)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*LogoutReq) when calling interceptor")
+					}
+					return c.callLogout(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*LogoutRes)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*LogoutRes) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *authProtobufClient) callLogout(ctx context.Context, in *LogoutReq) (*LogoutRes, error) {
+	out := new(LogoutRes)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[19], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+// ================
+// Auth JSON Client
+// ================
+
+type authJSONClient struct {
+	client      HTTPClient
+	urls        [20]string
+	interceptor twirp.Interceptor
+	opts        twirp.ClientOptions
+}
+
+// NewAuthJSONClient creates a JSON client that implements the Auth interface.
+// It communicates using JSON and can be configured with a custom HTTPClient.
+func NewAuthJSONClient(baseURL string, client HTTPClient, opts ...twirp.ClientOption) Auth {
+	if c, ok := client.(*http.Client); ok {
+		client = withoutRedirects(c)
+	}
+
+	clientOpts := twirp.ClientOptions{}
+	for _, o := range opts {
+		o(&clientOpts)
+	}
+
+	// Using ReadOpt allows backwards and forwads compatibility with new options in the future
+	literalURLs := false
+	_ = clientOpts.ReadOpt("literalURLs", &literalURLs)
+	var pathPrefix string
+	if ok := clientOpts.ReadOpt("pathPrefix", &pathPrefix); !ok {
+		pathPrefix = "/twirp" // default prefix
+	}
+
+	// Build method URLs: <baseURL>[<prefix>]/<package>.<Service>/<Method>
+	serviceURL := sanitizeBaseURL(baseURL)
+	serviceURL += baseServicePath(pathPrefix, "protos", "Auth")
+	urls := [20]string{
+		serviceURL + "Authenticate",
+		serviceURL + "Register",
+		serviceURL + "SendVerificationEmail",
+		serviceURL + "VerifyEmail",
+		serviceURL + "RequestPasswordReset",
+		serviceURL + "ResetPassword",
+		serviceURL + "ChangePassword",
+		serviceURL + "ChangeEmail",
+		serviceURL + "UpdateProfile",
+		serviceURL + "DeleteAccount",
+		serviceURL + "VerifyTwoFactor",
+		serviceURL + "EnrollTwoFactor",
+		serviceURL + "ConfirmTwoFactor",
+		serviceURL + "DisableTwoFactor",
+		serviceURL + "ListSessions",
+		serviceURL + "RevokeSession",
+		serviceURL + "RevokeAllOtherSessions",
+		serviceURL + "ListGrants",
+		serviceURL + "RevokeGrant",
+		serviceURL + "Logout",
+	}
+
+	return &authJSONClient{
+		client:      client,
+		urls:        urls,
+		interceptor: twirp.ChainInterceptors(clientOpts.Interceptors...),
+		opts:        clientOpts,
+	}
+}
+
+func (c *authJSONClient) Authenticate(ctx context.Context, in *AuthenticateReq) (*AuthenticateRes, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "protos")
+	ctx = ctxsetters.WithServiceName(ctx, "Auth")
+	ctx = ctxsetters.WithMethodName(ctx, "Authenticate")
+	caller := c.callAuthenticate
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *AuthenticateReq) (*AuthenticateRes, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*AuthenticateReq)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*AuthenticateReq) when calling interceptor")
+					}
+					return c.callAuthenticate(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*AuthenticateRes)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*AuthenticateRes) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *authJSONClient) callAuthenticate(ctx context.Context, in *AuthenticateReq) (*AuthenticateRes, error) {
+	out := new(AuthenticateRes)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[0], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *authJSONClient) Register(ctx context.Context, in *RegisterReq) (*RegisterRes, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "protos")
+	ctx = ctxsetters.WithServiceName(ctx, "Auth")
+	ctx = ctxsetters.WithMethodName(ctx, "Register")
+	caller := c.callRegister
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *RegisterReq) (*RegisterRes, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*RegisterReq)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*RegisterReq) when calling interceptor")
+					}
+					return c.callRegister(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*RegisterRes)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*RegisterRes) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *authJSONClient) callRegister(ctx context.Context, in *RegisterReq) (*RegisterRes, error) {
+	out := new(RegisterRes)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[1], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *authJSONClient) SendVerificationEmail(ctx context.Context, in *SendVerificationEmailReq) (*SendVerificationEmailRes, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "protos")
+	ctx = ctxsetters.WithServiceName(ctx, "Auth")
+	ctx = ctxsetters.WithMethodName(ctx, "SendVerificationEmail")
+	caller := c.callSendVerificationEmail
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *SendVerificationEmailReq) (*SendVerificationEmailRes, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*SendVerificationEmailReq)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*SendVerificationEmailReq) when calling interceptor")
+					}
+					return c.callSendVerificationEmail(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*SendVerificationEmailRes)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*SendVerificationEmailRes) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *authJSONClient) callSendVerificationEmail(ctx context.Context, in *SendVerificationEmailReq) (*SendVerificationEmailRes, error) {
+	out := new(SendVerificationEmailRes)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[2], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *authJSONClient) VerifyEmail(ctx context.Context, in *VerifyEmailReq) (*VerifyEmailRes, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "protos")
+	ctx = ctxsetters.WithServiceName(ctx, "Auth")
+	ctx = ctxsetters.WithMethodName(ctx, "VerifyEmail")
+	caller := c.callVerifyEmail
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *VerifyEmailReq) (*VerifyEmailRes, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*VerifyEmailReq)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*VerifyEmailReq) when calling interceptor")
+					}
+					return c.callVerifyEmail(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*VerifyEmailRes)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*VerifyEmailRes) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *authJSONClient) callVerifyEmail(ctx context.Context, in *VerifyEmailReq) (*VerifyEmailRes, error) {
+	out := new(VerifyEmailRes)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[3], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *authJSONClient) RequestPasswordReset(ctx context.Context, in *RequestPasswordResetReq) (*RequestPasswordResetRes, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "protos")
+	ctx = ctxsetters.WithServiceName(ctx, "Auth")
+	ctx = ctxsetters.WithMethodName(ctx, "RequestPasswordReset")
+	caller := c.callRequestPasswordReset
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *RequestPasswordResetReq) (*RequestPasswordResetRes, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*RequestPasswordResetReq)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*RequestPasswordResetReq) when calling interceptor")
+					}
+					return c.callRequestPasswordReset(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*RequestPasswordResetRes)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*RequestPasswordResetRes) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *authJSONClient) callRequestPasswordReset(ctx context.Context, in *RequestPasswordResetReq) (*RequestPasswordResetRes, error) {
+	out := new(RequestPasswordResetRes)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[4], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *authJSONClient) ResetPassword(ctx context.Context, in *ResetPasswordReq) (*ResetPasswordRes, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "protos")
+	ctx = ctxsetters.WithServiceName(ctx, "Auth")
+	ctx = ctxsetters.WithMethodName(ctx, "ResetPassword")
+	caller := c.callResetPassword
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *ResetPasswordReq) (*ResetPasswordRes, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ResetPasswordReq)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ResetPasswordReq) when calling interceptor")
+					}
+					return c.callResetPassword(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ResetPasswordRes)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ResetPasswordRes) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *authJSONClient) callResetPassword(ctx context.Context, in *ResetPasswordReq) (*ResetPasswordRes, error) {
+	out := new(ResetPasswordRes)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[5], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *authJSONClient) ChangePassword(ctx context.Context, in *ChangePasswordReq) (*ChangePasswordRes, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "protos")
+	ctx = ctxsetters.WithServiceName(ctx, "Auth")
+	ctx = ctxsetters.WithMethodName(ctx, "ChangePassword")
+	caller := c.callChangePassword
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *ChangePasswordReq) (*ChangePasswordRes, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ChangePasswordReq)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ChangePasswordReq) when calling interceptor")
+					}
+					return c.callChangePassword(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ChangePasswordRes)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ChangePasswordRes) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *authJSONClient) callChangePassword(ctx context.Context, in *ChangePasswordReq) (*ChangePasswordRes, error) {
+	out := new(ChangePasswordRes)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[6], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *authJSONClient) ChangeEmail(ctx context.Context, in *ChangeEmailReq) (*ChangeEmailRes, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "protos")
+	ctx = ctxsetters.WithServiceName(ctx, "Auth")
+	ctx = ctxsetters.WithMethodName(ctx, "ChangeEmail")
+	caller := c.callChangeEmail
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *ChangeEmailReq) (*ChangeEmailRes, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ChangeEmailReq)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ChangeEmailReq) when calling interceptor")
+					}
+					return c.callChangeEmail(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ChangeEmailRes)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ChangeEmailRes) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *authJSONClient) callChangeEmail(ctx context.Context, in *ChangeEmailReq) (*ChangeEmailRes, error) {
+	out := new(ChangeEmailRes)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[7], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *authJSONClient) UpdateProfile(ctx context.Context, in *UpdateProfileReq) (*UpdateProfileRes, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "protos")
+	ctx = ctxsetters.WithServiceName(ctx, "Auth")
+	ctx = ctxsetters.WithMethodName(ctx, "UpdateProfile")
+	caller := c.callUpdateProfile
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *UpdateProfileReq) (*UpdateProfileRes, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*UpdateProfileReq)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*UpdateProfileReq) when calling interceptor")
+					}
+					return c.callUpdateProfile(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*UpdateProfileRes)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*UpdateProfileRes) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *authJSONClient) callUpdateProfile(ctx context.Context, in *UpdateProfileReq) (*UpdateProfileRes, error) {
+	out := new(UpdateProfileRes)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[8], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *authJSONClient) DeleteAccount(ctx context.Context, in *DeleteAccountReq) (*DeleteAccountRes, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "protos")
+	ctx = ctxsetters.WithServiceName(ctx, "Auth")
+	ctx = ctxsetters.WithMethodName(ctx, "DeleteAccount")
+	caller := c.callDeleteAccount
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *DeleteAccountReq) (*DeleteAccountRes, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*DeleteAccountReq)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*DeleteAccountReq) when calling interceptor")
+					}
+					return c.callDeleteAccount(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*DeleteAccountRes)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*DeleteAccountRes) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *authJSONClient) callDeleteAccount(ctx context.Context, in *DeleteAccountReq) (*DeleteAccountRes, error) {
+	out := new(DeleteAccountRes)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[9], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *authJSONClient) VerifyTwoFactor(ctx context.Context, in *VerifyTwoFactorReq) (*VerifyTwoFactorRes, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "protos")
+	ctx = ctxsetters.WithServiceName(ctx, "Auth")
+	ctx = ctxsetters.WithMethodName(ctx, "VerifyTwoFactor")
+	caller := c.callVerifyTwoFactor
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *VerifyTwoFactorReq) (*VerifyTwoFactorRes, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*VerifyTwoFactorReq)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*VerifyTwoFactorReq) when calling interceptor")
+					}
+					return c.callVerifyTwoFactor(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*VerifyTwoFactorRes)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*VerifyTwoFactorRes) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *authJSONClient) callVerifyTwoFactor(ctx context.Context, in *VerifyTwoFactorReq) (*VerifyTwoFactorRes, error) {
+	out := new(VerifyTwoFactorRes)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[10], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *authJSONClient) EnrollTwoFactor(ctx context.Context, in *EnrollTwoFactorReq) (*EnrollTwoFactorRes, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "protos")
+	ctx = ctxsetters.WithServiceName(ctx, "Auth")
+	ctx = ctxsetters.WithMethodName(ctx, "EnrollTwoFactor")
+	caller := c.callEnrollTwoFactor
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *EnrollTwoFactorReq) (*EnrollTwoFactorRes, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*EnrollTwoFactorReq)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*EnrollTwoFactorReq) when calling interceptor")
+					}
+					return c.callEnrollTwoFactor(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*EnrollTwoFactorRes)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*EnrollTwoFactorRes) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *authJSONClient) callEnrollTwoFactor(ctx context.Context, in *EnrollTwoFactorReq) (*EnrollTwoFactorRes, error) {
+	out := new(EnrollTwoFactorRes)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[11], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *authJSONClient) ConfirmTwoFactor(ctx context.Context, in *ConfirmTwoFactorReq) (*ConfirmTwoFactorRes, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "protos")
+	ctx = ctxsetters.WithServiceName(ctx, "Auth")
+	ctx = ctxsetters.WithMethodName(ctx, "ConfirmTwoFactor")
+	caller := c.callConfirmTwoFactor
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *ConfirmTwoFactorReq) (*ConfirmTwoFactorRes, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ConfirmTwoFactorReq)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ConfirmTwoFactorReq) when calling interceptor")
+					}
+					return c.callConfirmTwoFactor(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ConfirmTwoFactorRes)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ConfirmTwoFactorRes) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *authJSONClient) callConfirmTwoFactor(ctx context.Context, in *ConfirmTwoFactorReq) (*ConfirmTwoFactorRes, error) {
+	out := new(ConfirmTwoFactorRes)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[12], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *authJSONClient) DisableTwoFactor(ctx context.Context, in *DisableTwoFactorReq) (*DisableTwoFactorRes, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "protos")
+	ctx = ctxsetters.WithServiceName(ctx, "Auth")
+	ctx = ctxsetters.WithMethodName(ctx, "DisableTwoFactor")
+	caller := c.callDisableTwoFactor
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *DisableTwoFactorReq) (*DisableTwoFactorRes, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*DisableTwoFactorReq)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*DisableTwoFactorReq) when calling interceptor")
+					}
+					return c.callDisableTwoFactor(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*DisableTwoFactorRes)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*DisableTwoFactorRes) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *authJSONClient) callDisableTwoFactor(ctx context.Context, in *DisableTwoFactorReq) (*DisableTwoFactorRes, error) {
+	out := new(DisableTwoFactorRes)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[13], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *authJSONClient) ListSessions(ctx context.Context, in *ListSessionsReq) (*ListSessionsRes, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "protos")
+	ctx = ctxsetters.WithServiceName(ctx, "Auth")
+	ctx = ctxsetters.WithMethodName(ctx, "ListSessions")
+	caller := c.callListSessions
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *ListSessionsReq) (*ListSessionsRes, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ListSessionsReq)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ListSessionsReq) when calling interceptor")
+					}
+					return c.callListSessions(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ListSessionsRes)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ListSessionsRes) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *authJSONClient) callListSessions(ctx context.Context, in *ListSessionsReq) (*ListSessionsRes, error) {
+	out := new(ListSessionsRes)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[14], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *authJSONClient) RevokeSession(ctx context.Context, in *RevokeSessionReq) (*RevokeSessionRes, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "protos")
+	ctx = ctxsetters.WithServiceName(ctx, "Auth")
+	ctx = ctxsetters.WithMethodName(ctx, "RevokeSession")
+	caller := c.callRevokeSession
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *RevokeSessionReq) (*RevokeSessionRes, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*RevokeSessionReq)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*RevokeSessionReq) when calling interceptor")
+					}
+					return c.callRevokeSession(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*RevokeSessionRes)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*RevokeSessionRes) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *authJSONClient) callRevokeSession(ctx context.Context, in *RevokeSessionReq) (*RevokeSessionRes, error) {
+	out := new(RevokeSessionRes)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[15], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *authJSONClient) RevokeAllOtherSessions(ctx context.Context, in *RevokeAllOtherSessionsReq) (*RevokeAllOtherSessionsRes, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "protos")
+	ctx = ctxsetters.WithServiceName(ctx, "Auth")
+	ctx = ctxsetters.WithMethodName(ctx, "RevokeAllOtherSessions")
+	caller := c.callRevokeAllOtherSessions
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *RevokeAllOtherSessionsReq) (*RevokeAllOtherSessionsRes, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*RevokeAllOtherSessionsReq)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*RevokeAllOtherSessionsReq) when calling interceptor")
+					}
+					return c.callRevokeAllOtherSessions(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*RevokeAllOtherSessionsRes)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*RevokeAllOtherSessionsRes) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *authJSONClient) callRevokeAllOtherSessions(ctx context.Context, in *RevokeAllOtherSessionsReq) (*RevokeAllOtherSessionsRes, error) {
+	out := new(RevokeAllOtherSessionsRes)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[16], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *authJSONClient) ListGrants(ctx context.Context, in *ListGrantsReq) (*ListGrantsRes, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "protos")
+	ctx = ctxsetters.WithServiceName(ctx, "Auth")
+	ctx = ctxsetters.WithMethodName(ctx, "ListGrants")
+	caller := c.callListGrants
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *ListGrantsReq) (*ListGrantsRes, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ListGrantsReq)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ListGrantsReq) when calling interceptor")
+					}
+					return c.callListGrants(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ListGrantsRes)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ListGrantsRes) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *authJSONClient) callListGrants(ctx context.Context, in *ListGrantsReq) (*ListGrantsRes, error) {
+	out := new(ListGrantsRes)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[17], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *authJSONClient) RevokeGrant(ctx context.Context, in *RevokeGrantReq) (*RevokeGrantRes, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "protos")
+	ctx = ctxsetters.WithServiceName(ctx, "Auth")
+	ctx = ctxsetters.WithMethodName(ctx, "RevokeGrant")
+	caller := c.callRevokeGrant
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *RevokeGrantReq) (*RevokeGrantRes, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*RevokeGrantReq)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*RevokeGrantReq) when calling interceptor")
+					}
+					return c.callRevokeGrant(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*RevokeGrantRes)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*RevokeGrantRes) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *authJSONClient) callRevokeGrant(ctx context.Context, in *RevokeGrantReq) (*RevokeGrantRes, error) {
+	out := new(RevokeGrantRes)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[18], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *authJSONClient) Logout(ctx context.Context, in *LogoutReq) (*LogoutRes, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "protos")
+	ctx = ctxsetters.WithServiceName(ctx, "Auth")
+	ctx = ctxsetters.WithMethodName(ctx, "Logout")
+	caller := c.callLogout
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *LogoutReq) (*LogoutRes, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*LogoutReq)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*LogoutReq) when calling interceptor")
+					}
+					return c.callLogout(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*LogoutRes)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*LogoutRes) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *authJSONClient) callLogout(ctx context.Context, in *LogoutReq) (*LogoutRes, error) {
+	out := new(LogoutRes)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[19], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+// ===================
+// Auth Server Handler
+// ===================
+
+type authServer struct {
+	Auth
+	interceptor      twirp.Interceptor
+	hooks            *twirp.ServerHooks
+	pathPrefix       string // prefix for routing
+	jsonSkipDefaults bool   // do not include unpopulated fields (default values) in the response
+	jsonCamelCase    bool   // JSON fields are serialized as lowerCamelCase rather than keeping the original proto names
+}
+
+// NewAuthServer builds a TwirpServer that can be used as an http.Handler to handle
+// HTTP requests that are routed to the right method in the provided svc implementation.
+// The opts are twirp.ServerOption modifiers, for example twirp.WithServerHooks(hooks).
+func NewAuthServer(svc Auth, opts ...interface{}) TwirpServer {
+	serverOpts := newServerOpts(opts)
+
+	// Using ReadOpt allows backwards and forwads compatibility with new options in the future
+	jsonSkipDefaults := false
+	_ = serverOpts.ReadOpt("jsonSkipDefaults", &jsonSkipDefaults)
+	jsonCamelCase := false
+	_ = serverOpts.ReadOpt("jsonCamelCase", &jsonCamelCase)
+	var pathPrefix string
+	if ok := serverOpts.ReadOpt("pathPrefix", &pathPrefix); !ok {
+		pathPrefix = "/twirp" // default prefix
+	}
+
+	return &authServer{
+		Auth:             svc,
+		hooks:            serverOpts.Hooks,
+		interceptor:      twirp.ChainInterceptors(serverOpts.Interceptors...),
+		pathPrefix:       pathPrefix,
+		jsonSkipDefaults: jsonSkipDefaults,
+		jsonCamelCase:    jsonCamelCase,
+	}
+}
+
+// writeError writes an HTTP response with a valid Twirp error format, and triggers hooks.
+// If err is not a twirp.Error, it will get wrapped with twirp.InternalErrorWith(err)
+func (s *authServer) writeError(ctx context.Context, resp http.ResponseWriter, err error) {
+	writeError(ctx, resp, err, s.hooks)
+}
+
+// handleRequestBodyError is used to handle error when the twirp server cannot read request
+func (s *authServer) handleRequestBodyError(ctx context.Context, resp http.ResponseWriter, msg string, err error) {
+	if context.Canceled == ctx.Err() {
+		s.writeError(ctx, resp, twirp.NewError(twirp.Canceled, "failed to read request: context canceled"))
+		return
+	}
+	if context.DeadlineExceeded == ctx.Err() {
+		s.writeError(ctx, resp, twirp.NewError(twirp.DeadlineExceeded, "failed to read request: deadline exceeded"))
+		return
+	}
+	s.writeError(ctx, resp, twirp.WrapError(malformedRequestError(msg), err))
+}
+
+// AuthPathPrefix is a convenience constant that may identify URL paths.
+// Should be used with caution, it only matches routes generated by Twirp Go clients,
+// with the default "/twirp" prefix and default CamelCase service and method names.
+// More info: https://twitchtv.github.io/twirp/docs/routing.html
+const AuthPathPrefix = "/twirp/protos.Auth/"
+
+func (s *authServer) ServeHTTP(resp http.ResponseWriter, req *http.Request) {
+	ctx := req.Context()
 	ctx = ctxsetters.WithPackageName(ctx, "protos")
 	ctx = ctxsetters.WithServiceName(ctx, "Auth")
 	ctx = ctxsetters.WithResponseWriter(ctx, resp)
 
 	var err error
-	ctx, err = callRequestReceived(ctx, s.hooks)
+	ctx, err = callRequestReceived(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	if req.Method != "POST" {
+		msg := fmt.Sprintf("unsupported method %q (only POST is allowed)", req.Method)
+		s.writeError(ctx, resp, badRouteError(msg, req.Method, req.URL.Path))
+		return
+	}
+
+	// Verify path format: [<prefix>]/<package>.<Service>/<Method>
+	prefix, pkgService, method := parseTwirpPath(req.URL.Path)
+	if pkgService != "protos.Auth" {
+		msg := fmt.Sprintf("no handler for path %q", req.URL.Path)
+		s.writeError(ctx, resp, badRouteError(msg, req.Method, req.URL.Path))
+		return
+	}
+	if prefix != s.pathPrefix {
+		msg := fmt.Sprintf("invalid path prefix %q, expected %q, on path %q", prefix, s.pathPrefix, req.URL.Path)
+		s.writeError(ctx, resp, badRouteError(msg, req.Method, req.URL.Path))
+		return
+	}
+
+	switch method {
+	case "Authenticate":
+		s.serveAuthenticate(ctx, resp, req)
+		return
+	case "Register":
+		s.serveRegister(ctx, resp, req)
+		return
+	case "SendVerificationEmail":
+		s.serveSendVerificationEmail(ctx, resp, req)
+		return
+	case "VerifyEmail":
+		s.serveVerifyEmail(ctx, resp, req)
+		return
+	case "RequestPasswordReset":
+		s.serveRequestPasswordReset(ctx, resp, req)
+		return
+	case "ResetPassword":
+		s.serveResetPassword(ctx, resp, req)
+		return
+	case "ChangePassword":
+		s.serveChangePassword(ctx, resp, req)
+		return
+	case "ChangeEmail":
+		s.serveChangeEmail(ctx, resp, req)
+		return
+	case "UpdateProfile":
+		s.serveUpdateProfile(ctx, resp, req)
+		return
+	case "DeleteAccount":
+		s.serveDeleteAccount(ctx, resp, req)
+		return
+	case "VerifyTwoFactor":
+		s.serveVerifyTwoFactor(ctx, resp, req)
+		return
+	case "EnrollTwoFactor":
+		s.serveEnrollTwoFactor(ctx, resp, req)
+		return
+	case "ConfirmTwoFactor":
+		s.serveConfirmTwoFactor(ctx, resp, req)
+		return
+	case "DisableTwoFactor":
+		s.serveDisableTwoFactor(ctx, resp, req)
+		return
+	case "ListSessions":
+		s.serveListSessions(ctx, resp, req)
+		return
+	case "RevokeSession":
+		s.serveRevokeSession(ctx, resp, req)
+		return
+	case "RevokeAllOtherSessions":
+		s.serveRevokeAllOtherSessions(ctx, resp, req)
+		return
+	case "ListGrants":
+		s.serveListGrants(ctx, resp, req)
+		return
+	case "RevokeGrant":
+		s.serveRevokeGrant(ctx, resp, req)
+		return
+	case "Logout":
+		s.serveLogout(ctx, resp, req)
+		return
+	default:
+		msg := fmt.Sprintf("no handler for path %q", req.URL.Path)
+		s.writeError(ctx, resp, badRouteError(msg, req.Method, req.URL.Path))
+		return
+	}
+}
+
+func (s *authServer) serveAuthenticate(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveAuthenticateJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveAuthenticateProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *authServer) serveAuthenticateJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "Authenticate")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(AuthenticateReq)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.Auth.Authenticate
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *AuthenticateReq) (*AuthenticateRes, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*AuthenticateReq)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*AuthenticateReq) when calling interceptor")
+					}
+					return s.Auth.Authenticate(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*AuthenticateRes)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*AuthenticateRes) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *AuthenticateRes
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *AuthenticateRes and nil error while calling Authenticate. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *authServer) serveAuthenticateProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "Authenticate")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(AuthenticateReq)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.Auth.Authenticate
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *AuthenticateReq) (*AuthenticateRes, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*AuthenticateReq)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*AuthenticateReq) when calling interceptor")
+					}
+					return s.Auth.Authenticate(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*AuthenticateRes)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*AuthenticateRes) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *AuthenticateRes
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *AuthenticateRes and nil error while calling Authenticate. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *authServer) serveRegister(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveRegisterJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveRegisterProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *authServer) serveRegisterJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "Register")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(RegisterReq)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.Auth.Register
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *RegisterReq) (*RegisterRes, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*RegisterReq)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*RegisterReq) when calling interceptor")
+					}
+					return s.Auth.Register(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*RegisterRes)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*RegisterRes) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *RegisterRes
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *RegisterRes and nil error while calling Register. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *authServer) serveRegisterProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "Register")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(RegisterReq)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.Auth.Register
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *RegisterReq) (*RegisterRes, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*RegisterReq)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*RegisterReq) when calling interceptor")
+					}
+					return s.Auth.Register(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*RegisterRes)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*RegisterRes) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *RegisterRes
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *RegisterRes and nil error while calling Register. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *authServer) serveSendVerificationEmail(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveSendVerificationEmailJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveSendVerificationEmailProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *authServer) serveSendVerificationEmailJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "SendVerificationEmail")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(SendVerificationEmailReq)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.Auth.SendVerificationEmail
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *SendVerificationEmailReq) (*SendVerificationEmailRes, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*SendVerificationEmailReq)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*SendVerificationEmailReq) when calling interceptor")
+					}
+					return s.Auth.SendVerificationEmail(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*SendVerificationEmailRes)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*SendVerificationEmailRes) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *SendVerificationEmailRes
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *SendVerificationEmailRes and nil error while calling SendVerificationEmail. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *authServer) serveSendVerificationEmailProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "SendVerificationEmail")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(SendVerificationEmailReq)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.Auth.SendVerificationEmail
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *SendVerificationEmailReq) (*SendVerificationEmailRes, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*SendVerificationEmailReq)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*SendVerificationEmailReq) when calling interceptor")
+					}
+					return s.Auth.SendVerificationEmail(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*SendVerificationEmailRes)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*SendVerificationEmailRes) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *SendVerificationEmailRes
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *SendVerificationEmailRes and nil error while calling SendVerificationEmail. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *authServer) serveVerifyEmail(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveVerifyEmailJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveVerifyEmailProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *authServer) serveVerifyEmailJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "VerifyEmail")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(VerifyEmailReq)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.Auth.VerifyEmail
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *VerifyEmailReq) (*VerifyEmailRes, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*VerifyEmailReq)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*VerifyEmailReq) when calling interceptor")
+					}
+					return s.Auth.VerifyEmail(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*VerifyEmailRes)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*VerifyEmailRes) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *VerifyEmailRes
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *VerifyEmailRes and nil error while calling VerifyEmail. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *authServer) serveVerifyEmailProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "VerifyEmail")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(VerifyEmailReq)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.Auth.VerifyEmail
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *VerifyEmailReq) (*VerifyEmailRes, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*VerifyEmailReq)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*VerifyEmailReq) when calling interceptor")
+					}
+					return s.Auth.VerifyEmail(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*VerifyEmailRes)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*VerifyEmailRes) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *VerifyEmailRes
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *VerifyEmailRes and nil error while calling VerifyEmail. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *authServer) serveRequestPasswordReset(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveRequestPasswordResetJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveRequestPasswordResetProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *authServer) serveRequestPasswordResetJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "RequestPasswordReset")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(RequestPasswordResetReq)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.Auth.RequestPasswordReset
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *RequestPasswordResetReq) (*RequestPasswordResetRes, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*RequestPasswordResetReq)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*RequestPasswordResetReq) when calling interceptor")
+					}
+					return s.Auth.RequestPasswordReset(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*RequestPasswordResetRes)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*RequestPasswordResetRes) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *RequestPasswordResetRes
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *RequestPasswordResetRes and nil error while calling RequestPasswordReset. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *authServer) serveRequestPasswordResetProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "RequestPasswordReset")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(RequestPasswordResetReq)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.Auth.RequestPasswordReset
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *RequestPasswordResetReq) (*RequestPasswordResetRes, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*RequestPasswordResetReq)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*RequestPasswordResetReq) when calling interceptor")
+					}
+					return s.Auth.RequestPasswordReset(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*RequestPasswordResetRes)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*RequestPasswordResetRes) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *RequestPasswordResetRes
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *RequestPasswordResetRes and nil error while calling RequestPasswordReset. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *authServer) serveResetPassword(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveResetPasswordJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveResetPasswordProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *authServer) serveResetPasswordJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "ResetPassword")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(ResetPasswordReq)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.Auth.ResetPassword
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *ResetPasswordReq) (*ResetPasswordRes, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ResetPasswordReq)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ResetPasswordReq) when calling interceptor")
+					}
+					return s.Auth.ResetPassword(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ResetPasswordRes)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ResetPasswordRes) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *ResetPasswordRes
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *ResetPasswordRes and nil error while calling ResetPassword. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *authServer) serveResetPasswordProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "ResetPassword")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(ResetPasswordReq)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.Auth.ResetPassword
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *ResetPasswordReq) (*ResetPasswordRes, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ResetPasswordReq)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ResetPasswordReq) when calling interceptor")
+					}
+					return s.Auth.ResetPassword(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ResetPasswordRes)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ResetPasswordRes) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *ResetPasswordRes
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *ResetPasswordRes and nil error while calling ResetPassword. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *authServer) serveChangePassword(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveChangePasswordJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveChangePasswordProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *authServer) serveChangePasswordJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "ChangePassword")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(ChangePasswordReq)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.Auth.ChangePassword
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *ChangePasswordReq) (*ChangePasswordRes, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ChangePasswordReq)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ChangePasswordReq) when calling interceptor")
+					}
+					return s.Auth.ChangePassword(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ChangePasswordRes)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ChangePasswordRes) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *ChangePasswordRes
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *ChangePasswordRes and nil error while calling ChangePassword. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *authServer) serveChangePasswordProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "ChangePassword")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(ChangePasswordReq)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.Auth.ChangePassword
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *ChangePasswordReq) (*ChangePasswordRes, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ChangePasswordReq)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ChangePasswordReq) when calling interceptor")
+					}
+					return s.Auth.ChangePassword(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ChangePasswordRes)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ChangePasswordRes) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *ChangePasswordRes
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *ChangePasswordRes and nil error while calling ChangePassword. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *authServer) serveChangeEmail(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveChangeEmailJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveChangeEmailProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *authServer) serveChangeEmailJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "ChangeEmail")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(ChangeEmailReq)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.Auth.ChangeEmail
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *ChangeEmailReq) (*ChangeEmailRes, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ChangeEmailReq)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ChangeEmailReq) when calling interceptor")
+					}
+					return s.Auth.ChangeEmail(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ChangeEmailRes)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ChangeEmailRes) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *ChangeEmailRes
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *ChangeEmailRes and nil error while calling ChangeEmail. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *authServer) serveChangeEmailProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "ChangeEmail")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(ChangeEmailReq)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.Auth.ChangeEmail
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *ChangeEmailReq) (*ChangeEmailRes, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ChangeEmailReq)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ChangeEmailReq) when calling interceptor")
+					}
+					return s.Auth.ChangeEmail(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ChangeEmailRes)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ChangeEmailRes) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *ChangeEmailRes
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *ChangeEmailRes and nil error while calling ChangeEmail. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *authServer) serveUpdateProfile(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveUpdateProfileJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveUpdateProfileProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *authServer) serveUpdateProfileJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "UpdateProfile")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(UpdateProfileReq)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.Auth.UpdateProfile
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *UpdateProfileReq) (*UpdateProfileRes, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*UpdateProfileReq)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*UpdateProfileReq) when calling interceptor")
+					}
+					return s.Auth.UpdateProfile(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*UpdateProfileRes)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*UpdateProfileRes) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *UpdateProfileRes
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *UpdateProfileRes and nil error while calling UpdateProfile. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *authServer) serveUpdateProfileProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "UpdateProfile")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(UpdateProfileReq)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.Auth.UpdateProfile
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *UpdateProfileReq) (*UpdateProfileRes, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*UpdateProfileReq)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*UpdateProfileReq) when calling interceptor")
+					}
+					return s.Auth.UpdateProfile(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*UpdateProfileRes)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*UpdateProfileRes) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *UpdateProfileRes
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *UpdateProfileRes and nil error while calling UpdateProfile. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *authServer) serveDeleteAccount(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveDeleteAccountJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveDeleteAccountProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *authServer) serveDeleteAccountJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "DeleteAccount")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(DeleteAccountReq)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.Auth.DeleteAccount
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *DeleteAccountReq) (*DeleteAccountRes, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*DeleteAccountReq)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*DeleteAccountReq) when calling interceptor")
+					}
+					return s.Auth.DeleteAccount(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*DeleteAccountRes)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*DeleteAccountRes) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *DeleteAccountRes
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *DeleteAccountRes and nil error while calling DeleteAccount. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *authServer) serveDeleteAccountProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "DeleteAccount")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(DeleteAccountReq)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.Auth.DeleteAccount
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *DeleteAccountReq) (*DeleteAccountRes, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*DeleteAccountReq)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*DeleteAccountReq) when calling interceptor")
+					}
+					return s.Auth.DeleteAccount(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*DeleteAccountRes)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*DeleteAccountRes) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *DeleteAccountRes
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *DeleteAccountRes and nil error while calling DeleteAccount. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *authServer) serveVerifyTwoFactor(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveVerifyTwoFactorJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveVerifyTwoFactorProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *authServer) serveVerifyTwoFactorJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "VerifyTwoFactor")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(VerifyTwoFactorReq)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.Auth.VerifyTwoFactor
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *VerifyTwoFactorReq) (*VerifyTwoFactorRes, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*VerifyTwoFactorReq)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*VerifyTwoFactorReq) when calling interceptor")
+					}
+					return s.Auth.VerifyTwoFactor(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*VerifyTwoFactorRes)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*VerifyTwoFactorRes) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *VerifyTwoFactorRes
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *VerifyTwoFactorRes and nil error while calling VerifyTwoFactor. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *authServer) serveVerifyTwoFactorProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "VerifyTwoFactor")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(VerifyTwoFactorReq)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.Auth.VerifyTwoFactor
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *VerifyTwoFactorReq) (*VerifyTwoFactorRes, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*VerifyTwoFactorReq)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*VerifyTwoFactorReq) when calling interceptor")
+					}
+					return s.Auth.VerifyTwoFactor(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*VerifyTwoFactorRes)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*VerifyTwoFactorRes) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *VerifyTwoFactorRes
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *VerifyTwoFactorRes and nil error while calling VerifyTwoFactor. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *authServer) serveEnrollTwoFactor(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveEnrollTwoFactorJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveEnrollTwoFactorProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *authServer) serveEnrollTwoFactorJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "EnrollTwoFactor")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(EnrollTwoFactorReq)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.Auth.EnrollTwoFactor
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *EnrollTwoFactorReq) (*EnrollTwoFactorRes, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*EnrollTwoFactorReq)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*EnrollTwoFactorReq) when calling interceptor")
+					}
+					return s.Auth.EnrollTwoFactor(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*EnrollTwoFactorRes)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*EnrollTwoFactorRes) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *EnrollTwoFactorRes
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *EnrollTwoFactorRes and nil error while calling EnrollTwoFactor. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *authServer) serveEnrollTwoFactorProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "EnrollTwoFactor")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(EnrollTwoFactorReq)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.Auth.EnrollTwoFactor
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *EnrollTwoFactorReq) (*EnrollTwoFactorRes, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*EnrollTwoFactorReq)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*EnrollTwoFactorReq) when calling interceptor")
+					}
+					return s.Auth.EnrollTwoFactor(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*EnrollTwoFactorRes)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*EnrollTwoFactorRes) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *EnrollTwoFactorRes
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *EnrollTwoFactorRes and nil error while calling EnrollTwoFactor. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *authServer) serveConfirmTwoFactor(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveConfirmTwoFactorJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveConfirmTwoFactorProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *authServer) serveConfirmTwoFactorJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "ConfirmTwoFactor")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(ConfirmTwoFactorReq)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.Auth.ConfirmTwoFactor
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *ConfirmTwoFactorReq) (*ConfirmTwoFactorRes, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ConfirmTwoFactorReq)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ConfirmTwoFactorReq) when calling interceptor")
+					}
+					return s.Auth.ConfirmTwoFactor(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ConfirmTwoFactorRes)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ConfirmTwoFactorRes) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *ConfirmTwoFactorRes
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *ConfirmTwoFactorRes and nil error while calling ConfirmTwoFactor. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *authServer) serveConfirmTwoFactorProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "ConfirmTwoFactor")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(ConfirmTwoFactorReq)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.Auth.ConfirmTwoFactor
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *ConfirmTwoFactorReq) (*ConfirmTwoFactorRes, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ConfirmTwoFactorReq)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ConfirmTwoFactorReq) when calling interceptor")
+					}
+					return s.Auth.ConfirmTwoFactor(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ConfirmTwoFactorRes)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ConfirmTwoFactorRes) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *ConfirmTwoFactorRes
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *ConfirmTwoFactorRes and nil error while calling ConfirmTwoFactor. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *authServer) serveDisableTwoFactor(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveDisableTwoFactorJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveDisableTwoFactorProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *authServer) serveDisableTwoFactorJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "DisableTwoFactor")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(DisableTwoFactorReq)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.Auth.DisableTwoFactor
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *DisableTwoFactorReq) (*DisableTwoFactorRes, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*DisableTwoFactorReq)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*DisableTwoFactorReq) when calling interceptor")
+					}
+					return s.Auth.DisableTwoFactor(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*DisableTwoFactorRes)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*DisableTwoFactorRes) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *DisableTwoFactorRes
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *DisableTwoFactorRes and nil error while calling DisableTwoFactor. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *authServer) serveDisableTwoFactorProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "DisableTwoFactor")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(DisableTwoFactorReq)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.Auth.DisableTwoFactor
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *DisableTwoFactorReq) (*DisableTwoFactorRes, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*DisableTwoFactorReq)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*DisableTwoFactorReq) when calling interceptor")
+					}
+					return s.Auth.DisableTwoFactor(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*DisableTwoFactorRes)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*DisableTwoFactorRes) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *DisableTwoFactorRes
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *DisableTwoFactorRes and nil error while calling DisableTwoFactor. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *authServer) serveListSessions(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveListSessionsJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveListSessionsProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *authServer) serveListSessionsJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "ListSessions")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(ListSessionsReq)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.Auth.ListSessions
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *ListSessionsReq) (*ListSessionsRes, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ListSessionsReq)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ListSessionsReq) when calling interceptor")
+					}
+					return s.Auth.ListSessions(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ListSessionsRes)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ListSessionsRes) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *ListSessionsRes
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *ListSessionsRes and nil error while calling ListSessions. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *authServer) serveListSessionsProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "ListSessions")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(ListSessionsReq)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.Auth.ListSessions
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *ListSessionsReq) (*ListSessionsRes, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ListSessionsReq)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ListSessionsReq) when calling interceptor")
+					}
+					return s.Auth.ListSessions(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ListSessionsRes)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ListSessionsRes) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *ListSessionsRes
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *ListSessionsRes and nil error while calling ListSessions. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *authServer) serveRevokeSession(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveRevokeSessionJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveRevokeSessionProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *authServer) serveRevokeSessionJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "RevokeSession")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(RevokeSessionReq)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.Auth.RevokeSession
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *RevokeSessionReq) (*RevokeSessionRes, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*RevokeSessionReq)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*RevokeSessionReq) when calling interceptor")
+					}
+					return s.Auth.RevokeSession(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*RevokeSessionRes)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*RevokeSessionRes) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *RevokeSessionRes
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *RevokeSessionRes and nil error while calling RevokeSession. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *authServer) serveRevokeSessionProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "RevokeSession")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(RevokeSessionReq)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.Auth.RevokeSession
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *RevokeSessionReq) (*RevokeSessionRes, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*RevokeSessionReq)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*RevokeSessionReq) when calling interceptor")
+					}
+					return s.Auth.RevokeSession(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*RevokeSessionRes)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*RevokeSessionRes) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *RevokeSessionRes
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *RevokeSessionRes and nil error while calling RevokeSession. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *authServer) serveRevokeAllOtherSessions(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveRevokeAllOtherSessionsJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveRevokeAllOtherSessionsProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *authServer) serveRevokeAllOtherSessionsJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "RevokeAllOtherSessions")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(RevokeAllOtherSessionsReq)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.Auth.RevokeAllOtherSessions
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *RevokeAllOtherSessionsReq) (*RevokeAllOtherSessionsRes, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*RevokeAllOtherSessionsReq)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*RevokeAllOtherSessionsReq) when calling interceptor")
+					}
+					return s.Auth.RevokeAllOtherSessions(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*RevokeAllOtherSessionsRes)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*RevokeAllOtherSessionsRes) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *RevokeAllOtherSessionsRes
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *RevokeAllOtherSessionsRes and nil error while calling RevokeAllOtherSessions. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *authServer) serveRevokeAllOtherSessionsProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "RevokeAllOtherSessions")
+	ctx, err = callRequestRouted(ctx, s.hooks)
 	if err != nil {
 		s.writeError(ctx, resp, err)
 		return
 	}
 
-	if req.Method != "POST" {
-		msg := fmt.Sprintf("unsupported method %q (only POST is allowed)", req.Method)
-		s.writeError(ctx, resp, badRouteError(msg, req.Method, req.URL.Path))
+	buf, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(RevokeAllOtherSessionsReq)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
 		return
 	}
 
-	// Verify path format: [<prefix>]/<package>.<Service>/<Method>
-	prefix, pkgService, method := parseTwirpPath(req.URL.Path)
-	if pkgService != "protos.Auth" {
-		msg := fmt.Sprintf("no handler for path %q", req.URL.Path)
-		s.writeError(ctx, resp, badRouteError(msg, req.Method, req.URL.Path))
+	handler := s.Auth.RevokeAllOtherSessions
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *RevokeAllOtherSessionsReq) (*RevokeAllOtherSessionsRes, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*RevokeAllOtherSessionsReq)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*RevokeAllOtherSessionsReq) when calling interceptor")
+					}
+					return s.Auth.RevokeAllOtherSessions(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*RevokeAllOtherSessionsRes)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*RevokeAllOtherSessionsRes) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *RevokeAllOtherSessionsRes
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
 		return
 	}
-	if prefix != s.pathPrefix {
-		msg := fmt.Sprintf("invalid path prefix %q, expected %q, on path %q", prefix, s.pathPrefix, req.URL.Path)
-		s.writeError(ctx, resp, badRouteError(msg, req.Method, req.URL.Path))
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *RevokeAllOtherSessionsRes and nil error while calling RevokeAllOtherSessions. nil responses are not supported"))
 		return
 	}
 
-	switch method {
-	case "Authenticate":
-		s.serveAuthenticate(ctx, resp, req)
-		return
-	case "Logout":
-		s.serveLogout(ctx, resp, req)
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
 		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *authServer) serveListGrants(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveListGrantsJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveListGrantsProtobuf(ctx, resp, req)
 	default:
-		msg := fmt.Sprintf("no handler for path %q", req.URL.Path)
-		s.writeError(ctx, resp, badRouteError(msg, req.Method, req.URL.Path))
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *authServer) serveListGrantsJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "ListGrants")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(ListGrantsReq)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.Auth.ListGrants
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *ListGrantsReq) (*ListGrantsRes, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ListGrantsReq)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ListGrantsReq) when calling interceptor")
+					}
+					return s.Auth.ListGrants(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ListGrantsRes)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ListGrantsRes) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *ListGrantsRes
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *ListGrantsRes and nil error while calling ListGrants. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
 		return
 	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
 }
 
-func (s *authServer) serveAuthenticate(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *authServer) serveListGrantsProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "ListGrants")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(ListGrantsReq)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.Auth.ListGrants
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *ListGrantsReq) (*ListGrantsRes, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ListGrantsReq)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ListGrantsReq) when calling interceptor")
+					}
+					return s.Auth.ListGrants(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ListGrantsRes)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ListGrantsRes) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *ListGrantsRes
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *ListGrantsRes and nil error while calling ListGrants. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *authServer) serveRevokeGrant(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	header := req.Header.Get("Content-Type")
 	i := strings.Index(header, ";")
 	if i == -1 {
@@ -429,9 +5451,9 @@ func (s *authServer) serveAuthenticate(ctx context.Context, resp http.ResponseWr
 	}
 	switch strings.TrimSpace(strings.ToLower(header[:i])) {
 	case "application/json":
-		s.serveAuthenticateJSON(ctx, resp, req)
+		s.serveRevokeGrantJSON(ctx, resp, req)
 	case "application/protobuf":
-		s.serveAuthenticateProtobuf(ctx, resp, req)
+		s.serveRevokeGrantProtobuf(ctx, resp, req)
 	default:
 		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
 		twerr := badRouteError(msg, req.Method, req.URL.Path)
@@ -439,9 +5461,9 @@ func (s *authServer) serveAuthenticate(ctx context.Context, resp http.ResponseWr
 	}
 }
 
-func (s *authServer) serveAuthenticateJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *authServer) serveRevokeGrantJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	var err error
-	ctx = ctxsetters.WithMethodName(ctx, "Authenticate")
+	ctx = ctxsetters.WithMethodName(ctx, "RevokeGrant")
 	ctx, err = callRequestRouted(ctx, s.hooks)
 	if err != nil {
 		s.writeError(ctx, resp, err)
@@ -454,29 +5476,29 @@ func (s *authServer) serveAuthenticateJSON(ctx context.Context, resp http.Respon
 		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
 		return
 	}
-	reqContent := new(AuthenticateReq)
+	reqContent := new(RevokeGrantReq)
 	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
 	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
 		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
 		return
 	}
 
-	handler := s.Auth.Authenticate
+	handler := s.Auth.RevokeGrant
 	if s.interceptor != nil {
-		handler = func(ctx context.Context, req *AuthenticateReq) (*AuthenticateRes, error) {
+		handler = func(ctx context.Context, req *RevokeGrantReq) (*RevokeGrantRes, error) {
 			resp, err := s.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*AuthenticateReq)
+					typedReq, ok := req.(*RevokeGrantReq)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*AuthenticateReq) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*RevokeGrantReq) when calling interceptor")
 					}
-					return s.Auth.Authenticate(ctx, typedReq)
+					return s.Auth.RevokeGrant(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*AuthenticateRes)
+				typedResp, ok := resp.(*RevokeGrantRes)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*AuthenticateRes) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*RevokeGrantRes) when calling interceptor")
 				}
 				return typedResp, err
 			}
@@ -485,7 +5507,7 @@ func (s *authServer) serveAuthenticateJSON(ctx context.Context, resp http.Respon
 	}
 
 	// Call service method
-	var respContent *AuthenticateRes
+	var respContent *RevokeGrantRes
 	func() {
 		defer ensurePanicResponses(ctx, resp, s.hooks)
 		respContent, err = handler(ctx, reqContent)
@@ -496,7 +5518,7 @@ func (s *authServer) serveAuthenticateJSON(ctx context.Context, resp http.Respon
 		return
 	}
 	if respContent == nil {
-		s.writeError(ctx, resp, twirp.InternalError("received a nil *AuthenticateRes and nil error while calling Authenticate. nil responses are not supported"))
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *RevokeGrantRes and nil error while calling RevokeGrant. nil responses are not supported"))
 		return
 	}
 
@@ -522,9 +5544,9 @@ func (s *authServer) serveAuthenticateJSON(ctx context.Context, resp http.Respon
 	callResponseSent(ctx, s.hooks)
 }
 
-func (s *authServer) serveAuthenticateProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *authServer) serveRevokeGrantProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	var err error
-	ctx = ctxsetters.WithMethodName(ctx, "Authenticate")
+	ctx = ctxsetters.WithMethodName(ctx, "RevokeGrant")
 	ctx, err = callRequestRouted(ctx, s.hooks)
 	if err != nil {
 		s.writeError(ctx, resp, err)
@@ -536,28 +5558,28 @@ func (s *authServer) serveAuthenticateProtobuf(ctx context.Context, resp http.Re
 		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
 		return
 	}
-	reqContent := new(AuthenticateReq)
+	reqContent := new(RevokeGrantReq)
 	if err = proto.Unmarshal(buf, reqContent); err != nil {
 		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
 		return
 	}
 
-	handler := s.Auth.Authenticate
+	handler := s.Auth.RevokeGrant
 	if s.interceptor != nil {
-		handler = func(ctx context.Context, req *AuthenticateReq) (*AuthenticateRes, error) {
+		handler = func(ctx context.Context, req *RevokeGrantReq) (*RevokeGrantRes, error) {
 			resp, err := s.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*AuthenticateReq)
+					typedReq, ok := req.(*RevokeGrantReq)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*AuthenticateReq) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*RevokeGrantReq) when calling interceptor")
 					}
-					return s.Auth.Authenticate(ctx, typedReq)
+					return s.Auth.RevokeGrant(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*AuthenticateRes)
+				typedResp, ok := resp.(*RevokeGrantRes)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*AuthenticateRes) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*RevokeGrantRes) when calling interceptor")
 				}
 				return typedResp, err
 			}
@@ -566,7 +5588,7 @@ func (s *authServer) serveAuthenticateProtobuf(ctx context.Context, resp http.Re
 	}
 
 	// Call service method
-	var respContent *AuthenticateRes
+	var respContent *RevokeGrantRes
 	func() {
 		defer ensurePanicResponses(ctx, resp, s.hooks)
 		respContent, err = handler(ctx, reqContent)
@@ -577,7 +5599,7 @@ func (s *authServer) serveAuthenticateProtobuf(ctx context.Context, resp http.Re
 		return
 	}
 	if respContent == nil {
-		s.writeError(ctx, resp, twirp.InternalError("received a nil *AuthenticateRes and nil error while calling Authenticate. nil responses are not supported"))
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *RevokeGrantRes and nil error while calling RevokeGrant. nil responses are not supported"))
 		return
 	}
 
@@ -797,28 +5819,101 @@ func (s *authServer) PathPrefix() string {
 }
 
 var twirpFileDescriptor1 = []byte{
-	// 356 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x92, 0xc1, 0x4a, 0xeb, 0x40,
-	0x14, 0x86, 0x99, 0xde, 0xd2, 0x9b, 0x9e, 0x5b, 0x28, 0x77, 0x50, 0x0c, 0xa1, 0x48, 0x18, 0x10,
-	0x4a, 0x85, 0x44, 0xeb, 0xae, 0xae, 0xea, 0x4e, 0xed, 0x2a, 0xe2, 0xc6, 0xdd, 0xb4, 0x1d, 0xd2,
-	0x40, 0x9d, 0xa9, 0x39, 0x13, 0xa5, 0x2e, 0xdd, 0xba, 0xf4, 0x5d, 0x7c, 0x11, 0x5f, 0xc1, 0x07,
-	0x91, 0x99, 0x31, 0xa9, 0xad, 0x14, 0xdd, 0x24, 0x39, 0xe7, 0xff, 0xe7, 0x9b, 0xff, 0x64, 0x06,
-	0x80, 0x17, 0x7a, 0x16, 0x2d, 0x72, 0xa5, 0x15, 0x6d, 0xd8, 0x17, 0x06, 0x9d, 0x54, 0xa9, 0x74,
-	0x2e, 0x62, 0xbe, 0xc8, 0x62, 0x2e, 0xa5, 0xd2, 0x5c, 0x67, 0x4a, 0xa2, 0x73, 0x05, 0x50, 0xa0,
-	0xc8, 0xdd, 0x37, 0x7b, 0x26, 0xd0, 0x1e, 0x16, 0x7a, 0x26, 0xa4, 0xce, 0x26, 0x5c, 0x8b, 0x44,
-	0xdc, 0xd1, 0x00, 0x3c, 0xe3, 0x90, 0xfc, 0x56, 0xf8, 0x24, 0x24, 0xdd, 0x66, 0x52, 0xd5, 0x46,
-	0x5b, 0x70, 0xc4, 0x07, 0x95, 0x4f, 0xfd, 0x9a, 0xd3, 0xca, 0x9a, 0x32, 0x68, 0xa1, 0xe6, 0xcb,
-	0x91, 0x4a, 0x53, 0x31, 0x3d, 0x97, 0xfe, 0x9f, 0x90, 0x74, 0xbd, 0x64, 0xad, 0x47, 0x3b, 0xd0,
-	0x34, 0xac, 0x61, 0x2a, 0xa4, 0xf6, 0xeb, 0x16, 0xb0, 0x6a, 0xb0, 0xab, 0xcd, 0x30, 0x48, 0xf7,
-	0x01, 0x50, 0x20, 0x66, 0x4a, 0x5e, 0x8a, 0xe5, 0x67, 0x9c, 0x2f, 0x1d, 0x1a, 0x42, 0xdd, 0xac,
-	0xb7, 0x61, 0xfe, 0xf5, 0x5b, 0x6e, 0x2c, 0x8c, 0xae, 0x51, 0xe4, 0x89, 0x55, 0x58, 0x04, 0x2d,
-	0x03, 0x55, 0x79, 0xf6, 0x68, 0xc7, 0xfb, 0x81, 0xc8, 0x8e, 0xd6, 0xfc, 0x58, 0xed, 0x40, 0xb6,
-	0xee, 0x70, 0x08, 0xcd, 0x91, 0x4a, 0x55, 0xa1, 0x7f, 0x83, 0x3f, 0x58, 0x99, 0x91, 0xfa, 0xf0,
-	0x17, 0x8b, 0xc9, 0x44, 0x20, 0x5a, 0xa7, 0x97, 0x94, 0x65, 0xff, 0x95, 0x40, 0xdd, 0xc4, 0xa0,
-	0x63, 0x17, 0xa7, 0xfc, 0x27, 0x74, 0xaf, 0x0c, 0xb0, 0x71, 0x6c, 0xc1, 0x16, 0x01, 0x59, 0xf8,
-	0xf4, 0xf6, 0xfe, 0x52, 0x0b, 0xd8, 0x6e, 0x7c, 0x7f, 0x1c, 0x9b, 0xdb, 0x62, 0x1f, 0xa5, 0x63,
-	0x40, 0x7a, 0xf4, 0x02, 0x1a, 0x2e, 0x13, 0xfd, 0x5f, 0x42, 0xaa, 0x81, 0x82, 0x6f, 0x2d, 0x64,
-	0x81, 0x25, 0xee, 0xb0, 0x76, 0x45, 0x9c, 0x5b, 0x6d, 0x40, 0x7a, 0x67, 0x70, 0xe3, 0x45, 0xa7,
-	0x6e, 0xc5, 0xd8, 0xdd, 0xc7, 0x93, 0x8f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x7b, 0xe7, 0x1d, 0x89,
-	0xa4, 0x02, 0x00, 0x00,
+	// 1535 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x58, 0xdf, 0x6e, 0xdc, 0xc4,
+	0x17, 0x96, 0x37, 0x69, 0xba, 0x39, 0x9b, 0x34, 0xc9, 0x34, 0x49, 0xb7, 0x93, 0xfc, 0x7e, 0xd9,
+	0x0e, 0x6d, 0x09, 0xdb, 0x74, 0x97, 0xa6, 0x05, 0xa1, 0x56, 0x42, 0x4a, 0x93, 0x82, 0x0a, 0x15,
+	0xad, 0xdc, 0xa6, 0x17, 0x70, 0xb1, 0x72, 0xec, 0x89, 0xd7, 0xaa, 0xe3, 0xd9, 0x78, 0x66, 0x13,
+	0xc2, 0x25, 0xe2, 0x02, 0x09, 0x21, 0x2e, 0x10, 0x0f, 0xc2, 0x3d, 0x6f, 0xc1, 0x2b, 0xf0, 0x04,
+	0x3c, 0x01, 0x9a, 0x19, 0xff, 0x5f, 0xdb, 0x1b, 0x24, 0xae, 0x92, 0x39, 0xe7, 0xf3, 0xf9, 0x8e,
+	0xbf, 0x39, 0x33, 0xfe, 0xb4, 0x00, 0xd6, 0x58, 0x0c, 0x7b, 0xa3, 0x90, 0x09, 0x86, 0xe6, 0xd4,
+	0x1f, 0x8e, 0x37, 0x5d, 0xc6, 0x5c, 0x9f, 0xf6, 0xad, 0x91, 0xd7, 0xb7, 0x82, 0x80, 0x09, 0x4b,
+	0x78, 0x2c, 0xe0, 0x1a, 0x85, 0xb7, 0xa2, 0xac, 0x5a, 0x1d, 0x8d, 0x8f, 0xfb, 0xc2, 0x3b, 0xa1,
+	0x5c, 0x58, 0x27, 0xa3, 0x08, 0x00, 0x63, 0x4e, 0x43, 0xfd, 0x3f, 0xf9, 0xc9, 0x80, 0xa5, 0xbd,
+	0xb1, 0x18, 0xd2, 0x40, 0x78, 0xb6, 0x25, 0xa8, 0x49, 0x4f, 0x11, 0x86, 0xa6, 0x44, 0x04, 0xd6,
+	0x09, 0x6d, 0x1b, 0x1d, 0x63, 0x7b, 0xde, 0x4c, 0xd6, 0x32, 0x37, 0xb2, 0x38, 0x3f, 0x67, 0xa1,
+	0xd3, 0x6e, 0xe8, 0x5c, 0xbc, 0x46, 0x04, 0x16, 0xb8, 0xb0, 0x2e, 0x5e, 0x30, 0xd7, 0xa5, 0xce,
+	0xf3, 0xa0, 0x3d, 0xd3, 0x31, 0xb6, 0x9b, 0x66, 0x2e, 0x86, 0x36, 0x61, 0x5e, 0xd6, 0xda, 0x73,
+	0x69, 0x20, 0xda, 0xb3, 0xaa, 0x40, 0x1a, 0x20, 0xbf, 0x4f, 0x74, 0xc3, 0xd1, 0xff, 0x01, 0x38,
+	0xe5, 0xdc, 0x63, 0xc1, 0x97, 0xf4, 0x22, 0xea, 0x27, 0x13, 0x41, 0x1d, 0x98, 0x95, 0x05, 0x54,
+	0x37, 0xad, 0xdd, 0x05, 0xfd, 0x5e, 0xbc, 0x77, 0xc8, 0x69, 0x68, 0xaa, 0x0c, 0xda, 0x81, 0x15,
+	0x71, 0xce, 0x3e, 0xb3, 0x6c, 0xc1, 0x42, 0x93, 0x9e, 0x8e, 0xbd, 0x90, 0x3a, 0x51, 0x73, 0x93,
+	0x09, 0xd4, 0x03, 0x94, 0x04, 0xf7, 0x87, 0x96, 0xef, 0xd3, 0xc0, 0xa5, 0x51, 0xab, 0x25, 0x19,
+	0xd2, 0x83, 0x05, 0xd9, 0x32, 0x0b, 0xbd, 0xef, 0x94, 0x7a, 0x53, 0xfa, 0x25, 0x1f, 0xe6, 0xf0,
+	0x3c, 0xe9, 0xdf, 0xa8, 0xea, 0x9f, 0xdc, 0x83, 0xf9, 0x17, 0xcc, 0x65, 0x63, 0x71, 0x99, 0xf2,
+	0x77, 0x52, 0x30, 0x47, 0x6d, 0xb8, 0xca, 0xc7, 0xb6, 0x4d, 0x39, 0x57, 0xc8, 0xa6, 0x19, 0x2f,
+	0xc9, 0x6f, 0x06, 0xb4, 0x4c, 0xea, 0x7a, 0x5c, 0x50, 0xf9, 0xea, 0x68, 0x15, 0xae, 0xd0, 0x13,
+	0xcb, 0xf3, 0xa3, 0x8a, 0x7a, 0x91, 0x9b, 0x84, 0x46, 0xcd, 0x24, 0xcc, 0x14, 0x26, 0xe1, 0x13,
+	0x98, 0x3f, 0xf2, 0x42, 0x31, 0x74, 0x2c, 0xa1, 0xa5, 0x6b, 0xed, 0xe2, 0x9e, 0x1e, 0xcb, 0x5e,
+	0x3c, 0x96, 0xbd, 0x37, 0xf1, 0x58, 0x9a, 0x29, 0x98, 0xf4, 0xb3, 0x6d, 0x5d, 0x46, 0x1c, 0x0c,
+	0xed, 0xd7, 0x34, 0x70, 0xde, 0xd2, 0xd0, 0x3b, 0x96, 0x33, 0xe3, 0xb1, 0xe0, 0x99, 0xec, 0xdd,
+	0xa4, 0xa7, 0xe4, 0x51, 0x65, 0xae, 0x4e, 0x9a, 0xbb, 0x70, 0x4d, 0x3d, 0x71, 0x11, 0xd7, 0x91,
+	0xe2, 0x08, 0xf6, 0x8e, 0x06, 0xb1, 0x38, 0x6a, 0x41, 0xba, 0x05, 0x5c, 0x5d, 0xcd, 0x3e, 0xdc,
+	0x90, 0x03, 0x46, 0xb9, 0x78, 0x15, 0x69, 0x64, 0x52, 0x4e, 0x45, 0xa5, 0xf2, 0xe4, 0x61, 0xd5,
+	0x03, 0x75, 0x2c, 0x07, 0xb0, 0xac, 0x50, 0xe9, 0x23, 0x15, 0xbd, 0xd7, 0x1d, 0x63, 0xb2, 0x33,
+	0x51, 0xa5, 0x8e, 0x73, 0x00, 0x2b, 0xfb, 0x43, 0x2b, 0x70, 0x69, 0x96, 0x74, 0x1b, 0x96, 0xec,
+	0x71, 0x18, 0xd2, 0x20, 0x29, 0x12, 0xd1, 0x17, 0xc3, 0xa8, 0x03, 0xad, 0x80, 0x9e, 0xbf, 0xca,
+	0xf7, 0x92, 0x0d, 0x91, 0xfb, 0x93, 0x04, 0x75, 0xfd, 0x3c, 0x85, 0x6b, 0x1a, 0x9e, 0xec, 0x5e,
+	0xf6, 0x5d, 0x8d, 0xc2, 0xa0, 0x26, 0xe2, 0x37, 0xb2, 0xe2, 0xef, 0x16, 0x6a, 0x5c, 0x66, 0x0e,
+	0x87, 0xb0, 0x7c, 0x38, 0x92, 0x23, 0xfc, 0x2a, 0x64, 0xc7, 0x9e, 0x3f, 0xf5, 0x22, 0xcd, 0x1d,
+	0x91, 0xc6, 0xbf, 0x39, 0x22, 0x8f, 0x26, 0x98, 0x2e, 0xd3, 0x5f, 0x0f, 0x96, 0x0f, 0xa8, 0x4f,
+	0x05, 0xdd, 0xb3, 0x6d, 0x36, 0x0e, 0xc4, 0x14, 0x65, 0xc8, 0xce, 0x04, 0xbe, 0x4e, 0x75, 0x07,
+	0x90, 0x3e, 0x0b, 0x6f, 0x32, 0xf7, 0xa9, 0xbc, 0xec, 0xed, 0xe4, 0x06, 0xd5, 0x04, 0x69, 0x00,
+	0x21, 0x98, 0xb5, 0x99, 0x13, 0x5f, 0x2c, 0xea, 0xff, 0xfc, 0xe7, 0x61, 0xa6, 0xf8, 0x79, 0x78,
+	0x5b, 0xc2, 0xf2, 0x1f, 0x7c, 0x20, 0xc8, 0x2a, 0xa0, 0x67, 0x41, 0xc8, 0x7c, 0x3f, 0xdb, 0x3d,
+	0x79, 0x5b, 0x12, 0xe5, 0x68, 0x1d, 0xe6, 0x38, 0xb5, 0x43, 0x2a, 0x22, 0xa6, 0x68, 0x25, 0x47,
+	0x7e, 0x14, 0xb2, 0x33, 0x4f, 0xb2, 0x7a, 0x81, 0x7b, 0x68, 0x3e, 0x8f, 0x5e, 0xac, 0x18, 0x26,
+	0x1f, 0xc0, 0xf5, 0x7d, 0x16, 0x1c, 0x7b, 0xe1, 0x49, 0x4e, 0xac, 0x58, 0x0e, 0x23, 0x95, 0x83,
+	0x3c, 0x29, 0x83, 0x72, 0x74, 0x1b, 0x16, 0x43, 0x6a, 0xb3, 0x33, 0x1a, 0x5e, 0xec, 0x33, 0x87,
+	0xca, 0xdd, 0x98, 0xd9, 0x9e, 0x37, 0xf3, 0x41, 0xf2, 0x0c, 0xae, 0x1f, 0x78, 0xdc, 0x3a, 0xf2,
+	0x69, 0x8e, 0xa7, 0xee, 0x38, 0x94, 0x6c, 0x09, 0xe9, 0x97, 0x95, 0xa9, 0x9b, 0x85, 0x5f, 0x1a,
+	0xb0, 0xb8, 0x67, 0x0b, 0xef, 0x8c, 0xbe, 0xd6, 0x9b, 0x20, 0x35, 0x1b, 0x5a, 0x81, 0xe3, 0xc7,
+	0x2f, 0x17, 0xad, 0xf2, 0xbb, 0xdd, 0x28, 0xec, 0xb6, 0x3c, 0x21, 0x3e, 0x73, 0xbd, 0x40, 0x1e,
+	0x82, 0xf6, 0xcc, 0xf4, 0x13, 0x92, 0x80, 0xd1, 0xa7, 0xb0, 0xe0, 0x5b, 0x5c, 0xbc, 0xa6, 0x54,
+	0x3f, 0x3c, 0xfd, 0x0b, 0x94, 0xc3, 0xa3, 0x47, 0x70, 0x95, 0x7e, 0x3b, 0xf2, 0x42, 0xca, 0xdb,
+	0x57, 0xa6, 0x3e, 0x1a, 0x43, 0xa5, 0x22, 0xd1, 0xed, 0xd6, 0x9e, 0xd3, 0x8a, 0x44, 0x4b, 0xb2,
+	0x02, 0x4b, 0x2f, 0x3c, 0x2e, 0x22, 0x39, 0xb8, 0x1c, 0xae, 0x83, 0x62, 0x88, 0xa3, 0x07, 0xd0,
+	0x8c, 0xa6, 0x56, 0x6f, 0x68, 0x6b, 0x77, 0x2d, 0x9e, 0xd5, 0x9c, 0x9c, 0x66, 0x02, 0x23, 0x5d,
+	0x79, 0x55, 0x9f, 0xb1, 0x77, 0x49, 0x8a, 0x9e, 0x56, 0x89, 0x4d, 0x76, 0x26, 0xb0, 0x75, 0x9b,
+	0xb8, 0x01, 0x37, 0x35, 0x7a, 0xcf, 0xf7, 0x5f, 0x8a, 0x21, 0x0d, 0xb3, 0xcd, 0x7f, 0x54, 0x9d,
+	0x54, 0x35, 0x43, 0x95, 0xd4, 0xe3, 0x35, 0x63, 0xc6, 0x4b, 0xf2, 0x87, 0x01, 0xf0, 0x52, 0x7a,
+	0x9f, 0xcf, 0x43, 0x2b, 0x10, 0x72, 0x10, 0x6d, 0xdf, 0xa3, 0x81, 0x78, 0x9e, 0x0c, 0x62, 0xbc,
+	0x96, 0x67, 0x5a, 0xff, 0xff, 0x55, 0x6a, 0x3d, 0x32, 0x11, 0xf4, 0x58, 0xfb, 0x62, 0x65, 0xa2,
+	0x9c, 0x4b, 0x0c, 0x47, 0x06, 0x8d, 0x3e, 0x86, 0xa6, 0xdc, 0xed, 0x43, 0x4e, 0x9d, 0x4b, 0x4c,
+	0x46, 0x82, 0x25, 0x4b, 0xb0, 0x28, 0xb7, 0x4c, 0x35, 0xaf, 0x64, 0x78, 0x92, 0x0f, 0x70, 0xd4,
+	0x85, 0x39, 0x57, 0x2d, 0xa2, 0xfd, 0x43, 0xf1, 0xfe, 0xa5, 0x6f, 0x6d, 0x46, 0x08, 0xb2, 0x03,
+	0xd7, 0xb4, 0x86, 0x3a, 0xac, 0x0f, 0x66, 0x95, 0x1e, 0xa4, 0x5b, 0x40, 0xd7, 0x6c, 0xdd, 0xee,
+	0xdf, 0xcb, 0x30, 0x2b, 0xf9, 0xd0, 0x11, 0x2c, 0x64, 0xcd, 0x34, 0xba, 0x91, 0x8c, 0x53, 0xde,
+	0xf0, 0xe3, 0x8a, 0x04, 0x27, 0x9d, 0xef, 0xff, 0xfc, 0xeb, 0xd7, 0x06, 0x26, 0x6b, 0xfd, 0xb3,
+	0x07, 0x7d, 0x29, 0x61, 0xdf, 0xca, 0x20, 0x1e, 0x1b, 0x5d, 0x64, 0x42, 0x33, 0xf6, 0x6b, 0xe8,
+	0x7a, 0x5c, 0x26, 0x63, 0x2c, 0x71, 0x49, 0x90, 0x93, 0x4d, 0x55, 0x77, 0x9d, 0xac, 0x24, 0x75,
+	0xc3, 0x28, 0x2b, 0x6b, 0xfe, 0x68, 0xc0, 0x5a, 0xa9, 0x6f, 0x43, 0x9d, 0xb8, 0x58, 0x95, 0xe5,
+	0xc3, 0xd3, 0x10, 0x9c, 0xdc, 0x53, 0xdc, 0x77, 0x48, 0x27, 0xe1, 0xe6, 0x34, 0x70, 0x06, 0x67,
+	0x19, 0xec, 0x40, 0xd9, 0x00, 0xd9, 0xca, 0x00, 0x5a, 0x19, 0x8f, 0x87, 0xd6, 0xe3, 0xea, 0x79,
+	0x83, 0x88, 0xcb, 0xe3, 0x65, 0xfa, 0x29, 0x9a, 0x8b, 0x94, 0xe0, 0x07, 0x03, 0x56, 0xcb, 0x8c,
+	0x1e, 0xda, 0x4a, 0x75, 0x2b, 0xf5, 0x8d, 0x78, 0x0a, 0x80, 0x93, 0xae, 0x22, 0xbf, 0x4d, 0xb6,
+	0x32, 0x22, 0x2b, 0xe4, 0x20, 0xbe, 0xef, 0x07, 0xa1, 0xc4, 0xca, 0x36, 0x5c, 0x58, 0xcc, 0x79,
+	0x3e, 0xd4, 0x4e, 0xab, 0xe7, 0x0d, 0x25, 0xae, 0xca, 0x70, 0x42, 0x14, 0xe1, 0x26, 0xb9, 0x91,
+	0x21, 0xe4, 0x34, 0xa5, 0x93, 0x44, 0xef, 0x62, 0x6b, 0x95, 0x30, 0xdd, 0x8c, 0xeb, 0x4d, 0xd8,
+	0x48, 0x5c, 0x99, 0xe2, 0xe4, 0x3d, 0xc5, 0xf5, 0x3f, 0xd2, 0x4e, 0xb8, 0x6c, 0x85, 0xc9, 0x91,
+	0x0d, 0xa0, 0x95, 0xf1, 0x71, 0xe9, 0xee, 0xe5, 0x0d, 0x22, 0x2e, 0x8f, 0x97, 0xed, 0x5e, 0xc4,
+	0x91, 0xec, 0x9e, 0x0b, 0x8b, 0x39, 0x2b, 0x96, 0xca, 0x56, 0xf4, 0x82, 0xb8, 0x2a, 0x53, 0x26,
+	0xdb, 0x58, 0x41, 0x06, 0x23, 0x8d, 0x89, 0x88, 0x72, 0x6e, 0x2c, 0x25, 0x2a, 0x9a, 0x3a, 0x5c,
+	0x95, 0x29, 0x23, 0x72, 0x14, 0x64, 0x60, 0x69, 0x8c, 0x24, 0x1a, 0xc1, 0x52, 0xc1, 0x62, 0x21,
+	0x9c, 0x1f, 0xee, 0xac, 0x99, 0xc0, 0xd5, 0x39, 0x4e, 0xee, 0x28, 0xba, 0x2d, 0x82, 0x8b, 0xc3,
+	0x2f, 0xce, 0xd9, 0xe0, 0x58, 0xc1, 0x22, 0xc6, 0x82, 0xcd, 0x4a, 0x19, 0x27, 0x5d, 0x19, 0xae,
+	0xce, 0x95, 0x31, 0x52, 0x05, 0x2a, 0x30, 0x0a, 0x58, 0x2e, 0xba, 0x2a, 0xb4, 0x91, 0xcc, 0xc0,
+	0xa4, 0x35, 0xc3, 0x35, 0x49, 0x4e, 0xee, 0x2a, 0xd2, 0x0e, 0xd9, 0x48, 0xa7, 0x44, 0xa3, 0x26,
+	0x59, 0x8b, 0x3e, 0x2a, 0x65, 0x2d, 0x31, 0x6a, 0xb8, 0x26, 0x59, 0xc6, 0xea, 0x68, 0x54, 0x81,
+	0xd5, 0x86, 0x85, 0xac, 0xcf, 0x48, 0xbf, 0x01, 0x05, 0x43, 0x82, 0x2b, 0x12, 0x9c, 0xdc, 0x52,
+	0x4c, 0x1b, 0x64, 0x3d, 0x61, 0xf2, 0x3d, 0x2e, 0x06, 0xb1, 0x07, 0x49, 0x6e, 0x8f, 0x8c, 0xb5,
+	0xc8, 0xde, 0x1e, 0x79, 0x77, 0x82, 0xab, 0x32, 0xe5, 0xb7, 0x87, 0x84, 0xc4, 0x4c, 0x92, 0xe8,
+	0x67, 0x03, 0xd6, 0xcb, 0x9d, 0x07, 0xba, 0x95, 0x2f, 0x5c, 0x62, 0x5b, 0xf0, 0x54, 0x08, 0x27,
+	0xf7, 0x55, 0x13, 0xef, 0x13, 0x52, 0x6c, 0xc2, 0xf2, 0xfd, 0x01, 0x93, 0xe8, 0xdc, 0x8b, 0x7f,
+	0x03, 0x90, 0x3a, 0x00, 0xb4, 0x96, 0x95, 0x30, 0xb1, 0x09, 0xb8, 0x34, 0xcc, 0xc9, 0x96, 0xa2,
+	0xba, 0x49, 0x56, 0xf3, 0xba, 0x6a, 0x7b, 0x10, 0xdd, 0x5e, 0x99, 0x6f, 0x7e, 0x7a, 0x7b, 0xe5,
+	0x6d, 0x03, 0x2e, 0x8f, 0x97, 0xdd, 0x5e, 0xd1, 0xab, 0x28, 0x06, 0x49, 0xf0, 0x05, 0xcc, 0xe9,
+	0x9f, 0x8a, 0xd0, 0x4a, 0xd2, 0x62, 0xfc, 0x3b, 0x13, 0x9e, 0x08, 0x71, 0x82, 0x55, 0xc5, 0x55,
+	0xb2, 0x94, 0x76, 0xac, 0x72, 0x8f, 0x8d, 0xee, 0x53, 0xf8, 0xba, 0xd9, 0x7b, 0xa2, 0x9f, 0x38,
+	0xd2, 0x3f, 0x53, 0x3e, 0xfc, 0x67, 0x00, 0x60, 0x87, 0x93, 0x9e, 0xbb, 0x14, 0x00, 0x00,
 }
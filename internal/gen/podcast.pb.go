@@ -22,6 +22,153 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// PodcastSort is the order podcast listings are returned in
+type PodcastSort int32
+
+const (
+	PodcastSort_SUBSCRIBERS    PodcastSort = 0 // most subscribed first
+	PodcastSort_LATEST_EPISODE PodcastSort = 1 // most recently published episode first
+	PodcastSort_TITLE          PodcastSort = 2 // alphabetical
+)
+
+// Enum value maps for PodcastSort.
+var (
+	PodcastSort_name = map[int32]string{
+		0: "SUBSCRIBERS",
+		1: "LATEST_EPISODE",
+		2: "TITLE",
+	}
+	PodcastSort_value = map[string]int32{
+		"SUBSCRIBERS":    0,
+		"LATEST_EPISODE": 1,
+		"TITLE":          2,
+	}
+)
+
+func (x PodcastSort) Enum() *PodcastSort {
+	p := new(PodcastSort)
+	*p = x
+	return p
+}
+
+func (x PodcastSort) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PodcastSort) Descriptor() protoreflect.EnumDescriptor {
+	return file_podcast_proto_enumTypes[0].Descriptor()
+}
+
+func (PodcastSort) Type() protoreflect.EnumType {
+	return &file_podcast_proto_enumTypes[0]
+}
+
+func (x PodcastSort) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PodcastSort.Descriptor instead.
+func (PodcastSort) EnumDescriptor() ([]byte, []int) {
+	return file_podcast_proto_rawDescGZIP(), []int{0}
+}
+
+// ChartType is the kind of popularity chart
+type ChartType int32
+
+const (
+	ChartType_TOP      ChartType = 0 // most subscribed
+	ChartType_TRENDING ChartType = 1 // most new subscribers and plays recently
+)
+
+// Enum value maps for ChartType.
+var (
+	ChartType_name = map[int32]string{
+		0: "TOP",
+		1: "TRENDING",
+	}
+	ChartType_value = map[string]int32{
+		"TOP":      0,
+		"TRENDING": 1,
+	}
+)
+
+func (x ChartType) Enum() *ChartType {
+	p := new(ChartType)
+	*p = x
+	return p
+}
+
+func (x ChartType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ChartType) Descriptor() protoreflect.EnumDescriptor {
+	return file_podcast_proto_enumTypes[1].Descriptor()
+}
+
+func (ChartType) Type() protoreflect.EnumType {
+	return &file_podcast_proto_enumTypes[1]
+}
+
+func (x ChartType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ChartType.Descriptor instead.
+func (ChartType) EnumDescriptor() ([]byte, []int) {
+	return file_podcast_proto_rawDescGZIP(), []int{1}
+}
+
+// StatsBucket is the period listening time is totaled by
+type StatsBucket int32
+
+const (
+	StatsBucket_DAY   StatsBucket = 0
+	StatsBucket_WEEK  StatsBucket = 1
+	StatsBucket_MONTH StatsBucket = 2
+)
+
+// Enum value maps for StatsBucket.
+var (
+	StatsBucket_name = map[int32]string{
+		0: "DAY",
+		1: "WEEK",
+		2: "MONTH",
+	}
+	StatsBucket_value = map[string]int32{
+		"DAY":   0,
+		"WEEK":  1,
+		"MONTH": 2,
+	}
+)
+
+func (x StatsBucket) Enum() *StatsBucket {
+	p := new(StatsBucket)
+	*p = x
+	return p
+}
+
+func (x StatsBucket) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (StatsBucket) Descriptor() protoreflect.EnumDescriptor {
+	return file_podcast_proto_enumTypes[2].Descriptor()
+}
+
+func (StatsBucket) Type() protoreflect.EnumType {
+	return &file_podcast_proto_enumTypes[2]
+}
+
+func (x StatsBucket) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use StatsBucket.Descriptor instead.
+func (StatsBucket) EnumDescriptor() ([]byte, []int) {
+	return file_podcast_proto_rawDescGZIP(), []int{2}
+}
+
 type Image struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -84,6 +231,7 @@ type Category struct {
 
 	Text     string      `protobuf:"bytes,1,opt,name=text,proto3" json:"text,omitempty"`
 	Category []*Category `protobuf:"bytes,2,rep,name=category,proto3" json:"category,omitempty"`
+	Id       int32       `protobuf:"varint,3,opt,name=id,proto3" json:"id,omitempty"`
 }
 
 func (x *Category) Reset() {
@@ -132,6 +280,13 @@ func (x *Category) GetCategory() []*Category {
 	return nil
 }
 
+func (x *Category) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
 type Podcast struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -812,6 +967,71 @@ func (x *Response) GetMessage() string {
 	return ""
 }
 
+// applied is false when the update was older than the stored state,
+// userEpisode is the stored state after the update
+type UpsertUserEpiRes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Applied     bool         `protobuf:"varint,1,opt,name=applied,proto3" json:"applied,omitempty"`
+	Message     string       `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	UserEpisode *UserEpisode `protobuf:"bytes,3,opt,name=userEpisode,proto3" json:"userEpisode,omitempty"`
+}
+
+func (x *UpsertUserEpiRes) Reset() {
+	*x = UpsertUserEpiRes{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_podcast_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpsertUserEpiRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpsertUserEpiRes) ProtoMessage() {}
+
+func (x *UpsertUserEpiRes) ProtoReflect() protoreflect.Message {
+	mi := &file_podcast_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpsertUserEpiRes.ProtoReflect.Descriptor instead.
+func (*UpsertUserEpiRes) Descriptor() ([]byte, []int) {
+	return file_podcast_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *UpsertUserEpiRes) GetApplied() bool {
+	if x != nil {
+		return x.Applied
+	}
+	return false
+}
+
+func (x *UpsertUserEpiRes) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *UpsertUserEpiRes) GetUserEpisode() *UserEpisode {
+	if x != nil {
+		return x.UserEpisode
+	}
+	return nil
+}
+
 type LastPlayedRes struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -825,7 +1045,7 @@ type LastPlayedRes struct {
 func (x *LastPlayedRes) Reset() {
 	*x = LastPlayedRes{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_podcast_proto_msgTypes[11]
+		mi := &file_podcast_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -838,7 +1058,7 @@ func (x *LastPlayedRes) String() string {
 func (*LastPlayedRes) ProtoMessage() {}
 
 func (x *LastPlayedRes) ProtoReflect() protoreflect.Message {
-	mi := &file_podcast_proto_msgTypes[11]
+	mi := &file_podcast_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -851,7 +1071,7 @@ func (x *LastPlayedRes) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LastPlayedRes.ProtoReflect.Descriptor instead.
 func (*LastPlayedRes) Descriptor() ([]byte, []int) {
-	return file_podcast_proto_rawDescGZIP(), []int{11}
+	return file_podcast_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *LastPlayedRes) GetPodcast() *Podcast {
@@ -886,7 +1106,7 @@ type Subscriptions struct {
 func (x *Subscriptions) Reset() {
 	*x = Subscriptions{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_podcast_proto_msgTypes[12]
+		mi := &file_podcast_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -899,7 +1119,7 @@ func (x *Subscriptions) String() string {
 func (*Subscriptions) ProtoMessage() {}
 
 func (x *Subscriptions) ProtoReflect() protoreflect.Message {
-	mi := &file_podcast_proto_msgTypes[12]
+	mi := &file_podcast_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -912,7 +1132,7 @@ func (x *Subscriptions) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Subscriptions.ProtoReflect.Descriptor instead.
 func (*Subscriptions) Descriptor() ([]byte, []int) {
-	return file_podcast_proto_rawDescGZIP(), []int{12}
+	return file_podcast_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *Subscriptions) GetSubscriptions() []*Subscription {
@@ -933,7 +1153,7 @@ type Episodes struct {
 func (x *Episodes) Reset() {
 	*x = Episodes{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_podcast_proto_msgTypes[13]
+		mi := &file_podcast_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -946,7 +1166,7 @@ func (x *Episodes) String() string {
 func (*Episodes) ProtoMessage() {}
 
 func (x *Episodes) ProtoReflect() protoreflect.Message {
-	mi := &file_podcast_proto_msgTypes[13]
+	mi := &file_podcast_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -959,7 +1179,7 @@ func (x *Episodes) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Episodes.ProtoReflect.Descriptor instead.
 func (*Episodes) Descriptor() ([]byte, []int) {
-	return file_podcast_proto_rawDescGZIP(), []int{13}
+	return file_podcast_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *Episodes) GetEpisodes() []*Episode {
@@ -969,232 +1189,3683 @@ func (x *Episodes) GetEpisodes() []*Episode {
 	return nil
 }
 
-var File_podcast_proto protoreflect.FileDescriptor
+type Podcasts struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-var file_podcast_proto_rawDesc = []byte{
-	0x0a, 0x0d, 0x70, 0x6f, 0x64, 0x63, 0x61, 0x73, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12,
-	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x1a, 0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f,
-	0x61, 0x70, 0x69, 0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x0a, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x22, 0x2f, 0x0a, 0x05, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x74,
-	0x69, 0x74, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c,
-	0x65, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
-	0x75, 0x72, 0x6c, 0x22, 0x4c, 0x0a, 0x08, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x12,
-	0x12, 0x0a, 0x04, 0x74, 0x65, 0x78, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74,
-	0x65, 0x78, 0x74, 0x12, 0x2c, 0x0a, 0x08, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x18,
-	0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x43,
-	0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x52, 0x08, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72,
-	0x79, 0x22, 0xc2, 0x03, 0x0a, 0x07, 0x50, 0x6f, 0x64, 0x63, 0x61, 0x73, 0x74, 0x12, 0x0e, 0x0a,
-	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x14, 0x0a,
-	0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69,
-	0x74, 0x6c, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x65,
-	0x70, 0x69, 0x73, 0x6f, 0x64, 0x69, 0x63, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x65,
-	0x70, 0x69, 0x73, 0x6f, 0x64, 0x69, 0x63, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x6d, 0x6d, 0x61,
-	0x72, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x75, 0x6d, 0x6d, 0x61, 0x72,
-	0x79, 0x12, 0x12, 0x0a, 0x04, 0x6c, 0x69, 0x6e, 0x6b, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x04, 0x6c, 0x69, 0x6e, 0x6b, 0x12, 0x23, 0x0a, 0x05, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x18, 0x07,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x49, 0x6d,
-	0x61, 0x67, 0x65, 0x52, 0x05, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x65, 0x78,
-	0x70, 0x6c, 0x69, 0x63, 0x69, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x65, 0x78,
-	0x70, 0x6c, 0x69, 0x63, 0x69, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61,
-	0x67, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61,
-	0x67, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x4b, 0x65, 0x79, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x18, 0x0a,
-	0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x4b, 0x65, 0x79, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x12, 0x2c,
-	0x0a, 0x08, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x10, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f,
-	0x72, 0x79, 0x52, 0x08, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x12, 0x34, 0x0a, 0x07,
-	0x70, 0x75, 0x62, 0x44, 0x61, 0x74, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
-	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
-	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x07, 0x70, 0x75, 0x62, 0x44, 0x61,
-	0x74, 0x65, 0x12, 0x40, 0x0a, 0x0d, 0x6c, 0x61, 0x73, 0x74, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x44,
-	0x61, 0x74, 0x65, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
-	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
-	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0d, 0x6c, 0x61, 0x73, 0x74, 0x42, 0x75, 0x69, 0x6c, 0x64,
-	0x44, 0x61, 0x74, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x72, 0x73, 0x73, 0x18, 0x0e, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x03, 0x72, 0x73, 0x73, 0x22, 0xe2, 0x03, 0x0a, 0x07, 0x45, 0x70, 0x69, 0x73, 0x6f,
-	0x64, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02,
-	0x69, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x6f, 0x64, 0x63, 0x61, 0x73, 0x74, 0x49, 0x44, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x6f, 0x64, 0x63, 0x61, 0x73, 0x74, 0x49, 0x44,
-	0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x12, 0x20,
-	0x0a, 0x0b, 0x65, 0x70, 0x69, 0x73, 0x6f, 0x64, 0x65, 0x54, 0x79, 0x70, 0x65, 0x18, 0x05, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0b, 0x65, 0x70, 0x69, 0x73, 0x6f, 0x64, 0x65, 0x54, 0x79, 0x70, 0x65,
-	0x12, 0x23, 0x0a, 0x05, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x0d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x05,
-	0x69, 0x6d, 0x61, 0x67, 0x65, 0x12, 0x34, 0x0a, 0x07, 0x70, 0x75, 0x62, 0x44, 0x61, 0x74, 0x65,
-	0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
-	0x6d, 0x70, 0x52, 0x07, 0x70, 0x75, 0x62, 0x44, 0x61, 0x74, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64,
-	0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a,
-	0x07, 0x73, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
-	0x73, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x61, 0x73, 0x6f,
-	0x6e, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x73, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12,
-	0x18, 0x0a, 0x07, 0x65, 0x70, 0x69, 0x73, 0x6f, 0x64, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x05,
-	0x52, 0x07, 0x65, 0x70, 0x69, 0x73, 0x6f, 0x64, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x65, 0x78, 0x70,
-	0x6c, 0x69, 0x63, 0x69, 0x74, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x65, 0x78, 0x70,
-	0x6c, 0x69, 0x63, 0x69, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x4d, 0x50, 0x33, 0x55, 0x52, 0x4c, 0x18,
-	0x0e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x4d, 0x50, 0x33, 0x55, 0x52, 0x4c, 0x12, 0x26, 0x0a,
-	0x0e, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x69, 0x6c, 0x6c, 0x69, 0x73, 0x18,
-	0x0f, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d,
-	0x69, 0x6c, 0x6c, 0x69, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x75, 0x62, 0x74, 0x69, 0x74, 0x6c,
-	0x65, 0x18, 0x10, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x75, 0x62, 0x74, 0x69, 0x74, 0x6c,
-	0x65, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x64, 0x18, 0x11, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x07, 0x65, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x64, 0x22, 0x1b, 0x0a, 0x09, 0x47,
-	0x65, 0x74, 0x50, 0x6f, 0x64, 0x52, 0x65, 0x71, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x6d, 0x0a, 0x07, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x6f, 0x64, 0x63, 0x61, 0x73, 0x74, 0x49, 0x44,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x6f, 0x64, 0x63, 0x61, 0x73, 0x74, 0x49,
-	0x44, 0x12, 0x1c, 0x0a, 0x09, 0x65, 0x70, 0x69, 0x73, 0x6f, 0x64, 0x65, 0x49, 0x44, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x65, 0x70, 0x69, 0x73, 0x6f, 0x64, 0x65, 0x49, 0x44, 0x12,
-	0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05,
-	0x73, 0x74, 0x61, 0x72, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x65, 0x6e, 0x64, 0x18, 0x05, 0x20, 0x01,
-	0x28, 0x03, 0x52, 0x03, 0x65, 0x6e, 0x64, 0x22, 0x43, 0x0a, 0x09, 0x47, 0x65, 0x74, 0x45, 0x70,
-	0x69, 0x52, 0x65, 0x71, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x02, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x03, 0x52, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x65, 0x6e,
-	0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x65, 0x6e, 0x64, 0x22, 0x25, 0x0a, 0x0d,
-	0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x45, 0x70, 0x69, 0x52, 0x65, 0x71, 0x12, 0x14, 0x0a,
-	0x05, 0x65, 0x70, 0x69, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x70,
-	0x69, 0x49, 0x44, 0x22, 0x0b, 0x0a, 0x09, 0x47, 0x65, 0x74, 0x53, 0x75, 0x62, 0x52, 0x65, 0x71,
-	0x22, 0x16, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x4c, 0x61, 0x73, 0x74, 0x50,
-	0x6c, 0x61, 0x79, 0x65, 0x64, 0x52, 0x65, 0x71, 0x22, 0x3e, 0x0a, 0x08, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x18,
-	0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x7d, 0x0a, 0x0d, 0x4c, 0x61, 0x73, 0x74,
-	0x50, 0x6c, 0x61, 0x79, 0x65, 0x64, 0x52, 0x65, 0x73, 0x12, 0x29, 0x0a, 0x07, 0x70, 0x6f, 0x64,
-	0x63, 0x61, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x73, 0x2e, 0x50, 0x6f, 0x64, 0x63, 0x61, 0x73, 0x74, 0x52, 0x07, 0x70, 0x6f, 0x64,
-	0x63, 0x61, 0x73, 0x74, 0x12, 0x29, 0x0a, 0x07, 0x65, 0x70, 0x69, 0x73, 0x6f, 0x64, 0x65, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x45,
-	0x70, 0x69, 0x73, 0x6f, 0x64, 0x65, 0x52, 0x07, 0x65, 0x70, 0x69, 0x73, 0x6f, 0x64, 0x65, 0x12,
-	0x16, 0x0a, 0x06, 0x6d, 0x69, 0x6c, 0x6c, 0x69, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52,
-	0x06, 0x6d, 0x69, 0x6c, 0x6c, 0x69, 0x73, 0x22, 0x4b, 0x0a, 0x0d, 0x53, 0x75, 0x62, 0x73, 0x63,
-	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x3a, 0x0a, 0x0d, 0x73, 0x75, 0x62, 0x73,
-	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69,
-	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0d, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
-	0x69, 0x6f, 0x6e, 0x73, 0x22, 0x37, 0x0a, 0x08, 0x45, 0x70, 0x69, 0x73, 0x6f, 0x64, 0x65, 0x73,
-	0x12, 0x2b, 0x0a, 0x08, 0x65, 0x70, 0x69, 0x73, 0x6f, 0x64, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x45, 0x70, 0x69, 0x73,
-	0x6f, 0x64, 0x65, 0x52, 0x08, 0x65, 0x70, 0x69, 0x73, 0x6f, 0x64, 0x65, 0x73, 0x32, 0xdf, 0x04,
-	0x0a, 0x03, 0x50, 0x6f, 0x64, 0x12, 0x54, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x64, 0x63,
-	0x61, 0x73, 0x74, 0x12, 0x11, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x47, 0x65, 0x74,
-	0x50, 0x6f, 0x64, 0x52, 0x65, 0x71, 0x1a, 0x0f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e,
-	0x50, 0x6f, 0x64, 0x63, 0x61, 0x73, 0x74, 0x22, 0x22, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1c, 0x22,
-	0x17, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x6f, 0x64, 0x63, 0x61, 0x73, 0x74, 0x2f, 0x67, 0x65, 0x74,
-	0x5f, 0x70, 0x6f, 0x64, 0x63, 0x61, 0x73, 0x74, 0x3a, 0x01, 0x2a, 0x12, 0x57, 0x0a, 0x0b, 0x47,
-	0x65, 0x74, 0x45, 0x70, 0x69, 0x73, 0x6f, 0x64, 0x65, 0x73, 0x12, 0x11, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x45, 0x70, 0x69, 0x52, 0x65, 0x71, 0x1a, 0x10, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x45, 0x70, 0x69, 0x73, 0x6f, 0x64, 0x65, 0x73, 0x22,
-	0x23, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1d, 0x22, 0x18, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x6f, 0x64,
-	0x63, 0x61, 0x73, 0x74, 0x2f, 0x67, 0x65, 0x74, 0x5f, 0x65, 0x70, 0x69, 0x73, 0x6f, 0x64, 0x65,
-	0x73, 0x3a, 0x01, 0x2a, 0x12, 0x65, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x45,
-	0x70, 0x69, 0x73, 0x6f, 0x64, 0x65, 0x12, 0x15, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e,
-	0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x45, 0x70, 0x69, 0x52, 0x65, 0x71, 0x1a, 0x13, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x45, 0x70, 0x69, 0x73, 0x6f,
-	0x64, 0x65, 0x22, 0x27, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x21, 0x22, 0x1c, 0x2f, 0x76, 0x31, 0x2f,
-	0x70, 0x6f, 0x64, 0x63, 0x61, 0x73, 0x74, 0x2f, 0x67, 0x65, 0x74, 0x5f, 0x75, 0x73, 0x65, 0x72,
-	0x5f, 0x65, 0x70, 0x69, 0x73, 0x6f, 0x64, 0x65, 0x3a, 0x01, 0x2a, 0x12, 0x63, 0x0a, 0x11, 0x55,
-	0x70, 0x73, 0x65, 0x72, 0x74, 0x55, 0x73, 0x65, 0x72, 0x45, 0x70, 0x69, 0x73, 0x6f, 0x64, 0x65,
-	0x12, 0x13, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x45, 0x70,
-	0x69, 0x73, 0x6f, 0x64, 0x65, 0x1a, 0x10, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x27, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x21, 0x22,
-	0x1c, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x6f, 0x64, 0x63, 0x61, 0x73, 0x74, 0x2f, 0x67, 0x65, 0x74,
-	0x5f, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x65, 0x70, 0x69, 0x73, 0x6f, 0x64, 0x65, 0x3a, 0x01, 0x2a,
-	0x12, 0x66, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
-	0x69, 0x6f, 0x6e, 0x73, 0x12, 0x11, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x47, 0x65,
-	0x74, 0x53, 0x75, 0x62, 0x52, 0x65, 0x71, 0x1a, 0x15, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73,
-	0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x28,
-	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x22, 0x22, 0x1d, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x6f, 0x64, 0x63,
-	0x61, 0x73, 0x74, 0x2f, 0x67, 0x65, 0x74, 0x5f, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70,
-	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x3a, 0x01, 0x2a, 0x12, 0x75, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x55,
-	0x73, 0x65, 0x72, 0x4c, 0x61, 0x73, 0x74, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x64, 0x12, 0x1c, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x4c, 0x61,
-	0x73, 0x74, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x64, 0x52, 0x65, 0x71, 0x1a, 0x15, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x4c, 0x61, 0x73, 0x74, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x64, 0x52,
-	0x65, 0x73, 0x22, 0x2b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x25, 0x22, 0x20, 0x2f, 0x76, 0x31, 0x2f,
-	0x70, 0x6f, 0x64, 0x63, 0x61, 0x73, 0x74, 0x2f, 0x67, 0x65, 0x74, 0x5f, 0x75, 0x73, 0x65, 0x72,
-	0x5f, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x64, 0x3a, 0x01, 0x2a, 0x42,
-	0x0a, 0x5a, 0x08, 0x2e, 0x3b, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x33,
+	Podcasts []*Podcast `protobuf:"bytes,1,rep,name=podcasts,proto3" json:"podcasts,omitempty"`
 }
 
-var (
-	file_podcast_proto_rawDescOnce sync.Once
-	file_podcast_proto_rawDescData = file_podcast_proto_rawDesc
-)
-
-func file_podcast_proto_rawDescGZIP() []byte {
-	file_podcast_proto_rawDescOnce.Do(func() {
-		file_podcast_proto_rawDescData = protoimpl.X.CompressGZIP(file_podcast_proto_rawDescData)
-	})
-	return file_podcast_proto_rawDescData
+func (x *Podcasts) Reset() {
+	*x = Podcasts{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_podcast_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
 }
 
-var file_podcast_proto_msgTypes = make([]protoimpl.MessageInfo, 14)
-var file_podcast_proto_goTypes = []interface{}{
-	(*Image)(nil),                 // 0: protos.Image
-	(*Category)(nil),              // 1: protos.Category
-	(*Podcast)(nil),               // 2: protos.Podcast
-	(*Episode)(nil),               // 3: protos.Episode
-	(*GetPodReq)(nil),             // 4: protos.GetPodReq
-	(*Request)(nil),               // 5: protos.Request
-	(*GetEpiReq)(nil),             // 6: protos.GetEpiReq
-	(*GetUserEpiReq)(nil),         // 7: protos.GetUserEpiReq
-	(*GetSubReq)(nil),             // 8: protos.GetSubReq
-	(*GetUserLastPlayedReq)(nil),  // 9: protos.GetUserLastPlayedReq
-	(*Response)(nil),              // 10: protos.Response
-	(*LastPlayedRes)(nil),         // 11: protos.LastPlayedRes
-	(*Subscriptions)(nil),         // 12: protos.Subscriptions
-	(*Episodes)(nil),              // 13: protos.Episodes
-	(*timestamppb.Timestamp)(nil), // 14: google.protobuf.Timestamp
-	(*Subscription)(nil),          // 15: protos.Subscription
-	(*UserEpisode)(nil),           // 16: protos.UserEpisode
-}
-var file_podcast_proto_depIdxs = []int32{
-	1,  // 0: protos.Category.category:type_name -> protos.Category
-	0,  // 1: protos.Podcast.image:type_name -> protos.Image
-	1,  // 2: protos.Podcast.category:type_name -> protos.Category
-	14, // 3: protos.Podcast.pubDate:type_name -> google.protobuf.Timestamp
-	14, // 4: protos.Podcast.lastBuildDate:type_name -> google.protobuf.Timestamp
-	0,  // 5: protos.Episode.image:type_name -> protos.Image
-	14, // 6: protos.Episode.pubDate:type_name -> google.protobuf.Timestamp
-	2,  // 7: protos.LastPlayedRes.podcast:type_name -> protos.Podcast
-	3,  // 8: protos.LastPlayedRes.episode:type_name -> protos.Episode
-	15, // 9: protos.Subscriptions.subscriptions:type_name -> protos.Subscription
-	3,  // 10: protos.Episodes.episodes:type_name -> protos.Episode
-	4,  // 11: protos.Pod.GetPodcast:input_type -> protos.GetPodReq
-	6,  // 12: protos.Pod.GetEpisodes:input_type -> protos.GetEpiReq
-	7,  // 13: protos.Pod.GetUserEpisode:input_type -> protos.GetUserEpiReq
-	16, // 14: protos.Pod.UpsertUserEpisode:input_type -> protos.UserEpisode
-	8,  // 15: protos.Pod.GetSubscriptions:input_type -> protos.GetSubReq
-	9,  // 16: protos.Pod.GetUserLastPlayed:input_type -> protos.GetUserLastPlayedReq
-	2,  // 17: protos.Pod.GetPodcast:output_type -> protos.Podcast
-	13, // 18: protos.Pod.GetEpisodes:output_type -> protos.Episodes
-	16, // 19: protos.Pod.GetUserEpisode:output_type -> protos.UserEpisode
-	10, // 20: protos.Pod.UpsertUserEpisode:output_type -> protos.Response
-	12, // 21: protos.Pod.GetSubscriptions:output_type -> protos.Subscriptions
-	11, // 22: protos.Pod.GetUserLastPlayed:output_type -> protos.LastPlayedRes
-	17, // [17:23] is the sub-list for method output_type
-	11, // [11:17] is the sub-list for method input_type
-	11, // [11:11] is the sub-list for extension type_name
-	11, // [11:11] is the sub-list for extension extendee
-	0,  // [0:11] is the sub-list for field type_name
+func (x *Podcasts) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func init() { file_podcast_proto_init() }
-func file_podcast_proto_init() {
-	if File_podcast_proto != nil {
-		return
-	}
-	file_user_proto_init()
-	if !protoimpl.UnsafeEnabled {
+func (*Podcasts) ProtoMessage() {}
+
+func (x *Podcasts) ProtoReflect() protoreflect.Message {
+	mi := &file_podcast_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Podcasts.ProtoReflect.Descriptor instead.
+func (*Podcasts) Descriptor() ([]byte, []int) {
+	return file_podcast_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *Podcasts) GetPodcasts() []*Podcast {
+	if x != nil {
+		return x.Podcasts
+	}
+	return nil
+}
+
+type Categories struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Categories []*Category `protobuf:"bytes,1,rep,name=categories,proto3" json:"categories,omitempty"`
+}
+
+func (x *Categories) Reset() {
+	*x = Categories{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_podcast_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Categories) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Categories) ProtoMessage() {}
+
+func (x *Categories) ProtoReflect() protoreflect.Message {
+	mi := &file_podcast_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Categories.ProtoReflect.Descriptor instead.
+func (*Categories) Descriptor() ([]byte, []int) {
+	return file_podcast_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *Categories) GetCategories() []*Category {
+	if x != nil {
+		return x.Categories
+	}
+	return nil
+}
+
+type ListCategoriesReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ListCategoriesReq) Reset() {
+	*x = ListCategoriesReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_podcast_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListCategoriesReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCategoriesReq) ProtoMessage() {}
+
+func (x *ListCategoriesReq) ProtoReflect() protoreflect.Message {
+	mi := &file_podcast_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCategoriesReq.ProtoReflect.Descriptor instead.
+func (*ListCategoriesReq) Descriptor() ([]byte, []int) {
+	return file_podcast_proto_rawDescGZIP(), []int{17}
+}
+
+// start & end represent the range of podcasts to return
+type BrowseCategoryReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id    int32       `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"` // includes podcasts within the subcategories of id
+	Sort  PodcastSort `protobuf:"varint,2,opt,name=sort,proto3,enum=protos.PodcastSort" json:"sort,omitempty"`
+	Start int64       `protobuf:"varint,3,opt,name=start,proto3" json:"start,omitempty"`
+	End   int64       `protobuf:"varint,4,opt,name=end,proto3" json:"end,omitempty"`
+}
+
+func (x *BrowseCategoryReq) Reset() {
+	*x = BrowseCategoryReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_podcast_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BrowseCategoryReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BrowseCategoryReq) ProtoMessage() {}
+
+func (x *BrowseCategoryReq) ProtoReflect() protoreflect.Message {
+	mi := &file_podcast_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BrowseCategoryReq.ProtoReflect.Descriptor instead.
+func (*BrowseCategoryReq) Descriptor() ([]byte, []int) {
+	return file_podcast_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *BrowseCategoryReq) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *BrowseCategoryReq) GetSort() PodcastSort {
+	if x != nil {
+		return x.Sort
+	}
+	return PodcastSort_SUBSCRIBERS
+}
+
+func (x *BrowseCategoryReq) GetStart() int64 {
+	if x != nil {
+		return x.Start
+	}
+	return 0
+}
+
+func (x *BrowseCategoryReq) GetEnd() int64 {
+	if x != nil {
+		return x.End
+	}
+	return 0
+}
+
+// start & end represent the range of podcasts to return
+type GetChartsReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type       ChartType `protobuf:"varint,1,opt,name=type,proto3,enum=protos.ChartType" json:"type,omitempty"`
+	CategoryID int32     `protobuf:"varint,2,opt,name=categoryID,proto3" json:"categoryID,omitempty"` // 0 for all categories
+	Language   string    `protobuf:"bytes,3,opt,name=language,proto3" json:"language,omitempty"`      // empty for all languages
+	Start      int64     `protobuf:"varint,4,opt,name=start,proto3" json:"start,omitempty"`
+	End        int64     `protobuf:"varint,5,opt,name=end,proto3" json:"end,omitempty"`
+}
+
+func (x *GetChartsReq) Reset() {
+	*x = GetChartsReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_podcast_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetChartsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetChartsReq) ProtoMessage() {}
+
+func (x *GetChartsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_podcast_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetChartsReq.ProtoReflect.Descriptor instead.
+func (*GetChartsReq) Descriptor() ([]byte, []int) {
+	return file_podcast_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *GetChartsReq) GetType() ChartType {
+	if x != nil {
+		return x.Type
+	}
+	return ChartType_TOP
+}
+
+func (x *GetChartsReq) GetCategoryID() int32 {
+	if x != nil {
+		return x.CategoryID
+	}
+	return 0
+}
+
+func (x *GetChartsReq) GetLanguage() string {
+	if x != nil {
+		return x.Language
+	}
+	return ""
+}
+
+func (x *GetChartsReq) GetStart() int64 {
+	if x != nil {
+		return x.Start
+	}
+	return 0
+}
+
+func (x *GetChartsReq) GetEnd() int64 {
+	if x != nil {
+		return x.End
+	}
+	return 0
+}
+
+// start & end represent the range of podcasts to return
+type GetRecommendationsReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Start int64 `protobuf:"varint,1,opt,name=start,proto3" json:"start,omitempty"`
+	End   int64 `protobuf:"varint,2,opt,name=end,proto3" json:"end,omitempty"`
+}
+
+func (x *GetRecommendationsReq) Reset() {
+	*x = GetRecommendationsReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_podcast_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetRecommendationsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRecommendationsReq) ProtoMessage() {}
+
+func (x *GetRecommendationsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_podcast_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRecommendationsReq.ProtoReflect.Descriptor instead.
+func (*GetRecommendationsReq) Descriptor() ([]byte, []int) {
+	return file_podcast_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *GetRecommendationsReq) GetStart() int64 {
+	if x != nil {
+		return x.Start
+	}
+	return 0
+}
+
+func (x *GetRecommendationsReq) GetEnd() int64 {
+	if x != nil {
+		return x.End
+	}
+	return 0
+}
+
+// start & end represent the range of podcasts to return
+type GetSimilarPodcastsReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id    string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Start int64  `protobuf:"varint,2,opt,name=start,proto3" json:"start,omitempty"`
+	End   int64  `protobuf:"varint,3,opt,name=end,proto3" json:"end,omitempty"`
+}
+
+func (x *GetSimilarPodcastsReq) Reset() {
+	*x = GetSimilarPodcastsReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_podcast_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetSimilarPodcastsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSimilarPodcastsReq) ProtoMessage() {}
+
+func (x *GetSimilarPodcastsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_podcast_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSimilarPodcastsReq.ProtoReflect.Descriptor instead.
+func (*GetSimilarPodcastsReq) Descriptor() ([]byte, []int) {
+	return file_podcast_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *GetSimilarPodcastsReq) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *GetSimilarPodcastsReq) GetStart() int64 {
+	if x != nil {
+		return x.Start
+	}
+	return 0
+}
+
+func (x *GetSimilarPodcastsReq) GetEnd() int64 {
+	if x != nil {
+		return x.End
+	}
+	return 0
+}
+
+type GetQueueReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *GetQueueReq) Reset() {
+	*x = GetQueueReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_podcast_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetQueueReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetQueueReq) ProtoMessage() {}
+
+func (x *GetQueueReq) ProtoReflect() protoreflect.Message {
+	mi := &file_podcast_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetQueueReq.ProtoReflect.Descriptor instead.
+func (*GetQueueReq) Descriptor() ([]byte, []int) {
+	return file_podcast_proto_rawDescGZIP(), []int{22}
+}
+
+// next inserts the episode at the front of the queue rather than the end
+// adding an episode already within the queue moves it
+type AddToQueueReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	EpisodeID string `protobuf:"bytes,1,opt,name=episodeID,proto3" json:"episodeID,omitempty"`
+	Next      bool   `protobuf:"varint,2,opt,name=next,proto3" json:"next,omitempty"`
+}
+
+func (x *AddToQueueReq) Reset() {
+	*x = AddToQueueReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_podcast_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AddToQueueReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddToQueueReq) ProtoMessage() {}
+
+func (x *AddToQueueReq) ProtoReflect() protoreflect.Message {
+	mi := &file_podcast_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddToQueueReq.ProtoReflect.Descriptor instead.
+func (*AddToQueueReq) Descriptor() ([]byte, []int) {
+	return file_podcast_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *AddToQueueReq) GetEpisodeID() string {
+	if x != nil {
+		return x.EpisodeID
+	}
+	return ""
+}
+
+func (x *AddToQueueReq) GetNext() bool {
+	if x != nil {
+		return x.Next
+	}
+	return false
+}
+
+type RemoveFromQueueReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	EpisodeID string `protobuf:"bytes,1,opt,name=episodeID,proto3" json:"episodeID,omitempty"`
+}
+
+func (x *RemoveFromQueueReq) Reset() {
+	*x = RemoveFromQueueReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_podcast_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RemoveFromQueueReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveFromQueueReq) ProtoMessage() {}
+
+func (x *RemoveFromQueueReq) ProtoReflect() protoreflect.Message {
+	mi := &file_podcast_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveFromQueueReq.ProtoReflect.Descriptor instead.
+func (*RemoveFromQueueReq) Descriptor() ([]byte, []int) {
+	return file_podcast_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *RemoveFromQueueReq) GetEpisodeID() string {
+	if x != nil {
+		return x.EpisodeID
+	}
+	return ""
+}
+
+// episodes missing from episodeIDs are moved after the listed ones
+type ReorderQueueReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	EpisodeIDs []string `protobuf:"bytes,1,rep,name=episodeIDs,proto3" json:"episodeIDs,omitempty"`
+}
+
+func (x *ReorderQueueReq) Reset() {
+	*x = ReorderQueueReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_podcast_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReorderQueueReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReorderQueueReq) ProtoMessage() {}
+
+func (x *ReorderQueueReq) ProtoReflect() protoreflect.Message {
+	mi := &file_podcast_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReorderQueueReq.ProtoReflect.Descriptor instead.
+func (*ReorderQueueReq) Descriptor() ([]byte, []int) {
+	return file_podcast_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *ReorderQueueReq) GetEpisodeIDs() []string {
+	if x != nil {
+		return x.EpisodeIDs
+	}
+	return nil
+}
+
+type ClearQueueReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ClearQueueReq) Reset() {
+	*x = ClearQueueReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_podcast_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ClearQueueReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClearQueueReq) ProtoMessage() {}
+
+func (x *ClearQueueReq) ProtoReflect() protoreflect.Message {
+	mi := &file_podcast_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClearQueueReq.ProtoReflect.Descriptor instead.
+func (*ClearQueueReq) Descriptor() ([]byte, []int) {
+	return file_podcast_proto_rawDescGZIP(), []int{26}
+}
+
+// enabled appends new episodes of the subscribed podcast to the queue
+type SetAutoQueueReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PodcastID string `protobuf:"bytes,1,opt,name=podcastID,proto3" json:"podcastID,omitempty"`
+	Enabled   bool   `protobuf:"varint,2,opt,name=enabled,proto3" json:"enabled,omitempty"`
+}
+
+func (x *SetAutoQueueReq) Reset() {
+	*x = SetAutoQueueReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_podcast_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetAutoQueueReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetAutoQueueReq) ProtoMessage() {}
+
+func (x *SetAutoQueueReq) ProtoReflect() protoreflect.Message {
+	mi := &file_podcast_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetAutoQueueReq.ProtoReflect.Descriptor instead.
+func (*SetAutoQueueReq) Descriptor() ([]byte, []int) {
+	return file_podcast_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *SetAutoQueueReq) GetPodcastID() string {
+	if x != nil {
+		return x.PodcastID
+	}
+	return ""
+}
+
+func (x *SetAutoQueueReq) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+// episodeIDs are ignored by smart playlists, their episodes are resolved from rules
+type Playlist struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id         string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name       string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Smart      bool                   `protobuf:"varint,3,opt,name=smart,proto3" json:"smart,omitempty"`
+	Rules      *PlaylistRules         `protobuf:"bytes,4,opt,name=rules,proto3" json:"rules,omitempty"`
+	EpisodeIDs []string               `protobuf:"bytes,5,rep,name=episodeIDs,proto3" json:"episodeIDs,omitempty"`
+	Created    *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created,proto3" json:"created,omitempty"`
+	Updated    *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=updated,proto3" json:"updated,omitempty"`
+}
+
+func (x *Playlist) Reset() {
+	*x = Playlist{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_podcast_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Playlist) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Playlist) ProtoMessage() {}
+
+func (x *Playlist) ProtoReflect() protoreflect.Message {
+	mi := &file_podcast_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Playlist.ProtoReflect.Descriptor instead.
+func (*Playlist) Descriptor() ([]byte, []int) {
+	return file_podcast_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *Playlist) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Playlist) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Playlist) GetSmart() bool {
+	if x != nil {
+		return x.Smart
+	}
+	return false
+}
+
+func (x *Playlist) GetRules() *PlaylistRules {
+	if x != nil {
+		return x.Rules
+	}
+	return nil
+}
+
+func (x *Playlist) GetEpisodeIDs() []string {
+	if x != nil {
+		return x.EpisodeIDs
+	}
+	return nil
+}
+
+func (x *Playlist) GetCreated() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Created
+	}
+	return nil
+}
+
+func (x *Playlist) GetUpdated() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Updated
+	}
+	return nil
+}
+
+// PlaylistRules filter the episodes of the user's subscriptions, zero values are ignored
+type PlaylistRules struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Categories            []int32  `protobuf:"varint,1,rep,packed,name=categories,proto3" json:"categories,omitempty"` // includes subcategories
+	PodcastIDs            []string `protobuf:"bytes,2,rep,name=podcastIDs,proto3" json:"podcastIDs,omitempty"`
+	UnplayedOnly          bool     `protobuf:"varint,3,opt,name=unplayedOnly,proto3" json:"unplayedOnly,omitempty"`
+	MinDurationMillis     int64    `protobuf:"varint,4,opt,name=minDurationMillis,proto3" json:"minDurationMillis,omitempty"`
+	MaxDurationMillis     int64    `protobuf:"varint,5,opt,name=maxDurationMillis,proto3" json:"maxDurationMillis,omitempty"`
+	PublishedWithinMillis int64    `protobuf:"varint,6,opt,name=publishedWithinMillis,proto3" json:"publishedWithinMillis,omitempty"`
+	Limit                 int64    `protobuf:"varint,7,opt,name=limit,proto3" json:"limit,omitempty"`
+}
+
+func (x *PlaylistRules) Reset() {
+	*x = PlaylistRules{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_podcast_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PlaylistRules) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlaylistRules) ProtoMessage() {}
+
+func (x *PlaylistRules) ProtoReflect() protoreflect.Message {
+	mi := &file_podcast_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlaylistRules.ProtoReflect.Descriptor instead.
+func (*PlaylistRules) Descriptor() ([]byte, []int) {
+	return file_podcast_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *PlaylistRules) GetCategories() []int32 {
+	if x != nil {
+		return x.Categories
+	}
+	return nil
+}
+
+func (x *PlaylistRules) GetPodcastIDs() []string {
+	if x != nil {
+		return x.PodcastIDs
+	}
+	return nil
+}
+
+func (x *PlaylistRules) GetUnplayedOnly() bool {
+	if x != nil {
+		return x.UnplayedOnly
+	}
+	return false
+}
+
+func (x *PlaylistRules) GetMinDurationMillis() int64 {
+	if x != nil {
+		return x.MinDurationMillis
+	}
+	return 0
+}
+
+func (x *PlaylistRules) GetMaxDurationMillis() int64 {
+	if x != nil {
+		return x.MaxDurationMillis
+	}
+	return 0
+}
+
+func (x *PlaylistRules) GetPublishedWithinMillis() int64 {
+	if x != nil {
+		return x.PublishedWithinMillis
+	}
+	return 0
+}
+
+func (x *PlaylistRules) GetLimit() int64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type Playlists struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Playlists []*Playlist `protobuf:"bytes,1,rep,name=playlists,proto3" json:"playlists,omitempty"`
+}
+
+func (x *Playlists) Reset() {
+	*x = Playlists{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_podcast_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Playlists) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Playlists) ProtoMessage() {}
+
+func (x *Playlists) ProtoReflect() protoreflect.Message {
+	mi := &file_podcast_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Playlists.ProtoReflect.Descriptor instead.
+func (*Playlists) Descriptor() ([]byte, []int) {
+	return file_podcast_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *Playlists) GetPlaylists() []*Playlist {
+	if x != nil {
+		return x.Playlists
+	}
+	return nil
+}
+
+type CreatePlaylistReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name       string         `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Smart      bool           `protobuf:"varint,2,opt,name=smart,proto3" json:"smart,omitempty"`
+	Rules      *PlaylistRules `protobuf:"bytes,3,opt,name=rules,proto3" json:"rules,omitempty"`
+	EpisodeIDs []string       `protobuf:"bytes,4,rep,name=episodeIDs,proto3" json:"episodeIDs,omitempty"`
+}
+
+func (x *CreatePlaylistReq) Reset() {
+	*x = CreatePlaylistReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_podcast_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreatePlaylistReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePlaylistReq) ProtoMessage() {}
+
+func (x *CreatePlaylistReq) ProtoReflect() protoreflect.Message {
+	mi := &file_podcast_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePlaylistReq.ProtoReflect.Descriptor instead.
+func (*CreatePlaylistReq) Descriptor() ([]byte, []int) {
+	return file_podcast_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *CreatePlaylistReq) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreatePlaylistReq) GetSmart() bool {
+	if x != nil {
+		return x.Smart
+	}
+	return false
+}
+
+func (x *CreatePlaylistReq) GetRules() *PlaylistRules {
+	if x != nil {
+		return x.Rules
+	}
+	return nil
+}
+
+func (x *CreatePlaylistReq) GetEpisodeIDs() []string {
+	if x != nil {
+		return x.EpisodeIDs
+	}
+	return nil
+}
+
+type GetPlaylistsReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *GetPlaylistsReq) Reset() {
+	*x = GetPlaylistsReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_podcast_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetPlaylistsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPlaylistsReq) ProtoMessage() {}
+
+func (x *GetPlaylistsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_podcast_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPlaylistsReq.ProtoReflect.Descriptor instead.
+func (*GetPlaylistsReq) Descriptor() ([]byte, []int) {
+	return file_podcast_proto_rawDescGZIP(), []int{32}
+}
+
+// replaces the name, rules and episodes of the playlist
+type UpdatePlaylistReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id         string         `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name       string         `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Rules      *PlaylistRules `protobuf:"bytes,3,opt,name=rules,proto3" json:"rules,omitempty"`
+	EpisodeIDs []string       `protobuf:"bytes,4,rep,name=episodeIDs,proto3" json:"episodeIDs,omitempty"`
+}
+
+func (x *UpdatePlaylistReq) Reset() {
+	*x = UpdatePlaylistReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_podcast_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdatePlaylistReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdatePlaylistReq) ProtoMessage() {}
+
+func (x *UpdatePlaylistReq) ProtoReflect() protoreflect.Message {
+	mi := &file_podcast_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdatePlaylistReq.ProtoReflect.Descriptor instead.
+func (*UpdatePlaylistReq) Descriptor() ([]byte, []int) {
+	return file_podcast_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *UpdatePlaylistReq) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdatePlaylistReq) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UpdatePlaylistReq) GetRules() *PlaylistRules {
+	if x != nil {
+		return x.Rules
+	}
+	return nil
+}
+
+func (x *UpdatePlaylistReq) GetEpisodeIDs() []string {
+	if x != nil {
+		return x.EpisodeIDs
+	}
+	return nil
+}
+
+type DeletePlaylistReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *DeletePlaylistReq) Reset() {
+	*x = DeletePlaylistReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_podcast_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeletePlaylistReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeletePlaylistReq) ProtoMessage() {}
+
+func (x *DeletePlaylistReq) ProtoReflect() protoreflect.Message {
+	mi := &file_podcast_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeletePlaylistReq.ProtoReflect.Descriptor instead.
+func (*DeletePlaylistReq) Descriptor() ([]byte, []int) {
+	return file_podcast_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *DeletePlaylistReq) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// start & end represent the range of episodes to return
+type GetPlaylistEpisodesReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id    string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Start int64  `protobuf:"varint,2,opt,name=start,proto3" json:"start,omitempty"`
+	End   int64  `protobuf:"varint,3,opt,name=end,proto3" json:"end,omitempty"`
+}
+
+func (x *GetPlaylistEpisodesReq) Reset() {
+	*x = GetPlaylistEpisodesReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_podcast_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetPlaylistEpisodesReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPlaylistEpisodesReq) ProtoMessage() {}
+
+func (x *GetPlaylistEpisodesReq) ProtoReflect() protoreflect.Message {
+	mi := &file_podcast_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPlaylistEpisodesReq.ProtoReflect.Descriptor instead.
+func (*GetPlaylistEpisodesReq) Descriptor() ([]byte, []int) {
+	return file_podcast_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *GetPlaylistEpisodesReq) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *GetPlaylistEpisodesReq) GetStart() int64 {
+	if x != nil {
+		return x.Start
+	}
+	return 0
+}
+
+func (x *GetPlaylistEpisodesReq) GetEnd() int64 {
+	if x != nil {
+		return x.End
+	}
+	return 0
+}
+
+// token is the token of the previous sync, empty for the entire state
+type SyncReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Token string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+}
+
+func (x *SyncReq) Reset() {
+	*x = SyncReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_podcast_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SyncReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SyncReq) ProtoMessage() {}
+
+func (x *SyncReq) ProtoReflect() protoreflect.Message {
+	mi := &file_podcast_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SyncReq.ProtoReflect.Descriptor instead.
+func (*SyncReq) Descriptor() ([]byte, []int) {
+	return file_podcast_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *SyncReq) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+// SyncRes contains the state changed since the token of the request,
+// full is set when it contains the entire state which replaces the client's
+type SyncRes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Token             string          `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"` // pass to the next sync
+	Full              bool            `protobuf:"varint,2,opt,name=full,proto3" json:"full,omitempty"`
+	Subscriptions     []*Subscription `protobuf:"bytes,3,rep,name=subscriptions,proto3" json:"subscriptions,omitempty"`         // added or changed subscriptions
+	RemovedPodcastIDs []string        `protobuf:"bytes,4,rep,name=removedPodcastIDs,proto3" json:"removedPodcastIDs,omitempty"` // podcasts no longer subscribed
+	UserEpisodes      []*UserEpisode  `protobuf:"bytes,5,rep,name=userEpisodes,proto3" json:"userEpisodes,omitempty"`           // changed progress & played flags
+	QueueChanged      bool            `protobuf:"varint,6,opt,name=queueChanged,proto3" json:"queueChanged,omitempty"`
+	Queue             []*Episode      `protobuf:"bytes,7,rep,name=queue,proto3" json:"queue,omitempty"`             // entire queue, only set when queueChanged
+	NewEpisodes       []*Episode      `protobuf:"bytes,8,rep,name=newEpisodes,proto3" json:"newEpisodes,omitempty"` // new episodes of subscribed podcasts
+}
+
+func (x *SyncRes) Reset() {
+	*x = SyncRes{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_podcast_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SyncRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SyncRes) ProtoMessage() {}
+
+func (x *SyncRes) ProtoReflect() protoreflect.Message {
+	mi := &file_podcast_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SyncRes.ProtoReflect.Descriptor instead.
+func (*SyncRes) Descriptor() ([]byte, []int) {
+	return file_podcast_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *SyncRes) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *SyncRes) GetFull() bool {
+	if x != nil {
+		return x.Full
+	}
+	return false
+}
+
+func (x *SyncRes) GetSubscriptions() []*Subscription {
+	if x != nil {
+		return x.Subscriptions
+	}
+	return nil
+}
+
+func (x *SyncRes) GetRemovedPodcastIDs() []string {
+	if x != nil {
+		return x.RemovedPodcastIDs
+	}
+	return nil
+}
+
+func (x *SyncRes) GetUserEpisodes() []*UserEpisode {
+	if x != nil {
+		return x.UserEpisodes
+	}
+	return nil
+}
+
+func (x *SyncRes) GetQueueChanged() bool {
+	if x != nil {
+		return x.QueueChanged
+	}
+	return false
+}
+
+func (x *SyncRes) GetQueue() []*Episode {
+	if x != nil {
+		return x.Queue
+	}
+	return nil
+}
+
+func (x *SyncRes) GetNewEpisodes() []*Episode {
+	if x != nil {
+		return x.NewEpisodes
+	}
+	return nil
+}
+
+// ListeningSession is a continuous playback of an episode
+type ListeningSession struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id          int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	EpisodeID   string                 `protobuf:"bytes,2,opt,name=episodeID,proto3" json:"episodeID,omitempty"`
+	StartOffset int64                  `protobuf:"varint,3,opt,name=startOffset,proto3" json:"startOffset,omitempty"`
+	EndOffset   int64                  `protobuf:"varint,4,opt,name=endOffset,proto3" json:"endOffset,omitempty"`
+	Started     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=started,proto3" json:"started,omitempty"`
+	Ended       *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=ended,proto3" json:"ended,omitempty"`
+	Device      string                 `protobuf:"bytes,7,opt,name=device,proto3" json:"device,omitempty"` // device or agent the episode was played on
+}
+
+func (x *ListeningSession) Reset() {
+	*x = ListeningSession{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_podcast_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListeningSession) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListeningSession) ProtoMessage() {}
+
+func (x *ListeningSession) ProtoReflect() protoreflect.Message {
+	mi := &file_podcast_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListeningSession.ProtoReflect.Descriptor instead.
+func (*ListeningSession) Descriptor() ([]byte, []int) {
+	return file_podcast_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *ListeningSession) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ListeningSession) GetEpisodeID() string {
+	if x != nil {
+		return x.EpisodeID
+	}
+	return ""
+}
+
+func (x *ListeningSession) GetStartOffset() int64 {
+	if x != nil {
+		return x.StartOffset
+	}
+	return 0
+}
+
+func (x *ListeningSession) GetEndOffset() int64 {
+	if x != nil {
+		return x.EndOffset
+	}
+	return 0
+}
+
+func (x *ListeningSession) GetStarted() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Started
+	}
+	return nil
+}
+
+func (x *ListeningSession) GetEnded() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Ended
+	}
+	return nil
+}
+
+func (x *ListeningSession) GetDevice() string {
+	if x != nil {
+		return x.Device
+	}
+	return ""
+}
+
+type AddListeningSessionReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Session *ListeningSession `protobuf:"bytes,1,opt,name=session,proto3" json:"session,omitempty"` // id is ignored
+}
+
+func (x *AddListeningSessionReq) Reset() {
+	*x = AddListeningSessionReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_podcast_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AddListeningSessionReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddListeningSessionReq) ProtoMessage() {}
+
+func (x *AddListeningSessionReq) ProtoReflect() protoreflect.Message {
+	mi := &file_podcast_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddListeningSessionReq.ProtoReflect.Descriptor instead.
+func (*AddListeningSessionReq) Descriptor() ([]byte, []int) {
+	return file_podcast_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *AddListeningSessionReq) GetSession() *ListeningSession {
+	if x != nil {
+		return x.Session
+	}
+	return nil
+}
+
+// start & end represent the range of sessions to return
+type GetHistoryReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Start int64 `protobuf:"varint,1,opt,name=start,proto3" json:"start,omitempty"`
+	End   int64 `protobuf:"varint,2,opt,name=end,proto3" json:"end,omitempty"`
+}
+
+func (x *GetHistoryReq) Reset() {
+	*x = GetHistoryReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_podcast_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetHistoryReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetHistoryReq) ProtoMessage() {}
+
+func (x *GetHistoryReq) ProtoReflect() protoreflect.Message {
+	mi := &file_podcast_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetHistoryReq.ProtoReflect.Descriptor instead.
+func (*GetHistoryReq) Descriptor() ([]byte, []int) {
+	return file_podcast_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *GetHistoryReq) GetStart() int64 {
+	if x != nil {
+		return x.Start
+	}
+	return 0
+}
+
+func (x *GetHistoryReq) GetEnd() int64 {
+	if x != nil {
+		return x.End
+	}
+	return 0
+}
+
+// History contains the sessions most recent first along with their episodes
+type History struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Sessions []*ListeningSession `protobuf:"bytes,1,rep,name=sessions,proto3" json:"sessions,omitempty"`
+	Episodes []*Episode          `protobuf:"bytes,2,rep,name=episodes,proto3" json:"episodes,omitempty"`
+}
+
+func (x *History) Reset() {
+	*x = History{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_podcast_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *History) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*History) ProtoMessage() {}
+
+func (x *History) ProtoReflect() protoreflect.Message {
+	mi := &file_podcast_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use History.ProtoReflect.Descriptor instead.
+func (*History) Descriptor() ([]byte, []int) {
+	return file_podcast_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *History) GetSessions() []*ListeningSession {
+	if x != nil {
+		return x.Sessions
+	}
+	return nil
+}
+
+func (x *History) GetEpisodes() []*Episode {
+	if x != nil {
+		return x.Episodes
+	}
+	return nil
+}
+
+// statistics of the sessions started within [from,to), to defaults to now
+type GetStatsReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Bucket      StatsBucket            `protobuf:"varint,1,opt,name=bucket,proto3,enum=protos.StatsBucket" json:"bucket,omitempty"`
+	From        *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`
+	To          *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=to,proto3" json:"to,omitempty"`
+	Timezone    string                 `protobuf:"bytes,4,opt,name=timezone,proto3" json:"timezone,omitempty"`        // IANA name buckets begin within, defaults to UTC
+	TopPodcasts int32                  `protobuf:"varint,5,opt,name=topPodcasts,proto3" json:"topPodcasts,omitempty"` // amount of top podcasts, defaults to 10
+}
+
+func (x *GetStatsReq) Reset() {
+	*x = GetStatsReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_podcast_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetStatsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStatsReq) ProtoMessage() {}
+
+func (x *GetStatsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_podcast_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStatsReq.ProtoReflect.Descriptor instead.
+func (*GetStatsReq) Descriptor() ([]byte, []int) {
+	return file_podcast_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *GetStatsReq) GetBucket() StatsBucket {
+	if x != nil {
+		return x.Bucket
+	}
+	return StatsBucket_DAY
+}
+
+func (x *GetStatsReq) GetFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *GetStatsReq) GetTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+func (x *GetStatsReq) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+func (x *GetStatsReq) GetTopPodcasts() int32 {
+	if x != nil {
+		return x.TopPodcasts
+	}
+	return 0
+}
+
+type ListeningTotal struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Start  *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=start,proto3" json:"start,omitempty"`
+	Millis int64                  `protobuf:"varint,2,opt,name=millis,proto3" json:"millis,omitempty"`
+}
+
+func (x *ListeningTotal) Reset() {
+	*x = ListeningTotal{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_podcast_proto_msgTypes[43]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListeningTotal) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListeningTotal) ProtoMessage() {}
+
+func (x *ListeningTotal) ProtoReflect() protoreflect.Message {
+	mi := &file_podcast_proto_msgTypes[43]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListeningTotal.ProtoReflect.Descriptor instead.
+func (*ListeningTotal) Descriptor() ([]byte, []int) {
+	return file_podcast_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *ListeningTotal) GetStart() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Start
+	}
+	return nil
+}
+
+func (x *ListeningTotal) GetMillis() int64 {
+	if x != nil {
+		return x.Millis
+	}
+	return 0
+}
+
+type PodcastListening struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Podcast *Podcast `protobuf:"bytes,1,opt,name=podcast,proto3" json:"podcast,omitempty"`
+	Millis  int64    `protobuf:"varint,2,opt,name=millis,proto3" json:"millis,omitempty"`
+}
+
+func (x *PodcastListening) Reset() {
+	*x = PodcastListening{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_podcast_proto_msgTypes[44]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PodcastListening) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PodcastListening) ProtoMessage() {}
+
+func (x *PodcastListening) ProtoReflect() protoreflect.Message {
+	mi := &file_podcast_proto_msgTypes[44]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PodcastListening.ProtoReflect.Descriptor instead.
+func (*PodcastListening) Descriptor() ([]byte, []int) {
+	return file_podcast_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *PodcastListening) GetPodcast() *Podcast {
+	if x != nil {
+		return x.Podcast
+	}
+	return nil
+}
+
+func (x *PodcastListening) GetMillis() int64 {
+	if x != nil {
+		return x.Millis
+	}
+	return 0
+}
+
+// Stats contains the listening time of each non-empty bucket & the top podcasts,
+// completionRate is the ratio of episodes listened to that are played
+type Stats struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Totals            []*ListeningTotal   `protobuf:"bytes,1,rep,name=totals,proto3" json:"totals,omitempty"`
+	TotalMillis       int64               `protobuf:"varint,2,opt,name=totalMillis,proto3" json:"totalMillis,omitempty"`
+	TopPodcasts       []*PodcastListening `protobuf:"bytes,3,rep,name=topPodcasts,proto3" json:"topPodcasts,omitempty"`
+	EpisodesStarted   int64               `protobuf:"varint,4,opt,name=episodesStarted,proto3" json:"episodesStarted,omitempty"`
+	EpisodesCompleted int64               `protobuf:"varint,5,opt,name=episodesCompleted,proto3" json:"episodesCompleted,omitempty"`
+	CompletionRate    float64             `protobuf:"fixed64,6,opt,name=completionRate,proto3" json:"completionRate,omitempty"`
+}
+
+func (x *Stats) Reset() {
+	*x = Stats{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_podcast_proto_msgTypes[45]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Stats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Stats) ProtoMessage() {}
+
+func (x *Stats) ProtoReflect() protoreflect.Message {
+	mi := &file_podcast_proto_msgTypes[45]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Stats.ProtoReflect.Descriptor instead.
+func (*Stats) Descriptor() ([]byte, []int) {
+	return file_podcast_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *Stats) GetTotals() []*ListeningTotal {
+	if x != nil {
+		return x.Totals
+	}
+	return nil
+}
+
+func (x *Stats) GetTotalMillis() int64 {
+	if x != nil {
+		return x.TotalMillis
+	}
+	return 0
+}
+
+func (x *Stats) GetTopPodcasts() []*PodcastListening {
+	if x != nil {
+		return x.TopPodcasts
+	}
+	return nil
+}
+
+func (x *Stats) GetEpisodesStarted() int64 {
+	if x != nil {
+		return x.EpisodesStarted
+	}
+	return 0
+}
+
+func (x *Stats) GetEpisodesCompleted() int64 {
+	if x != nil {
+		return x.EpisodesCompleted
+	}
+	return 0
+}
+
+func (x *Stats) GetCompletionRate() float64 {
+	if x != nil {
+		return x.CompletionRate
+	}
+	return 0
+}
+
+type Bookmark struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id        string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	EpisodeID string                 `protobuf:"bytes,2,opt,name=episodeID,proto3" json:"episodeID,omitempty"`
+	Offset    int64                  `protobuf:"varint,3,opt,name=offset,proto3" json:"offset,omitempty"`
+	Note      string                 `protobuf:"bytes,4,opt,name=note,proto3" json:"note,omitempty"`
+	Created   *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created,proto3" json:"created,omitempty"`
+}
+
+func (x *Bookmark) Reset() {
+	*x = Bookmark{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_podcast_proto_msgTypes[46]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Bookmark) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Bookmark) ProtoMessage() {}
+
+func (x *Bookmark) ProtoReflect() protoreflect.Message {
+	mi := &file_podcast_proto_msgTypes[46]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Bookmark.ProtoReflect.Descriptor instead.
+func (*Bookmark) Descriptor() ([]byte, []int) {
+	return file_podcast_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *Bookmark) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Bookmark) GetEpisodeID() string {
+	if x != nil {
+		return x.EpisodeID
+	}
+	return ""
+}
+
+func (x *Bookmark) GetOffset() int64 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *Bookmark) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+func (x *Bookmark) GetCreated() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Created
+	}
+	return nil
+}
+
+type Bookmarks struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Bookmarks []*Bookmark `protobuf:"bytes,1,rep,name=bookmarks,proto3" json:"bookmarks,omitempty"`
+}
+
+func (x *Bookmarks) Reset() {
+	*x = Bookmarks{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_podcast_proto_msgTypes[47]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Bookmarks) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Bookmarks) ProtoMessage() {}
+
+func (x *Bookmarks) ProtoReflect() protoreflect.Message {
+	mi := &file_podcast_proto_msgTypes[47]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Bookmarks.ProtoReflect.Descriptor instead.
+func (*Bookmarks) Descriptor() ([]byte, []int) {
+	return file_podcast_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *Bookmarks) GetBookmarks() []*Bookmark {
+	if x != nil {
+		return x.Bookmarks
+	}
+	return nil
+}
+
+type CreateBookmarkReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	EpisodeID string `protobuf:"bytes,1,opt,name=episodeID,proto3" json:"episodeID,omitempty"`
+	Offset    int64  `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
+	Note      string `protobuf:"bytes,3,opt,name=note,proto3" json:"note,omitempty"`
+}
+
+func (x *CreateBookmarkReq) Reset() {
+	*x = CreateBookmarkReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_podcast_proto_msgTypes[48]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateBookmarkReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateBookmarkReq) ProtoMessage() {}
+
+func (x *CreateBookmarkReq) ProtoReflect() protoreflect.Message {
+	mi := &file_podcast_proto_msgTypes[48]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateBookmarkReq.ProtoReflect.Descriptor instead.
+func (*CreateBookmarkReq) Descriptor() ([]byte, []int) {
+	return file_podcast_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *CreateBookmarkReq) GetEpisodeID() string {
+	if x != nil {
+		return x.EpisodeID
+	}
+	return ""
+}
+
+func (x *CreateBookmarkReq) GetOffset() int64 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *CreateBookmarkReq) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+// episodeID is empty for the bookmarks of every episode
+type GetBookmarksReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	EpisodeID string `protobuf:"bytes,1,opt,name=episodeID,proto3" json:"episodeID,omitempty"`
+}
+
+func (x *GetBookmarksReq) Reset() {
+	*x = GetBookmarksReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_podcast_proto_msgTypes[49]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetBookmarksReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBookmarksReq) ProtoMessage() {}
+
+func (x *GetBookmarksReq) ProtoReflect() protoreflect.Message {
+	mi := &file_podcast_proto_msgTypes[49]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBookmarksReq.ProtoReflect.Descriptor instead.
+func (*GetBookmarksReq) Descriptor() ([]byte, []int) {
+	return file_podcast_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *GetBookmarksReq) GetEpisodeID() string {
+	if x != nil {
+		return x.EpisodeID
+	}
+	return ""
+}
+
+type DeleteBookmarkReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *DeleteBookmarkReq) Reset() {
+	*x = DeleteBookmarkReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_podcast_proto_msgTypes[50]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteBookmarkReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteBookmarkReq) ProtoMessage() {}
+
+func (x *DeleteBookmarkReq) ProtoReflect() protoreflect.Message {
+	mi := &file_podcast_proto_msgTypes[50]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteBookmarkReq.ProtoReflect.Descriptor instead.
+func (*DeleteBookmarkReq) Descriptor() ([]byte, []int) {
+	return file_podcast_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *DeleteBookmarkReq) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type Clip struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id          string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	EpisodeID   string                 `protobuf:"bytes,2,opt,name=episodeID,proto3" json:"episodeID,omitempty"`
+	StartOffset int64                  `protobuf:"varint,3,opt,name=startOffset,proto3" json:"startOffset,omitempty"`
+	EndOffset   int64                  `protobuf:"varint,4,opt,name=endOffset,proto3" json:"endOffset,omitempty"`
+	Title       string                 `protobuf:"bytes,5,opt,name=title,proto3" json:"title,omitempty"`
+	Created     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created,proto3" json:"created,omitempty"`
+	SharePath   string                 `protobuf:"bytes,7,opt,name=sharePath,proto3" json:"sharePath,omitempty"` // public path of the web server, ie: /share/clip/{id}
+}
+
+func (x *Clip) Reset() {
+	*x = Clip{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_podcast_proto_msgTypes[51]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Clip) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Clip) ProtoMessage() {}
+
+func (x *Clip) ProtoReflect() protoreflect.Message {
+	mi := &file_podcast_proto_msgTypes[51]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Clip.ProtoReflect.Descriptor instead.
+func (*Clip) Descriptor() ([]byte, []int) {
+	return file_podcast_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *Clip) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Clip) GetEpisodeID() string {
+	if x != nil {
+		return x.EpisodeID
+	}
+	return ""
+}
+
+func (x *Clip) GetStartOffset() int64 {
+	if x != nil {
+		return x.StartOffset
+	}
+	return 0
+}
+
+func (x *Clip) GetEndOffset() int64 {
+	if x != nil {
+		return x.EndOffset
+	}
+	return 0
+}
+
+func (x *Clip) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *Clip) GetCreated() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Created
+	}
+	return nil
+}
+
+func (x *Clip) GetSharePath() string {
+	if x != nil {
+		return x.SharePath
+	}
+	return ""
+}
+
+type Clips struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Clips []*Clip `protobuf:"bytes,1,rep,name=clips,proto3" json:"clips,omitempty"`
+}
+
+func (x *Clips) Reset() {
+	*x = Clips{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_podcast_proto_msgTypes[52]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Clips) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Clips) ProtoMessage() {}
+
+func (x *Clips) ProtoReflect() protoreflect.Message {
+	mi := &file_podcast_proto_msgTypes[52]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Clips.ProtoReflect.Descriptor instead.
+func (*Clips) Descriptor() ([]byte, []int) {
+	return file_podcast_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *Clips) GetClips() []*Clip {
+	if x != nil {
+		return x.Clips
+	}
+	return nil
+}
+
+type CreateClipReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	EpisodeID   string `protobuf:"bytes,1,opt,name=episodeID,proto3" json:"episodeID,omitempty"`
+	StartOffset int64  `protobuf:"varint,2,opt,name=startOffset,proto3" json:"startOffset,omitempty"`
+	EndOffset   int64  `protobuf:"varint,3,opt,name=endOffset,proto3" json:"endOffset,omitempty"`
+	Title       string `protobuf:"bytes,4,opt,name=title,proto3" json:"title,omitempty"`
+}
+
+func (x *CreateClipReq) Reset() {
+	*x = CreateClipReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_podcast_proto_msgTypes[53]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateClipReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateClipReq) ProtoMessage() {}
+
+func (x *CreateClipReq) ProtoReflect() protoreflect.Message {
+	mi := &file_podcast_proto_msgTypes[53]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateClipReq.ProtoReflect.Descriptor instead.
+func (*CreateClipReq) Descriptor() ([]byte, []int) {
+	return file_podcast_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *CreateClipReq) GetEpisodeID() string {
+	if x != nil {
+		return x.EpisodeID
+	}
+	return ""
+}
+
+func (x *CreateClipReq) GetStartOffset() int64 {
+	if x != nil {
+		return x.StartOffset
+	}
+	return 0
+}
+
+func (x *CreateClipReq) GetEndOffset() int64 {
+	if x != nil {
+		return x.EndOffset
+	}
+	return 0
+}
+
+func (x *CreateClipReq) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+// episodeID is empty for the clips of every episode
+type GetClipsReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	EpisodeID string `protobuf:"bytes,1,opt,name=episodeID,proto3" json:"episodeID,omitempty"`
+}
+
+func (x *GetClipsReq) Reset() {
+	*x = GetClipsReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_podcast_proto_msgTypes[54]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetClipsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetClipsReq) ProtoMessage() {}
+
+func (x *GetClipsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_podcast_proto_msgTypes[54]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetClipsReq.ProtoReflect.Descriptor instead.
+func (*GetClipsReq) Descriptor() ([]byte, []int) {
+	return file_podcast_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *GetClipsReq) GetEpisodeID() string {
+	if x != nil {
+		return x.EpisodeID
+	}
+	return ""
+}
+
+type DeleteClipReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *DeleteClipReq) Reset() {
+	*x = DeleteClipReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_podcast_proto_msgTypes[55]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteClipReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteClipReq) ProtoMessage() {}
+
+func (x *DeleteClipReq) ProtoReflect() protoreflect.Message {
+	mi := &file_podcast_proto_msgTypes[55]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteClipReq.ProtoReflect.Descriptor instead.
+func (*DeleteClipReq) Descriptor() ([]byte, []int) {
+	return file_podcast_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *DeleteClipReq) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type GetPodcastSettingsReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PodcastID string `protobuf:"bytes,1,opt,name=podcastID,proto3" json:"podcastID,omitempty"`
+}
+
+func (x *GetPodcastSettingsReq) Reset() {
+	*x = GetPodcastSettingsReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_podcast_proto_msgTypes[56]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetPodcastSettingsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPodcastSettingsReq) ProtoMessage() {}
+
+func (x *GetPodcastSettingsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_podcast_proto_msgTypes[56]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPodcastSettingsReq.ProtoReflect.Descriptor instead.
+func (*GetPodcastSettingsReq) Descriptor() ([]byte, []int) {
+	return file_podcast_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *GetPodcastSettingsReq) GetPodcastID() string {
+	if x != nil {
+		return x.PodcastID
+	}
+	return ""
+}
+
+// the episodes must be distinct
+type UpsertUserEpisodesReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserEpisodes []*UserEpisode `protobuf:"bytes,1,rep,name=userEpisodes,proto3" json:"userEpisodes,omitempty"`
+}
+
+func (x *UpsertUserEpisodesReq) Reset() {
+	*x = UpsertUserEpisodesReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_podcast_proto_msgTypes[57]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpsertUserEpisodesReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpsertUserEpisodesReq) ProtoMessage() {}
+
+func (x *UpsertUserEpisodesReq) ProtoReflect() protoreflect.Message {
+	mi := &file_podcast_proto_msgTypes[57]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpsertUserEpisodesReq.ProtoReflect.Descriptor instead.
+func (*UpsertUserEpisodesReq) Descriptor() ([]byte, []int) {
+	return file_podcast_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *UpsertUserEpisodesReq) GetUserEpisodes() []*UserEpisode {
+	if x != nil {
+		return x.UserEpisodes
+	}
+	return nil
+}
+
+// results are in order of the request
+type UpsertUserEpisodesRes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Results []*UpsertUserEpiRes `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+}
+
+func (x *UpsertUserEpisodesRes) Reset() {
+	*x = UpsertUserEpisodesRes{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_podcast_proto_msgTypes[58]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpsertUserEpisodesRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpsertUserEpisodesRes) ProtoMessage() {}
+
+func (x *UpsertUserEpisodesRes) ProtoReflect() protoreflect.Message {
+	mi := &file_podcast_proto_msgTypes[58]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpsertUserEpisodesRes.ProtoReflect.Descriptor instead.
+func (*UpsertUserEpisodesRes) Descriptor() ([]byte, []int) {
+	return file_podcast_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *UpsertUserEpisodesRes) GetResults() []*UpsertUserEpiRes {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+// before is optional, every episode of the podcast is marked played if unset
+type MarkPodcastPlayedReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PodcastID string                 `protobuf:"bytes,1,opt,name=podcastID,proto3" json:"podcastID,omitempty"`
+	Before    *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=before,proto3" json:"before,omitempty"`
+}
+
+func (x *MarkPodcastPlayedReq) Reset() {
+	*x = MarkPodcastPlayedReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_podcast_proto_msgTypes[59]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MarkPodcastPlayedReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarkPodcastPlayedReq) ProtoMessage() {}
+
+func (x *MarkPodcastPlayedReq) ProtoReflect() protoreflect.Message {
+	mi := &file_podcast_proto_msgTypes[59]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarkPodcastPlayedReq.ProtoReflect.Descriptor instead.
+func (*MarkPodcastPlayedReq) Descriptor() ([]byte, []int) {
+	return file_podcast_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *MarkPodcastPlayedReq) GetPodcastID() string {
+	if x != nil {
+		return x.PodcastID
+	}
+	return ""
+}
+
+func (x *MarkPodcastPlayedReq) GetBefore() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Before
+	}
+	return nil
+}
+
+type MarkPodcastPlayedRes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Updated int64 `protobuf:"varint,1,opt,name=updated,proto3" json:"updated,omitempty"` // episodes that were not yet played
+}
+
+func (x *MarkPodcastPlayedRes) Reset() {
+	*x = MarkPodcastPlayedRes{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_podcast_proto_msgTypes[60]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MarkPodcastPlayedRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarkPodcastPlayedRes) ProtoMessage() {}
+
+func (x *MarkPodcastPlayedRes) ProtoReflect() protoreflect.Message {
+	mi := &file_podcast_proto_msgTypes[60]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarkPodcastPlayedRes.ProtoReflect.Descriptor instead.
+func (*MarkPodcastPlayedRes) Descriptor() ([]byte, []int) {
+	return file_podcast_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *MarkPodcastPlayedRes) GetUpdated() int64 {
+	if x != nil {
+		return x.Updated
+	}
+	return 0
+}
+
+type MarkEpisodeUnplayedReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	EpisodeID string `protobuf:"bytes,1,opt,name=episodeID,proto3" json:"episodeID,omitempty"`
+}
+
+func (x *MarkEpisodeUnplayedReq) Reset() {
+	*x = MarkEpisodeUnplayedReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_podcast_proto_msgTypes[61]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MarkEpisodeUnplayedReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarkEpisodeUnplayedReq) ProtoMessage() {}
+
+func (x *MarkEpisodeUnplayedReq) ProtoReflect() protoreflect.Message {
+	mi := &file_podcast_proto_msgTypes[61]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarkEpisodeUnplayedReq.ProtoReflect.Descriptor instead.
+func (*MarkEpisodeUnplayedReq) Descriptor() ([]byte, []int) {
+	return file_podcast_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *MarkEpisodeUnplayedReq) GetEpisodeID() string {
+	if x != nil {
+		return x.EpisodeID
+	}
+	return ""
+}
+
+var File_podcast_proto protoreflect.FileDescriptor
+
+var file_podcast_proto_rawDesc = []byte{
+	0x0a, 0x0d, 0x70, 0x6f, 0x64, 0x63, 0x61, 0x73, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12,
+	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x1a, 0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f,
+	0x61, 0x70, 0x69, 0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x0a, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x22, 0x2f, 0x0a, 0x05, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x74,
+	0x69, 0x74, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c,
+	0x65, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x75, 0x72, 0x6c, 0x22, 0x5c, 0x0a, 0x08, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x12,
+	0x12, 0x0a, 0x04, 0x74, 0x65, 0x78, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74,
+	0x65, 0x78, 0x74, 0x12, 0x2c, 0x0a, 0x08, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x43,
+	0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x52, 0x08, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72,
+	0x79, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69,
+	0x64, 0x22, 0xc2, 0x03, 0x0a, 0x07, 0x50, 0x6f, 0x64, 0x63, 0x61, 0x73, 0x74, 0x12, 0x0e, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x14, 0x0a,
+	0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69,
+	0x74, 0x6c, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x65,
+	0x70, 0x69, 0x73, 0x6f, 0x64, 0x69, 0x63, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x65,
+	0x70, 0x69, 0x73, 0x6f, 0x64, 0x69, 0x63, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x6d, 0x6d, 0x61,
+	0x72, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x75, 0x6d, 0x6d, 0x61, 0x72,
+	0x79, 0x12, 0x12, 0x0a, 0x04, 0x6c, 0x69, 0x6e, 0x6b, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x6c, 0x69, 0x6e, 0x6b, 0x12, 0x23, 0x0a, 0x05, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x49, 0x6d,
+	0x61, 0x67, 0x65, 0x52, 0x05, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x65, 0x78,
+	0x70, 0x6c, 0x69, 0x63, 0x69, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x65, 0x78,
+	0x70, 0x6c, 0x69, 0x63, 0x69, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61,
+	0x67, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61,
+	0x67, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x4b, 0x65, 0x79, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x18, 0x0a,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x4b, 0x65, 0x79, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x12, 0x2c,
+	0x0a, 0x08, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x10, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f,
+	0x72, 0x79, 0x52, 0x08, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x12, 0x34, 0x0a, 0x07,
+	0x70, 0x75, 0x62, 0x44, 0x61, 0x74, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x07, 0x70, 0x75, 0x62, 0x44, 0x61,
+	0x74, 0x65, 0x12, 0x40, 0x0a, 0x0d, 0x6c, 0x61, 0x73, 0x74, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x44,
+	0x61, 0x74, 0x65, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0d, 0x6c, 0x61, 0x73, 0x74, 0x42, 0x75, 0x69, 0x6c, 0x64,
+	0x44, 0x61, 0x74, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x72, 0x73, 0x73, 0x18, 0x0e, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x72, 0x73, 0x73, 0x22, 0xe2, 0x03, 0x0a, 0x07, 0x45, 0x70, 0x69, 0x73, 0x6f,
+	0x64, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02,
+	0x69, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x6f, 0x64, 0x63, 0x61, 0x73, 0x74, 0x49, 0x44, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x6f, 0x64, 0x63, 0x61, 0x73, 0x74, 0x49, 0x44,
+	0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x12, 0x20,
+	0x0a, 0x0b, 0x65, 0x70, 0x69, 0x73, 0x6f, 0x64, 0x65, 0x54, 0x79, 0x70, 0x65, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0b, 0x65, 0x70, 0x69, 0x73, 0x6f, 0x64, 0x65, 0x54, 0x79, 0x70, 0x65,
+	0x12, 0x23, 0x0a, 0x05, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x0d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x05,
+	0x69, 0x6d, 0x61, 0x67, 0x65, 0x12, 0x34, 0x0a, 0x07, 0x70, 0x75, 0x62, 0x44, 0x61, 0x74, 0x65,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x52, 0x07, 0x70, 0x75, 0x62, 0x44, 0x61, 0x74, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64,
+	0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a,
+	0x07, 0x73, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x73, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x61, 0x73, 0x6f,
+	0x6e, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x73, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12,
+	0x18, 0x0a, 0x07, 0x65, 0x70, 0x69, 0x73, 0x6f, 0x64, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x07, 0x65, 0x70, 0x69, 0x73, 0x6f, 0x64, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x65, 0x78, 0x70,
+	0x6c, 0x69, 0x63, 0x69, 0x74, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x65, 0x78, 0x70,
+	0x6c, 0x69, 0x63, 0x69, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x4d, 0x50, 0x33, 0x55, 0x52, 0x4c, 0x18,
+	0x0e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x4d, 0x50, 0x33, 0x55, 0x52, 0x4c, 0x12, 0x26, 0x0a,
+	0x0e, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x69, 0x6c, 0x6c, 0x69, 0x73, 0x18,
+	0x0f, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d,
+	0x69, 0x6c, 0x6c, 0x69, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x75, 0x62, 0x74, 0x69, 0x74, 0x6c,
+	0x65, 0x18, 0x10, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x75, 0x62, 0x74, 0x69, 0x74, 0x6c,
+	0x65, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x64, 0x18, 0x11, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x65, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x64, 0x22, 0x1b, 0x0a, 0x09, 0x47,
+	0x65, 0x74, 0x50, 0x6f, 0x64, 0x52, 0x65, 0x71, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x6d, 0x0a, 0x07, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x6f, 0x64, 0x63, 0x61, 0x73, 0x74, 0x49, 0x44,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x6f, 0x64, 0x63, 0x61, 0x73, 0x74, 0x49,
+	0x44, 0x12, 0x1c, 0x0a, 0x09, 0x65, 0x70, 0x69, 0x73, 0x6f, 0x64, 0x65, 0x49, 0x44, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x65, 0x70, 0x69, 0x73, 0x6f, 0x64, 0x65, 0x49, 0x44, 0x12,
+	0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05,
+	0x73, 0x74, 0x61, 0x72, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x65, 0x6e, 0x64, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x03, 0x65, 0x6e, 0x64, 0x22, 0x43, 0x0a, 0x09, 0x47, 0x65, 0x74, 0x45, 0x70,
+	0x69, 0x52, 0x65, 0x71, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x02, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x65, 0x6e,
+	0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x65, 0x6e, 0x64, 0x22, 0x25, 0x0a, 0x0d,
+	0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x45, 0x70, 0x69, 0x52, 0x65, 0x71, 0x12, 0x14, 0x0a,
+	0x05, 0x65, 0x70, 0x69, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x70,
+	0x69, 0x49, 0x44, 0x22, 0x0b, 0x0a, 0x09, 0x47, 0x65, 0x74, 0x53, 0x75, 0x62, 0x52, 0x65, 0x71,
+	0x22, 0x16, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x4c, 0x61, 0x73, 0x74, 0x50,
+	0x6c, 0x61, 0x79, 0x65, 0x64, 0x52, 0x65, 0x71, 0x22, 0x3e, 0x0a, 0x08, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x18,
+	0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x7d, 0x0a, 0x10, 0x55, 0x70, 0x73, 0x65,
+	0x72, 0x74, 0x55, 0x73, 0x65, 0x72, 0x45, 0x70, 0x69, 0x52, 0x65, 0x73, 0x12, 0x18, 0x0a, 0x07,
+	0x61, 0x70, 0x70, 0x6c, 0x69, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x61,
+	0x70, 0x70, 0x6c, 0x69, 0x65, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x12, 0x35, 0x0a, 0x0b, 0x75, 0x73, 0x65, 0x72, 0x45, 0x70, 0x69, 0x73, 0x6f, 0x64, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x55,
+	0x73, 0x65, 0x72, 0x45, 0x70, 0x69, 0x73, 0x6f, 0x64, 0x65, 0x52, 0x0b, 0x75, 0x73, 0x65, 0x72,
+	0x45, 0x70, 0x69, 0x73, 0x6f, 0x64, 0x65, 0x22, 0x7d, 0x0a, 0x0d, 0x4c, 0x61, 0x73, 0x74, 0x50,
+	0x6c, 0x61, 0x79, 0x65, 0x64, 0x52, 0x65, 0x73, 0x12, 0x29, 0x0a, 0x07, 0x70, 0x6f, 0x64, 0x63,
+	0x61, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x73, 0x2e, 0x50, 0x6f, 0x64, 0x63, 0x61, 0x73, 0x74, 0x52, 0x07, 0x70, 0x6f, 0x64, 0x63,
+	0x61, 0x73, 0x74, 0x12, 0x29, 0x0a, 0x07, 0x65, 0x70, 0x69, 0x73, 0x6f, 0x64, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x45, 0x70,
+	0x69, 0x73, 0x6f, 0x64, 0x65, 0x52, 0x07, 0x65, 0x70, 0x69, 0x73, 0x6f, 0x64, 0x65, 0x12, 0x16,
+	0x0a, 0x06, 0x6d, 0x69, 0x6c, 0x6c, 0x69, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06,
+	0x6d, 0x69, 0x6c, 0x6c, 0x69, 0x73, 0x22, 0x4b, 0x0a, 0x0d, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x3a, 0x0a, 0x0d, 0x73, 0x75, 0x62, 0x73, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0d, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x22, 0x37, 0x0a, 0x08, 0x45, 0x70, 0x69, 0x73, 0x6f, 0x64, 0x65, 0x73, 0x12,
+	0x2b, 0x0a, 0x08, 0x65, 0x70, 0x69, 0x73, 0x6f, 0x64, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x0f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x45, 0x70, 0x69, 0x73, 0x6f,
+	0x64, 0x65, 0x52, 0x08, 0x65, 0x70, 0x69, 0x73, 0x6f, 0x64, 0x65, 0x73, 0x22, 0x37, 0x0a, 0x08,
+	0x50, 0x6f, 0x64, 0x63, 0x61, 0x73, 0x74, 0x73, 0x12, 0x2b, 0x0a, 0x08, 0x70, 0x6f, 0x64, 0x63,
+	0x61, 0x73, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x73, 0x2e, 0x50, 0x6f, 0x64, 0x63, 0x61, 0x73, 0x74, 0x52, 0x08, 0x70, 0x6f, 0x64,
+	0x63, 0x61, 0x73, 0x74, 0x73, 0x22, 0x3e, 0x0a, 0x0a, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72,
+	0x69, 0x65, 0x73, 0x12, 0x30, 0x0a, 0x0a, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x65,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73,
+	0x2e, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x52, 0x0a, 0x63, 0x61, 0x74, 0x65, 0x67,
+	0x6f, 0x72, 0x69, 0x65, 0x73, 0x22, 0x13, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x61, 0x74,
+	0x65, 0x67, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x22, 0x74, 0x0a, 0x11, 0x42, 0x72,
+	0x6f, 0x77, 0x73, 0x65, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x12,
+	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x12,
+	0x27, 0x0a, 0x04, 0x73, 0x6f, 0x72, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x50, 0x6f, 0x64, 0x63, 0x61, 0x73, 0x74, 0x53, 0x6f,
+	0x72, 0x74, 0x52, 0x04, 0x73, 0x6f, 0x72, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x72,
+	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x12, 0x10,
+	0x0a, 0x03, 0x65, 0x6e, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x65, 0x6e, 0x64,
+	0x22, 0x99, 0x01, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x43, 0x68, 0x61, 0x72, 0x74, 0x73, 0x52, 0x65,
+	0x71, 0x12, 0x25, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x11, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x43, 0x68, 0x61, 0x72, 0x74, 0x54, 0x79,
+	0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x61, 0x74, 0x65,
+	0x67, 0x6f, 0x72, 0x79, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x63, 0x61,
+	0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x61, 0x6e, 0x67,
+	0x75, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6c, 0x61, 0x6e, 0x67,
+	0x75, 0x61, 0x67, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x65, 0x6e,
+	0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x65, 0x6e, 0x64, 0x22, 0x3f, 0x0a, 0x15,
+	0x47, 0x65, 0x74, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x52, 0x65, 0x71, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x65,
+	0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x65, 0x6e, 0x64, 0x22, 0x4f, 0x0a,
+	0x15, 0x47, 0x65, 0x74, 0x53, 0x69, 0x6d, 0x69, 0x6c, 0x61, 0x72, 0x50, 0x6f, 0x64, 0x63, 0x61,
+	0x73, 0x74, 0x73, 0x52, 0x65, 0x71, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x12, 0x10, 0x0a, 0x03,
+	0x65, 0x6e, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x65, 0x6e, 0x64, 0x22, 0x0d,
+	0x0a, 0x0b, 0x47, 0x65, 0x74, 0x51, 0x75, 0x65, 0x75, 0x65, 0x52, 0x65, 0x71, 0x22, 0x41, 0x0a,
+	0x0d, 0x41, 0x64, 0x64, 0x54, 0x6f, 0x51, 0x75, 0x65, 0x75, 0x65, 0x52, 0x65, 0x71, 0x12, 0x1c,
+	0x0a, 0x09, 0x65, 0x70, 0x69, 0x73, 0x6f, 0x64, 0x65, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x65, 0x70, 0x69, 0x73, 0x6f, 0x64, 0x65, 0x49, 0x44, 0x12, 0x12, 0x0a, 0x04,
+	0x6e, 0x65, 0x78, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x6e, 0x65, 0x78, 0x74,
+	0x22, 0x32, 0x0a, 0x12, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x46, 0x72, 0x6f, 0x6d, 0x51, 0x75,
+	0x65, 0x75, 0x65, 0x52, 0x65, 0x71, 0x12, 0x1c, 0x0a, 0x09, 0x65, 0x70, 0x69, 0x73, 0x6f, 0x64,
+	0x65, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x65, 0x70, 0x69, 0x73, 0x6f,
+	0x64, 0x65, 0x49, 0x44, 0x22, 0x31, 0x0a, 0x0f, 0x52, 0x65, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x51,
+	0x75, 0x65, 0x75, 0x65, 0x52, 0x65, 0x71, 0x12, 0x1e, 0x0a, 0x0a, 0x65, 0x70, 0x69, 0x73, 0x6f,
+	0x64, 0x65, 0x49, 0x44, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x65, 0x70, 0x69,
+	0x73, 0x6f, 0x64, 0x65, 0x49, 0x44, 0x73, 0x22, 0x0f, 0x0a, 0x0d, 0x43, 0x6c, 0x65, 0x61, 0x72,
+	0x51, 0x75, 0x65, 0x75, 0x65, 0x52, 0x65, 0x71, 0x22, 0x49, 0x0a, 0x0f, 0x53, 0x65, 0x74, 0x41,
+	0x75, 0x74, 0x6f, 0x51, 0x75, 0x65, 0x75, 0x65, 0x52, 0x65, 0x71, 0x12, 0x1c, 0x0a, 0x09, 0x70,
+	0x6f, 0x64, 0x63, 0x61, 0x73, 0x74, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x70, 0x6f, 0x64, 0x63, 0x61, 0x73, 0x74, 0x49, 0x44, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e, 0x61,
+	0x62, 0x6c, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x65, 0x6e, 0x61, 0x62,
+	0x6c, 0x65, 0x64, 0x22, 0xfd, 0x01, 0x0a, 0x08, 0x50, 0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73, 0x74,
+	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64,
+	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x6d, 0x61, 0x72, 0x74, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x05, 0x73, 0x6d, 0x61, 0x72, 0x74, 0x12, 0x2b, 0x0a, 0x05, 0x72, 0x75,
+	0x6c, 0x65, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x73, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x73,
+	0x52, 0x05, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x65, 0x70, 0x69, 0x73, 0x6f,
+	0x64, 0x65, 0x49, 0x44, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x65, 0x70, 0x69,
+	0x73, 0x6f, 0x64, 0x65, 0x49, 0x44, 0x73, 0x12, 0x34, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x12, 0x34, 0x0a,
+	0x07, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x07, 0x75, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x64, 0x22, 0x9b, 0x02, 0x0a, 0x0d, 0x50, 0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73, 0x74,
+	0x52, 0x75, 0x6c, 0x65, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72,
+	0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x05, 0x52, 0x0a, 0x63, 0x61, 0x74, 0x65, 0x67,
+	0x6f, 0x72, 0x69, 0x65, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x6f, 0x64, 0x63, 0x61, 0x73, 0x74,
+	0x49, 0x44, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x6f, 0x64, 0x63, 0x61,
+	0x73, 0x74, 0x49, 0x44, 0x73, 0x12, 0x22, 0x0a, 0x0c, 0x75, 0x6e, 0x70, 0x6c, 0x61, 0x79, 0x65,
+	0x64, 0x4f, 0x6e, 0x6c, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x75, 0x6e, 0x70,
+	0x6c, 0x61, 0x79, 0x65, 0x64, 0x4f, 0x6e, 0x6c, 0x79, 0x12, 0x2c, 0x0a, 0x11, 0x6d, 0x69, 0x6e,
+	0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x69, 0x6c, 0x6c, 0x69, 0x73, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x11, 0x6d, 0x69, 0x6e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x4d, 0x69, 0x6c, 0x6c, 0x69, 0x73, 0x12, 0x2c, 0x0a, 0x11, 0x6d, 0x61, 0x78, 0x44, 0x75,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x69, 0x6c, 0x6c, 0x69, 0x73, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x11, 0x6d, 0x61, 0x78, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d,
+	0x69, 0x6c, 0x6c, 0x69, 0x73, 0x12, 0x34, 0x0a, 0x15, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68,
+	0x65, 0x64, 0x57, 0x69, 0x74, 0x68, 0x69, 0x6e, 0x4d, 0x69, 0x6c, 0x6c, 0x69, 0x73, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x15, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x65, 0x64, 0x57,
+	0x69, 0x74, 0x68, 0x69, 0x6e, 0x4d, 0x69, 0x6c, 0x6c, 0x69, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x6c,
+	0x69, 0x6d, 0x69, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69,
+	0x74, 0x22, 0x3b, 0x0a, 0x09, 0x50, 0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x73, 0x12, 0x2e,
+	0x0a, 0x09, 0x70, 0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x10, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x6c,
+	0x69, 0x73, 0x74, 0x52, 0x09, 0x70, 0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x73, 0x22, 0x8a,
+	0x01, 0x0a, 0x11, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73,
+	0x74, 0x52, 0x65, 0x71, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x6d, 0x61, 0x72,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x73, 0x6d, 0x61, 0x72, 0x74, 0x12, 0x2b,
+	0x0a, 0x05, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x52,
+	0x75, 0x6c, 0x65, 0x73, 0x52, 0x05, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x65,
+	0x70, 0x69, 0x73, 0x6f, 0x64, 0x65, 0x49, 0x44, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x0a, 0x65, 0x70, 0x69, 0x73, 0x6f, 0x64, 0x65, 0x49, 0x44, 0x73, 0x22, 0x11, 0x0a, 0x0f, 0x47,
+	0x65, 0x74, 0x50, 0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x73, 0x52, 0x65, 0x71, 0x22, 0x84,
+	0x01, 0x0a, 0x11, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73,
+	0x74, 0x52, 0x65, 0x71, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x2b, 0x0a, 0x05, 0x72, 0x75, 0x6c, 0x65,
+	0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73,
+	0x2e, 0x50, 0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x52, 0x05,
+	0x72, 0x75, 0x6c, 0x65, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x65, 0x70, 0x69, 0x73, 0x6f, 0x64, 0x65,
+	0x49, 0x44, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x65, 0x70, 0x69, 0x73, 0x6f,
+	0x64, 0x65, 0x49, 0x44, 0x73, 0x22, 0x23, 0x0a, 0x11, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50,
+	0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x50, 0x0a, 0x16, 0x47, 0x65,
+	0x74, 0x50, 0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x45, 0x70, 0x69, 0x73, 0x6f, 0x64, 0x65,
+	0x73, 0x52, 0x65, 0x71, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x02, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x65, 0x6e,
+	0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x65, 0x6e, 0x64, 0x22, 0x1f, 0x0a, 0x07,
+	0x53, 0x79, 0x6e, 0x63, 0x52, 0x65, 0x71, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0xd4, 0x02,
+	0x0a, 0x07, 0x53, 0x79, 0x6e, 0x63, 0x52, 0x65, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b,
+	0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12,
+	0x12, 0x0a, 0x04, 0x66, 0x75, 0x6c, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x66,
+	0x75, 0x6c, 0x6c, 0x12, 0x3a, 0x0a, 0x0d, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x73, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x0d, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12,
+	0x2c, 0x0a, 0x11, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x50, 0x6f, 0x64, 0x63, 0x61, 0x73,
+	0x74, 0x49, 0x44, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x11, 0x72, 0x65, 0x6d, 0x6f,
+	0x76, 0x65, 0x64, 0x50, 0x6f, 0x64, 0x63, 0x61, 0x73, 0x74, 0x49, 0x44, 0x73, 0x12, 0x37, 0x0a,
+	0x0c, 0x75, 0x73, 0x65, 0x72, 0x45, 0x70, 0x69, 0x73, 0x6f, 0x64, 0x65, 0x73, 0x18, 0x05, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x55, 0x73, 0x65,
+	0x72, 0x45, 0x70, 0x69, 0x73, 0x6f, 0x64, 0x65, 0x52, 0x0c, 0x75, 0x73, 0x65, 0x72, 0x45, 0x70,
+	0x69, 0x73, 0x6f, 0x64, 0x65, 0x73, 0x12, 0x22, 0x0a, 0x0c, 0x71, 0x75, 0x65, 0x75, 0x65, 0x43,
+	0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x71, 0x75,
+	0x65, 0x75, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x12, 0x25, 0x0a, 0x05, 0x71, 0x75,
+	0x65, 0x75, 0x65, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x73, 0x2e, 0x45, 0x70, 0x69, 0x73, 0x6f, 0x64, 0x65, 0x52, 0x05, 0x71, 0x75, 0x65, 0x75,
+	0x65, 0x12, 0x31, 0x0a, 0x0b, 0x6e, 0x65, 0x77, 0x45, 0x70, 0x69, 0x73, 0x6f, 0x64, 0x65, 0x73,
+	0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e,
+	0x45, 0x70, 0x69, 0x73, 0x6f, 0x64, 0x65, 0x52, 0x0b, 0x6e, 0x65, 0x77, 0x45, 0x70, 0x69, 0x73,
+	0x6f, 0x64, 0x65, 0x73, 0x22, 0x80, 0x02, 0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x69,
+	0x6e, 0x67, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x65, 0x70, 0x69,
+	0x73, 0x6f, 0x64, 0x65, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x65, 0x70,
+	0x69, 0x73, 0x6f, 0x64, 0x65, 0x49, 0x44, 0x12, 0x20, 0x0a, 0x0b, 0x73, 0x74, 0x61, 0x72, 0x74,
+	0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x73, 0x74,
+	0x61, 0x72, 0x74, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x65, 0x6e, 0x64,
+	0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x65, 0x6e,
+	0x64, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x34, 0x0a, 0x07, 0x73, 0x74, 0x61, 0x72, 0x74,
+	0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x52, 0x07, 0x73, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x12, 0x30, 0x0a,
+	0x05, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x05, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x12,
+	0x16, 0x0a, 0x06, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x22, 0x4c, 0x0a, 0x16, 0x41, 0x64, 0x64, 0x4c, 0x69,
+	0x73, 0x74, 0x65, 0x6e, 0x69, 0x6e, 0x67, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x71, 0x12, 0x32, 0x0a, 0x07, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x18, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x4c, 0x69, 0x73, 0x74,
+	0x65, 0x6e, 0x69, 0x6e, 0x67, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x07, 0x73, 0x65,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x37, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x48, 0x69, 0x73, 0x74,
+	0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x12, 0x10, 0x0a, 0x03,
+	0x65, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x65, 0x6e, 0x64, 0x22, 0x6c,
+	0x0a, 0x07, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x34, 0x0a, 0x08, 0x73, 0x65, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x69, 0x6e, 0x67, 0x53, 0x65,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12,
+	0x2b, 0x0a, 0x08, 0x65, 0x70, 0x69, 0x73, 0x6f, 0x64, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x0f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x45, 0x70, 0x69, 0x73, 0x6f,
+	0x64, 0x65, 0x52, 0x08, 0x65, 0x70, 0x69, 0x73, 0x6f, 0x64, 0x65, 0x73, 0x22, 0xd4, 0x01, 0x0a,
+	0x0b, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x71, 0x12, 0x2b, 0x0a, 0x06,
+	0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x73, 0x42, 0x75, 0x63, 0x6b, 0x65,
+	0x74, 0x52, 0x06, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x12, 0x2e, 0x0a, 0x04, 0x66, 0x72, 0x6f,
+	0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12, 0x2a, 0x0a, 0x02, 0x74, 0x6f, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x52, 0x02, 0x74, 0x6f, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x7a, 0x6f, 0x6e,
+	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x7a, 0x6f, 0x6e,
+	0x65, 0x12, 0x20, 0x0a, 0x0b, 0x74, 0x6f, 0x70, 0x50, 0x6f, 0x64, 0x63, 0x61, 0x73, 0x74, 0x73,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x74, 0x6f, 0x70, 0x50, 0x6f, 0x64, 0x63, 0x61,
+	0x73, 0x74, 0x73, 0x22, 0x5a, 0x0a, 0x0e, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x69, 0x6e, 0x67,
+	0x54, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x30, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x52, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x69, 0x6c, 0x6c, 0x69,
+	0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x6d, 0x69, 0x6c, 0x6c, 0x69, 0x73, 0x22,
+	0x55, 0x0a, 0x10, 0x50, 0x6f, 0x64, 0x63, 0x61, 0x73, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e,
+	0x69, 0x6e, 0x67, 0x12, 0x29, 0x0a, 0x07, 0x70, 0x6f, 0x64, 0x63, 0x61, 0x73, 0x74, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x50, 0x6f,
+	0x64, 0x63, 0x61, 0x73, 0x74, 0x52, 0x07, 0x70, 0x6f, 0x64, 0x63, 0x61, 0x73, 0x74, 0x12, 0x16,
+	0x0a, 0x06, 0x6d, 0x69, 0x6c, 0x6c, 0x69, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06,
+	0x6d, 0x69, 0x6c, 0x6c, 0x69, 0x73, 0x22, 0x95, 0x02, 0x0a, 0x05, 0x53, 0x74, 0x61, 0x74, 0x73,
+	0x12, 0x2e, 0x0a, 0x06, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x16, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e,
+	0x69, 0x6e, 0x67, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x52, 0x06, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x73,
+	0x12, 0x20, 0x0a, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x4d, 0x69, 0x6c, 0x6c, 0x69, 0x73, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x4d, 0x69, 0x6c, 0x6c,
+	0x69, 0x73, 0x12, 0x3a, 0x0a, 0x0b, 0x74, 0x6f, 0x70, 0x50, 0x6f, 0x64, 0x63, 0x61, 0x73, 0x74,
+	0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73,
+	0x2e, 0x50, 0x6f, 0x64, 0x63, 0x61, 0x73, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x69, 0x6e,
+	0x67, 0x52, 0x0b, 0x74, 0x6f, 0x70, 0x50, 0x6f, 0x64, 0x63, 0x61, 0x73, 0x74, 0x73, 0x12, 0x28,
+	0x0a, 0x0f, 0x65, 0x70, 0x69, 0x73, 0x6f, 0x64, 0x65, 0x73, 0x53, 0x74, 0x61, 0x72, 0x74, 0x65,
+	0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x65, 0x70, 0x69, 0x73, 0x6f, 0x64, 0x65,
+	0x73, 0x53, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x12, 0x2c, 0x0a, 0x11, 0x65, 0x70, 0x69, 0x73,
+	0x6f, 0x64, 0x65, 0x73, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x11, 0x65, 0x70, 0x69, 0x73, 0x6f, 0x64, 0x65, 0x73, 0x43, 0x6f, 0x6d,
+	0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x12, 0x26, 0x0a, 0x0e, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x61, 0x74, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0e,
+	0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x61, 0x74, 0x65, 0x22, 0x9a,
+	0x01, 0x0a, 0x08, 0x42, 0x6f, 0x6f, 0x6b, 0x6d, 0x61, 0x72, 0x6b, 0x12, 0x0e, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x65,
+	0x70, 0x69, 0x73, 0x6f, 0x64, 0x65, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x65, 0x70, 0x69, 0x73, 0x6f, 0x64, 0x65, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x66, 0x66,
+	0x73, 0x65, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65,
+	0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x6f, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x6e, 0x6f, 0x74, 0x65, 0x12, 0x34, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x22, 0x3b, 0x0a, 0x09, 0x42,
+	0x6f, 0x6f, 0x6b, 0x6d, 0x61, 0x72, 0x6b, 0x73, 0x12, 0x2e, 0x0a, 0x09, 0x62, 0x6f, 0x6f, 0x6b,
+	0x6d, 0x61, 0x72, 0x6b, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x42, 0x6f, 0x6f, 0x6b, 0x6d, 0x61, 0x72, 0x6b, 0x52, 0x09, 0x62,
+	0x6f, 0x6f, 0x6b, 0x6d, 0x61, 0x72, 0x6b, 0x73, 0x22, 0x5d, 0x0a, 0x11, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x42, 0x6f, 0x6f, 0x6b, 0x6d, 0x61, 0x72, 0x6b, 0x52, 0x65, 0x71, 0x12, 0x1c, 0x0a,
+	0x09, 0x65, 0x70, 0x69, 0x73, 0x6f, 0x64, 0x65, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x65, 0x70, 0x69, 0x73, 0x6f, 0x64, 0x65, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x6f,
+	0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x6f, 0x66, 0x66,
+	0x73, 0x65, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x6f, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x6e, 0x6f, 0x74, 0x65, 0x22, 0x2f, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x42, 0x6f,
+	0x6f, 0x6b, 0x6d, 0x61, 0x72, 0x6b, 0x73, 0x52, 0x65, 0x71, 0x12, 0x1c, 0x0a, 0x09, 0x65, 0x70,
+	0x69, 0x73, 0x6f, 0x64, 0x65, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x65,
+	0x70, 0x69, 0x73, 0x6f, 0x64, 0x65, 0x49, 0x44, 0x22, 0x23, 0x0a, 0x11, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x42, 0x6f, 0x6f, 0x6b, 0x6d, 0x61, 0x72, 0x6b, 0x52, 0x65, 0x71, 0x12, 0x0e, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0xde, 0x01,
+	0x0a, 0x04, 0x43, 0x6c, 0x69, 0x70, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x65, 0x70, 0x69, 0x73, 0x6f, 0x64,
+	0x65, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x65, 0x70, 0x69, 0x73, 0x6f,
+	0x64, 0x65, 0x49, 0x44, 0x12, 0x20, 0x0a, 0x0b, 0x73, 0x74, 0x61, 0x72, 0x74, 0x4f, 0x66, 0x66,
+	0x73, 0x65, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x73, 0x74, 0x61, 0x72, 0x74,
+	0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x65, 0x6e, 0x64, 0x4f, 0x66, 0x66,
+	0x73, 0x65, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x65, 0x6e, 0x64, 0x4f, 0x66,
+	0x66, 0x73, 0x65, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x34, 0x0a, 0x07, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64,
+	0x12, 0x1c, 0x0a, 0x09, 0x73, 0x68, 0x61, 0x72, 0x65, 0x50, 0x61, 0x74, 0x68, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x68, 0x61, 0x72, 0x65, 0x50, 0x61, 0x74, 0x68, 0x22, 0x2b,
+	0x0a, 0x05, 0x43, 0x6c, 0x69, 0x70, 0x73, 0x12, 0x22, 0x0a, 0x05, 0x63, 0x6c, 0x69, 0x70, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e,
+	0x43, 0x6c, 0x69, 0x70, 0x52, 0x05, 0x63, 0x6c, 0x69, 0x70, 0x73, 0x22, 0x83, 0x01, 0x0a, 0x0d,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x6c, 0x69, 0x70, 0x52, 0x65, 0x71, 0x12, 0x1c, 0x0a,
+	0x09, 0x65, 0x70, 0x69, 0x73, 0x6f, 0x64, 0x65, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x65, 0x70, 0x69, 0x73, 0x6f, 0x64, 0x65, 0x49, 0x44, 0x12, 0x20, 0x0a, 0x0b, 0x73,
+	0x74, 0x61, 0x72, 0x74, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x0b, 0x73, 0x74, 0x61, 0x72, 0x74, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x1c, 0x0a,
+	0x09, 0x65, 0x6e, 0x64, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x09, 0x65, 0x6e, 0x64, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x74,
+	0x69, 0x74, 0x6c, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c,
+	0x65, 0x22, 0x2b, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x43, 0x6c, 0x69, 0x70, 0x73, 0x52, 0x65, 0x71,
+	0x12, 0x1c, 0x0a, 0x09, 0x65, 0x70, 0x69, 0x73, 0x6f, 0x64, 0x65, 0x49, 0x44, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x65, 0x70, 0x69, 0x73, 0x6f, 0x64, 0x65, 0x49, 0x44, 0x22, 0x1f,
+	0x0a, 0x0d, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x6c, 0x69, 0x70, 0x52, 0x65, 0x71, 0x12,
+	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22,
+	0x35, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x64, 0x63, 0x61, 0x73, 0x74, 0x53, 0x65, 0x74,
+	0x74, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x71, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x6f, 0x64, 0x63,
+	0x61, 0x73, 0x74, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x6f, 0x64,
+	0x63, 0x61, 0x73, 0x74, 0x49, 0x44, 0x22, 0x50, 0x0a, 0x15, 0x55, 0x70, 0x73, 0x65, 0x72, 0x74,
+	0x55, 0x73, 0x65, 0x72, 0x45, 0x70, 0x69, 0x73, 0x6f, 0x64, 0x65, 0x73, 0x52, 0x65, 0x71, 0x12,
+	0x37, 0x0a, 0x0c, 0x75, 0x73, 0x65, 0x72, 0x45, 0x70, 0x69, 0x73, 0x6f, 0x64, 0x65, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x55,
+	0x73, 0x65, 0x72, 0x45, 0x70, 0x69, 0x73, 0x6f, 0x64, 0x65, 0x52, 0x0c, 0x75, 0x73, 0x65, 0x72,
+	0x45, 0x70, 0x69, 0x73, 0x6f, 0x64, 0x65, 0x73, 0x22, 0x4b, 0x0a, 0x15, 0x55, 0x70, 0x73, 0x65,
+	0x72, 0x74, 0x55, 0x73, 0x65, 0x72, 0x45, 0x70, 0x69, 0x73, 0x6f, 0x64, 0x65, 0x73, 0x52, 0x65,
+	0x73, 0x12, 0x32, 0x0a, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x18, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x55, 0x70, 0x73, 0x65,
+	0x72, 0x74, 0x55, 0x73, 0x65, 0x72, 0x45, 0x70, 0x69, 0x52, 0x65, 0x73, 0x52, 0x07, 0x72, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x73, 0x22, 0x68, 0x0a, 0x14, 0x4d, 0x61, 0x72, 0x6b, 0x50, 0x6f, 0x64,
+	0x63, 0x61, 0x73, 0x74, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x64, 0x52, 0x65, 0x71, 0x12, 0x1c, 0x0a,
+	0x09, 0x70, 0x6f, 0x64, 0x63, 0x61, 0x73, 0x74, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x70, 0x6f, 0x64, 0x63, 0x61, 0x73, 0x74, 0x49, 0x44, 0x12, 0x32, 0x0a, 0x06, 0x62,
+	0x65, 0x66, 0x6f, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x06, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x22,
+	0x30, 0x0a, 0x14, 0x4d, 0x61, 0x72, 0x6b, 0x50, 0x6f, 0x64, 0x63, 0x61, 0x73, 0x74, 0x50, 0x6c,
+	0x61, 0x79, 0x65, 0x64, 0x52, 0x65, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x75, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x64, 0x22, 0x36, 0x0a, 0x16, 0x4d, 0x61, 0x72, 0x6b, 0x45, 0x70, 0x69, 0x73, 0x6f, 0x64, 0x65,
+	0x55, 0x6e, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x64, 0x52, 0x65, 0x71, 0x12, 0x1c, 0x0a, 0x09, 0x65,
+	0x70, 0x69, 0x73, 0x6f, 0x64, 0x65, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x65, 0x70, 0x69, 0x73, 0x6f, 0x64, 0x65, 0x49, 0x44, 0x2a, 0x3d, 0x0a, 0x0b, 0x50, 0x6f, 0x64,
+	0x63, 0x61, 0x73, 0x74, 0x53, 0x6f, 0x72, 0x74, 0x12, 0x0f, 0x0a, 0x0b, 0x53, 0x55, 0x42, 0x53,
+	0x43, 0x52, 0x49, 0x42, 0x45, 0x52, 0x53, 0x10, 0x00, 0x12, 0x12, 0x0a, 0x0e, 0x4c, 0x41, 0x54,
+	0x45, 0x53, 0x54, 0x5f, 0x45, 0x50, 0x49, 0x53, 0x4f, 0x44, 0x45, 0x10, 0x01, 0x12, 0x09, 0x0a,
+	0x05, 0x54, 0x49, 0x54, 0x4c, 0x45, 0x10, 0x02, 0x2a, 0x22, 0x0a, 0x09, 0x43, 0x68, 0x61, 0x72,
+	0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x07, 0x0a, 0x03, 0x54, 0x4f, 0x50, 0x10, 0x00, 0x12, 0x0c,
+	0x0a, 0x08, 0x54, 0x52, 0x45, 0x4e, 0x44, 0x49, 0x4e, 0x47, 0x10, 0x01, 0x2a, 0x2b, 0x0a, 0x0b,
+	0x53, 0x74, 0x61, 0x74, 0x73, 0x42, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x12, 0x07, 0x0a, 0x03, 0x44,
+	0x41, 0x59, 0x10, 0x00, 0x12, 0x08, 0x0a, 0x04, 0x57, 0x45, 0x45, 0x4b, 0x10, 0x01, 0x12, 0x09,
+	0x0a, 0x05, 0x4d, 0x4f, 0x4e, 0x54, 0x48, 0x10, 0x02, 0x32, 0xc7, 0x1d, 0x0a, 0x03, 0x50, 0x6f,
+	0x64, 0x12, 0x54, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x64, 0x63, 0x61, 0x73, 0x74, 0x12,
+	0x11, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x64, 0x52,
+	0x65, 0x71, 0x1a, 0x0f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x50, 0x6f, 0x64, 0x63,
+	0x61, 0x73, 0x74, 0x22, 0x22, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1c, 0x22, 0x17, 0x2f, 0x76, 0x31,
+	0x2f, 0x70, 0x6f, 0x64, 0x63, 0x61, 0x73, 0x74, 0x2f, 0x67, 0x65, 0x74, 0x5f, 0x70, 0x6f, 0x64,
+	0x63, 0x61, 0x73, 0x74, 0x3a, 0x01, 0x2a, 0x12, 0x57, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x45, 0x70,
+	0x69, 0x73, 0x6f, 0x64, 0x65, 0x73, 0x12, 0x11, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e,
+	0x47, 0x65, 0x74, 0x45, 0x70, 0x69, 0x52, 0x65, 0x71, 0x1a, 0x10, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x73, 0x2e, 0x45, 0x70, 0x69, 0x73, 0x6f, 0x64, 0x65, 0x73, 0x22, 0x23, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x1d, 0x22, 0x18, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x6f, 0x64, 0x63, 0x61, 0x73, 0x74,
+	0x2f, 0x67, 0x65, 0x74, 0x5f, 0x65, 0x70, 0x69, 0x73, 0x6f, 0x64, 0x65, 0x73, 0x3a, 0x01, 0x2a,
+	0x12, 0x65, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x45, 0x70, 0x69, 0x73, 0x6f,
+	0x64, 0x65, 0x12, 0x15, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x55,
+	0x73, 0x65, 0x72, 0x45, 0x70, 0x69, 0x52, 0x65, 0x71, 0x1a, 0x13, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x73, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x45, 0x70, 0x69, 0x73, 0x6f, 0x64, 0x65, 0x22, 0x27,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x21, 0x22, 0x1c, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x6f, 0x64, 0x63,
+	0x61, 0x73, 0x74, 0x2f, 0x67, 0x65, 0x74, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x65, 0x70, 0x69,
+	0x73, 0x6f, 0x64, 0x65, 0x3a, 0x01, 0x2a, 0x12, 0x6b, 0x0a, 0x11, 0x55, 0x70, 0x73, 0x65, 0x72,
+	0x74, 0x55, 0x73, 0x65, 0x72, 0x45, 0x70, 0x69, 0x73, 0x6f, 0x64, 0x65, 0x12, 0x13, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x45, 0x70, 0x69, 0x73, 0x6f, 0x64,
+	0x65, 0x1a, 0x18, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x55, 0x70, 0x73, 0x65, 0x72,
+	0x74, 0x55, 0x73, 0x65, 0x72, 0x45, 0x70, 0x69, 0x52, 0x65, 0x73, 0x22, 0x27, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x21, 0x22, 0x1c, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x6f, 0x64, 0x63, 0x61, 0x73, 0x74,
+	0x2f, 0x67, 0x65, 0x74, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x65, 0x70, 0x69, 0x73, 0x6f, 0x64,
+	0x65, 0x3a, 0x01, 0x2a, 0x12, 0x7f, 0x0a, 0x12, 0x55, 0x70, 0x73, 0x65, 0x72, 0x74, 0x55, 0x73,
+	0x65, 0x72, 0x45, 0x70, 0x69, 0x73, 0x6f, 0x64, 0x65, 0x73, 0x12, 0x1d, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x73, 0x2e, 0x55, 0x70, 0x73, 0x65, 0x72, 0x74, 0x55, 0x73, 0x65, 0x72, 0x45, 0x70,
+	0x69, 0x73, 0x6f, 0x64, 0x65, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x1d, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x73, 0x2e, 0x55, 0x70, 0x73, 0x65, 0x72, 0x74, 0x55, 0x73, 0x65, 0x72, 0x45, 0x70, 0x69,
+	0x73, 0x6f, 0x64, 0x65, 0x73, 0x52, 0x65, 0x73, 0x22, 0x2b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x25,
+	0x22, 0x20, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x6f, 0x64, 0x63, 0x61, 0x73, 0x74, 0x2f, 0x75, 0x70,
+	0x73, 0x65, 0x72, 0x74, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x65, 0x70, 0x69, 0x73, 0x6f, 0x64,
+	0x65, 0x73, 0x3a, 0x01, 0x2a, 0x12, 0x7b, 0x0a, 0x11, 0x4d, 0x61, 0x72, 0x6b, 0x50, 0x6f, 0x64,
+	0x63, 0x61, 0x73, 0x74, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x64, 0x12, 0x1c, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x73, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x50, 0x6f, 0x64, 0x63, 0x61, 0x73, 0x74, 0x50,
+	0x6c, 0x61, 0x79, 0x65, 0x64, 0x52, 0x65, 0x71, 0x1a, 0x1c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x73, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x50, 0x6f, 0x64, 0x63, 0x61, 0x73, 0x74, 0x50, 0x6c, 0x61,
+	0x79, 0x65, 0x64, 0x52, 0x65, 0x73, 0x22, 0x2a, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x24, 0x22, 0x1f,
+	0x2f, 0x76, 0x31, 0x2f, 0x70, 0x6f, 0x64, 0x63, 0x61, 0x73, 0x74, 0x2f, 0x6d, 0x61, 0x72, 0x6b,
+	0x5f, 0x70, 0x6f, 0x64, 0x63, 0x61, 0x73, 0x74, 0x5f, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x64, 0x3a,
+	0x01, 0x2a, 0x12, 0x75, 0x0a, 0x13, 0x4d, 0x61, 0x72, 0x6b, 0x45, 0x70, 0x69, 0x73, 0x6f, 0x64,
+	0x65, 0x55, 0x6e, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x64, 0x12, 0x1e, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x73, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x45, 0x70, 0x69, 0x73, 0x6f, 0x64, 0x65, 0x55, 0x6e,
+	0x70, 0x6c, 0x61, 0x79, 0x65, 0x64, 0x52, 0x65, 0x71, 0x1a, 0x10, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x73, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2c, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x26, 0x22, 0x21, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x6f, 0x64, 0x63, 0x61, 0x73, 0x74,
+	0x2f, 0x6d, 0x61, 0x72, 0x6b, 0x5f, 0x65, 0x70, 0x69, 0x73, 0x6f, 0x64, 0x65, 0x5f, 0x75, 0x6e,
+	0x70, 0x6c, 0x61, 0x79, 0x65, 0x64, 0x3a, 0x01, 0x2a, 0x12, 0x66, 0x0a, 0x10, 0x47, 0x65, 0x74,
+	0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x11, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x75, 0x62, 0x52, 0x65, 0x71,
+	0x1a, 0x15, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x28, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x22, 0x22,
+	0x1d, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x6f, 0x64, 0x63, 0x61, 0x73, 0x74, 0x2f, 0x67, 0x65, 0x74,
+	0x5f, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x3a, 0x01,
+	0x2a, 0x12, 0x67, 0x0a, 0x0e, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72,
+	0x69, 0x65, 0x73, 0x12, 0x19, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x4c, 0x69, 0x73,
+	0x74, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x12,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69,
+	0x65, 0x73, 0x22, 0x26, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x20, 0x22, 0x1b, 0x2f, 0x76, 0x31, 0x2f,
+	0x70, 0x6f, 0x64, 0x63, 0x61, 0x73, 0x74, 0x2f, 0x6c, 0x69, 0x73, 0x74, 0x5f, 0x63, 0x61, 0x74,
+	0x65, 0x67, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x3a, 0x01, 0x2a, 0x12, 0x65, 0x0a, 0x0e, 0x42, 0x72,
+	0x6f, 0x77, 0x73, 0x65, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x12, 0x19, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x42, 0x72, 0x6f, 0x77, 0x73, 0x65, 0x43, 0x61, 0x74, 0x65,
+	0x67, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x1a, 0x10, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73,
+	0x2e, 0x50, 0x6f, 0x64, 0x63, 0x61, 0x73, 0x74, 0x73, 0x22, 0x26, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x20, 0x22, 0x1b, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x6f, 0x64, 0x63, 0x61, 0x73, 0x74, 0x2f, 0x62,
+	0x72, 0x6f, 0x77, 0x73, 0x65, 0x5f, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x3a, 0x01,
+	0x2a, 0x12, 0x56, 0x0a, 0x09, 0x47, 0x65, 0x74, 0x43, 0x68, 0x61, 0x72, 0x74, 0x73, 0x12, 0x14,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x68, 0x61, 0x72, 0x74,
+	0x73, 0x52, 0x65, 0x71, 0x1a, 0x10, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x50, 0x6f,
+	0x64, 0x63, 0x61, 0x73, 0x74, 0x73, 0x22, 0x21, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1b, 0x22, 0x16,
+	0x2f, 0x76, 0x31, 0x2f, 0x70, 0x6f, 0x64, 0x63, 0x61, 0x73, 0x74, 0x2f, 0x67, 0x65, 0x74, 0x5f,
+	0x63, 0x68, 0x61, 0x72, 0x74, 0x73, 0x3a, 0x01, 0x2a, 0x12, 0x71, 0x0a, 0x12, 0x47, 0x65, 0x74,
+	0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12,
+	0x1d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x63, 0x6f,
+	0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x10,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x50, 0x6f, 0x64, 0x63, 0x61, 0x73, 0x74, 0x73,
+	0x22, 0x2a, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x24, 0x22, 0x1f, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x6f,
+	0x64, 0x63, 0x61, 0x73, 0x74, 0x2f, 0x67, 0x65, 0x74, 0x5f, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x6d,
+	0x65, 0x6e, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x3a, 0x01, 0x2a, 0x12, 0x72, 0x0a, 0x12,
+	0x47, 0x65, 0x74, 0x53, 0x69, 0x6d, 0x69, 0x6c, 0x61, 0x72, 0x50, 0x6f, 0x64, 0x63, 0x61, 0x73,
+	0x74, 0x73, 0x12, 0x1d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x53,
+	0x69, 0x6d, 0x69, 0x6c, 0x61, 0x72, 0x50, 0x6f, 0x64, 0x63, 0x61, 0x73, 0x74, 0x73, 0x52, 0x65,
+	0x71, 0x1a, 0x10, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x50, 0x6f, 0x64, 0x63, 0x61,
+	0x73, 0x74, 0x73, 0x22, 0x2b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x25, 0x22, 0x20, 0x2f, 0x76, 0x31,
+	0x2f, 0x70, 0x6f, 0x64, 0x63, 0x61, 0x73, 0x74, 0x2f, 0x67, 0x65, 0x74, 0x5f, 0x73, 0x69, 0x6d,
+	0x69, 0x6c, 0x61, 0x72, 0x5f, 0x70, 0x6f, 0x64, 0x63, 0x61, 0x73, 0x74, 0x73, 0x3a, 0x01, 0x2a,
+	0x12, 0x53, 0x0a, 0x08, 0x47, 0x65, 0x74, 0x51, 0x75, 0x65, 0x75, 0x65, 0x12, 0x13, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x51, 0x75, 0x65, 0x75, 0x65, 0x52, 0x65,
+	0x71, 0x1a, 0x10, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x45, 0x70, 0x69, 0x73, 0x6f,
+	0x64, 0x65, 0x73, 0x22, 0x20, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1a, 0x22, 0x15, 0x2f, 0x76, 0x31,
+	0x2f, 0x70, 0x6f, 0x64, 0x63, 0x61, 0x73, 0x74, 0x2f, 0x67, 0x65, 0x74, 0x5f, 0x71, 0x75, 0x65,
+	0x75, 0x65, 0x3a, 0x01, 0x2a, 0x12, 0x5a, 0x0a, 0x0a, 0x41, 0x64, 0x64, 0x54, 0x6f, 0x51, 0x75,
+	0x65, 0x75, 0x65, 0x12, 0x15, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64,
+	0x54, 0x6f, 0x51, 0x75, 0x65, 0x75, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x10, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x73, 0x2e, 0x45, 0x70, 0x69, 0x73, 0x6f, 0x64, 0x65, 0x73, 0x22, 0x23, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x1d, 0x22, 0x18, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x6f, 0x64, 0x63, 0x61, 0x73,
+	0x74, 0x2f, 0x61, 0x64, 0x64, 0x5f, 0x74, 0x6f, 0x5f, 0x71, 0x75, 0x65, 0x75, 0x65, 0x3a, 0x01,
+	0x2a, 0x12, 0x69, 0x0a, 0x0f, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x46, 0x72, 0x6f, 0x6d, 0x51,
+	0x75, 0x65, 0x75, 0x65, 0x12, 0x1a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x52, 0x65,
+	0x6d, 0x6f, 0x76, 0x65, 0x46, 0x72, 0x6f, 0x6d, 0x51, 0x75, 0x65, 0x75, 0x65, 0x52, 0x65, 0x71,
+	0x1a, 0x10, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x45, 0x70, 0x69, 0x73, 0x6f, 0x64,
+	0x65, 0x73, 0x22, 0x28, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x22, 0x22, 0x1d, 0x2f, 0x76, 0x31, 0x2f,
+	0x70, 0x6f, 0x64, 0x63, 0x61, 0x73, 0x74, 0x2f, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x5f, 0x66,
+	0x72, 0x6f, 0x6d, 0x5f, 0x71, 0x75, 0x65, 0x75, 0x65, 0x3a, 0x01, 0x2a, 0x12, 0x5f, 0x0a, 0x0c,
+	0x52, 0x65, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x51, 0x75, 0x65, 0x75, 0x65, 0x12, 0x17, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x52, 0x65, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x51, 0x75, 0x65,
+	0x75, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x10, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x45,
+	0x70, 0x69, 0x73, 0x6f, 0x64, 0x65, 0x73, 0x22, 0x24, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1e, 0x22,
+	0x19, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x6f, 0x64, 0x63, 0x61, 0x73, 0x74, 0x2f, 0x72, 0x65, 0x6f,
+	0x72, 0x64, 0x65, 0x72, 0x5f, 0x71, 0x75, 0x65, 0x75, 0x65, 0x3a, 0x01, 0x2a, 0x12, 0x59, 0x0a,
+	0x0a, 0x43, 0x6c, 0x65, 0x61, 0x72, 0x51, 0x75, 0x65, 0x75, 0x65, 0x12, 0x15, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x43, 0x6c, 0x65, 0x61, 0x72, 0x51, 0x75, 0x65, 0x75, 0x65, 0x52,
+	0x65, 0x71, 0x1a, 0x10, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x22, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1c, 0x22, 0x17, 0x2f, 0x76,
+	0x31, 0x2f, 0x70, 0x6f, 0x64, 0x63, 0x61, 0x73, 0x74, 0x2f, 0x63, 0x6c, 0x65, 0x61, 0x72, 0x5f,
+	0x71, 0x75, 0x65, 0x75, 0x65, 0x3a, 0x01, 0x2a, 0x12, 0x60, 0x0a, 0x0c, 0x53, 0x65, 0x74, 0x41,
+	0x75, 0x74, 0x6f, 0x51, 0x75, 0x65, 0x75, 0x65, 0x12, 0x17, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x73, 0x2e, 0x53, 0x65, 0x74, 0x41, 0x75, 0x74, 0x6f, 0x51, 0x75, 0x65, 0x75, 0x65, 0x52, 0x65,
+	0x71, 0x1a, 0x10, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x25, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1f, 0x22, 0x1a, 0x2f, 0x76, 0x31,
+	0x2f, 0x70, 0x6f, 0x64, 0x63, 0x61, 0x73, 0x74, 0x2f, 0x73, 0x65, 0x74, 0x5f, 0x61, 0x75, 0x74,
+	0x6f, 0x5f, 0x71, 0x75, 0x65, 0x75, 0x65, 0x3a, 0x01, 0x2a, 0x12, 0x65, 0x0a, 0x0e, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x50, 0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x12, 0x19, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x6c, 0x61, 0x79,
+	0x6c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x10, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73,
+	0x2e, 0x50, 0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x22, 0x26, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x20, 0x22, 0x1b, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x6f, 0x64, 0x63, 0x61, 0x73, 0x74, 0x2f, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x5f, 0x70, 0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x3a, 0x01,
+	0x2a, 0x12, 0x60, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x50, 0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73, 0x74,
+	0x73, 0x12, 0x17, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x6c,
+	0x61, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x11, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x73, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x73, 0x22, 0x24, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x1e, 0x22, 0x19, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x6f, 0x64, 0x63, 0x61,
+	0x73, 0x74, 0x2f, 0x67, 0x65, 0x74, 0x5f, 0x70, 0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x73,
+	0x3a, 0x01, 0x2a, 0x12, 0x65, 0x0a, 0x0e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x6c, 0x61,
+	0x79, 0x6c, 0x69, 0x73, 0x74, 0x12, 0x19, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71,
+	0x1a, 0x10, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x6c, 0x69,
+	0x73, 0x74, 0x22, 0x26, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x20, 0x22, 0x1b, 0x2f, 0x76, 0x31, 0x2f,
+	0x70, 0x6f, 0x64, 0x63, 0x61, 0x73, 0x74, 0x2f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x70,
+	0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x3a, 0x01, 0x2a, 0x12, 0x65, 0x0a, 0x0e, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x50, 0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x12, 0x19, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x6c, 0x61, 0x79,
+	0x6c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x10, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73,
+	0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x26, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x20, 0x22, 0x1b, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x6f, 0x64, 0x63, 0x61, 0x73, 0x74, 0x2f, 0x64,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x5f, 0x70, 0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x3a, 0x01,
+	0x2a, 0x12, 0x75, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x50, 0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73, 0x74,
+	0x45, 0x70, 0x69, 0x73, 0x6f, 0x64, 0x65, 0x73, 0x12, 0x1e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x73, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x45, 0x70, 0x69,
+	0x73, 0x6f, 0x64, 0x65, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x10, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x73, 0x2e, 0x45, 0x70, 0x69, 0x73, 0x6f, 0x64, 0x65, 0x73, 0x22, 0x2c, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x26, 0x22, 0x21, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x6f, 0x64, 0x63, 0x61, 0x73, 0x74, 0x2f,
+	0x67, 0x65, 0x74, 0x5f, 0x70, 0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x5f, 0x65, 0x70, 0x69,
+	0x73, 0x6f, 0x64, 0x65, 0x73, 0x3a, 0x01, 0x2a, 0x12, 0x45, 0x0a, 0x04, 0x53, 0x79, 0x6e, 0x63,
+	0x12, 0x0f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x53, 0x79, 0x6e, 0x63, 0x52, 0x65,
+	0x71, 0x1a, 0x0f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x53, 0x79, 0x6e, 0x63, 0x52,
+	0x65, 0x73, 0x22, 0x1b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x15, 0x22, 0x10, 0x2f, 0x76, 0x31, 0x2f,
+	0x70, 0x6f, 0x64, 0x63, 0x61, 0x73, 0x74, 0x2f, 0x73, 0x79, 0x6e, 0x63, 0x3a, 0x01, 0x2a, 0x12,
+	0x75, 0x0a, 0x13, 0x41, 0x64, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x69, 0x6e, 0x67, 0x53,
+	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e,
+	0x41, 0x64, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x69, 0x6e, 0x67, 0x53, 0x65, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x1a, 0x10, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x26,
+	0x22, 0x21, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x6f, 0x64, 0x63, 0x61, 0x73, 0x74, 0x2f, 0x61, 0x64,
+	0x64, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x73, 0x65, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x3a, 0x01, 0x2a, 0x12, 0x58, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x48, 0x69, 0x73,
+	0x74, 0x6f, 0x72, 0x79, 0x12, 0x15, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x47, 0x65,
+	0x74, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x1a, 0x0f, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x22, 0x22, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x1c, 0x22, 0x17, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x6f, 0x64, 0x63, 0x61, 0x73,
+	0x74, 0x2f, 0x67, 0x65, 0x74, 0x5f, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x3a, 0x01, 0x2a,
+	0x12, 0x50, 0x0a, 0x08, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x13, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65,
+	0x71, 0x1a, 0x0d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x73,
+	0x22, 0x20, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1a, 0x22, 0x15, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x6f,
+	0x64, 0x63, 0x61, 0x73, 0x74, 0x2f, 0x67, 0x65, 0x74, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x73, 0x3a,
+	0x01, 0x2a, 0x12, 0x65, 0x0a, 0x0e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x6f, 0x6f, 0x6b,
+	0x6d, 0x61, 0x72, 0x6b, 0x12, 0x19, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x42, 0x6f, 0x6f, 0x6b, 0x6d, 0x61, 0x72, 0x6b, 0x52, 0x65, 0x71, 0x1a,
+	0x10, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x42, 0x6f, 0x6f, 0x6b, 0x6d, 0x61, 0x72,
+	0x6b, 0x22, 0x26, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x20, 0x22, 0x1b, 0x2f, 0x76, 0x31, 0x2f, 0x70,
+	0x6f, 0x64, 0x63, 0x61, 0x73, 0x74, 0x2f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x5f, 0x62, 0x6f,
+	0x6f, 0x6b, 0x6d, 0x61, 0x72, 0x6b, 0x3a, 0x01, 0x2a, 0x12, 0x60, 0x0a, 0x0c, 0x47, 0x65, 0x74,
+	0x42, 0x6f, 0x6f, 0x6b, 0x6d, 0x61, 0x72, 0x6b, 0x73, 0x12, 0x17, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x6f, 0x6f, 0x6b, 0x6d, 0x61, 0x72, 0x6b, 0x73, 0x52,
+	0x65, 0x71, 0x1a, 0x11, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x42, 0x6f, 0x6f, 0x6b,
+	0x6d, 0x61, 0x72, 0x6b, 0x73, 0x22, 0x24, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1e, 0x22, 0x19, 0x2f,
+	0x76, 0x31, 0x2f, 0x70, 0x6f, 0x64, 0x63, 0x61, 0x73, 0x74, 0x2f, 0x67, 0x65, 0x74, 0x5f, 0x62,
+	0x6f, 0x6f, 0x6b, 0x6d, 0x61, 0x72, 0x6b, 0x73, 0x3a, 0x01, 0x2a, 0x12, 0x65, 0x0a, 0x0e, 0x44,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x42, 0x6f, 0x6f, 0x6b, 0x6d, 0x61, 0x72, 0x6b, 0x12, 0x19, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x42, 0x6f, 0x6f,
+	0x6b, 0x6d, 0x61, 0x72, 0x6b, 0x52, 0x65, 0x71, 0x1a, 0x10, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x73, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x26, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x20, 0x22, 0x1b, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x6f, 0x64, 0x63, 0x61, 0x73, 0x74, 0x2f,
+	0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x5f, 0x62, 0x6f, 0x6f, 0x6b, 0x6d, 0x61, 0x72, 0x6b, 0x3a,
+	0x01, 0x2a, 0x12, 0x55, 0x0a, 0x0a, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x6c, 0x69, 0x70,
+	0x12, 0x15, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x43, 0x6c, 0x69, 0x70, 0x52, 0x65, 0x71, 0x1a, 0x0c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73,
+	0x2e, 0x43, 0x6c, 0x69, 0x70, 0x22, 0x22, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1c, 0x22, 0x17, 0x2f,
+	0x76, 0x31, 0x2f, 0x70, 0x6f, 0x64, 0x63, 0x61, 0x73, 0x74, 0x2f, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x5f, 0x63, 0x6c, 0x69, 0x70, 0x3a, 0x01, 0x2a, 0x12, 0x50, 0x0a, 0x08, 0x47, 0x65, 0x74,
+	0x43, 0x6c, 0x69, 0x70, 0x73, 0x12, 0x13, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x47,
+	0x65, 0x74, 0x43, 0x6c, 0x69, 0x70, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x0d, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x73, 0x2e, 0x43, 0x6c, 0x69, 0x70, 0x73, 0x22, 0x20, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x1a, 0x22, 0x15, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x6f, 0x64, 0x63, 0x61, 0x73, 0x74, 0x2f, 0x67,
+	0x65, 0x74, 0x5f, 0x63, 0x6c, 0x69, 0x70, 0x73, 0x3a, 0x01, 0x2a, 0x12, 0x59, 0x0a, 0x0a, 0x44,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x6c, 0x69, 0x70, 0x12, 0x15, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x73, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x6c, 0x69, 0x70, 0x52, 0x65, 0x71,
+	0x1a, 0x10, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x22, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1c, 0x22, 0x17, 0x2f, 0x76, 0x31, 0x2f,
+	0x70, 0x6f, 0x64, 0x63, 0x61, 0x73, 0x74, 0x2f, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x5f, 0x63,
+	0x6c, 0x69, 0x70, 0x3a, 0x01, 0x2a, 0x12, 0x79, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x64,
+	0x63, 0x61, 0x73, 0x74, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x1d, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x64, 0x63, 0x61, 0x73, 0x74,
+	0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x17, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x50, 0x6f, 0x64, 0x63, 0x61, 0x73, 0x74, 0x53, 0x65, 0x74, 0x74,
+	0x69, 0x6e, 0x67, 0x73, 0x22, 0x2b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x25, 0x22, 0x20, 0x2f, 0x76,
+	0x31, 0x2f, 0x70, 0x6f, 0x64, 0x63, 0x61, 0x73, 0x74, 0x2f, 0x67, 0x65, 0x74, 0x5f, 0x70, 0x6f,
+	0x64, 0x63, 0x61, 0x73, 0x74, 0x5f, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x3a, 0x01,
+	0x2a, 0x12, 0x79, 0x0a, 0x15, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x64, 0x63, 0x61,
+	0x73, 0x74, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x17, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x73, 0x2e, 0x50, 0x6f, 0x64, 0x63, 0x61, 0x73, 0x74, 0x53, 0x65, 0x74, 0x74, 0x69,
+	0x6e, 0x67, 0x73, 0x1a, 0x17, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x50, 0x6f, 0x64,
+	0x63, 0x61, 0x73, 0x74, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x22, 0x2e, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x28, 0x22, 0x23, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x6f, 0x64, 0x63, 0x61, 0x73,
+	0x74, 0x2f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x70, 0x6f, 0x64, 0x63, 0x61, 0x73, 0x74,
+	0x5f, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x3a, 0x01, 0x2a, 0x12, 0x75, 0x0a, 0x11,
+	0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x4c, 0x61, 0x73, 0x74, 0x50, 0x6c, 0x61, 0x79, 0x65,
+	0x64, 0x12, 0x1c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x73,
+	0x65, 0x72, 0x4c, 0x61, 0x73, 0x74, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x64, 0x52, 0x65, 0x71, 0x1a,
+	0x15, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x4c, 0x61, 0x73, 0x74, 0x50, 0x6c, 0x61,
+	0x79, 0x65, 0x64, 0x52, 0x65, 0x73, 0x22, 0x2b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x25, 0x22, 0x20,
+	0x2f, 0x76, 0x31, 0x2f, 0x70, 0x6f, 0x64, 0x63, 0x61, 0x73, 0x74, 0x2f, 0x67, 0x65, 0x74, 0x5f,
+	0x75, 0x73, 0x65, 0x72, 0x5f, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x64,
+	0x3a, 0x01, 0x2a, 0x42, 0x0a, 0x5a, 0x08, 0x2e, 0x3b, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x62,
+	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_podcast_proto_rawDescOnce sync.Once
+	file_podcast_proto_rawDescData = file_podcast_proto_rawDesc
+)
+
+func file_podcast_proto_rawDescGZIP() []byte {
+	file_podcast_proto_rawDescOnce.Do(func() {
+		file_podcast_proto_rawDescData = protoimpl.X.CompressGZIP(file_podcast_proto_rawDescData)
+	})
+	return file_podcast_proto_rawDescData
+}
+
+var file_podcast_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
+var file_podcast_proto_msgTypes = make([]protoimpl.MessageInfo, 62)
+var file_podcast_proto_goTypes = []interface{}{
+	(PodcastSort)(0),               // 0: protos.PodcastSort
+	(ChartType)(0),                 // 1: protos.ChartType
+	(StatsBucket)(0),               // 2: protos.StatsBucket
+	(*Image)(nil),                  // 3: protos.Image
+	(*Category)(nil),               // 4: protos.Category
+	(*Podcast)(nil),                // 5: protos.Podcast
+	(*Episode)(nil),                // 6: protos.Episode
+	(*GetPodReq)(nil),              // 7: protos.GetPodReq
+	(*Request)(nil),                // 8: protos.Request
+	(*GetEpiReq)(nil),              // 9: protos.GetEpiReq
+	(*GetUserEpiReq)(nil),          // 10: protos.GetUserEpiReq
+	(*GetSubReq)(nil),              // 11: protos.GetSubReq
+	(*GetUserLastPlayedReq)(nil),   // 12: protos.GetUserLastPlayedReq
+	(*Response)(nil),               // 13: protos.Response
+	(*UpsertUserEpiRes)(nil),       // 14: protos.UpsertUserEpiRes
+	(*LastPlayedRes)(nil),          // 15: protos.LastPlayedRes
+	(*Subscriptions)(nil),          // 16: protos.Subscriptions
+	(*Episodes)(nil),               // 17: protos.Episodes
+	(*Podcasts)(nil),               // 18: protos.Podcasts
+	(*Categories)(nil),             // 19: protos.Categories
+	(*ListCategoriesReq)(nil),      // 20: protos.ListCategoriesReq
+	(*BrowseCategoryReq)(nil),      // 21: protos.BrowseCategoryReq
+	(*GetChartsReq)(nil),           // 22: protos.GetChartsReq
+	(*GetRecommendationsReq)(nil),  // 23: protos.GetRecommendationsReq
+	(*GetSimilarPodcastsReq)(nil),  // 24: protos.GetSimilarPodcastsReq
+	(*GetQueueReq)(nil),            // 25: protos.GetQueueReq
+	(*AddToQueueReq)(nil),          // 26: protos.AddToQueueReq
+	(*RemoveFromQueueReq)(nil),     // 27: protos.RemoveFromQueueReq
+	(*ReorderQueueReq)(nil),        // 28: protos.ReorderQueueReq
+	(*ClearQueueReq)(nil),          // 29: protos.ClearQueueReq
+	(*SetAutoQueueReq)(nil),        // 30: protos.SetAutoQueueReq
+	(*Playlist)(nil),               // 31: protos.Playlist
+	(*PlaylistRules)(nil),          // 32: protos.PlaylistRules
+	(*Playlists)(nil),              // 33: protos.Playlists
+	(*CreatePlaylistReq)(nil),      // 34: protos.CreatePlaylistReq
+	(*GetPlaylistsReq)(nil),        // 35: protos.GetPlaylistsReq
+	(*UpdatePlaylistReq)(nil),      // 36: protos.UpdatePlaylistReq
+	(*DeletePlaylistReq)(nil),      // 37: protos.DeletePlaylistReq
+	(*GetPlaylistEpisodesReq)(nil), // 38: protos.GetPlaylistEpisodesReq
+	(*SyncReq)(nil),                // 39: protos.SyncReq
+	(*SyncRes)(nil),                // 40: protos.SyncRes
+	(*ListeningSession)(nil),       // 41: protos.ListeningSession
+	(*AddListeningSessionReq)(nil), // 42: protos.AddListeningSessionReq
+	(*GetHistoryReq)(nil),          // 43: protos.GetHistoryReq
+	(*History)(nil),                // 44: protos.History
+	(*GetStatsReq)(nil),            // 45: protos.GetStatsReq
+	(*ListeningTotal)(nil),         // 46: protos.ListeningTotal
+	(*PodcastListening)(nil),       // 47: protos.PodcastListening
+	(*Stats)(nil),                  // 48: protos.Stats
+	(*Bookmark)(nil),               // 49: protos.Bookmark
+	(*Bookmarks)(nil),              // 50: protos.Bookmarks
+	(*CreateBookmarkReq)(nil),      // 51: protos.CreateBookmarkReq
+	(*GetBookmarksReq)(nil),        // 52: protos.GetBookmarksReq
+	(*DeleteBookmarkReq)(nil),      // 53: protos.DeleteBookmarkReq
+	(*Clip)(nil),                   // 54: protos.Clip
+	(*Clips)(nil),                  // 55: protos.Clips
+	(*CreateClipReq)(nil),          // 56: protos.CreateClipReq
+	(*GetClipsReq)(nil),            // 57: protos.GetClipsReq
+	(*DeleteClipReq)(nil),          // 58: protos.DeleteClipReq
+	(*GetPodcastSettingsReq)(nil),  // 59: protos.GetPodcastSettingsReq
+	(*UpsertUserEpisodesReq)(nil),  // 60: protos.UpsertUserEpisodesReq
+	(*UpsertUserEpisodesRes)(nil),  // 61: protos.UpsertUserEpisodesRes
+	(*MarkPodcastPlayedReq)(nil),   // 62: protos.MarkPodcastPlayedReq
+	(*MarkPodcastPlayedRes)(nil),   // 63: protos.MarkPodcastPlayedRes
+	(*MarkEpisodeUnplayedReq)(nil), // 64: protos.MarkEpisodeUnplayedReq
+	(*timestamppb.Timestamp)(nil),  // 65: google.protobuf.Timestamp
+	(*UserEpisode)(nil),            // 66: protos.UserEpisode
+	(*Subscription)(nil),           // 67: protos.Subscription
+	(*PodcastSettings)(nil),        // 68: protos.PodcastSettings
+}
+var file_podcast_proto_depIdxs = []int32{
+	4,  // 0: protos.Category.category:type_name -> protos.Category
+	3,  // 1: protos.Podcast.image:type_name -> protos.Image
+	4,  // 2: protos.Podcast.category:type_name -> protos.Category
+	65, // 3: protos.Podcast.pubDate:type_name -> google.protobuf.Timestamp
+	65, // 4: protos.Podcast.lastBuildDate:type_name -> google.protobuf.Timestamp
+	3,  // 5: protos.Episode.image:type_name -> protos.Image
+	65, // 6: protos.Episode.pubDate:type_name -> google.protobuf.Timestamp
+	66, // 7: protos.UpsertUserEpiRes.userEpisode:type_name -> protos.UserEpisode
+	5,  // 8: protos.LastPlayedRes.podcast:type_name -> protos.Podcast
+	6,  // 9: protos.LastPlayedRes.episode:type_name -> protos.Episode
+	67, // 10: protos.Subscriptions.subscriptions:type_name -> protos.Subscription
+	6,  // 11: protos.Episodes.episodes:type_name -> protos.Episode
+	5,  // 12: protos.Podcasts.podcasts:type_name -> protos.Podcast
+	4,  // 13: protos.Categories.categories:type_name -> protos.Category
+	0,  // 14: protos.BrowseCategoryReq.sort:type_name -> protos.PodcastSort
+	1,  // 15: protos.GetChartsReq.type:type_name -> protos.ChartType
+	32, // 16: protos.Playlist.rules:type_name -> protos.PlaylistRules
+	65, // 17: protos.Playlist.created:type_name -> google.protobuf.Timestamp
+	65, // 18: protos.Playlist.updated:type_name -> google.protobuf.Timestamp
+	31, // 19: protos.Playlists.playlists:type_name -> protos.Playlist
+	32, // 20: protos.CreatePlaylistReq.rules:type_name -> protos.PlaylistRules
+	32, // 21: protos.UpdatePlaylistReq.rules:type_name -> protos.PlaylistRules
+	67, // 22: protos.SyncRes.subscriptions:type_name -> protos.Subscription
+	66, // 23: protos.SyncRes.userEpisodes:type_name -> protos.UserEpisode
+	6,  // 24: protos.SyncRes.queue:type_name -> protos.Episode
+	6,  // 25: protos.SyncRes.newEpisodes:type_name -> protos.Episode
+	65, // 26: protos.ListeningSession.started:type_name -> google.protobuf.Timestamp
+	65, // 27: protos.ListeningSession.ended:type_name -> google.protobuf.Timestamp
+	41, // 28: protos.AddListeningSessionReq.session:type_name -> protos.ListeningSession
+	41, // 29: protos.History.sessions:type_name -> protos.ListeningSession
+	6,  // 30: protos.History.episodes:type_name -> protos.Episode
+	2,  // 31: protos.GetStatsReq.bucket:type_name -> protos.StatsBucket
+	65, // 32: protos.GetStatsReq.from:type_name -> google.protobuf.Timestamp
+	65, // 33: protos.GetStatsReq.to:type_name -> google.protobuf.Timestamp
+	65, // 34: protos.ListeningTotal.start:type_name -> google.protobuf.Timestamp
+	5,  // 35: protos.PodcastListening.podcast:type_name -> protos.Podcast
+	46, // 36: protos.Stats.totals:type_name -> protos.ListeningTotal
+	47, // 37: protos.Stats.topPodcasts:type_name -> protos.PodcastListening
+	65, // 38: protos.Bookmark.created:type_name -> google.protobuf.Timestamp
+	49, // 39: protos.Bookmarks.bookmarks:type_name -> protos.Bookmark
+	65, // 40: protos.Clip.created:type_name -> google.protobuf.Timestamp
+	54, // 41: protos.Clips.clips:type_name -> protos.Clip
+	66, // 42: protos.UpsertUserEpisodesReq.userEpisodes:type_name -> protos.UserEpisode
+	14, // 43: protos.UpsertUserEpisodesRes.results:type_name -> protos.UpsertUserEpiRes
+	65, // 44: protos.MarkPodcastPlayedReq.before:type_name -> google.protobuf.Timestamp
+	7,  // 45: protos.Pod.GetPodcast:input_type -> protos.GetPodReq
+	9,  // 46: protos.Pod.GetEpisodes:input_type -> protos.GetEpiReq
+	10, // 47: protos.Pod.GetUserEpisode:input_type -> protos.GetUserEpiReq
+	66, // 48: protos.Pod.UpsertUserEpisode:input_type -> protos.UserEpisode
+	60, // 49: protos.Pod.UpsertUserEpisodes:input_type -> protos.UpsertUserEpisodesReq
+	62, // 50: protos.Pod.MarkPodcastPlayed:input_type -> protos.MarkPodcastPlayedReq
+	64, // 51: protos.Pod.MarkEpisodeUnplayed:input_type -> protos.MarkEpisodeUnplayedReq
+	11, // 52: protos.Pod.GetSubscriptions:input_type -> protos.GetSubReq
+	20, // 53: protos.Pod.ListCategories:input_type -> protos.ListCategoriesReq
+	21, // 54: protos.Pod.BrowseCategory:input_type -> protos.BrowseCategoryReq
+	22, // 55: protos.Pod.GetCharts:input_type -> protos.GetChartsReq
+	23, // 56: protos.Pod.GetRecommendations:input_type -> protos.GetRecommendationsReq
+	24, // 57: protos.Pod.GetSimilarPodcasts:input_type -> protos.GetSimilarPodcastsReq
+	25, // 58: protos.Pod.GetQueue:input_type -> protos.GetQueueReq
+	26, // 59: protos.Pod.AddToQueue:input_type -> protos.AddToQueueReq
+	27, // 60: protos.Pod.RemoveFromQueue:input_type -> protos.RemoveFromQueueReq
+	28, // 61: protos.Pod.ReorderQueue:input_type -> protos.ReorderQueueReq
+	29, // 62: protos.Pod.ClearQueue:input_type -> protos.ClearQueueReq
+	30, // 63: protos.Pod.SetAutoQueue:input_type -> protos.SetAutoQueueReq
+	34, // 64: protos.Pod.CreatePlaylist:input_type -> protos.CreatePlaylistReq
+	35, // 65: protos.Pod.GetPlaylists:input_type -> protos.GetPlaylistsReq
+	36, // 66: protos.Pod.UpdatePlaylist:input_type -> protos.UpdatePlaylistReq
+	37, // 67: protos.Pod.DeletePlaylist:input_type -> protos.DeletePlaylistReq
+	38, // 68: protos.Pod.GetPlaylistEpisodes:input_type -> protos.GetPlaylistEpisodesReq
+	39, // 69: protos.Pod.Sync:input_type -> protos.SyncReq
+	42, // 70: protos.Pod.AddListeningSession:input_type -> protos.AddListeningSessionReq
+	43, // 71: protos.Pod.GetHistory:input_type -> protos.GetHistoryReq
+	45, // 72: protos.Pod.GetStats:input_type -> protos.GetStatsReq
+	51, // 73: protos.Pod.CreateBookmark:input_type -> protos.CreateBookmarkReq
+	52, // 74: protos.Pod.GetBookmarks:input_type -> protos.GetBookmarksReq
+	53, // 75: protos.Pod.DeleteBookmark:input_type -> protos.DeleteBookmarkReq
+	56, // 76: protos.Pod.CreateClip:input_type -> protos.CreateClipReq
+	57, // 77: protos.Pod.GetClips:input_type -> protos.GetClipsReq
+	58, // 78: protos.Pod.DeleteClip:input_type -> protos.DeleteClipReq
+	59, // 79: protos.Pod.GetPodcastSettings:input_type -> protos.GetPodcastSettingsReq
+	68, // 80: protos.Pod.UpdatePodcastSettings:input_type -> protos.PodcastSettings
+	12, // 81: protos.Pod.GetUserLastPlayed:input_type -> protos.GetUserLastPlayedReq
+	5,  // 82: protos.Pod.GetPodcast:output_type -> protos.Podcast
+	17, // 83: protos.Pod.GetEpisodes:output_type -> protos.Episodes
+	66, // 84: protos.Pod.GetUserEpisode:output_type -> protos.UserEpisode
+	14, // 85: protos.Pod.UpsertUserEpisode:output_type -> protos.UpsertUserEpiRes
+	61, // 86: protos.Pod.UpsertUserEpisodes:output_type -> protos.UpsertUserEpisodesRes
+	63, // 87: protos.Pod.MarkPodcastPlayed:output_type -> protos.MarkPodcastPlayedRes
+	13, // 88: protos.Pod.MarkEpisodeUnplayed:output_type -> protos.Response
+	16, // 89: protos.Pod.GetSubscriptions:output_type -> protos.Subscriptions
+	19, // 90: protos.Pod.ListCategories:output_type -> protos.Categories
+	18, // 91: protos.Pod.BrowseCategory:output_type -> protos.Podcasts
+	18, // 92: protos.Pod.GetCharts:output_type -> protos.Podcasts
+	18, // 93: protos.Pod.GetRecommendations:output_type -> protos.Podcasts
+	18, // 94: protos.Pod.GetSimilarPodcasts:output_type -> protos.Podcasts
+	17, // 95: protos.Pod.GetQueue:output_type -> protos.Episodes
+	17, // 96: protos.Pod.AddToQueue:output_type -> protos.Episodes
+	17, // 97: protos.Pod.RemoveFromQueue:output_type -> protos.Episodes
+	17, // 98: protos.Pod.ReorderQueue:output_type -> protos.Episodes
+	13, // 99: protos.Pod.ClearQueue:output_type -> protos.Response
+	13, // 100: protos.Pod.SetAutoQueue:output_type -> protos.Response
+	31, // 101: protos.Pod.CreatePlaylist:output_type -> protos.Playlist
+	33, // 102: protos.Pod.GetPlaylists:output_type -> protos.Playlists
+	31, // 103: protos.Pod.UpdatePlaylist:output_type -> protos.Playlist
+	13, // 104: protos.Pod.DeletePlaylist:output_type -> protos.Response
+	17, // 105: protos.Pod.GetPlaylistEpisodes:output_type -> protos.Episodes
+	40, // 106: protos.Pod.Sync:output_type -> protos.SyncRes
+	13, // 107: protos.Pod.AddListeningSession:output_type -> protos.Response
+	44, // 108: protos.Pod.GetHistory:output_type -> protos.History
+	48, // 109: protos.Pod.GetStats:output_type -> protos.Stats
+	49, // 110: protos.Pod.CreateBookmark:output_type -> protos.Bookmark
+	50, // 111: protos.Pod.GetBookmarks:output_type -> protos.Bookmarks
+	13, // 112: protos.Pod.DeleteBookmark:output_type -> protos.Response
+	54, // 113: protos.Pod.CreateClip:output_type -> protos.Clip
+	55, // 114: protos.Pod.GetClips:output_type -> protos.Clips
+	13, // 115: protos.Pod.DeleteClip:output_type -> protos.Response
+	68, // 116: protos.Pod.GetPodcastSettings:output_type -> protos.PodcastSettings
+	68, // 117: protos.Pod.UpdatePodcastSettings:output_type -> protos.PodcastSettings
+	15, // 118: protos.Pod.GetUserLastPlayed:output_type -> protos.LastPlayedRes
+	82, // [82:119] is the sub-list for method output_type
+	45, // [45:82] is the sub-list for method input_type
+	45, // [45:45] is the sub-list for extension type_name
+	45, // [45:45] is the sub-list for extension extendee
+	0,  // [0:45] is the sub-list for field type_name
+}
+
+func init() { file_podcast_proto_init() }
+func file_podcast_proto_init() {
+	if File_podcast_proto != nil {
+		return
+	}
+	file_user_proto_init()
+	if !protoimpl.UnsafeEnabled {
 		file_podcast_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Image); i {
 			case 0:
@@ -1207,8 +4878,308 @@ func file_podcast_proto_init() {
 				return nil
 			}
 		}
-		file_podcast_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Category); i {
+		file_podcast_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Category); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_podcast_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Podcast); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_podcast_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Episode); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_podcast_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetPodReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_podcast_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Request); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_podcast_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetEpiReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_podcast_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetUserEpiReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_podcast_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetSubReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_podcast_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetUserLastPlayedReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_podcast_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Response); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_podcast_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpsertUserEpiRes); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_podcast_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LastPlayedRes); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_podcast_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Subscriptions); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_podcast_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Episodes); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_podcast_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Podcasts); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_podcast_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Categories); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_podcast_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListCategoriesReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_podcast_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BrowseCategoryReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_podcast_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetChartsReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_podcast_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetRecommendationsReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_podcast_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetSimilarPodcastsReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_podcast_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetQueueReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_podcast_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddToQueueReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_podcast_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemoveFromQueueReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_podcast_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReorderQueueReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_podcast_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ClearQueueReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1219,8 +5190,8 @@ func file_podcast_proto_init() {
 				return nil
 			}
 		}
-		file_podcast_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Podcast); i {
+		file_podcast_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetAutoQueueReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1231,8 +5202,8 @@ func file_podcast_proto_init() {
 				return nil
 			}
 		}
-		file_podcast_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Episode); i {
+		file_podcast_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Playlist); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1243,8 +5214,8 @@ func file_podcast_proto_init() {
 				return nil
 			}
 		}
-		file_podcast_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetPodReq); i {
+		file_podcast_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PlaylistRules); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1255,8 +5226,8 @@ func file_podcast_proto_init() {
 				return nil
 			}
 		}
-		file_podcast_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Request); i {
+		file_podcast_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Playlists); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1267,8 +5238,8 @@ func file_podcast_proto_init() {
 				return nil
 			}
 		}
-		file_podcast_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetEpiReq); i {
+		file_podcast_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreatePlaylistReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1279,8 +5250,8 @@ func file_podcast_proto_init() {
 				return nil
 			}
 		}
-		file_podcast_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetUserEpiReq); i {
+		file_podcast_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetPlaylistsReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1291,8 +5262,8 @@ func file_podcast_proto_init() {
 				return nil
 			}
 		}
-		file_podcast_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetSubReq); i {
+		file_podcast_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdatePlaylistReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1303,8 +5274,8 @@ func file_podcast_proto_init() {
 				return nil
 			}
 		}
-		file_podcast_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetUserLastPlayedReq); i {
+		file_podcast_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeletePlaylistReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1315,8 +5286,8 @@ func file_podcast_proto_init() {
 				return nil
 			}
 		}
-		file_podcast_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Response); i {
+		file_podcast_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetPlaylistEpisodesReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1327,8 +5298,8 @@ func file_podcast_proto_init() {
 				return nil
 			}
 		}
-		file_podcast_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*LastPlayedRes); i {
+		file_podcast_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SyncReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1339,8 +5310,8 @@ func file_podcast_proto_init() {
 				return nil
 			}
 		}
-		file_podcast_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Subscriptions); i {
+		file_podcast_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SyncRes); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1351,8 +5322,284 @@ func file_podcast_proto_init() {
 				return nil
 			}
 		}
-		file_podcast_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Episodes); i {
+		file_podcast_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListeningSession); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_podcast_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddListeningSessionReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_podcast_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetHistoryReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_podcast_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*History); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_podcast_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetStatsReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_podcast_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListeningTotal); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_podcast_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PodcastListening); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_podcast_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Stats); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_podcast_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Bookmark); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_podcast_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Bookmarks); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_podcast_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateBookmarkReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_podcast_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetBookmarksReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_podcast_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteBookmarkReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_podcast_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Clip); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_podcast_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Clips); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_podcast_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateClipReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_podcast_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetClipsReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_podcast_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteClipReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_podcast_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetPodcastSettingsReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_podcast_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpsertUserEpisodesReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_podcast_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpsertUserEpisodesRes); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_podcast_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MarkPodcastPlayedReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_podcast_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MarkPodcastPlayedRes); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_podcast_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MarkEpisodeUnplayedReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1369,13 +5616,14 @@ func file_podcast_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_podcast_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   14,
+			NumEnums:      3,
+			NumMessages:   62,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_podcast_proto_goTypes,
 		DependencyIndexes: file_podcast_proto_depIdxs,
+		EnumInfos:         file_podcast_proto_enumTypes,
 		MessageInfos:      file_podcast_proto_msgTypes,
 	}.Build()
 	File_podcast_proto = out.File
@@ -35,11 +35,82 @@ type Pod interface {
 	// UserEpisode
 	GetUserEpisode(context.Context, *GetUserEpiReq) (*UserEpisode, error)
 
-	UpsertUserEpisode(context.Context, *UserEpisode) (*Response, error)
+	UpsertUserEpisode(context.Context, *UserEpisode) (*UpsertUserEpiRes, error)
+
+	UpsertUserEpisodes(context.Context, *UpsertUserEpisodesReq) (*UpsertUserEpisodesRes, error)
+
+	MarkPodcastPlayed(context.Context, *MarkPodcastPlayedReq) (*MarkPodcastPlayedRes, error)
+
+	MarkEpisodeUnplayed(context.Context, *MarkEpisodeUnplayedReq) (*Response, error)
 
 	// Subscriptions
 	GetSubscriptions(context.Context, *GetSubReq) (*Subscriptions, error)
 
+	// Categories
+	ListCategories(context.Context, *ListCategoriesReq) (*Categories, error)
+
+	BrowseCategory(context.Context, *BrowseCategoryReq) (*Podcasts, error)
+
+	// Charts
+	GetCharts(context.Context, *GetChartsReq) (*Podcasts, error)
+
+	// Recommendations
+	GetRecommendations(context.Context, *GetRecommendationsReq) (*Podcasts, error)
+
+	GetSimilarPodcasts(context.Context, *GetSimilarPodcastsReq) (*Podcasts, error)
+
+	// Queue
+	GetQueue(context.Context, *GetQueueReq) (*Episodes, error)
+
+	AddToQueue(context.Context, *AddToQueueReq) (*Episodes, error)
+
+	RemoveFromQueue(context.Context, *RemoveFromQueueReq) (*Episodes, error)
+
+	ReorderQueue(context.Context, *ReorderQueueReq) (*Episodes, error)
+
+	ClearQueue(context.Context, *ClearQueueReq) (*Response, error)
+
+	SetAutoQueue(context.Context, *SetAutoQueueReq) (*Response, error)
+
+	// Playlists
+	CreatePlaylist(context.Context, *CreatePlaylistReq) (*Playlist, error)
+
+	GetPlaylists(context.Context, *GetPlaylistsReq) (*Playlists, error)
+
+	UpdatePlaylist(context.Context, *UpdatePlaylistReq) (*Playlist, error)
+
+	DeletePlaylist(context.Context, *DeletePlaylistReq) (*Response, error)
+
+	GetPlaylistEpisodes(context.Context, *GetPlaylistEpisodesReq) (*Episodes, error)
+
+	// Sync
+	Sync(context.Context, *SyncReq) (*SyncRes, error)
+
+	// History
+	AddListeningSession(context.Context, *AddListeningSessionReq) (*Response, error)
+
+	GetHistory(context.Context, *GetHistoryReq) (*History, error)
+
+	GetStats(context.Context, *GetStatsReq) (*Stats, error)
+
+	// Bookmarks & Clips
+	CreateBookmark(context.Context, *CreateBookmarkReq) (*Bookmark, error)
+
+	GetBookmarks(context.Context, *GetBookmarksReq) (*Bookmarks, error)
+
+	DeleteBookmark(context.Context, *DeleteBookmarkReq) (*Response, error)
+
+	CreateClip(context.Context, *CreateClipReq) (*Clip, error)
+
+	GetClips(context.Context, *GetClipsReq) (*Clips, error)
+
+	DeleteClip(context.Context, *DeleteClipReq) (*Response, error)
+
+	// Settings
+	GetPodcastSettings(context.Context, *GetPodcastSettingsReq) (*PodcastSettings, error)
+
+	UpdatePodcastSettings(context.Context, *PodcastSettings) (*PodcastSettings, error)
+
 	// Misc.
 	GetUserLastPlayed(context.Context, *GetUserLastPlayedReq) (*LastPlayedRes, error)
 }
@@ -50,7 +121,7 @@ type Pod interface {
 
 type podProtobufClient struct {
 	client      HTTPClient
-	urls        [6]string
+	urls        [37]string
 	interceptor twirp.Interceptor
 	opts        twirp.ClientOptions
 }
@@ -78,12 +149,43 @@ func NewPodProtobufClient(baseURL string, client HTTPClient, opts ...twirp.Clien
 	// Build method URLs: <baseURL>[<prefix>]/<package>.<Service>/<Method>
 	serviceURL := sanitizeBaseURL(baseURL)
 	serviceURL += baseServicePath(pathPrefix, "protos", "Pod")
-	urls := [6]string{
+	urls := [37]string{
 		serviceURL + "GetPodcast",
 		serviceURL + "GetEpisodes",
 		serviceURL + "GetUserEpisode",
 		serviceURL + "UpsertUserEpisode",
+		serviceURL + "UpsertUserEpisodes",
+		serviceURL + "MarkPodcastPlayed",
+		serviceURL + "MarkEpisodeUnplayed",
 		serviceURL + "GetSubscriptions",
+		serviceURL + "ListCategories",
+		serviceURL + "BrowseCategory",
+		serviceURL + "GetCharts",
+		serviceURL + "GetRecommendations",
+		serviceURL + "GetSimilarPodcasts",
+		serviceURL + "GetQueue",
+		serviceURL + "AddToQueue",
+		serviceURL + "RemoveFromQueue",
+		serviceURL + "ReorderQueue",
+		serviceURL + "ClearQueue",
+		serviceURL + "SetAutoQueue",
+		serviceURL + "CreatePlaylist",
+		serviceURL + "GetPlaylists",
+		serviceURL + "UpdatePlaylist",
+		serviceURL + "DeletePlaylist",
+		serviceURL + "GetPlaylistEpisodes",
+		serviceURL + "Sync",
+		serviceURL + "AddListeningSession",
+		serviceURL + "GetHistory",
+		serviceURL + "GetStats",
+		serviceURL + "CreateBookmark",
+		serviceURL + "GetBookmarks",
+		serviceURL + "DeleteBookmark",
+		serviceURL + "CreateClip",
+		serviceURL + "GetClips",
+		serviceURL + "DeleteClip",
+		serviceURL + "GetPodcastSettings",
+		serviceURL + "UpdatePodcastSettings",
 		serviceURL + "GetUserLastPlayed",
 	}
 
@@ -233,13 +335,13 @@ func (c *podProtobufClient) callGetUserEpisode(ctx context.Context, in *GetUserE
 	return out, nil
 }
 
-func (c *podProtobufClient) UpsertUserEpisode(ctx context.Context, in *UserEpisode) (*Response, error) {
+func (c *podProtobufClient) UpsertUserEpisode(ctx context.Context, in *UserEpisode) (*UpsertUserEpiRes, error) {
 	ctx = ctxsetters.WithPackageName(ctx, "protos")
 	ctx = ctxsetters.WithServiceName(ctx, "Pod")
 	ctx = ctxsetters.WithMethodName(ctx, "UpsertUserEpisode")
 	caller := c.callUpsertUserEpisode
 	if c.interceptor != nil {
-		caller = func(ctx context.Context, req *UserEpisode) (*Response, error) {
+		caller = func(ctx context.Context, req *UserEpisode) (*UpsertUserEpiRes, error) {
 			resp, err := c.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
 					typedReq, ok := req.(*UserEpisode)
@@ -250,9 +352,9 @@ func (c *podProtobufClient) UpsertUserEpisode(ctx context.Context, in *UserEpiso
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*Response)
+				typedResp, ok := resp.(*UpsertUserEpiRes)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*Response) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*UpsertUserEpiRes) when calling interceptor")
 				}
 				return typedResp, err
 			}
@@ -262,8 +364,8 @@ func (c *podProtobufClient) UpsertUserEpisode(ctx context.Context, in *UserEpiso
 	return caller(ctx, in)
 }
 
-func (c *podProtobufClient) callUpsertUserEpisode(ctx context.Context, in *UserEpisode) (*Response, error) {
-	out := new(Response)
+func (c *podProtobufClient) callUpsertUserEpisode(ctx context.Context, in *UserEpisode) (*UpsertUserEpiRes, error) {
+	out := new(UpsertUserEpiRes)
 	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[3], in, out)
 	if err != nil {
 		twerr, ok := err.(twirp.Error)
@@ -279,26 +381,26 @@ func (c *podProtobufClient) callUpsertUserEpisode(ctx context.Context, in *UserE
 	return out, nil
 }
 
-func (c *podProtobufClient) GetSubscriptions(ctx context.Context, in *GetSubReq) (*Subscriptions, error) {
+func (c *podProtobufClient) UpsertUserEpisodes(ctx context.Context, in *UpsertUserEpisodesReq) (*UpsertUserEpisodesRes, error) {
 	ctx = ctxsetters.WithPackageName(ctx, "protos")
 	ctx = ctxsetters.WithServiceName(ctx, "Pod")
-	ctx = ctxsetters.WithMethodName(ctx, "GetSubscriptions")
-	caller := c.callGetSubscriptions
+	ctx = ctxsetters.WithMethodName(ctx, "UpsertUserEpisodes")
+	caller := c.callUpsertUserEpisodes
 	if c.interceptor != nil {
-		caller = func(ctx context.Context, req *GetSubReq) (*Subscriptions, error) {
+		caller = func(ctx context.Context, req *UpsertUserEpisodesReq) (*UpsertUserEpisodesRes, error) {
 			resp, err := c.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*GetSubReq)
+					typedReq, ok := req.(*UpsertUserEpisodesReq)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*GetSubReq) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*UpsertUserEpisodesReq) when calling interceptor")
 					}
-					return c.callGetSubscriptions(ctx, typedReq)
+					return c.callUpsertUserEpisodes(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*Subscriptions)
+				typedResp, ok := resp.(*UpsertUserEpisodesRes)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*Subscriptions) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*UpsertUserEpisodesRes) when calling interceptor")
 				}
 				return typedResp, err
 			}
@@ -308,8 +410,8 @@ func (c *podProtobufClient) GetSubscriptions(ctx context.Context, in *GetSubReq)
 	return caller(ctx, in)
 }
 
-func (c *podProtobufClient) callGetSubscriptions(ctx context.Context, in *GetSubReq) (*Subscriptions, error) {
-	out := new(Subscriptions)
+func (c *podProtobufClient) callUpsertUserEpisodes(ctx context.Context, in *UpsertUserEpisodesReq) (*UpsertUserEpisodesRes, error) {
+	out := new(UpsertUserEpisodesRes)
 	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[4], in, out)
 	if err != nil {
 		twerr, ok := err.(twirp.Error)
@@ -325,26 +427,26 @@ func (c *podProtobufClient) callGetSubscriptions(ctx context.Context, in *GetSub
 	return out, nil
 }
 
-func (c *podProtobufClient) GetUserLastPlayed(ctx context.Context, in *GetUserLastPlayedReq) (*LastPlayedRes, error) {
+func (c *podProtobufClient) MarkPodcastPlayed(ctx context.Context, in *MarkPodcastPlayedReq) (*MarkPodcastPlayedRes, error) {
 	ctx = ctxsetters.WithPackageName(ctx, "protos")
 	ctx = ctxsetters.WithServiceName(ctx, "Pod")
-	ctx = ctxsetters.WithMethodName(ctx, "GetUserLastPlayed")
-	caller := c.callGetUserLastPlayed
+	ctx = ctxsetters.WithMethodName(ctx, "MarkPodcastPlayed")
+	caller := c.callMarkPodcastPlayed
 	if c.interceptor != nil {
-		caller = func(ctx context.Context, req *GetUserLastPlayedReq) (*LastPlayedRes, error) {
+		caller = func(ctx context.Context, req *MarkPodcastPlayedReq) (*MarkPodcastPlayedRes, error) {
 			resp, err := c.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*GetUserLastPlayedReq)
+					typedReq, ok := req.(*MarkPodcastPlayedReq)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*GetUserLastPlayedReq) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*MarkPodcastPlayedReq) when calling interceptor")
 					}
-					return c.callGetUserLastPlayed(ctx, typedReq)
+					return c.callMarkPodcastPlayed(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*LastPlayedRes)
+				typedResp, ok := resp.(*MarkPodcastPlayedRes)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*LastPlayedRes) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*MarkPodcastPlayedRes) when calling interceptor")
 				}
 				return typedResp, err
 			}
@@ -354,8 +456,8 @@ func (c *podProtobufClient) GetUserLastPlayed(ctx context.Context, in *GetUserLa
 	return caller(ctx, in)
 }
 
-func (c *podProtobufClient) callGetUserLastPlayed(ctx context.Context, in *GetUserLastPlayedReq) (*LastPlayedRes, error) {
-	out := new(LastPlayedRes)
+func (c *podProtobufClient) callMarkPodcastPlayed(ctx context.Context, in *MarkPodcastPlayedReq) (*MarkPodcastPlayedRes, error) {
+	out := new(MarkPodcastPlayedRes)
 	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[5], in, out)
 	if err != nil {
 		twerr, ok := err.(twirp.Error)
@@ -371,77 +473,118 @@ func (c *podProtobufClient) callGetUserLastPlayed(ctx context.Context, in *GetUs
 	return out, nil
 }
 
-// ===============
-// Pod JSON Client
-// ===============
-
-type podJSONClient struct {
-	client      HTTPClient
-	urls        [6]string
-	interceptor twirp.Interceptor
-	opts        twirp.ClientOptions
+func (c *podProtobufClient) MarkEpisodeUnplayed(ctx context.Context, in *MarkEpisodeUnplayedReq) (*Response, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "protos")
+	ctx = ctxsetters.WithServiceName(ctx, "Pod")
+	ctx = ctxsetters.WithMethodName(ctx, "MarkEpisodeUnplayed")
+	caller := c.callMarkEpisodeUnplayed
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *MarkEpisodeUnplayedReq) (*Response, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*MarkEpisodeUnplayedReq)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*MarkEpisodeUnplayedReq) when calling interceptor")
+					}
+					return c.callMarkEpisodeUnplayed(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*Response)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*Response) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
 }
 
-// NewPodJSONClient creates a JSON client that implements the Pod interface.
-// It communicates using JSON and can be configured with a custom HTTPClient.
-func NewPodJSONClient(baseURL string, client HTTPClient, opts ...twirp.ClientOption) Pod {
-	if c, ok := client.(*http.Client); ok {
-		client = withoutRedirects(c)
+func (c *podProtobufClient) callMarkEpisodeUnplayed(ctx context.Context, in *MarkEpisodeUnplayedReq) (*Response, error) {
+	out := new(Response)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[6], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
 	}
 
-	clientOpts := twirp.ClientOptions{}
-	for _, o := range opts {
-		o(&clientOpts)
-	}
+	callClientResponseReceived(ctx, c.opts.Hooks)
 
-	// Using ReadOpt allows backwards and forwads compatibility with new options in the future
-	literalURLs := false
-	_ = clientOpts.ReadOpt("literalURLs", &literalURLs)
-	var pathPrefix string
-	if ok := clientOpts.ReadOpt("pathPrefix", &pathPrefix); !ok {
-		pathPrefix = "/twirp" // default prefix
-	}
+	return out, nil
+}
 
-	// Build method URLs: <baseURL>[<prefix>]/<package>.<Service>/<Method>
-	serviceURL := sanitizeBaseURL(baseURL)
-	serviceURL += baseServicePath(pathPrefix, "protos", "Pod")
-	urls := [6]string{
-		serviceURL + "GetPodcast",
-		serviceURL + "GetEpisodes",
-		serviceURL + "GetUserEpisode",
-		serviceURL + "UpsertUserEpisode",
-		serviceURL + "GetSubscriptions",
-		serviceURL + "GetUserLastPlayed",
+func (c *podProtobufClient) GetSubscriptions(ctx context.Context, in *GetSubReq) (*Subscriptions, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "protos")
+	ctx = ctxsetters.WithServiceName(ctx, "Pod")
+	ctx = ctxsetters.WithMethodName(ctx, "GetSubscriptions")
+	caller := c.callGetSubscriptions
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *GetSubReq) (*Subscriptions, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*GetSubReq)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*GetSubReq) when calling interceptor")
+					}
+					return c.callGetSubscriptions(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*Subscriptions)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*Subscriptions) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
 	}
+	return caller(ctx, in)
+}
 
-	return &podJSONClient{
-		client:      client,
-		urls:        urls,
-		interceptor: twirp.ChainInterceptors(clientOpts.Interceptors...),
-		opts:        clientOpts,
+func (c *podProtobufClient) callGetSubscriptions(ctx context.Context, in *GetSubReq) (*Subscriptions, error) {
+	out := new(Subscriptions)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[7], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
 	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
 }
 
-func (c *podJSONClient) GetPodcast(ctx context.Context, in *GetPodReq) (*Podcast, error) {
+func (c *podProtobufClient) ListCategories(ctx context.Context, in *ListCategoriesReq) (*Categories, error) {
 	ctx = ctxsetters.WithPackageName(ctx, "protos")
 	ctx = ctxsetters.WithServiceName(ctx, "Pod")
-	ctx = ctxsetters.WithMethodName(ctx, "GetPodcast")
-	caller := c.callGetPodcast
+	ctx = ctxsetters.WithMethodName(ctx, "ListCategories")
+	caller := c.callListCategories
 	if c.interceptor != nil {
-		caller = func(ctx context.Context, req *GetPodReq) (*Podcast, error) {
+		caller = func(ctx context.Context, req *ListCategoriesReq) (*Categories, error) {
 			resp, err := c.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*GetPodReq)
+					typedReq, ok := req.(*ListCategoriesReq)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*GetPodReq) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*ListCategoriesReq) when calling interceptor")
 					}
-					return c.callGetPodcast(ctx, typedReq)
+					return c.callListCategories(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*Podcast)
+				typedResp, ok := resp.(*Categories)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*Podcast) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*Categories) when calling interceptor")
 				}
 				return typedResp, err
 			}
@@ -451,9 +594,9 @@ func (c *podJSONClient) GetPodcast(ctx context.Context, in *GetPodReq) (*Podcast
 	return caller(ctx, in)
 }
 
-func (c *podJSONClient) callGetPodcast(ctx context.Context, in *GetPodReq) (*Podcast, error) {
-	out := new(Podcast)
-	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[0], in, out)
+func (c *podProtobufClient) callListCategories(ctx context.Context, in *ListCategoriesReq) (*Categories, error) {
+	out := new(Categories)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[8], in, out)
 	if err != nil {
 		twerr, ok := err.(twirp.Error)
 		if !ok {
@@ -468,26 +611,26 @@ func (c *podJSONClient) callGetPodcast(ctx context.Context, in *GetPodReq) (*Pod
 	return out, nil
 }
 
-func (c *podJSONClient) GetEpisodes(ctx context.Context, in *GetEpiReq) (*Episodes, error) {
+func (c *podProtobufClient) BrowseCategory(ctx context.Context, in *BrowseCategoryReq) (*Podcasts, error) {
 	ctx = ctxsetters.WithPackageName(ctx, "protos")
 	ctx = ctxsetters.WithServiceName(ctx, "Pod")
-	ctx = ctxsetters.WithMethodName(ctx, "GetEpisodes")
-	caller := c.callGetEpisodes
+	ctx = ctxsetters.WithMethodName(ctx, "BrowseCategory")
+	caller := c.callBrowseCategory
 	if c.interceptor != nil {
-		caller = func(ctx context.Context, req *GetEpiReq) (*Episodes, error) {
+		caller = func(ctx context.Context, req *BrowseCategoryReq) (*Podcasts, error) {
 			resp, err := c.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*GetEpiReq)
+					typedReq, ok := req.(*BrowseCategoryReq)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*GetEpiReq) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*BrowseCategoryReq) when calling interceptor")
 					}
-					return c.callGetEpisodes(ctx, typedReq)
+					return c.callBrowseCategory(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*Episodes)
+				typedResp, ok := resp.(*Podcasts)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*Episodes) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*Podcasts) when calling interceptor")
 				}
 				return typedResp, err
 			}
@@ -497,9 +640,9 @@ func (c *podJSONClient) GetEpisodes(ctx context.Context, in *GetEpiReq) (*Episod
 	return caller(ctx, in)
 }
 
-func (c *podJSONClient) callGetEpisodes(ctx context.Context, in *GetEpiReq) (*Episodes, error) {
-	out := new(Episodes)
-	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[1], in, out)
+func (c *podProtobufClient) callBrowseCategory(ctx context.Context, in *BrowseCategoryReq) (*Podcasts, error) {
+	out := new(Podcasts)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[9], in, out)
 	if err != nil {
 		twerr, ok := err.(twirp.Error)
 		if !ok {
@@ -514,26 +657,26 @@ func (c *podJSONClient) callGetEpisodes(ctx context.Context, in *GetEpiReq) (*Ep
 	return out, nil
 }
 
-func (c *podJSONClient) GetUserEpisode(ctx context.Context, in *GetUserEpiReq) (*UserEpisode, error) {
+func (c *podProtobufClient) GetCharts(ctx context.Context, in *GetChartsReq) (*Podcasts, error) {
 	ctx = ctxsetters.WithPackageName(ctx, "protos")
 	ctx = ctxsetters.WithServiceName(ctx, "Pod")
-	ctx = ctxsetters.WithMethodName(ctx, "GetUserEpisode")
-	caller := c.callGetUserEpisode
+	ctx = ctxsetters.WithMethodName(ctx, "GetCharts")
+	caller := c.callGetCharts
 	if c.interceptor != nil {
-		caller = func(ctx context.Context, req *GetUserEpiReq) (*UserEpisode, error) {
+		caller = func(ctx context.Context, req *GetChartsReq) (*Podcasts, error) {
 			resp, err := c.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*GetUserEpiReq)
+					typedReq, ok := req.(*GetChartsReq)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*GetUserEpiReq) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*GetChartsReq) when calling interceptor")
 					}
-					return c.callGetUserEpisode(ctx, typedReq)
+					return c.callGetCharts(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*UserEpisode)
+				typedResp, ok := resp.(*Podcasts)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*UserEpisode) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*Podcasts) when calling interceptor")
 				}
 				return typedResp, err
 			}
@@ -543,9 +686,9 @@ func (c *podJSONClient) GetUserEpisode(ctx context.Context, in *GetUserEpiReq) (
 	return caller(ctx, in)
 }
 
-func (c *podJSONClient) callGetUserEpisode(ctx context.Context, in *GetUserEpiReq) (*UserEpisode, error) {
-	out := new(UserEpisode)
-	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[2], in, out)
+func (c *podProtobufClient) callGetCharts(ctx context.Context, in *GetChartsReq) (*Podcasts, error) {
+	out := new(Podcasts)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[10], in, out)
 	if err != nil {
 		twerr, ok := err.(twirp.Error)
 		if !ok {
@@ -560,26 +703,26 @@ func (c *podJSONClient) callGetUserEpisode(ctx context.Context, in *GetUserEpiRe
 	return out, nil
 }
 
-func (c *podJSONClient) UpsertUserEpisode(ctx context.Context, in *UserEpisode) (*Response, error) {
+func (c *podProtobufClient) GetRecommendations(ctx context.Context, in *GetRecommendationsReq) (*Podcasts, error) {
 	ctx = ctxsetters.WithPackageName(ctx, "protos")
 	ctx = ctxsetters.WithServiceName(ctx, "Pod")
-	ctx = ctxsetters.WithMethodName(ctx, "UpsertUserEpisode")
-	caller := c.callUpsertUserEpisode
+	ctx = ctxsetters.WithMethodName(ctx, "GetRecommendations")
+	caller := c.callGetRecommendations
 	if c.interceptor != nil {
-		caller = func(ctx context.Context, req *UserEpisode) (*Response, error) {
+		caller = func(ctx context.Context, req *GetRecommendationsReq) (*Podcasts, error) {
 			resp, err := c.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*UserEpisode)
+					typedReq, ok := req.(*GetRecommendationsReq)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*UserEpisode) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*GetRecommendationsReq) when calling interceptor")
 					}
-					return c.callUpsertUserEpisode(ctx, typedReq)
+					return c.callGetRecommendations(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*Response)
+				typedResp, ok := resp.(*Podcasts)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*Response) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*Podcasts) when calling interceptor")
 				}
 				return typedResp, err
 			}
@@ -589,9 +732,9 @@ func (c *podJSONClient) UpsertUserEpisode(ctx context.Context, in *UserEpisode)
 	return caller(ctx, in)
 }
 
-func (c *podJSONClient) callUpsertUserEpisode(ctx context.Context, in *UserEpisode) (*Response, error) {
-	out := new(Response)
-	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[3], in, out)
+func (c *podProtobufClient) callGetRecommendations(ctx context.Context, in *GetRecommendationsReq) (*Podcasts, error) {
+	out := new(Podcasts)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[11], in, out)
 	if err != nil {
 		twerr, ok := err.(twirp.Error)
 		if !ok {
@@ -606,26 +749,26 @@ func (c *podJSONClient) callUpsertUserEpisode(ctx context.Context, in *UserEpiso
 	return out, nil
 }
 
-func (c *podJSONClient) GetSubscriptions(ctx context.Context, in *GetSubReq) (*Subscriptions, error) {
+func (c *podProtobufClient) GetSimilarPodcasts(ctx context.Context, in *GetSimilarPodcastsReq) (*Podcasts, error) {
 	ctx = ctxsetters.WithPackageName(ctx, "protos")
 	ctx = ctxsetters.WithServiceName(ctx, "Pod")
-	ctx = ctxsetters.WithMethodName(ctx, "GetSubscriptions")
-	caller := c.callGetSubscriptions
+	ctx = ctxsetters.WithMethodName(ctx, "GetSimilarPodcasts")
+	caller := c.callGetSimilarPodcasts
 	if c.interceptor != nil {
-		caller = func(ctx context.Context, req *GetSubReq) (*Subscriptions, error) {
+		caller = func(ctx context.Context, req *GetSimilarPodcastsReq) (*Podcasts, error) {
 			resp, err := c.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*GetSubReq)
+					typedReq, ok := req.(*GetSimilarPodcastsReq)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*GetSubReq) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*GetSimilarPodcastsReq) when calling interceptor")
 					}
-					return c.callGetSubscriptions(ctx, typedReq)
+					return c.callGetSimilarPodcasts(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*Subscriptions)
+				typedResp, ok := resp.(*Podcasts)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*Subscriptions) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*Podcasts) when calling interceptor")
 				}
 				return typedResp, err
 			}
@@ -635,9 +778,9 @@ func (c *podJSONClient) GetSubscriptions(ctx context.Context, in *GetSubReq) (*S
 	return caller(ctx, in)
 }
 
-func (c *podJSONClient) callGetSubscriptions(ctx context.Context, in *GetSubReq) (*Subscriptions, error) {
-	out := new(Subscriptions)
-	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[4], in, out)
+func (c *podProtobufClient) callGetSimilarPodcasts(ctx context.Context, in *GetSimilarPodcastsReq) (*Podcasts, error) {
+	out := new(Podcasts)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[12], in, out)
 	if err != nil {
 		twerr, ok := err.(twirp.Error)
 		if !ok {
@@ -652,26 +795,26 @@ func (c *podJSONClient) callGetSubscriptions(ctx context.Context, in *GetSubReq)
 	return out, nil
 }
 
-func (c *podJSONClient) GetUserLastPlayed(ctx context.Context, in *GetUserLastPlayedReq) (*LastPlayedRes, error) {
+func (c *podProtobufClient) GetQueue(ctx context.Context, in *GetQueueReq) (*Episodes, error) {
 	ctx = ctxsetters.WithPackageName(ctx, "protos")
 	ctx = ctxsetters.WithServiceName(ctx, "Pod")
-	ctx = ctxsetters.WithMethodName(ctx, "GetUserLastPlayed")
-	caller := c.callGetUserLastPlayed
+	ctx = ctxsetters.WithMethodName(ctx, "GetQueue")
+	caller := c.callGetQueue
 	if c.interceptor != nil {
-		caller = func(ctx context.Context, req *GetUserLastPlayedReq) (*LastPlayedRes, error) {
+		caller = func(ctx context.Context, req *GetQueueReq) (*Episodes, error) {
 			resp, err := c.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*GetUserLastPlayedReq)
+					typedReq, ok := req.(*GetQueueReq)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*GetUserLastPlayedReq) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*GetQueueReq) when calling interceptor")
 					}
-					return c.callGetUserLastPlayed(ctx, typedReq)
+					return c.callGetQueue(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*LastPlayedRes)
+				typedResp, ok := resp.(*Episodes)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*LastPlayedRes) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*Episodes) when calling interceptor")
 				}
 				return typedResp, err
 			}
@@ -681,9 +824,9 @@ func (c *podJSONClient) GetUserLastPlayed(ctx context.Context, in *GetUserLastPl
 	return caller(ctx, in)
 }
 
-func (c *podJSONClient) callGetUserLastPlayed(ctx context.Context, in *GetUserLastPlayedReq) (*LastPlayedRes, error) {
-	out := new(LastPlayedRes)
-	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[5], in, out)
+func (c *podProtobufClient) callGetQueue(ctx context.Context, in *GetQueueReq) (*Episodes, error) {
+	out := new(Episodes)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[13], in, out)
 	if err != nil {
 		twerr, ok := err.(twirp.Error)
 		if !ok {
@@ -698,25 +841,2867 @@ func (c *podJSONClient) callGetUserLastPlayed(ctx context.Context, in *GetUserLa
 	return out, nil
 }
 
-// ==================
-// Pod Server Handler
-// ==================
-
-type podServer struct {
-	Pod
-	interceptor      twirp.Interceptor
-	hooks            *twirp.ServerHooks
-	pathPrefix       string // prefix for routing
-	jsonSkipDefaults bool   // do not include unpopulated fields (default values) in the response
-	jsonCamelCase    bool   // JSON fields are serialized as lowerCamelCase rather than keeping the original proto names
-}
-
-// NewPodServer builds a TwirpServer that can be used as an http.Handler to handle
-// HTTP requests that are routed to the right method in the provided svc implementation.
-// The opts are twirp.ServerOption modifiers, for example twirp.WithServerHooks(hooks).
-func NewPodServer(svc Pod, opts ...interface{}) TwirpServer {
-	serverOpts := newServerOpts(opts)
-
+func (c *podProtobufClient) AddToQueue(ctx context.Context, in *AddToQueueReq) (*Episodes, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "protos")
+	ctx = ctxsetters.WithServiceName(ctx, "Pod")
+	ctx = ctxsetters.WithMethodName(ctx, "AddToQueue")
+	caller := c.callAddToQueue
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *AddToQueueReq) (*Episodes, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*AddToQueueReq)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*AddToQueueReq) when calling interceptor")
+					}
+					return c.callAddToQueue(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*Episodes)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*Episodes) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *podProtobufClient) callAddToQueue(ctx context.Context, in *AddToQueueReq) (*Episodes, error) {
+	out := new(Episodes)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[14], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *podProtobufClient) RemoveFromQueue(ctx context.Context, in *RemoveFromQueueReq) (*Episodes, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "protos")
+	ctx = ctxsetters.WithServiceName(ctx, "Pod")
+	ctx = ctxsetters.WithMethodName(ctx, "RemoveFromQueue")
+	caller := c.callRemoveFromQueue
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *RemoveFromQueueReq) (*Episodes, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*RemoveFromQueueReq)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*RemoveFromQueueReq) when calling interceptor")
+					}
+					return c.callRemoveFromQueue(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*Episodes)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*Episodes) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *podProtobufClient) callRemoveFromQueue(ctx context.Context, in *RemoveFromQueueReq) (*Episodes, error) {
+	out := new(Episodes)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[15], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *podProtobufClient) ReorderQueue(ctx context.Context, in *ReorderQueueReq) (*Episodes, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "protos")
+	ctx = ctxsetters.WithServiceName(ctx, "Pod")
+	ctx = ctxsetters.WithMethodName(ctx, "ReorderQueue")
+	caller := c.callReorderQueue
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *ReorderQueueReq) (*Episodes, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ReorderQueueReq)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ReorderQueueReq) when calling interceptor")
+					}
+					return c.callReorderQueue(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*Episodes)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*Episodes) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *podProtobufClient) callReorderQueue(ctx context.Context, in *ReorderQueueReq) (*Episodes, error) {
+	out := new(Episodes)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[16], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *podProtobufClient) ClearQueue(ctx context.Context, in *ClearQueueReq) (*Response, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "protos")
+	ctx = ctxsetters.WithServiceName(ctx, "Pod")
+	ctx = ctxsetters.WithMethodName(ctx, "ClearQueue")
+	caller := c.callClearQueue
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *ClearQueueReq) (*Response, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ClearQueueReq)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ClearQueueReq) when calling interceptor")
+					}
+					return c.callClearQueue(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*Response)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*Response) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *podProtobufClient) callClearQueue(ctx context.Context, in *ClearQueueReq) (*Response, error) {
+	out := new(Response)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[17], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *podProtobufClient) SetAutoQueue(ctx context.Context, in *SetAutoQueueReq) (*Response, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "protos")
+	ctx = ctxsetters.WithServiceName(ctx, "Pod")
+	ctx = ctxsetters.WithMethodName(ctx, "SetAutoQueue")
+	caller := c.callSetAutoQueue
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *SetAutoQueueReq) (*Response, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*SetAutoQueueReq)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*SetAutoQueueReq) when calling interceptor")
+					}
+					return c.callSetAutoQueue(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*Response)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*Response) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *podProtobufClient) callSetAutoQueue(ctx context.Context, in *SetAutoQueueReq) (*Response, error) {
+	out := new(Response)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[18], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *podProtobufClient) CreatePlaylist(ctx context.Context, in *CreatePlaylistReq) (*Playlist, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "protos")
+	ctx = ctxsetters.WithServiceName(ctx, "Pod")
+	ctx = ctxsetters.WithMethodName(ctx, "CreatePlaylist")
+	caller := c.callCreatePlaylist
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *CreatePlaylistReq) (*Playlist, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*CreatePlaylistReq)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*CreatePlaylistReq) when calling interceptor")
+					}
+					return c.callCreatePlaylist(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*Playlist)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*Playlist) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *podProtobufClient) callCreatePlaylist(ctx context.Context, in *CreatePlaylistReq) (*Playlist, error) {
+	out := new(Playlist)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[19], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *podProtobufClient) GetPlaylists(ctx context.Context, in *GetPlaylistsReq) (*Playlists, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "protos")
+	ctx = ctxsetters.WithServiceName(ctx, "Pod")
+	ctx = ctxsetters.WithMethodName(ctx, "GetPlaylists")
+	caller := c.callGetPlaylists
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *GetPlaylistsReq) (*Playlists, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*GetPlaylistsReq)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*GetPlaylistsReq) when calling interceptor")
+					}
+					return c.callGetPlaylists(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*Playlists)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*Playlists) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *podProtobufClient) callGetPlaylists(ctx context.Context, in *GetPlaylistsReq) (*Playlists, error) {
+	out := new(Playlists)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[20], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *podProtobufClient) UpdatePlaylist(ctx context.Context, in *UpdatePlaylistReq) (*Playlist, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "protos")
+	ctx = ctxsetters.WithServiceName(ctx, "Pod")
+	ctx = ctxsetters.WithMethodName(ctx, "UpdatePlaylist")
+	caller := c.callUpdatePlaylist
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *UpdatePlaylistReq) (*Playlist, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*UpdatePlaylistReq)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*UpdatePlaylistReq) when calling interceptor")
+					}
+					return c.callUpdatePlaylist(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*Playlist)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*Playlist) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *podProtobufClient) callUpdatePlaylist(ctx context.Context, in *UpdatePlaylistReq) (*Playlist, error) {
+	out := new(Playlist)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[21], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *podProtobufClient) DeletePlaylist(ctx context.Context, in *DeletePlaylistReq) (*Response, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "protos")
+	ctx = ctxsetters.WithServiceName(ctx, "Pod")
+	ctx = ctxsetters.WithMethodName(ctx, "DeletePlaylist")
+	caller := c.callDeletePlaylist
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *DeletePlaylistReq) (*Response, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*DeletePlaylistReq)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*DeletePlaylistReq) when calling interceptor")
+					}
+					return c.callDeletePlaylist(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*Response)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*Response) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *podProtobufClient) callDeletePlaylist(ctx context.Context, in *DeletePlaylistReq) (*Response, error) {
+	out := new(Response)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[22], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *podProtobufClient) GetPlaylistEpisodes(ctx context.Context, in *GetPlaylistEpisodesReq) (*Episodes, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "protos")
+	ctx = ctxsetters.WithServiceName(ctx, "Pod")
+	ctx = ctxsetters.WithMethodName(ctx, "GetPlaylistEpisodes")
+	caller := c.callGetPlaylistEpisodes
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *GetPlaylistEpisodesReq) (*Episodes, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*GetPlaylistEpisodesReq)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*GetPlaylistEpisodesReq) when calling interceptor")
+					}
+					return c.callGetPlaylistEpisodes(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*Episodes)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*Episodes) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *podProtobufClient) callGetPlaylistEpisodes(ctx context.Context, in *GetPlaylistEpisodesReq) (*Episodes, error) {
+	out := new(Episodes)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[23], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *podProtobufClient) Sync(ctx context.Context, in *SyncReq) (*SyncRes, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "protos")
+	ctx = ctxsetters.WithServiceName(ctx, "Pod")
+	ctx = ctxsetters.WithMethodName(ctx, "Sync")
+	caller := c.callSync
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *SyncReq) (*SyncRes, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*SyncReq)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*SyncReq) when calling interceptor")
+					}
+					return c.callSync(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*SyncRes)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*SyncRes) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *podProtobufClient) callSync(ctx context.Context, in *SyncReq) (*SyncRes, error) {
+	out := new(SyncRes)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[24], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *podProtobufClient) AddListeningSession(ctx context.Context, in *AddListeningSessionReq) (*Response, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "protos")
+	ctx = ctxsetters.WithServiceName(ctx, "Pod")
+	ctx = ctxsetters.WithMethodName(ctx, "AddListeningSession")
+	caller := c.callAddListeningSession
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *AddListeningSessionReq) (*Response, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*AddListeningSessionReq)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*AddListeningSessionReq) when calling interceptor")
+					}
+					return c.callAddListeningSession(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*Response)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*Response) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *podProtobufClient) callAddListeningSession(ctx context.Context, in *AddListeningSessionReq) (*Response, error) {
+	out := new(Response)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[25], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *podProtobufClient) GetHistory(ctx context.Context, in *GetHistoryReq) (*History, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "protos")
+	ctx = ctxsetters.WithServiceName(ctx, "Pod")
+	ctx = ctxsetters.WithMethodName(ctx, "GetHistory")
+	caller := c.callGetHistory
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *GetHistoryReq) (*History, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*GetHistoryReq)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*GetHistoryReq) when calling interceptor")
+					}
+					return c.callGetHistory(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*History)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*History) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *podProtobufClient) callGetHistory(ctx context.Context, in *GetHistoryReq) (*History, error) {
+	out := new(History)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[26], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *podProtobufClient) GetStats(ctx context.Context, in *GetStatsReq) (*Stats, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "protos")
+	ctx = ctxsetters.WithServiceName(ctx, "Pod")
+	ctx = ctxsetters.WithMethodName(ctx, "GetStats")
+	caller := c.callGetStats
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *GetStatsReq) (*Stats, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*GetStatsReq)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*GetStatsReq) when calling interceptor")
+					}
+					return c.callGetStats(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*Stats)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*Stats) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *podProtobufClient) callGetStats(ctx context.Context, in *GetStatsReq) (*Stats, error) {
+	out := new(Stats)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[27], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *podProtobufClient) CreateBookmark(ctx context.Context, in *CreateBookmarkReq) (*Bookmark, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "protos")
+	ctx = ctxsetters.WithServiceName(ctx, "Pod")
+	ctx = ctxsetters.WithMethodName(ctx, "CreateBookmark")
+	caller := c.callCreateBookmark
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *CreateBookmarkReq) (*Bookmark, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*CreateBookmarkReq)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*CreateBookmarkReq) when calling interceptor")
+					}
+					return c.callCreateBookmark(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*Bookmark)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*Bookmark) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *podProtobufClient) callCreateBookmark(ctx context.Context, in *CreateBookmarkReq) (*Bookmark, error) {
+	out := new(Bookmark)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[28], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *podProtobufClient) GetBookmarks(ctx context.Context, in *GetBookmarksReq) (*Bookmarks, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "protos")
+	ctx = ctxsetters.WithServiceName(ctx, "Pod")
+	ctx = ctxsetters.WithMethodName(ctx, "GetBookmarks")
+	caller := c.callGetBookmarks
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *GetBookmarksReq) (*Bookmarks, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*GetBookmarksReq)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*GetBookmarksReq) when calling interceptor")
+					}
+					return c.callGetBookmarks(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*Bookmarks)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*Bookmarks) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *podProtobufClient) callGetBookmarks(ctx context.Context, in *GetBookmarksReq) (*Bookmarks, error) {
+	out := new(Bookmarks)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[29], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *podProtobufClient) DeleteBookmark(ctx context.Context, in *DeleteBookmarkReq) (*Response, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "protos")
+	ctx = ctxsetters.WithServiceName(ctx, "Pod")
+	ctx = ctxsetters.WithMethodName(ctx, "DeleteBookmark")
+	caller := c.callDeleteBookmark
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *DeleteBookmarkReq) (*Response, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*DeleteBookmarkReq)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*DeleteBookmarkReq) when calling interceptor")
+					}
+					return c.callDeleteBookmark(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*Response)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*Response) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *podProtobufClient) callDeleteBookmark(ctx context.Context, in *DeleteBookmarkReq) (*Response, error) {
+	out := new(Response)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[30], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *podProtobufClient) CreateClip(ctx context.Context, in *CreateClipReq) (*Clip, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "protos")
+	ctx = ctxsetters.WithServiceName(ctx, "Pod")
+	ctx = ctxsetters.WithMethodName(ctx, "CreateClip")
+	caller := c.callCreateClip
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *CreateClipReq) (*Clip, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*CreateClipReq)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*CreateClipReq) when calling interceptor")
+					}
+					return c.callCreateClip(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*Clip)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*Clip) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *podProtobufClient) callCreateClip(ctx context.Context, in *CreateClipReq) (*Clip, error) {
+	out := new(Clip)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[31], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *podProtobufClient) GetClips(ctx context.Context, in *GetClipsReq) (*Clips, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "protos")
+	ctx = ctxsetters.WithServiceName(ctx, "Pod")
+	ctx = ctxsetters.WithMethodName(ctx, "GetClips")
+	caller := c.callGetClips
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *GetClipsReq) (*Clips, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*GetClipsReq)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*GetClipsReq) when calling interceptor")
+					}
+					return c.callGetClips(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*Clips)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*Clips) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *podProtobufClient) callGetClips(ctx context.Context, in *GetClipsReq) (*Clips, error) {
+	out := new(Clips)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[32], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *podProtobufClient) DeleteClip(ctx context.Context, in *DeleteClipReq) (*Response, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "protos")
+	ctx = ctxsetters.WithServiceName(ctx, "Pod")
+	ctx = ctxsetters.WithMethodName(ctx, "DeleteClip")
+	caller := c.callDeleteClip
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *DeleteClipReq) (*Response, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*DeleteClipReq)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*DeleteClipReq) when calling interceptor")
+					}
+					return c.callDeleteClip(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*Response)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*Response) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *podProtobufClient) callDeleteClip(ctx context.Context, in *DeleteClipReq) (*Response, error) {
+	out := new(Response)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[33], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *podProtobufClient) GetPodcastSettings(ctx context.Context, in *GetPodcastSettingsReq) (*PodcastSettings, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "protos")
+	ctx = ctxsetters.WithServiceName(ctx, "Pod")
+	ctx = ctxsetters.WithMethodName(ctx, "GetPodcastSettings")
+	caller := c.callGetPodcastSettings
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *GetPodcastSettingsReq) (*PodcastSettings, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*GetPodcastSettingsReq)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*GetPodcastSettingsReq) when calling interceptor")
+					}
+					return c.callGetPodcastSettings(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*PodcastSettings)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*PodcastSettings) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *podProtobufClient) callGetPodcastSettings(ctx context.Context, in *GetPodcastSettingsReq) (*PodcastSettings, error) {
+	out := new(PodcastSettings)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[34], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *podProtobufClient) UpdatePodcastSettings(ctx context.Context, in *PodcastSettings) (*PodcastSettings, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "protos")
+	ctx = ctxsetters.WithServiceName(ctx, "Pod")
+	ctx = ctxsetters.WithMethodName(ctx, "UpdatePodcastSettings")
+	caller := c.callUpdatePodcastSettings
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *PodcastSettings) (*PodcastSettings, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*PodcastSettings)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*PodcastSettings) when calling interceptor")
+					}
+					return c.callUpdatePodcastSettings(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*PodcastSettings)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*PodcastSettings) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *podProtobufClient) callUpdatePodcastSettings(ctx context.Context, in *PodcastSettings) (*PodcastSettings, error) {
+	out := new(PodcastSettings)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[35], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *podProtobufClient) GetUserLastPlayed(ctx context.Context, in *GetUserLastPlayedReq) (*LastPlayedRes, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "protos")
+	ctx = ctxsetters.WithServiceName(ctx, "Pod")
+	ctx = ctxsetters.WithMethodName(ctx, "GetUserLastPlayed")
+	caller := c.callGetUserLastPlayed
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *GetUserLastPlayedReq) (*LastPlayedRes, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*GetUserLastPlayedReq)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*GetUserLastPlayedReq) when calling interceptor")
+					}
+					return c.callGetUserLastPlayed(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*LastPlayedRes)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*LastPlayedRes) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *podProtobufClient) callGetUserLastPlayed(ctx context.Context, in *GetUserLastPlayedReq) (*LastPlayedRes, error) {
+	out := new(LastPlayedRes)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[36], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+// ===============
+// Pod JSON Client
+// ===============
+
+type podJSONClient struct {
+	client      HTTPClient
+	urls        [37]string
+	interceptor twirp.Interceptor
+	opts        twirp.ClientOptions
+}
+
+// NewPodJSONClient creates a JSON client that implements the Pod interface.
+// It communicates using JSON and can be configured with a custom HTTPClient.
+func NewPodJSONClient(baseURL string, client HTTPClient, opts ...twirp.ClientOption) Pod {
+	if c, ok := client.(*http.Client); ok {
+		client = withoutRedirects(c)
+	}
+
+	clientOpts := twirp.ClientOptions{}
+	for _, o := range opts {
+		o(&clientOpts)
+	}
+
+	// Using ReadOpt allows backwards and forwads compatibility with new options in the future
+	literalURLs := false
+	_ = clientOpts.ReadOpt("literalURLs", &literalURLs)
+	var pathPrefix string
+	if ok := clientOpts.ReadOpt("pathPrefix", &pathPrefix); !ok {
+		pathPrefix = "/twirp" // default prefix
+	}
+
+	// Build method URLs: <baseURL>[<prefix>]/<package>.<Service>/<Method>
+	serviceURL := sanitizeBaseURL(baseURL)
+	serviceURL += baseServicePath(pathPrefix, "protos", "Pod")
+	urls := [37]string{
+		serviceURL + "GetPodcast",
+		serviceURL + "GetEpisodes",
+		serviceURL + "GetUserEpisode",
+		serviceURL + "UpsertUserEpisode",
+		serviceURL + "UpsertUserEpisodes",
+		serviceURL + "MarkPodcastPlayed",
+		serviceURL + "MarkEpisodeUnplayed",
+		serviceURL + "GetSubscriptions",
+		serviceURL + "ListCategories",
+		serviceURL + "BrowseCategory",
+		serviceURL + "GetCharts",
+		serviceURL + "GetRecommendations",
+		serviceURL + "GetSimilarPodcasts",
+		serviceURL + "GetQueue",
+		serviceURL + "AddToQueue",
+		serviceURL + "RemoveFromQueue",
+		serviceURL + "ReorderQueue",
+		serviceURL + "ClearQueue",
+		serviceURL + "SetAutoQueue",
+		serviceURL + "CreatePlaylist",
+		serviceURL + "GetPlaylists",
+		serviceURL + "UpdatePlaylist",
+		serviceURL + "DeletePlaylist",
+		serviceURL + "GetPlaylistEpisodes",
+		serviceURL + "Sync",
+		serviceURL + "AddListeningSession",
+		serviceURL + "GetHistory",
+		serviceURL + "GetStats",
+		serviceURL + "CreateBookmark",
+		serviceURL + "GetBookmarks",
+		serviceURL + "DeleteBookmark",
+		serviceURL + "CreateClip",
+		serviceURL + "GetClips",
+		serviceURL + "DeleteClip",
+		serviceURL + "GetPodcastSettings",
+		serviceURL + "UpdatePodcastSettings",
+		serviceURL + "GetUserLastPlayed",
+	}
+
+	return &podJSONClient{
+		client:      client,
+		urls:        urls,
+		interceptor: twirp.ChainInterceptors(clientOpts.Interceptors...),
+		opts:        clientOpts,
+	}
+}
+
+func (c *podJSONClient) GetPodcast(ctx context.Context, in *GetPodReq) (*Podcast, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "protos")
+	ctx = ctxsetters.WithServiceName(ctx, "Pod")
+	ctx = ctxsetters.WithMethodName(ctx, "GetPodcast")
+	caller := c.callGetPodcast
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *GetPodReq) (*Podcast, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*GetPodReq)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*GetPodReq) when calling interceptor")
+					}
+					return c.callGetPodcast(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*Podcast)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*Podcast) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *podJSONClient) callGetPodcast(ctx context.Context, in *GetPodReq) (*Podcast, error) {
+	out := new(Podcast)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[0], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *podJSONClient) GetEpisodes(ctx context.Context, in *GetEpiReq) (*Episodes, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "protos")
+	ctx = ctxsetters.WithServiceName(ctx, "Pod")
+	ctx = ctxsetters.WithMethodName(ctx, "GetEpisodes")
+	caller := c.callGetEpisodes
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *GetEpiReq) (*Episodes, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*GetEpiReq)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*GetEpiReq) when calling interceptor")
+					}
+					return c.callGetEpisodes(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*Episodes)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*Episodes) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *podJSONClient) callGetEpisodes(ctx context.Context, in *GetEpiReq) (*Episodes, error) {
+	out := new(Episodes)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[1], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *podJSONClient) GetUserEpisode(ctx context.Context, in *GetUserEpiReq) (*UserEpisode, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "protos")
+	ctx = ctxsetters.WithServiceName(ctx, "Pod")
+	ctx = ctxsetters.WithMethodName(ctx, "GetUserEpisode")
+	caller := c.callGetUserEpisode
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *GetUserEpiReq) (*UserEpisode, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*GetUserEpiReq)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*GetUserEpiReq) when calling interceptor")
+					}
+					return c.callGetUserEpisode(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*UserEpisode)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*UserEpisode) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *podJSONClient) callGetUserEpisode(ctx context.Context, in *GetUserEpiReq) (*UserEpisode, error) {
+	out := new(UserEpisode)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[2], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *podJSONClient) UpsertUserEpisode(ctx context.Context, in *UserEpisode) (*UpsertUserEpiRes, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "protos")
+	ctx = ctxsetters.WithServiceName(ctx, "Pod")
+	ctx = ctxsetters.WithMethodName(ctx, "UpsertUserEpisode")
+	caller := c.callUpsertUserEpisode
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *UserEpisode) (*UpsertUserEpiRes, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*UserEpisode)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*UserEpisode) when calling interceptor")
+					}
+					return c.callUpsertUserEpisode(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*UpsertUserEpiRes)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*UpsertUserEpiRes) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *podJSONClient) callUpsertUserEpisode(ctx context.Context, in *UserEpisode) (*UpsertUserEpiRes, error) {
+	out := new(UpsertUserEpiRes)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[3], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *podJSONClient) UpsertUserEpisodes(ctx context.Context, in *UpsertUserEpisodesReq) (*UpsertUserEpisodesRes, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "protos")
+	ctx = ctxsetters.WithServiceName(ctx, "Pod")
+	ctx = ctxsetters.WithMethodName(ctx, "UpsertUserEpisodes")
+	caller := c.callUpsertUserEpisodes
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *UpsertUserEpisodesReq) (*UpsertUserEpisodesRes, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*UpsertUserEpisodesReq)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*UpsertUserEpisodesReq) when calling interceptor")
+					}
+					return c.callUpsertUserEpisodes(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*UpsertUserEpisodesRes)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*UpsertUserEpisodesRes) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *podJSONClient) callUpsertUserEpisodes(ctx context.Context, in *UpsertUserEpisodesReq) (*UpsertUserEpisodesRes, error) {
+	out := new(UpsertUserEpisodesRes)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[4], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *podJSONClient) MarkPodcastPlayed(ctx context.Context, in *MarkPodcastPlayedReq) (*MarkPodcastPlayedRes, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "protos")
+	ctx = ctxsetters.WithServiceName(ctx, "Pod")
+	ctx = ctxsetters.WithMethodName(ctx, "MarkPodcastPlayed")
+	caller := c.callMarkPodcastPlayed
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *MarkPodcastPlayedReq) (*MarkPodcastPlayedRes, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*MarkPodcastPlayedReq)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*MarkPodcastPlayedReq) when calling interceptor")
+					}
+					return c.callMarkPodcastPlayed(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*MarkPodcastPlayedRes)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*MarkPodcastPlayedRes) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *podJSONClient) callMarkPodcastPlayed(ctx context.Context, in *MarkPodcastPlayedReq) (*MarkPodcastPlayedRes, error) {
+	out := new(MarkPodcastPlayedRes)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[5], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *podJSONClient) MarkEpisodeUnplayed(ctx context.Context, in *MarkEpisodeUnplayedReq) (*Response, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "protos")
+	ctx = ctxsetters.WithServiceName(ctx, "Pod")
+	ctx = ctxsetters.WithMethodName(ctx, "MarkEpisodeUnplayed")
+	caller := c.callMarkEpisodeUnplayed
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *MarkEpisodeUnplayedReq) (*Response, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*MarkEpisodeUnplayedReq)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*MarkEpisodeUnplayedReq) when calling interceptor")
+					}
+					return c.callMarkEpisodeUnplayed(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*Response)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*Response) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *podJSONClient) callMarkEpisodeUnplayed(ctx context.Context, in *MarkEpisodeUnplayedReq) (*Response, error) {
+	out := new(Response)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[6], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *podJSONClient) GetSubscriptions(ctx context.Context, in *GetSubReq) (*Subscriptions, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "protos")
+	ctx = ctxsetters.WithServiceName(ctx, "Pod")
+	ctx = ctxsetters.WithMethodName(ctx, "GetSubscriptions")
+	caller := c.callGetSubscriptions
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *GetSubReq) (*Subscriptions, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*GetSubReq)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*GetSubReq) when calling interceptor")
+					}
+					return c.callGetSubscriptions(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*Subscriptions)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*Subscriptions) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *podJSONClient) callGetSubscriptions(ctx context.Context, in *GetSubReq) (*Subscriptions, error) {
+	out := new(Subscriptions)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[7], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *podJSONClient) ListCategories(ctx context.Context, in *ListCategoriesReq) (*Categories, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "protos")
+	ctx = ctxsetters.WithServiceName(ctx, "Pod")
+	ctx = ctxsetters.WithMethodName(ctx, "ListCategories")
+	caller := c.callListCategories
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *ListCategoriesReq) (*Categories, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ListCategoriesReq)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ListCategoriesReq) when calling interceptor")
+					}
+					return c.callListCategories(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*Categories)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*Categories) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *podJSONClient) callListCategories(ctx context.Context, in *ListCategoriesReq) (*Categories, error) {
+	out := new(Categories)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[8], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *podJSONClient) BrowseCategory(ctx context.Context, in *BrowseCategoryReq) (*Podcasts, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "protos")
+	ctx = ctxsetters.WithServiceName(ctx, "Pod")
+	ctx = ctxsetters.WithMethodName(ctx, "BrowseCategory")
+	caller := c.callBrowseCategory
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *BrowseCategoryReq) (*Podcasts, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*BrowseCategoryReq)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*BrowseCategoryReq) when calling interceptor")
+					}
+					return c.callBrowseCategory(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*Podcasts)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*Podcasts) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *podJSONClient) callBrowseCategory(ctx context.Context, in *BrowseCategoryReq) (*Podcasts, error) {
+	out := new(Podcasts)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[9], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *podJSONClient) GetCharts(ctx context.Context, in *GetChartsReq) (*Podcasts, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "protos")
+	ctx = ctxsetters.WithServiceName(ctx, "Pod")
+	ctx = ctxsetters.WithMethodName(ctx, "GetCharts")
+	caller := c.callGetCharts
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *GetChartsReq) (*Podcasts, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*GetChartsReq)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*GetChartsReq) when calling interceptor")
+					}
+					return c.callGetCharts(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*Podcasts)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*Podcasts) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *podJSONClient) callGetCharts(ctx context.Context, in *GetChartsReq) (*Podcasts, error) {
+	out := new(Podcasts)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[10], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *podJSONClient) GetRecommendations(ctx context.Context, in *GetRecommendationsReq) (*Podcasts, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "protos")
+	ctx = ctxsetters.WithServiceName(ctx, "Pod")
+	ctx = ctxsetters.WithMethodName(ctx, "GetRecommendations")
+	caller := c.callGetRecommendations
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *GetRecommendationsReq) (*Podcasts, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*GetRecommendationsReq)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*GetRecommendationsReq) when calling interceptor")
+					}
+					return c.callGetRecommendations(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*Podcasts)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*Podcasts) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *podJSONClient) callGetRecommendations(ctx context.Context, in *GetRecommendationsReq) (*Podcasts, error) {
+	out := new(Podcasts)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[11], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *podJSONClient) GetSimilarPodcasts(ctx context.Context, in *GetSimilarPodcastsReq) (*Podcasts, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "protos")
+	ctx = ctxsetters.WithServiceName(ctx, "Pod")
+	ctx = ctxsetters.WithMethodName(ctx, "GetSimilarPodcasts")
+	caller := c.callGetSimilarPodcasts
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *GetSimilarPodcastsReq) (*Podcasts, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*GetSimilarPodcastsReq)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*GetSimilarPodcastsReq) when calling interceptor")
+					}
+					return c.callGetSimilarPodcasts(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*Podcasts)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*Podcasts) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *podJSONClient) callGetSimilarPodcasts(ctx context.Context, in *GetSimilarPodcastsReq) (*Podcasts, error) {
+	out := new(Podcasts)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[12], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *podJSONClient) GetQueue(ctx context.Context, in *GetQueueReq) (*Episodes, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "protos")
+	ctx = ctxsetters.WithServiceName(ctx, "Pod")
+	ctx = ctxsetters.WithMethodName(ctx, "GetQueue")
+	caller := c.callGetQueue
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *GetQueueReq) (*Episodes, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*GetQueueReq)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*GetQueueReq) when calling interceptor")
+					}
+					return c.callGetQueue(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*Episodes)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*Episodes) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *podJSONClient) callGetQueue(ctx context.Context, in *GetQueueReq) (*Episodes, error) {
+	out := new(Episodes)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[13], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *podJSONClient) AddToQueue(ctx context.Context, in *AddToQueueReq) (*Episodes, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "protos")
+	ctx = ctxsetters.WithServiceName(ctx, "Pod")
+	ctx = ctxsetters.WithMethodName(ctx, "AddToQueue")
+	caller := c.callAddToQueue
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *AddToQueueReq) (*Episodes, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*AddToQueueReq)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*AddToQueueReq) when calling interceptor")
+					}
+					return c.callAddToQueue(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*Episodes)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*Episodes) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *podJSONClient) callAddToQueue(ctx context.Context, in *AddToQueueReq) (*Episodes, error) {
+	out := new(Episodes)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[14], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *podJSONClient) RemoveFromQueue(ctx context.Context, in *RemoveFromQueueReq) (*Episodes, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "protos")
+	ctx = ctxsetters.WithServiceName(ctx, "Pod")
+	ctx = ctxsetters.WithMethodName(ctx, "RemoveFromQueue")
+	caller := c.callRemoveFromQueue
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *RemoveFromQueueReq) (*Episodes, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*RemoveFromQueueReq)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*RemoveFromQueueReq) when calling interceptor")
+					}
+					return c.callRemoveFromQueue(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*Episodes)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*Episodes) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *podJSONClient) callRemoveFromQueue(ctx context.Context, in *RemoveFromQueueReq) (*Episodes, error) {
+	out := new(Episodes)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[15], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *podJSONClient) ReorderQueue(ctx context.Context, in *ReorderQueueReq) (*Episodes, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "protos")
+	ctx = ctxsetters.WithServiceName(ctx, "Pod")
+	ctx = ctxsetters.WithMethodName(ctx, "ReorderQueue")
+	caller := c.callReorderQueue
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *ReorderQueueReq) (*Episodes, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ReorderQueueReq)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ReorderQueueReq) when calling interceptor")
+					}
+					return c.callReorderQueue(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*Episodes)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*Episodes) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *podJSONClient) callReorderQueue(ctx context.Context, in *ReorderQueueReq) (*Episodes, error) {
+	out := new(Episodes)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[16], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *podJSONClient) ClearQueue(ctx context.Context, in *ClearQueueReq) (*Response, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "protos")
+	ctx = ctxsetters.WithServiceName(ctx, "Pod")
+	ctx = ctxsetters.WithMethodName(ctx, "ClearQueue")
+	caller := c.callClearQueue
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *ClearQueueReq) (*Response, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ClearQueueReq)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ClearQueueReq) when calling interceptor")
+					}
+					return c.callClearQueue(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*Response)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*Response) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *podJSONClient) callClearQueue(ctx context.Context, in *ClearQueueReq) (*Response, error) {
+	out := new(Response)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[17], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *podJSONClient) SetAutoQueue(ctx context.Context, in *SetAutoQueueReq) (*Response, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "protos")
+	ctx = ctxsetters.WithServiceName(ctx, "Pod")
+	ctx = ctxsetters.WithMethodName(ctx, "SetAutoQueue")
+	caller := c.callSetAutoQueue
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *SetAutoQueueReq) (*Response, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*SetAutoQueueReq)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*SetAutoQueueReq) when calling interceptor")
+					}
+					return c.callSetAutoQueue(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*Response)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*Response) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *podJSONClient) callSetAutoQueue(ctx context.Context, in *SetAutoQueueReq) (*Response, error) {
+	out := new(Response)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[18], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *podJSONClient) CreatePlaylist(ctx context.Context, in *CreatePlaylistReq) (*Playlist, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "protos")
+	ctx = ctxsetters.WithServiceName(ctx, "Pod")
+	ctx = ctxsetters.WithMethodName(ctx, "CreatePlaylist")
+	caller := c.callCreatePlaylist
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *CreatePlaylistReq) (*Playlist, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*CreatePlaylistReq)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*CreatePlaylistReq) when calling interceptor")
+					}
+					return c.callCreatePlaylist(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*Playlist)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*Playlist) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *podJSONClient) callCreatePlaylist(ctx context.Context, in *CreatePlaylistReq) (*Playlist, error) {
+	out := new(Playlist)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[19], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *podJSONClient) GetPlaylists(ctx context.Context, in *GetPlaylistsReq) (*Playlists, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "protos")
+	ctx = ctxsetters.WithServiceName(ctx, "Pod")
+	ctx = ctxsetters.WithMethodName(ctx, "GetPlaylists")
+	caller := c.callGetPlaylists
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *GetPlaylistsReq) (*Playlists, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*GetPlaylistsReq)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*GetPlaylistsReq) when calling interceptor")
+					}
+					return c.callGetPlaylists(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*Playlists)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*Playlists) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *podJSONClient) callGetPlaylists(ctx context.Context, in *GetPlaylistsReq) (*Playlists, error) {
+	out := new(Playlists)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[20], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *podJSONClient) UpdatePlaylist(ctx context.Context, in *UpdatePlaylistReq) (*Playlist, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "protos")
+	ctx = ctxsetters.WithServiceName(ctx, "Pod")
+	ctx = ctxsetters.WithMethodName(ctx, "UpdatePlaylist")
+	caller := c.callUpdatePlaylist
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *UpdatePlaylistReq) (*Playlist, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*UpdatePlaylistReq)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*UpdatePlaylistReq) when calling interceptor")
+					}
+					return c.callUpdatePlaylist(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*Playlist)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*Playlist) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *podJSONClient) callUpdatePlaylist(ctx context.Context, in *UpdatePlaylistReq) (*Playlist, error) {
+	out := new(Playlist)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[21], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *podJSONClient) DeletePlaylist(ctx context.Context, in *DeletePlaylistReq) (*Response, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "protos")
+	ctx = ctxsetters.WithServiceName(ctx, "Pod")
+	ctx = ctxsetters.WithMethodName(ctx, "DeletePlaylist")
+	caller := c.callDeletePlaylist
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *DeletePlaylistReq) (*Response, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*DeletePlaylistReq)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*DeletePlaylistReq) when calling interceptor")
+					}
+					return c.callDeletePlaylist(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*Response)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*Response) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *podJSONClient) callDeletePlaylist(ctx context.Context, in *DeletePlaylistReq) (*Response, error) {
+	out := new(Response)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[22], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *podJSONClient) GetPlaylistEpisodes(ctx context.Context, in *GetPlaylistEpisodesReq) (*Episodes, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "protos")
+	ctx = ctxsetters.WithServiceName(ctx, "Pod")
+	ctx = ctxsetters.WithMethodName(ctx, "GetPlaylistEpisodes")
+	caller := c.callGetPlaylistEpisodes
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *GetPlaylistEpisodesReq) (*Episodes, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*GetPlaylistEpisodesReq)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*GetPlaylistEpisodesReq) when calling interceptor")
+					}
+					return c.callGetPlaylistEpisodes(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*Episodes)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*Episodes) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *podJSONClient) callGetPlaylistEpisodes(ctx context.Context, in *GetPlaylistEpisodesReq) (*Episodes, error) {
+	out := new(Episodes)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[23], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *podJSONClient) Sync(ctx context.Context, in *SyncReq) (*SyncRes, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "protos")
+	ctx = ctxsetters.WithServiceName(ctx, "Pod")
+	ctx = ctxsetters.WithMethodName(ctx, "Sync")
+	caller := c.callSync
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *SyncReq) (*SyncRes, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*SyncReq)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*SyncReq) when calling interceptor")
+					}
+					return c.callSync(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*SyncRes)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*SyncRes) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *podJSONClient) callSync(ctx context.Context, in *SyncReq) (*SyncRes, error) {
+	out := new(SyncRes)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[24], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *podJSONClient) AddListeningSession(ctx context.Context, in *AddListeningSessionReq) (*Response, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "protos")
+	ctx = ctxsetters.WithServiceName(ctx, "Pod")
+	ctx = ctxsetters.WithMethodName(ctx, "AddListeningSession")
+	caller := c.callAddListeningSession
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *AddListeningSessionReq) (*Response, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*AddListeningSessionReq)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*AddListeningSessionReq) when calling interceptor")
+					}
+					return c.callAddListeningSession(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*Response)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*Response) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *podJSONClient) callAddListeningSession(ctx context.Context, in *AddListeningSessionReq) (*Response, error) {
+	out := new(Response)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[25], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *podJSONClient) GetHistory(ctx context.Context, in *GetHistoryReq) (*History, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "protos")
+	ctx = ctxsetters.WithServiceName(ctx, "Pod")
+	ctx = ctxsetters.WithMethodName(ctx, "GetHistory")
+	caller := c.callGetHistory
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *GetHistoryReq) (*History, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*GetHistoryReq)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*GetHistoryReq) when calling interceptor")
+					}
+					return c.callGetHistory(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*History)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*History) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *podJSONClient) callGetHistory(ctx context.Context, in *GetHistoryReq) (*History, error) {
+	out := new(History)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[26], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *podJSONClient) GetStats(ctx context.Context, in *GetStatsReq) (*Stats, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "protos")
+	ctx = ctxsetters.WithServiceName(ctx, "Pod")
+	ctx = ctxsetters.WithMethodName(ctx, "GetStats")
+	caller := c.callGetStats
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *GetStatsReq) (*Stats, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*GetStatsReq)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*GetStatsReq) when calling interceptor")
+					}
+					return c.callGetStats(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*Stats)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*Stats) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *podJSONClient) callGetStats(ctx context.Context, in *GetStatsReq) (*Stats, error) {
+	out := new(Stats)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[27], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *podJSONClient) CreateBookmark(ctx context.Context, in *CreateBookmarkReq) (*Bookmark, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "protos")
+	ctx = ctxsetters.WithServiceName(ctx, "Pod")
+	ctx = ctxsetters.WithMethodName(ctx, "CreateBookmark")
+	caller := c.callCreateBookmark
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *CreateBookmarkReq) (*Bookmark, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*CreateBookmarkReq)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*CreateBookmarkReq) when calling interceptor")
+					}
+					return c.callCreateBookmark(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*Bookmark)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*Bookmark) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *podJSONClient) callCreateBookmark(ctx context.Context, in *CreateBookmarkReq) (*Bookmark, error) {
+	out := new(Bookmark)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[28], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *podJSONClient) GetBookmarks(ctx context.Context, in *GetBookmarksReq) (*Bookmarks, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "protos")
+	ctx = ctxsetters.WithServiceName(ctx, "Pod")
+	ctx = ctxsetters.WithMethodName(ctx, "GetBookmarks")
+	caller := c.callGetBookmarks
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *GetBookmarksReq) (*Bookmarks, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*GetBookmarksReq)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*GetBookmarksReq) when calling interceptor")
+					}
+					return c.callGetBookmarks(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*Bookmarks)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*Bookmarks) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *podJSONClient) callGetBookmarks(ctx context.Context, in *GetBookmarksReq) (*Bookmarks, error) {
+	out := new(Bookmarks)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[29], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *podJSONClient) DeleteBookmark(ctx context.Context, in *DeleteBookmarkReq) (*Response, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "protos")
+	ctx = ctxsetters.WithServiceName(ctx, "Pod")
+	ctx = ctxsetters.WithMethodName(ctx, "DeleteBookmark")
+	caller := c.callDeleteBookmark
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *DeleteBookmarkReq) (*Response, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*DeleteBookmarkReq)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*DeleteBookmarkReq) when calling interceptor")
+					}
+					return c.callDeleteBookmark(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*Response)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*Response) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *podJSONClient) callDeleteBookmark(ctx context.Context, in *DeleteBookmarkReq) (*Response, error) {
+	out := new(Response)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[30], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *podJSONClient) CreateClip(ctx context.Context, in *CreateClipReq) (*Clip, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "protos")
+	ctx = ctxsetters.WithServiceName(ctx, "Pod")
+	ctx = ctxsetters.WithMethodName(ctx, "CreateClip")
+	caller := c.callCreateClip
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *CreateClipReq) (*Clip, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*CreateClipReq)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*CreateClipReq) when calling interceptor")
+					}
+					return c.callCreateClip(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*Clip)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*Clip) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *podJSONClient) callCreateClip(ctx context.Context, in *CreateClipReq) (*Clip, error) {
+	out := new(Clip)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[31], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *podJSONClient) GetClips(ctx context.Context, in *GetClipsReq) (*Clips, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "protos")
+	ctx = ctxsetters.WithServiceName(ctx, "Pod")
+	ctx = ctxsetters.WithMethodName(ctx, "GetClips")
+	caller := c.callGetClips
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *GetClipsReq) (*Clips, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*GetClipsReq)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*GetClipsReq) when calling interceptor")
+					}
+					return c.callGetClips(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*Clips)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*Clips) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *podJSONClient) callGetClips(ctx context.Context, in *GetClipsReq) (*Clips, error) {
+	out := new(Clips)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[32], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *podJSONClient) DeleteClip(ctx context.Context, in *DeleteClipReq) (*Response, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "protos")
+	ctx = ctxsetters.WithServiceName(ctx, "Pod")
+	ctx = ctxsetters.WithMethodName(ctx, "DeleteClip")
+	caller := c.callDeleteClip
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *DeleteClipReq) (*Response, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*DeleteClipReq)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*DeleteClipReq) when calling interceptor")
+					}
+					return c.callDeleteClip(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*Response)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*Response) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *podJSONClient) callDeleteClip(ctx context.Context, in *DeleteClipReq) (*Response, error) {
+	out := new(Response)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[33], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *podJSONClient) GetPodcastSettings(ctx context.Context, in *GetPodcastSettingsReq) (*PodcastSettings, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "protos")
+	ctx = ctxsetters.WithServiceName(ctx, "Pod")
+	ctx = ctxsetters.WithMethodName(ctx, "GetPodcastSettings")
+	caller := c.callGetPodcastSettings
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *GetPodcastSettingsReq) (*PodcastSettings, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*GetPodcastSettingsReq)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*GetPodcastSettingsReq) when calling interceptor")
+					}
+					return c.callGetPodcastSettings(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*PodcastSettings)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*PodcastSettings) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *podJSONClient) callGetPodcastSettings(ctx context.Context, in *GetPodcastSettingsReq) (*PodcastSettings, error) {
+	out := new(PodcastSettings)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[34], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *podJSONClient) UpdatePodcastSettings(ctx context.Context, in *PodcastSettings) (*PodcastSettings, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "protos")
+	ctx = ctxsetters.WithServiceName(ctx, "Pod")
+	ctx = ctxsetters.WithMethodName(ctx, "UpdatePodcastSettings")
+	caller := c.callUpdatePodcastSettings
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *PodcastSettings) (*PodcastSettings, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*PodcastSettings)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*PodcastSettings) when calling interceptor")
+					}
+					return c.callUpdatePodcastSettings(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*PodcastSettings)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*PodcastSettings) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *podJSONClient) callUpdatePodcastSettings(ctx context.Context, in *PodcastSettings) (*PodcastSettings, error) {
+	out := new(PodcastSettings)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[35], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *podJSONClient) GetUserLastPlayed(ctx context.Context, in *GetUserLastPlayedReq) (*LastPlayedRes, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "protos")
+	ctx = ctxsetters.WithServiceName(ctx, "Pod")
+	ctx = ctxsetters.WithMethodName(ctx, "GetUserLastPlayed")
+	caller := c.callGetUserLastPlayed
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *GetUserLastPlayedReq) (*LastPlayedRes, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*GetUserLastPlayedReq)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*GetUserLastPlayedReq) when calling interceptor")
+					}
+					return c.callGetUserLastPlayed(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*LastPlayedRes)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*LastPlayedRes) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *podJSONClient) callGetUserLastPlayed(ctx context.Context, in *GetUserLastPlayedReq) (*LastPlayedRes, error) {
+	out := new(LastPlayedRes)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[36], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+// ==================
+// Pod Server Handler
+// ==================
+
+type podServer struct {
+	Pod
+	interceptor      twirp.Interceptor
+	hooks            *twirp.ServerHooks
+	pathPrefix       string // prefix for routing
+	jsonSkipDefaults bool   // do not include unpopulated fields (default values) in the response
+	jsonCamelCase    bool   // JSON fields are serialized as lowerCamelCase rather than keeping the original proto names
+}
+
+// NewPodServer builds a TwirpServer that can be used as an http.Handler to handle
+// HTTP requests that are routed to the right method in the provided svc implementation.
+// The opts are twirp.ServerOption modifiers, for example twirp.WithServerHooks(hooks).
+func NewPodServer(svc Pod, opts ...interface{}) TwirpServer {
+	serverOpts := newServerOpts(opts)
+
 	// Using ReadOpt allows backwards and forwads compatibility with new options in the future
 	jsonSkipDefaults := false
 	_ = serverOpts.ReadOpt("jsonSkipDefaults", &jsonSkipDefaults)
@@ -727,100 +3712,5773 @@ func NewPodServer(svc Pod, opts ...interface{}) TwirpServer {
 		pathPrefix = "/twirp" // default prefix
 	}
 
-	return &podServer{
-		Pod:              svc,
-		hooks:            serverOpts.Hooks,
-		interceptor:      twirp.ChainInterceptors(serverOpts.Interceptors...),
-		pathPrefix:       pathPrefix,
-		jsonSkipDefaults: jsonSkipDefaults,
-		jsonCamelCase:    jsonCamelCase,
+	return &podServer{
+		Pod:              svc,
+		hooks:            serverOpts.Hooks,
+		interceptor:      twirp.ChainInterceptors(serverOpts.Interceptors...),
+		pathPrefix:       pathPrefix,
+		jsonSkipDefaults: jsonSkipDefaults,
+		jsonCamelCase:    jsonCamelCase,
+	}
+}
+
+// writeError writes an HTTP response with a valid Twirp error format, and triggers hooks.
+// If err is not a twirp.Error, it will get wrapped with twirp.InternalErrorWith(err)
+func (s *podServer) writeError(ctx context.Context, resp http.ResponseWriter, err error) {
+	writeError(ctx, resp, err, s.hooks)
+}
+
+// handleRequestBodyError is used to handle error when the twirp server cannot read request
+func (s *podServer) handleRequestBodyError(ctx context.Context, resp http.ResponseWriter, msg string, err error) {
+	if context.Canceled == ctx.Err() {
+		s.writeError(ctx, resp, twirp.NewError(twirp.Canceled, "failed to read request: context canceled"))
+		return
+	}
+	if context.DeadlineExceeded == ctx.Err() {
+		s.writeError(ctx, resp, twirp.NewError(twirp.DeadlineExceeded, "failed to read request: deadline exceeded"))
+		return
+	}
+	s.writeError(ctx, resp, twirp.WrapError(malformedRequestError(msg), err))
+}
+
+// PodPathPrefix is a convenience constant that may identify URL paths.
+// Should be used with caution, it only matches routes generated by Twirp Go clients,
+// with the default "/twirp" prefix and default CamelCase service and method names.
+// More info: https://twitchtv.github.io/twirp/docs/routing.html
+const PodPathPrefix = "/twirp/protos.Pod/"
+
+func (s *podServer) ServeHTTP(resp http.ResponseWriter, req *http.Request) {
+	ctx := req.Context()
+	ctx = ctxsetters.WithPackageName(ctx, "protos")
+	ctx = ctxsetters.WithServiceName(ctx, "Pod")
+	ctx = ctxsetters.WithResponseWriter(ctx, resp)
+
+	var err error
+	ctx, err = callRequestReceived(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	if req.Method != "POST" {
+		msg := fmt.Sprintf("unsupported method %q (only POST is allowed)", req.Method)
+		s.writeError(ctx, resp, badRouteError(msg, req.Method, req.URL.Path))
+		return
+	}
+
+	// Verify path format: [<prefix>]/<package>.<Service>/<Method>
+	prefix, pkgService, method := parseTwirpPath(req.URL.Path)
+	if pkgService != "protos.Pod" {
+		msg := fmt.Sprintf("no handler for path %q", req.URL.Path)
+		s.writeError(ctx, resp, badRouteError(msg, req.Method, req.URL.Path))
+		return
+	}
+	if prefix != s.pathPrefix {
+		msg := fmt.Sprintf("invalid path prefix %q, expected %q, on path %q", prefix, s.pathPrefix, req.URL.Path)
+		s.writeError(ctx, resp, badRouteError(msg, req.Method, req.URL.Path))
+		return
+	}
+
+	switch method {
+	case "GetPodcast":
+		s.serveGetPodcast(ctx, resp, req)
+		return
+	case "GetEpisodes":
+		s.serveGetEpisodes(ctx, resp, req)
+		return
+	case "GetUserEpisode":
+		s.serveGetUserEpisode(ctx, resp, req)
+		return
+	case "UpsertUserEpisode":
+		s.serveUpsertUserEpisode(ctx, resp, req)
+		return
+	case "UpsertUserEpisodes":
+		s.serveUpsertUserEpisodes(ctx, resp, req)
+		return
+	case "MarkPodcastPlayed":
+		s.serveMarkPodcastPlayed(ctx, resp, req)
+		return
+	case "MarkEpisodeUnplayed":
+		s.serveMarkEpisodeUnplayed(ctx, resp, req)
+		return
+	case "GetSubscriptions":
+		s.serveGetSubscriptions(ctx, resp, req)
+		return
+	case "ListCategories":
+		s.serveListCategories(ctx, resp, req)
+		return
+	case "BrowseCategory":
+		s.serveBrowseCategory(ctx, resp, req)
+		return
+	case "GetCharts":
+		s.serveGetCharts(ctx, resp, req)
+		return
+	case "GetRecommendations":
+		s.serveGetRecommendations(ctx, resp, req)
+		return
+	case "GetSimilarPodcasts":
+		s.serveGetSimilarPodcasts(ctx, resp, req)
+		return
+	case "GetQueue":
+		s.serveGetQueue(ctx, resp, req)
+		return
+	case "AddToQueue":
+		s.serveAddToQueue(ctx, resp, req)
+		return
+	case "RemoveFromQueue":
+		s.serveRemoveFromQueue(ctx, resp, req)
+		return
+	case "ReorderQueue":
+		s.serveReorderQueue(ctx, resp, req)
+		return
+	case "ClearQueue":
+		s.serveClearQueue(ctx, resp, req)
+		return
+	case "SetAutoQueue":
+		s.serveSetAutoQueue(ctx, resp, req)
+		return
+	case "CreatePlaylist":
+		s.serveCreatePlaylist(ctx, resp, req)
+		return
+	case "GetPlaylists":
+		s.serveGetPlaylists(ctx, resp, req)
+		return
+	case "UpdatePlaylist":
+		s.serveUpdatePlaylist(ctx, resp, req)
+		return
+	case "DeletePlaylist":
+		s.serveDeletePlaylist(ctx, resp, req)
+		return
+	case "GetPlaylistEpisodes":
+		s.serveGetPlaylistEpisodes(ctx, resp, req)
+		return
+	case "Sync":
+		s.serveSync(ctx, resp, req)
+		return
+	case "AddListeningSession":
+		s.serveAddListeningSession(ctx, resp, req)
+		return
+	case "GetHistory":
+		s.serveGetHistory(ctx, resp, req)
+		return
+	case "GetStats":
+		s.serveGetStats(ctx, resp, req)
+		return
+	case "CreateBookmark":
+		s.serveCreateBookmark(ctx, resp, req)
+		return
+	case "GetBookmarks":
+		s.serveGetBookmarks(ctx, resp, req)
+		return
+	case "DeleteBookmark":
+		s.serveDeleteBookmark(ctx, resp, req)
+		return
+	case "CreateClip":
+		s.serveCreateClip(ctx, resp, req)
+		return
+	case "GetClips":
+		s.serveGetClips(ctx, resp, req)
+		return
+	case "DeleteClip":
+		s.serveDeleteClip(ctx, resp, req)
+		return
+	case "GetPodcastSettings":
+		s.serveGetPodcastSettings(ctx, resp, req)
+		return
+	case "UpdatePodcastSettings":
+		s.serveUpdatePodcastSettings(ctx, resp, req)
+		return
+	case "GetUserLastPlayed":
+		s.serveGetUserLastPlayed(ctx, resp, req)
+		return
+	default:
+		msg := fmt.Sprintf("no handler for path %q", req.URL.Path)
+		s.writeError(ctx, resp, badRouteError(msg, req.Method, req.URL.Path))
+		return
+	}
+}
+
+func (s *podServer) serveGetPodcast(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveGetPodcastJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveGetPodcastProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *podServer) serveGetPodcastJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "GetPodcast")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(GetPodReq)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.Pod.GetPodcast
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *GetPodReq) (*Podcast, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*GetPodReq)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*GetPodReq) when calling interceptor")
+					}
+					return s.Pod.GetPodcast(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*Podcast)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*Podcast) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *Podcast
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *Podcast and nil error while calling GetPodcast. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *podServer) serveGetPodcastProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "GetPodcast")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(GetPodReq)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.Pod.GetPodcast
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *GetPodReq) (*Podcast, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*GetPodReq)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*GetPodReq) when calling interceptor")
+					}
+					return s.Pod.GetPodcast(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*Podcast)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*Podcast) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *Podcast
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *Podcast and nil error while calling GetPodcast. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *podServer) serveGetEpisodes(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveGetEpisodesJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveGetEpisodesProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *podServer) serveGetEpisodesJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "GetEpisodes")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(GetEpiReq)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.Pod.GetEpisodes
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *GetEpiReq) (*Episodes, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*GetEpiReq)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*GetEpiReq) when calling interceptor")
+					}
+					return s.Pod.GetEpisodes(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*Episodes)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*Episodes) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *Episodes
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *Episodes and nil error while calling GetEpisodes. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *podServer) serveGetEpisodesProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "GetEpisodes")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(GetEpiReq)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.Pod.GetEpisodes
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *GetEpiReq) (*Episodes, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*GetEpiReq)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*GetEpiReq) when calling interceptor")
+					}
+					return s.Pod.GetEpisodes(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*Episodes)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*Episodes) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *Episodes
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *Episodes and nil error while calling GetEpisodes. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *podServer) serveGetUserEpisode(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveGetUserEpisodeJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveGetUserEpisodeProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *podServer) serveGetUserEpisodeJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "GetUserEpisode")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(GetUserEpiReq)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.Pod.GetUserEpisode
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *GetUserEpiReq) (*UserEpisode, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*GetUserEpiReq)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*GetUserEpiReq) when calling interceptor")
+					}
+					return s.Pod.GetUserEpisode(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*UserEpisode)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*UserEpisode) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *UserEpisode
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *UserEpisode and nil error while calling GetUserEpisode. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *podServer) serveGetUserEpisodeProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "GetUserEpisode")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(GetUserEpiReq)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.Pod.GetUserEpisode
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *GetUserEpiReq) (*UserEpisode, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*GetUserEpiReq)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*GetUserEpiReq) when calling interceptor")
+					}
+					return s.Pod.GetUserEpisode(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*UserEpisode)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*UserEpisode) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *UserEpisode
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *UserEpisode and nil error while calling GetUserEpisode. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *podServer) serveUpsertUserEpisode(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveUpsertUserEpisodeJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveUpsertUserEpisodeProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *podServer) serveUpsertUserEpisodeJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "UpsertUserEpisode")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(UserEpisode)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.Pod.UpsertUserEpisode
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *UserEpisode) (*UpsertUserEpiRes, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*UserEpisode)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*UserEpisode) when calling interceptor")
+					}
+					return s.Pod.UpsertUserEpisode(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*UpsertUserEpiRes)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*UpsertUserEpiRes) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *UpsertUserEpiRes
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *UpsertUserEpiRes and nil error while calling UpsertUserEpisode. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *podServer) serveUpsertUserEpisodeProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "UpsertUserEpisode")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(UserEpisode)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.Pod.UpsertUserEpisode
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *UserEpisode) (*UpsertUserEpiRes, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*UserEpisode)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*UserEpisode) when calling interceptor")
+					}
+					return s.Pod.UpsertUserEpisode(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*UpsertUserEpiRes)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*UpsertUserEpiRes) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *UpsertUserEpiRes
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *UpsertUserEpiRes and nil error while calling UpsertUserEpisode. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *podServer) serveUpsertUserEpisodes(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveUpsertUserEpisodesJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveUpsertUserEpisodesProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *podServer) serveUpsertUserEpisodesJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "UpsertUserEpisodes")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(UpsertUserEpisodesReq)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.Pod.UpsertUserEpisodes
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *UpsertUserEpisodesReq) (*UpsertUserEpisodesRes, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*UpsertUserEpisodesReq)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*UpsertUserEpisodesReq) when calling interceptor")
+					}
+					return s.Pod.UpsertUserEpisodes(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*UpsertUserEpisodesRes)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*UpsertUserEpisodesRes) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *UpsertUserEpisodesRes
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *UpsertUserEpisodesRes and nil error while calling UpsertUserEpisodes. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *podServer) serveUpsertUserEpisodesProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "UpsertUserEpisodes")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(UpsertUserEpisodesReq)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.Pod.UpsertUserEpisodes
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *UpsertUserEpisodesReq) (*UpsertUserEpisodesRes, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*UpsertUserEpisodesReq)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*UpsertUserEpisodesReq) when calling interceptor")
+					}
+					return s.Pod.UpsertUserEpisodes(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*UpsertUserEpisodesRes)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*UpsertUserEpisodesRes) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *UpsertUserEpisodesRes
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *UpsertUserEpisodesRes and nil error while calling UpsertUserEpisodes. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *podServer) serveMarkPodcastPlayed(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveMarkPodcastPlayedJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveMarkPodcastPlayedProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *podServer) serveMarkPodcastPlayedJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "MarkPodcastPlayed")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(MarkPodcastPlayedReq)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.Pod.MarkPodcastPlayed
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *MarkPodcastPlayedReq) (*MarkPodcastPlayedRes, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*MarkPodcastPlayedReq)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*MarkPodcastPlayedReq) when calling interceptor")
+					}
+					return s.Pod.MarkPodcastPlayed(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*MarkPodcastPlayedRes)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*MarkPodcastPlayedRes) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *MarkPodcastPlayedRes
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *MarkPodcastPlayedRes and nil error while calling MarkPodcastPlayed. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *podServer) serveMarkPodcastPlayedProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "MarkPodcastPlayed")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(MarkPodcastPlayedReq)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.Pod.MarkPodcastPlayed
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *MarkPodcastPlayedReq) (*MarkPodcastPlayedRes, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*MarkPodcastPlayedReq)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*MarkPodcastPlayedReq) when calling interceptor")
+					}
+					return s.Pod.MarkPodcastPlayed(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*MarkPodcastPlayedRes)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*MarkPodcastPlayedRes) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *MarkPodcastPlayedRes
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *MarkPodcastPlayedRes and nil error while calling MarkPodcastPlayed. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *podServer) serveMarkEpisodeUnplayed(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveMarkEpisodeUnplayedJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveMarkEpisodeUnplayedProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *podServer) serveMarkEpisodeUnplayedJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "MarkEpisodeUnplayed")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(MarkEpisodeUnplayedReq)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.Pod.MarkEpisodeUnplayed
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *MarkEpisodeUnplayedReq) (*Response, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*MarkEpisodeUnplayedReq)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*MarkEpisodeUnplayedReq) when calling interceptor")
+					}
+					return s.Pod.MarkEpisodeUnplayed(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*Response)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*Response) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *Response
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *Response and nil error while calling MarkEpisodeUnplayed. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *podServer) serveMarkEpisodeUnplayedProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "MarkEpisodeUnplayed")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(MarkEpisodeUnplayedReq)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.Pod.MarkEpisodeUnplayed
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *MarkEpisodeUnplayedReq) (*Response, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*MarkEpisodeUnplayedReq)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*MarkEpisodeUnplayedReq) when calling interceptor")
+					}
+					return s.Pod.MarkEpisodeUnplayed(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*Response)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*Response) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *Response
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *Response and nil error while calling MarkEpisodeUnplayed. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *podServer) serveGetSubscriptions(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveGetSubscriptionsJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveGetSubscriptionsProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *podServer) serveGetSubscriptionsJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "GetSubscriptions")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(GetSubReq)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.Pod.GetSubscriptions
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *GetSubReq) (*Subscriptions, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*GetSubReq)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*GetSubReq) when calling interceptor")
+					}
+					return s.Pod.GetSubscriptions(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*Subscriptions)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*Subscriptions) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *Subscriptions
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *Subscriptions and nil error while calling GetSubscriptions. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *podServer) serveGetSubscriptionsProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "GetSubscriptions")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(GetSubReq)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.Pod.GetSubscriptions
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *GetSubReq) (*Subscriptions, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*GetSubReq)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*GetSubReq) when calling interceptor")
+					}
+					return s.Pod.GetSubscriptions(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*Subscriptions)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*Subscriptions) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *Subscriptions
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *Subscriptions and nil error while calling GetSubscriptions. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *podServer) serveListCategories(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveListCategoriesJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveListCategoriesProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *podServer) serveListCategoriesJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "ListCategories")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(ListCategoriesReq)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.Pod.ListCategories
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *ListCategoriesReq) (*Categories, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ListCategoriesReq)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ListCategoriesReq) when calling interceptor")
+					}
+					return s.Pod.ListCategories(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*Categories)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*Categories) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *Categories
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *Categories and nil error while calling ListCategories. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *podServer) serveListCategoriesProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "ListCategories")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(ListCategoriesReq)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.Pod.ListCategories
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *ListCategoriesReq) (*Categories, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ListCategoriesReq)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ListCategoriesReq) when calling interceptor")
+					}
+					return s.Pod.ListCategories(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*Categories)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*Categories) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *Categories
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *Categories and nil error while calling ListCategories. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *podServer) serveBrowseCategory(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveBrowseCategoryJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveBrowseCategoryProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *podServer) serveBrowseCategoryJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "BrowseCategory")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(BrowseCategoryReq)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.Pod.BrowseCategory
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *BrowseCategoryReq) (*Podcasts, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*BrowseCategoryReq)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*BrowseCategoryReq) when calling interceptor")
+					}
+					return s.Pod.BrowseCategory(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*Podcasts)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*Podcasts) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *Podcasts
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *Podcasts and nil error while calling BrowseCategory. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *podServer) serveBrowseCategoryProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "BrowseCategory")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(BrowseCategoryReq)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.Pod.BrowseCategory
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *BrowseCategoryReq) (*Podcasts, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*BrowseCategoryReq)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*BrowseCategoryReq) when calling interceptor")
+					}
+					return s.Pod.BrowseCategory(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*Podcasts)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*Podcasts) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *Podcasts
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *Podcasts and nil error while calling BrowseCategory. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *podServer) serveGetCharts(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveGetChartsJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveGetChartsProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *podServer) serveGetChartsJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "GetCharts")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(GetChartsReq)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.Pod.GetCharts
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *GetChartsReq) (*Podcasts, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*GetChartsReq)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*GetChartsReq) when calling interceptor")
+					}
+					return s.Pod.GetCharts(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*Podcasts)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*Podcasts) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *Podcasts
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *Podcasts and nil error while calling GetCharts. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *podServer) serveGetChartsProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "GetCharts")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(GetChartsReq)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.Pod.GetCharts
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *GetChartsReq) (*Podcasts, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*GetChartsReq)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*GetChartsReq) when calling interceptor")
+					}
+					return s.Pod.GetCharts(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*Podcasts)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*Podcasts) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *Podcasts
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *Podcasts and nil error while calling GetCharts. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *podServer) serveGetRecommendations(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveGetRecommendationsJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveGetRecommendationsProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *podServer) serveGetRecommendationsJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "GetRecommendations")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(GetRecommendationsReq)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.Pod.GetRecommendations
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *GetRecommendationsReq) (*Podcasts, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*GetRecommendationsReq)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*GetRecommendationsReq) when calling interceptor")
+					}
+					return s.Pod.GetRecommendations(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*Podcasts)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*Podcasts) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *Podcasts
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *Podcasts and nil error while calling GetRecommendations. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *podServer) serveGetRecommendationsProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "GetRecommendations")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(GetRecommendationsReq)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.Pod.GetRecommendations
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *GetRecommendationsReq) (*Podcasts, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*GetRecommendationsReq)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*GetRecommendationsReq) when calling interceptor")
+					}
+					return s.Pod.GetRecommendations(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*Podcasts)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*Podcasts) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *Podcasts
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *Podcasts and nil error while calling GetRecommendations. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *podServer) serveGetSimilarPodcasts(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveGetSimilarPodcastsJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveGetSimilarPodcastsProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *podServer) serveGetSimilarPodcastsJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "GetSimilarPodcasts")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(GetSimilarPodcastsReq)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.Pod.GetSimilarPodcasts
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *GetSimilarPodcastsReq) (*Podcasts, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*GetSimilarPodcastsReq)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*GetSimilarPodcastsReq) when calling interceptor")
+					}
+					return s.Pod.GetSimilarPodcasts(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*Podcasts)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*Podcasts) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *Podcasts
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *Podcasts and nil error while calling GetSimilarPodcasts. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *podServer) serveGetSimilarPodcastsProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "GetSimilarPodcasts")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(GetSimilarPodcastsReq)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.Pod.GetSimilarPodcasts
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *GetSimilarPodcastsReq) (*Podcasts, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*GetSimilarPodcastsReq)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*GetSimilarPodcastsReq) when calling interceptor")
+					}
+					return s.Pod.GetSimilarPodcasts(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*Podcasts)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*Podcasts) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *Podcasts
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *Podcasts and nil error while calling GetSimilarPodcasts. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *podServer) serveGetQueue(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveGetQueueJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveGetQueueProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *podServer) serveGetQueueJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "GetQueue")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(GetQueueReq)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.Pod.GetQueue
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *GetQueueReq) (*Episodes, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*GetQueueReq)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*GetQueueReq) when calling interceptor")
+					}
+					return s.Pod.GetQueue(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*Episodes)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*Episodes) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *Episodes
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *Episodes and nil error while calling GetQueue. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *podServer) serveGetQueueProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "GetQueue")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(GetQueueReq)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.Pod.GetQueue
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *GetQueueReq) (*Episodes, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*GetQueueReq)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*GetQueueReq) when calling interceptor")
+					}
+					return s.Pod.GetQueue(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*Episodes)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*Episodes) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *Episodes
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *Episodes and nil error while calling GetQueue. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *podServer) serveAddToQueue(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveAddToQueueJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveAddToQueueProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *podServer) serveAddToQueueJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "AddToQueue")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(AddToQueueReq)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.Pod.AddToQueue
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *AddToQueueReq) (*Episodes, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*AddToQueueReq)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*AddToQueueReq) when calling interceptor")
+					}
+					return s.Pod.AddToQueue(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*Episodes)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*Episodes) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *Episodes
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *Episodes and nil error while calling AddToQueue. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *podServer) serveAddToQueueProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "AddToQueue")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(AddToQueueReq)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.Pod.AddToQueue
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *AddToQueueReq) (*Episodes, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*AddToQueueReq)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*AddToQueueReq) when calling interceptor")
+					}
+					return s.Pod.AddToQueue(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*Episodes)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*Episodes) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *Episodes
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *Episodes and nil error while calling AddToQueue. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *podServer) serveRemoveFromQueue(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveRemoveFromQueueJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveRemoveFromQueueProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *podServer) serveRemoveFromQueueJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "RemoveFromQueue")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(RemoveFromQueueReq)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.Pod.RemoveFromQueue
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *RemoveFromQueueReq) (*Episodes, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*RemoveFromQueueReq)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*RemoveFromQueueReq) when calling interceptor")
+					}
+					return s.Pod.RemoveFromQueue(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*Episodes)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*Episodes) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *Episodes
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *Episodes and nil error while calling RemoveFromQueue. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *podServer) serveRemoveFromQueueProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "RemoveFromQueue")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(RemoveFromQueueReq)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.Pod.RemoveFromQueue
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *RemoveFromQueueReq) (*Episodes, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*RemoveFromQueueReq)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*RemoveFromQueueReq) when calling interceptor")
+					}
+					return s.Pod.RemoveFromQueue(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*Episodes)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*Episodes) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *Episodes
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *Episodes and nil error while calling RemoveFromQueue. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *podServer) serveReorderQueue(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveReorderQueueJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveReorderQueueProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *podServer) serveReorderQueueJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "ReorderQueue")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(ReorderQueueReq)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.Pod.ReorderQueue
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *ReorderQueueReq) (*Episodes, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ReorderQueueReq)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ReorderQueueReq) when calling interceptor")
+					}
+					return s.Pod.ReorderQueue(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*Episodes)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*Episodes) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *Episodes
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *Episodes and nil error while calling ReorderQueue. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *podServer) serveReorderQueueProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "ReorderQueue")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(ReorderQueueReq)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.Pod.ReorderQueue
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *ReorderQueueReq) (*Episodes, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ReorderQueueReq)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ReorderQueueReq) when calling interceptor")
+					}
+					return s.Pod.ReorderQueue(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*Episodes)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*Episodes) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *Episodes
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *Episodes and nil error while calling ReorderQueue. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *podServer) serveClearQueue(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveClearQueueJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveClearQueueProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *podServer) serveClearQueueJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "ClearQueue")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(ClearQueueReq)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.Pod.ClearQueue
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *ClearQueueReq) (*Response, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ClearQueueReq)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ClearQueueReq) when calling interceptor")
+					}
+					return s.Pod.ClearQueue(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*Response)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*Response) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *Response
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *Response and nil error while calling ClearQueue. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *podServer) serveClearQueueProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "ClearQueue")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(ClearQueueReq)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.Pod.ClearQueue
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *ClearQueueReq) (*Response, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ClearQueueReq)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ClearQueueReq) when calling interceptor")
+					}
+					return s.Pod.ClearQueue(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*Response)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*Response) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *Response
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *Response and nil error while calling ClearQueue. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *podServer) serveSetAutoQueue(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveSetAutoQueueJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveSetAutoQueueProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *podServer) serveSetAutoQueueJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "SetAutoQueue")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(SetAutoQueueReq)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.Pod.SetAutoQueue
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *SetAutoQueueReq) (*Response, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*SetAutoQueueReq)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*SetAutoQueueReq) when calling interceptor")
+					}
+					return s.Pod.SetAutoQueue(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*Response)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*Response) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *Response
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *Response and nil error while calling SetAutoQueue. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *podServer) serveSetAutoQueueProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "SetAutoQueue")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(SetAutoQueueReq)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.Pod.SetAutoQueue
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *SetAutoQueueReq) (*Response, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*SetAutoQueueReq)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*SetAutoQueueReq) when calling interceptor")
+					}
+					return s.Pod.SetAutoQueue(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*Response)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*Response) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *Response
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *Response and nil error while calling SetAutoQueue. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *podServer) serveCreatePlaylist(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveCreatePlaylistJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveCreatePlaylistProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *podServer) serveCreatePlaylistJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "CreatePlaylist")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(CreatePlaylistReq)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.Pod.CreatePlaylist
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *CreatePlaylistReq) (*Playlist, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*CreatePlaylistReq)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*CreatePlaylistReq) when calling interceptor")
+					}
+					return s.Pod.CreatePlaylist(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*Playlist)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*Playlist) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *Playlist
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *Playlist and nil error while calling CreatePlaylist. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *podServer) serveCreatePlaylistProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "CreatePlaylist")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(CreatePlaylistReq)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.Pod.CreatePlaylist
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *CreatePlaylistReq) (*Playlist, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*CreatePlaylistReq)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*CreatePlaylistReq) when calling interceptor")
+					}
+					return s.Pod.CreatePlaylist(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*Playlist)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*Playlist) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *Playlist
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *Playlist and nil error while calling CreatePlaylist. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *podServer) serveGetPlaylists(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveGetPlaylistsJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveGetPlaylistsProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *podServer) serveGetPlaylistsJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "GetPlaylists")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(GetPlaylistsReq)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.Pod.GetPlaylists
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *GetPlaylistsReq) (*Playlists, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*GetPlaylistsReq)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*GetPlaylistsReq) when calling interceptor")
+					}
+					return s.Pod.GetPlaylists(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*Playlists)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*Playlists) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *Playlists
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *Playlists and nil error while calling GetPlaylists. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *podServer) serveGetPlaylistsProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "GetPlaylists")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(GetPlaylistsReq)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.Pod.GetPlaylists
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *GetPlaylistsReq) (*Playlists, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*GetPlaylistsReq)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*GetPlaylistsReq) when calling interceptor")
+					}
+					return s.Pod.GetPlaylists(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*Playlists)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*Playlists) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *Playlists
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *Playlists and nil error while calling GetPlaylists. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *podServer) serveUpdatePlaylist(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveUpdatePlaylistJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveUpdatePlaylistProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *podServer) serveUpdatePlaylistJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "UpdatePlaylist")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(UpdatePlaylistReq)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.Pod.UpdatePlaylist
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *UpdatePlaylistReq) (*Playlist, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*UpdatePlaylistReq)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*UpdatePlaylistReq) when calling interceptor")
+					}
+					return s.Pod.UpdatePlaylist(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*Playlist)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*Playlist) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *Playlist
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *Playlist and nil error while calling UpdatePlaylist. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *podServer) serveUpdatePlaylistProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "UpdatePlaylist")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(UpdatePlaylistReq)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.Pod.UpdatePlaylist
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *UpdatePlaylistReq) (*Playlist, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*UpdatePlaylistReq)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*UpdatePlaylistReq) when calling interceptor")
+					}
+					return s.Pod.UpdatePlaylist(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*Playlist)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*Playlist) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *Playlist
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *Playlist and nil error while calling UpdatePlaylist. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *podServer) serveDeletePlaylist(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveDeletePlaylistJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveDeletePlaylistProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *podServer) serveDeletePlaylistJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "DeletePlaylist")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(DeletePlaylistReq)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.Pod.DeletePlaylist
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *DeletePlaylistReq) (*Response, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*DeletePlaylistReq)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*DeletePlaylistReq) when calling interceptor")
+					}
+					return s.Pod.DeletePlaylist(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*Response)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*Response) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *Response
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *Response and nil error while calling DeletePlaylist. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *podServer) serveDeletePlaylistProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "DeletePlaylist")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(DeletePlaylistReq)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.Pod.DeletePlaylist
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *DeletePlaylistReq) (*Response, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*DeletePlaylistReq)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*DeletePlaylistReq) when calling interceptor")
+					}
+					return s.Pod.DeletePlaylist(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*Response)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*Response) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *Response
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *Response and nil error while calling DeletePlaylist. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *podServer) serveGetPlaylistEpisodes(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveGetPlaylistEpisodesJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveGetPlaylistEpisodesProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *podServer) serveGetPlaylistEpisodesJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "GetPlaylistEpisodes")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(GetPlaylistEpisodesReq)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.Pod.GetPlaylistEpisodes
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *GetPlaylistEpisodesReq) (*Episodes, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*GetPlaylistEpisodesReq)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*GetPlaylistEpisodesReq) when calling interceptor")
+					}
+					return s.Pod.GetPlaylistEpisodes(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*Episodes)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*Episodes) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *Episodes
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *Episodes and nil error while calling GetPlaylistEpisodes. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *podServer) serveGetPlaylistEpisodesProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "GetPlaylistEpisodes")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(GetPlaylistEpisodesReq)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.Pod.GetPlaylistEpisodes
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *GetPlaylistEpisodesReq) (*Episodes, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*GetPlaylistEpisodesReq)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*GetPlaylistEpisodesReq) when calling interceptor")
+					}
+					return s.Pod.GetPlaylistEpisodes(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*Episodes)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*Episodes) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *Episodes
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *Episodes and nil error while calling GetPlaylistEpisodes. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *podServer) serveSync(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveSyncJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveSyncProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *podServer) serveSyncJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "Sync")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(SyncReq)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.Pod.Sync
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *SyncReq) (*SyncRes, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*SyncReq)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*SyncReq) when calling interceptor")
+					}
+					return s.Pod.Sync(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*SyncRes)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*SyncRes) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *SyncRes
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *SyncRes and nil error while calling Sync. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *podServer) serveSyncProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "Sync")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(SyncReq)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.Pod.Sync
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *SyncReq) (*SyncRes, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*SyncReq)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*SyncReq) when calling interceptor")
+					}
+					return s.Pod.Sync(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*SyncRes)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*SyncRes) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *SyncRes
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *SyncRes and nil error while calling Sync. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *podServer) serveAddListeningSession(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveAddListeningSessionJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveAddListeningSessionProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *podServer) serveAddListeningSessionJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "AddListeningSession")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(AddListeningSessionReq)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.Pod.AddListeningSession
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *AddListeningSessionReq) (*Response, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*AddListeningSessionReq)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*AddListeningSessionReq) when calling interceptor")
+					}
+					return s.Pod.AddListeningSession(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*Response)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*Response) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *Response
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *Response and nil error while calling AddListeningSession. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *podServer) serveAddListeningSessionProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "AddListeningSession")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(AddListeningSessionReq)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.Pod.AddListeningSession
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *AddListeningSessionReq) (*Response, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*AddListeningSessionReq)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*AddListeningSessionReq) when calling interceptor")
+					}
+					return s.Pod.AddListeningSession(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*Response)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*Response) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *Response
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *Response and nil error while calling AddListeningSession. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *podServer) serveGetHistory(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveGetHistoryJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveGetHistoryProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *podServer) serveGetHistoryJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "GetHistory")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(GetHistoryReq)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.Pod.GetHistory
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *GetHistoryReq) (*History, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*GetHistoryReq)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*GetHistoryReq) when calling interceptor")
+					}
+					return s.Pod.GetHistory(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*History)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*History) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *History
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *History and nil error while calling GetHistory. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *podServer) serveGetHistoryProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "GetHistory")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(GetHistoryReq)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.Pod.GetHistory
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *GetHistoryReq) (*History, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*GetHistoryReq)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*GetHistoryReq) when calling interceptor")
+					}
+					return s.Pod.GetHistory(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*History)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*History) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *History
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *History and nil error while calling GetHistory. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *podServer) serveGetStats(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveGetStatsJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveGetStatsProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *podServer) serveGetStatsJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "GetStats")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(GetStatsReq)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.Pod.GetStats
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *GetStatsReq) (*Stats, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*GetStatsReq)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*GetStatsReq) when calling interceptor")
+					}
+					return s.Pod.GetStats(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*Stats)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*Stats) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *Stats
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *Stats and nil error while calling GetStats. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *podServer) serveGetStatsProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "GetStats")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(GetStatsReq)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.Pod.GetStats
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *GetStatsReq) (*Stats, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*GetStatsReq)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*GetStatsReq) when calling interceptor")
+					}
+					return s.Pod.GetStats(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*Stats)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*Stats) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *Stats
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *Stats and nil error while calling GetStats. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *podServer) serveCreateBookmark(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveCreateBookmarkJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveCreateBookmarkProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *podServer) serveCreateBookmarkJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "CreateBookmark")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(CreateBookmarkReq)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.Pod.CreateBookmark
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *CreateBookmarkReq) (*Bookmark, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*CreateBookmarkReq)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*CreateBookmarkReq) when calling interceptor")
+					}
+					return s.Pod.CreateBookmark(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*Bookmark)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*Bookmark) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *Bookmark
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *Bookmark and nil error while calling CreateBookmark. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *podServer) serveCreateBookmarkProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "CreateBookmark")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(CreateBookmarkReq)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.Pod.CreateBookmark
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *CreateBookmarkReq) (*Bookmark, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*CreateBookmarkReq)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*CreateBookmarkReq) when calling interceptor")
+					}
+					return s.Pod.CreateBookmark(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*Bookmark)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*Bookmark) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *Bookmark
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *Bookmark and nil error while calling CreateBookmark. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
 	}
+	callResponseSent(ctx, s.hooks)
 }
 
-// writeError writes an HTTP response with a valid Twirp error format, and triggers hooks.
-// If err is not a twirp.Error, it will get wrapped with twirp.InternalErrorWith(err)
-func (s *podServer) writeError(ctx context.Context, resp http.ResponseWriter, err error) {
-	writeError(ctx, resp, err, s.hooks)
+func (s *podServer) serveGetBookmarks(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveGetBookmarksJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveGetBookmarksProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
 }
 
-// handleRequestBodyError is used to handle error when the twirp server cannot read request
-func (s *podServer) handleRequestBodyError(ctx context.Context, resp http.ResponseWriter, msg string, err error) {
-	if context.Canceled == ctx.Err() {
-		s.writeError(ctx, resp, twirp.NewError(twirp.Canceled, "failed to read request: context canceled"))
+func (s *podServer) serveGetBookmarksJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "GetBookmarks")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(GetBookmarksReq)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.Pod.GetBookmarks
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *GetBookmarksReq) (*Bookmarks, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*GetBookmarksReq)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*GetBookmarksReq) when calling interceptor")
+					}
+					return s.Pod.GetBookmarks(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*Bookmarks)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*Bookmarks) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *Bookmarks
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *Bookmarks and nil error while calling GetBookmarks. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *podServer) serveGetBookmarksProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "GetBookmarks")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(GetBookmarksReq)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.Pod.GetBookmarks
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *GetBookmarksReq) (*Bookmarks, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*GetBookmarksReq)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*GetBookmarksReq) when calling interceptor")
+					}
+					return s.Pod.GetBookmarks(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*Bookmarks)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*Bookmarks) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *Bookmarks
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *Bookmarks and nil error while calling GetBookmarks. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *podServer) serveDeleteBookmark(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveDeleteBookmarkJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveDeleteBookmarkProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *podServer) serveDeleteBookmarkJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "DeleteBookmark")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(DeleteBookmarkReq)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.Pod.DeleteBookmark
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *DeleteBookmarkReq) (*Response, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*DeleteBookmarkReq)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*DeleteBookmarkReq) when calling interceptor")
+					}
+					return s.Pod.DeleteBookmark(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*Response)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*Response) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *Response
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *Response and nil error while calling DeleteBookmark. nil responses are not supported"))
 		return
 	}
-	if context.DeadlineExceeded == ctx.Err() {
-		s.writeError(ctx, resp, twirp.NewError(twirp.DeadlineExceeded, "failed to read request: deadline exceeded"))
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
 		return
 	}
-	s.writeError(ctx, resp, twirp.WrapError(malformedRequestError(msg), err))
-}
 
-// PodPathPrefix is a convenience constant that may identify URL paths.
-// Should be used with caution, it only matches routes generated by Twirp Go clients,
-// with the default "/twirp" prefix and default CamelCase service and method names.
-// More info: https://twitchtv.github.io/twirp/docs/routing.html
-const PodPathPrefix = "/twirp/protos.Pod/"
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
 
-func (s *podServer) ServeHTTP(resp http.ResponseWriter, req *http.Request) {
-	ctx := req.Context()
-	ctx = ctxsetters.WithPackageName(ctx, "protos")
-	ctx = ctxsetters.WithServiceName(ctx, "Pod")
-	ctx = ctxsetters.WithResponseWriter(ctx, resp)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
 
+func (s *podServer) serveDeleteBookmarkProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	var err error
-	ctx, err = callRequestReceived(ctx, s.hooks)
+	ctx = ctxsetters.WithMethodName(ctx, "DeleteBookmark")
+	ctx, err = callRequestRouted(ctx, s.hooks)
 	if err != nil {
 		s.writeError(ctx, resp, err)
 		return
 	}
 
-	if req.Method != "POST" {
-		msg := fmt.Sprintf("unsupported method %q (only POST is allowed)", req.Method)
-		s.writeError(ctx, resp, badRouteError(msg, req.Method, req.URL.Path))
+	buf, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
 		return
 	}
-
-	// Verify path format: [<prefix>]/<package>.<Service>/<Method>
-	prefix, pkgService, method := parseTwirpPath(req.URL.Path)
-	if pkgService != "protos.Pod" {
-		msg := fmt.Sprintf("no handler for path %q", req.URL.Path)
-		s.writeError(ctx, resp, badRouteError(msg, req.Method, req.URL.Path))
+	reqContent := new(DeleteBookmarkReq)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
 		return
 	}
-	if prefix != s.pathPrefix {
-		msg := fmt.Sprintf("invalid path prefix %q, expected %q, on path %q", prefix, s.pathPrefix, req.URL.Path)
-		s.writeError(ctx, resp, badRouteError(msg, req.Method, req.URL.Path))
-		return
+
+	handler := s.Pod.DeleteBookmark
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *DeleteBookmarkReq) (*Response, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*DeleteBookmarkReq)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*DeleteBookmarkReq) when calling interceptor")
+					}
+					return s.Pod.DeleteBookmark(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*Response)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*Response) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
 	}
 
-	switch method {
-	case "GetPodcast":
-		s.serveGetPodcast(ctx, resp, req)
-		return
-	case "GetEpisodes":
-		s.serveGetEpisodes(ctx, resp, req)
-		return
-	case "GetUserEpisode":
-		s.serveGetUserEpisode(ctx, resp, req)
-		return
-	case "UpsertUserEpisode":
-		s.serveUpsertUserEpisode(ctx, resp, req)
-		return
-	case "GetSubscriptions":
-		s.serveGetSubscriptions(ctx, resp, req)
+	// Call service method
+	var respContent *Response
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
 		return
-	case "GetUserLastPlayed":
-		s.serveGetUserLastPlayed(ctx, resp, req)
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *Response and nil error while calling DeleteBookmark. nil responses are not supported"))
 		return
-	default:
-		msg := fmt.Sprintf("no handler for path %q", req.URL.Path)
-		s.writeError(ctx, resp, badRouteError(msg, req.Method, req.URL.Path))
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
 		return
 	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
 }
 
-func (s *podServer) serveGetPodcast(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *podServer) serveCreateClip(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	header := req.Header.Get("Content-Type")
 	i := strings.Index(header, ";")
 	if i == -1 {
@@ -828,9 +9486,9 @@ func (s *podServer) serveGetPodcast(ctx context.Context, resp http.ResponseWrite
 	}
 	switch strings.TrimSpace(strings.ToLower(header[:i])) {
 	case "application/json":
-		s.serveGetPodcastJSON(ctx, resp, req)
+		s.serveCreateClipJSON(ctx, resp, req)
 	case "application/protobuf":
-		s.serveGetPodcastProtobuf(ctx, resp, req)
+		s.serveCreateClipProtobuf(ctx, resp, req)
 	default:
 		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
 		twerr := badRouteError(msg, req.Method, req.URL.Path)
@@ -838,9 +9496,9 @@ func (s *podServer) serveGetPodcast(ctx context.Context, resp http.ResponseWrite
 	}
 }
 
-func (s *podServer) serveGetPodcastJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *podServer) serveCreateClipJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	var err error
-	ctx = ctxsetters.WithMethodName(ctx, "GetPodcast")
+	ctx = ctxsetters.WithMethodName(ctx, "CreateClip")
 	ctx, err = callRequestRouted(ctx, s.hooks)
 	if err != nil {
 		s.writeError(ctx, resp, err)
@@ -853,29 +9511,29 @@ func (s *podServer) serveGetPodcastJSON(ctx context.Context, resp http.ResponseW
 		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
 		return
 	}
-	reqContent := new(GetPodReq)
+	reqContent := new(CreateClipReq)
 	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
 	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
 		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
 		return
 	}
 
-	handler := s.Pod.GetPodcast
+	handler := s.Pod.CreateClip
 	if s.interceptor != nil {
-		handler = func(ctx context.Context, req *GetPodReq) (*Podcast, error) {
+		handler = func(ctx context.Context, req *CreateClipReq) (*Clip, error) {
 			resp, err := s.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*GetPodReq)
+					typedReq, ok := req.(*CreateClipReq)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*GetPodReq) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*CreateClipReq) when calling interceptor")
 					}
-					return s.Pod.GetPodcast(ctx, typedReq)
+					return s.Pod.CreateClip(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*Podcast)
+				typedResp, ok := resp.(*Clip)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*Podcast) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*Clip) when calling interceptor")
 				}
 				return typedResp, err
 			}
@@ -884,7 +9542,7 @@ func (s *podServer) serveGetPodcastJSON(ctx context.Context, resp http.ResponseW
 	}
 
 	// Call service method
-	var respContent *Podcast
+	var respContent *Clip
 	func() {
 		defer ensurePanicResponses(ctx, resp, s.hooks)
 		respContent, err = handler(ctx, reqContent)
@@ -895,7 +9553,7 @@ func (s *podServer) serveGetPodcastJSON(ctx context.Context, resp http.ResponseW
 		return
 	}
 	if respContent == nil {
-		s.writeError(ctx, resp, twirp.InternalError("received a nil *Podcast and nil error while calling GetPodcast. nil responses are not supported"))
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *Clip and nil error while calling CreateClip. nil responses are not supported"))
 		return
 	}
 
@@ -921,9 +9579,9 @@ func (s *podServer) serveGetPodcastJSON(ctx context.Context, resp http.ResponseW
 	callResponseSent(ctx, s.hooks)
 }
 
-func (s *podServer) serveGetPodcastProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *podServer) serveCreateClipProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	var err error
-	ctx = ctxsetters.WithMethodName(ctx, "GetPodcast")
+	ctx = ctxsetters.WithMethodName(ctx, "CreateClip")
 	ctx, err = callRequestRouted(ctx, s.hooks)
 	if err != nil {
 		s.writeError(ctx, resp, err)
@@ -935,28 +9593,28 @@ func (s *podServer) serveGetPodcastProtobuf(ctx context.Context, resp http.Respo
 		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
 		return
 	}
-	reqContent := new(GetPodReq)
+	reqContent := new(CreateClipReq)
 	if err = proto.Unmarshal(buf, reqContent); err != nil {
 		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
 		return
 	}
 
-	handler := s.Pod.GetPodcast
+	handler := s.Pod.CreateClip
 	if s.interceptor != nil {
-		handler = func(ctx context.Context, req *GetPodReq) (*Podcast, error) {
+		handler = func(ctx context.Context, req *CreateClipReq) (*Clip, error) {
 			resp, err := s.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*GetPodReq)
+					typedReq, ok := req.(*CreateClipReq)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*GetPodReq) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*CreateClipReq) when calling interceptor")
 					}
-					return s.Pod.GetPodcast(ctx, typedReq)
+					return s.Pod.CreateClip(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*Podcast)
+				typedResp, ok := resp.(*Clip)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*Podcast) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*Clip) when calling interceptor")
 				}
 				return typedResp, err
 			}
@@ -965,7 +9623,7 @@ func (s *podServer) serveGetPodcastProtobuf(ctx context.Context, resp http.Respo
 	}
 
 	// Call service method
-	var respContent *Podcast
+	var respContent *Clip
 	func() {
 		defer ensurePanicResponses(ctx, resp, s.hooks)
 		respContent, err = handler(ctx, reqContent)
@@ -976,7 +9634,7 @@ func (s *podServer) serveGetPodcastProtobuf(ctx context.Context, resp http.Respo
 		return
 	}
 	if respContent == nil {
-		s.writeError(ctx, resp, twirp.InternalError("received a nil *Podcast and nil error while calling GetPodcast. nil responses are not supported"))
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *Clip and nil error while calling CreateClip. nil responses are not supported"))
 		return
 	}
 
@@ -1000,7 +9658,7 @@ func (s *podServer) serveGetPodcastProtobuf(ctx context.Context, resp http.Respo
 	callResponseSent(ctx, s.hooks)
 }
 
-func (s *podServer) serveGetEpisodes(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *podServer) serveGetClips(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	header := req.Header.Get("Content-Type")
 	i := strings.Index(header, ";")
 	if i == -1 {
@@ -1008,9 +9666,9 @@ func (s *podServer) serveGetEpisodes(ctx context.Context, resp http.ResponseWrit
 	}
 	switch strings.TrimSpace(strings.ToLower(header[:i])) {
 	case "application/json":
-		s.serveGetEpisodesJSON(ctx, resp, req)
+		s.serveGetClipsJSON(ctx, resp, req)
 	case "application/protobuf":
-		s.serveGetEpisodesProtobuf(ctx, resp, req)
+		s.serveGetClipsProtobuf(ctx, resp, req)
 	default:
 		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
 		twerr := badRouteError(msg, req.Method, req.URL.Path)
@@ -1018,9 +9676,9 @@ func (s *podServer) serveGetEpisodes(ctx context.Context, resp http.ResponseWrit
 	}
 }
 
-func (s *podServer) serveGetEpisodesJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *podServer) serveGetClipsJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	var err error
-	ctx = ctxsetters.WithMethodName(ctx, "GetEpisodes")
+	ctx = ctxsetters.WithMethodName(ctx, "GetClips")
 	ctx, err = callRequestRouted(ctx, s.hooks)
 	if err != nil {
 		s.writeError(ctx, resp, err)
@@ -1033,29 +9691,29 @@ func (s *podServer) serveGetEpisodesJSON(ctx context.Context, resp http.Response
 		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
 		return
 	}
-	reqContent := new(GetEpiReq)
+	reqContent := new(GetClipsReq)
 	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
 	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
 		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
 		return
 	}
 
-	handler := s.Pod.GetEpisodes
+	handler := s.Pod.GetClips
 	if s.interceptor != nil {
-		handler = func(ctx context.Context, req *GetEpiReq) (*Episodes, error) {
+		handler = func(ctx context.Context, req *GetClipsReq) (*Clips, error) {
 			resp, err := s.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*GetEpiReq)
+					typedReq, ok := req.(*GetClipsReq)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*GetEpiReq) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*GetClipsReq) when calling interceptor")
 					}
-					return s.Pod.GetEpisodes(ctx, typedReq)
+					return s.Pod.GetClips(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*Episodes)
+				typedResp, ok := resp.(*Clips)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*Episodes) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*Clips) when calling interceptor")
 				}
 				return typedResp, err
 			}
@@ -1064,7 +9722,7 @@ func (s *podServer) serveGetEpisodesJSON(ctx context.Context, resp http.Response
 	}
 
 	// Call service method
-	var respContent *Episodes
+	var respContent *Clips
 	func() {
 		defer ensurePanicResponses(ctx, resp, s.hooks)
 		respContent, err = handler(ctx, reqContent)
@@ -1075,7 +9733,7 @@ func (s *podServer) serveGetEpisodesJSON(ctx context.Context, resp http.Response
 		return
 	}
 	if respContent == nil {
-		s.writeError(ctx, resp, twirp.InternalError("received a nil *Episodes and nil error while calling GetEpisodes. nil responses are not supported"))
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *Clips and nil error while calling GetClips. nil responses are not supported"))
 		return
 	}
 
@@ -1101,9 +9759,9 @@ func (s *podServer) serveGetEpisodesJSON(ctx context.Context, resp http.Response
 	callResponseSent(ctx, s.hooks)
 }
 
-func (s *podServer) serveGetEpisodesProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *podServer) serveGetClipsProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	var err error
-	ctx = ctxsetters.WithMethodName(ctx, "GetEpisodes")
+	ctx = ctxsetters.WithMethodName(ctx, "GetClips")
 	ctx, err = callRequestRouted(ctx, s.hooks)
 	if err != nil {
 		s.writeError(ctx, resp, err)
@@ -1115,28 +9773,28 @@ func (s *podServer) serveGetEpisodesProtobuf(ctx context.Context, resp http.Resp
 		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
 		return
 	}
-	reqContent := new(GetEpiReq)
+	reqContent := new(GetClipsReq)
 	if err = proto.Unmarshal(buf, reqContent); err != nil {
 		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
 		return
 	}
 
-	handler := s.Pod.GetEpisodes
+	handler := s.Pod.GetClips
 	if s.interceptor != nil {
-		handler = func(ctx context.Context, req *GetEpiReq) (*Episodes, error) {
+		handler = func(ctx context.Context, req *GetClipsReq) (*Clips, error) {
 			resp, err := s.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*GetEpiReq)
+					typedReq, ok := req.(*GetClipsReq)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*GetEpiReq) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*GetClipsReq) when calling interceptor")
 					}
-					return s.Pod.GetEpisodes(ctx, typedReq)
+					return s.Pod.GetClips(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*Episodes)
+				typedResp, ok := resp.(*Clips)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*Episodes) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*Clips) when calling interceptor")
 				}
 				return typedResp, err
 			}
@@ -1145,7 +9803,7 @@ func (s *podServer) serveGetEpisodesProtobuf(ctx context.Context, resp http.Resp
 	}
 
 	// Call service method
-	var respContent *Episodes
+	var respContent *Clips
 	func() {
 		defer ensurePanicResponses(ctx, resp, s.hooks)
 		respContent, err = handler(ctx, reqContent)
@@ -1156,7 +9814,7 @@ func (s *podServer) serveGetEpisodesProtobuf(ctx context.Context, resp http.Resp
 		return
 	}
 	if respContent == nil {
-		s.writeError(ctx, resp, twirp.InternalError("received a nil *Episodes and nil error while calling GetEpisodes. nil responses are not supported"))
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *Clips and nil error while calling GetClips. nil responses are not supported"))
 		return
 	}
 
@@ -1180,7 +9838,7 @@ func (s *podServer) serveGetEpisodesProtobuf(ctx context.Context, resp http.Resp
 	callResponseSent(ctx, s.hooks)
 }
 
-func (s *podServer) serveGetUserEpisode(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *podServer) serveDeleteClip(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	header := req.Header.Get("Content-Type")
 	i := strings.Index(header, ";")
 	if i == -1 {
@@ -1188,9 +9846,9 @@ func (s *podServer) serveGetUserEpisode(ctx context.Context, resp http.ResponseW
 	}
 	switch strings.TrimSpace(strings.ToLower(header[:i])) {
 	case "application/json":
-		s.serveGetUserEpisodeJSON(ctx, resp, req)
+		s.serveDeleteClipJSON(ctx, resp, req)
 	case "application/protobuf":
-		s.serveGetUserEpisodeProtobuf(ctx, resp, req)
+		s.serveDeleteClipProtobuf(ctx, resp, req)
 	default:
 		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
 		twerr := badRouteError(msg, req.Method, req.URL.Path)
@@ -1198,9 +9856,9 @@ func (s *podServer) serveGetUserEpisode(ctx context.Context, resp http.ResponseW
 	}
 }
 
-func (s *podServer) serveGetUserEpisodeJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *podServer) serveDeleteClipJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	var err error
-	ctx = ctxsetters.WithMethodName(ctx, "GetUserEpisode")
+	ctx = ctxsetters.WithMethodName(ctx, "DeleteClip")
 	ctx, err = callRequestRouted(ctx, s.hooks)
 	if err != nil {
 		s.writeError(ctx, resp, err)
@@ -1213,29 +9871,29 @@ func (s *podServer) serveGetUserEpisodeJSON(ctx context.Context, resp http.Respo
 		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
 		return
 	}
-	reqContent := new(GetUserEpiReq)
+	reqContent := new(DeleteClipReq)
 	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
 	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
 		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
 		return
 	}
 
-	handler := s.Pod.GetUserEpisode
+	handler := s.Pod.DeleteClip
 	if s.interceptor != nil {
-		handler = func(ctx context.Context, req *GetUserEpiReq) (*UserEpisode, error) {
+		handler = func(ctx context.Context, req *DeleteClipReq) (*Response, error) {
 			resp, err := s.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*GetUserEpiReq)
+					typedReq, ok := req.(*DeleteClipReq)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*GetUserEpiReq) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*DeleteClipReq) when calling interceptor")
 					}
-					return s.Pod.GetUserEpisode(ctx, typedReq)
+					return s.Pod.DeleteClip(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*UserEpisode)
+				typedResp, ok := resp.(*Response)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*UserEpisode) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*Response) when calling interceptor")
 				}
 				return typedResp, err
 			}
@@ -1244,7 +9902,7 @@ func (s *podServer) serveGetUserEpisodeJSON(ctx context.Context, resp http.Respo
 	}
 
 	// Call service method
-	var respContent *UserEpisode
+	var respContent *Response
 	func() {
 		defer ensurePanicResponses(ctx, resp, s.hooks)
 		respContent, err = handler(ctx, reqContent)
@@ -1255,7 +9913,7 @@ func (s *podServer) serveGetUserEpisodeJSON(ctx context.Context, resp http.Respo
 		return
 	}
 	if respContent == nil {
-		s.writeError(ctx, resp, twirp.InternalError("received a nil *UserEpisode and nil error while calling GetUserEpisode. nil responses are not supported"))
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *Response and nil error while calling DeleteClip. nil responses are not supported"))
 		return
 	}
 
@@ -1281,9 +9939,9 @@ func (s *podServer) serveGetUserEpisodeJSON(ctx context.Context, resp http.Respo
 	callResponseSent(ctx, s.hooks)
 }
 
-func (s *podServer) serveGetUserEpisodeProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *podServer) serveDeleteClipProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	var err error
-	ctx = ctxsetters.WithMethodName(ctx, "GetUserEpisode")
+	ctx = ctxsetters.WithMethodName(ctx, "DeleteClip")
 	ctx, err = callRequestRouted(ctx, s.hooks)
 	if err != nil {
 		s.writeError(ctx, resp, err)
@@ -1295,28 +9953,28 @@ func (s *podServer) serveGetUserEpisodeProtobuf(ctx context.Context, resp http.R
 		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
 		return
 	}
-	reqContent := new(GetUserEpiReq)
+	reqContent := new(DeleteClipReq)
 	if err = proto.Unmarshal(buf, reqContent); err != nil {
 		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
 		return
 	}
 
-	handler := s.Pod.GetUserEpisode
+	handler := s.Pod.DeleteClip
 	if s.interceptor != nil {
-		handler = func(ctx context.Context, req *GetUserEpiReq) (*UserEpisode, error) {
+		handler = func(ctx context.Context, req *DeleteClipReq) (*Response, error) {
 			resp, err := s.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*GetUserEpiReq)
+					typedReq, ok := req.(*DeleteClipReq)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*GetUserEpiReq) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*DeleteClipReq) when calling interceptor")
 					}
-					return s.Pod.GetUserEpisode(ctx, typedReq)
+					return s.Pod.DeleteClip(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*UserEpisode)
+				typedResp, ok := resp.(*Response)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*UserEpisode) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*Response) when calling interceptor")
 				}
 				return typedResp, err
 			}
@@ -1325,7 +9983,7 @@ func (s *podServer) serveGetUserEpisodeProtobuf(ctx context.Context, resp http.R
 	}
 
 	// Call service method
-	var respContent *UserEpisode
+	var respContent *Response
 	func() {
 		defer ensurePanicResponses(ctx, resp, s.hooks)
 		respContent, err = handler(ctx, reqContent)
@@ -1336,7 +9994,7 @@ func (s *podServer) serveGetUserEpisodeProtobuf(ctx context.Context, resp http.R
 		return
 	}
 	if respContent == nil {
-		s.writeError(ctx, resp, twirp.InternalError("received a nil *UserEpisode and nil error while calling GetUserEpisode. nil responses are not supported"))
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *Response and nil error while calling DeleteClip. nil responses are not supported"))
 		return
 	}
 
@@ -1360,7 +10018,7 @@ func (s *podServer) serveGetUserEpisodeProtobuf(ctx context.Context, resp http.R
 	callResponseSent(ctx, s.hooks)
 }
 
-func (s *podServer) serveUpsertUserEpisode(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *podServer) serveGetPodcastSettings(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	header := req.Header.Get("Content-Type")
 	i := strings.Index(header, ";")
 	if i == -1 {
@@ -1368,9 +10026,9 @@ func (s *podServer) serveUpsertUserEpisode(ctx context.Context, resp http.Respon
 	}
 	switch strings.TrimSpace(strings.ToLower(header[:i])) {
 	case "application/json":
-		s.serveUpsertUserEpisodeJSON(ctx, resp, req)
+		s.serveGetPodcastSettingsJSON(ctx, resp, req)
 	case "application/protobuf":
-		s.serveUpsertUserEpisodeProtobuf(ctx, resp, req)
+		s.serveGetPodcastSettingsProtobuf(ctx, resp, req)
 	default:
 		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
 		twerr := badRouteError(msg, req.Method, req.URL.Path)
@@ -1378,9 +10036,9 @@ func (s *podServer) serveUpsertUserEpisode(ctx context.Context, resp http.Respon
 	}
 }
 
-func (s *podServer) serveUpsertUserEpisodeJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *podServer) serveGetPodcastSettingsJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	var err error
-	ctx = ctxsetters.WithMethodName(ctx, "UpsertUserEpisode")
+	ctx = ctxsetters.WithMethodName(ctx, "GetPodcastSettings")
 	ctx, err = callRequestRouted(ctx, s.hooks)
 	if err != nil {
 		s.writeError(ctx, resp, err)
@@ -1393,29 +10051,29 @@ func (s *podServer) serveUpsertUserEpisodeJSON(ctx context.Context, resp http.Re
 		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
 		return
 	}
-	reqContent := new(UserEpisode)
+	reqContent := new(GetPodcastSettingsReq)
 	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
 	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
 		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
 		return
 	}
 
-	handler := s.Pod.UpsertUserEpisode
+	handler := s.Pod.GetPodcastSettings
 	if s.interceptor != nil {
-		handler = func(ctx context.Context, req *UserEpisode) (*Response, error) {
+		handler = func(ctx context.Context, req *GetPodcastSettingsReq) (*PodcastSettings, error) {
 			resp, err := s.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*UserEpisode)
+					typedReq, ok := req.(*GetPodcastSettingsReq)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*UserEpisode) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*GetPodcastSettingsReq) when calling interceptor")
 					}
-					return s.Pod.UpsertUserEpisode(ctx, typedReq)
+					return s.Pod.GetPodcastSettings(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*Response)
+				typedResp, ok := resp.(*PodcastSettings)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*Response) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*PodcastSettings) when calling interceptor")
 				}
 				return typedResp, err
 			}
@@ -1424,7 +10082,7 @@ func (s *podServer) serveUpsertUserEpisodeJSON(ctx context.Context, resp http.Re
 	}
 
 	// Call service method
-	var respContent *Response
+	var respContent *PodcastSettings
 	func() {
 		defer ensurePanicResponses(ctx, resp, s.hooks)
 		respContent, err = handler(ctx, reqContent)
@@ -1435,7 +10093,7 @@ func (s *podServer) serveUpsertUserEpisodeJSON(ctx context.Context, resp http.Re
 		return
 	}
 	if respContent == nil {
-		s.writeError(ctx, resp, twirp.InternalError("received a nil *Response and nil error while calling UpsertUserEpisode. nil responses are not supported"))
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *PodcastSettings and nil error while calling GetPodcastSettings. nil responses are not supported"))
 		return
 	}
 
@@ -1461,9 +10119,9 @@ func (s *podServer) serveUpsertUserEpisodeJSON(ctx context.Context, resp http.Re
 	callResponseSent(ctx, s.hooks)
 }
 
-func (s *podServer) serveUpsertUserEpisodeProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *podServer) serveGetPodcastSettingsProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	var err error
-	ctx = ctxsetters.WithMethodName(ctx, "UpsertUserEpisode")
+	ctx = ctxsetters.WithMethodName(ctx, "GetPodcastSettings")
 	ctx, err = callRequestRouted(ctx, s.hooks)
 	if err != nil {
 		s.writeError(ctx, resp, err)
@@ -1475,28 +10133,28 @@ func (s *podServer) serveUpsertUserEpisodeProtobuf(ctx context.Context, resp htt
 		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
 		return
 	}
-	reqContent := new(UserEpisode)
+	reqContent := new(GetPodcastSettingsReq)
 	if err = proto.Unmarshal(buf, reqContent); err != nil {
 		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
 		return
 	}
 
-	handler := s.Pod.UpsertUserEpisode
+	handler := s.Pod.GetPodcastSettings
 	if s.interceptor != nil {
-		handler = func(ctx context.Context, req *UserEpisode) (*Response, error) {
+		handler = func(ctx context.Context, req *GetPodcastSettingsReq) (*PodcastSettings, error) {
 			resp, err := s.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*UserEpisode)
+					typedReq, ok := req.(*GetPodcastSettingsReq)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*UserEpisode) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*GetPodcastSettingsReq) when calling interceptor")
 					}
-					return s.Pod.UpsertUserEpisode(ctx, typedReq)
+					return s.Pod.GetPodcastSettings(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*Response)
+				typedResp, ok := resp.(*PodcastSettings)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*Response) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*PodcastSettings) when calling interceptor")
 				}
 				return typedResp, err
 			}
@@ -1505,7 +10163,7 @@ func (s *podServer) serveUpsertUserEpisodeProtobuf(ctx context.Context, resp htt
 	}
 
 	// Call service method
-	var respContent *Response
+	var respContent *PodcastSettings
 	func() {
 		defer ensurePanicResponses(ctx, resp, s.hooks)
 		respContent, err = handler(ctx, reqContent)
@@ -1516,7 +10174,7 @@ func (s *podServer) serveUpsertUserEpisodeProtobuf(ctx context.Context, resp htt
 		return
 	}
 	if respContent == nil {
-		s.writeError(ctx, resp, twirp.InternalError("received a nil *Response and nil error while calling UpsertUserEpisode. nil responses are not supported"))
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *PodcastSettings and nil error while calling GetPodcastSettings. nil responses are not supported"))
 		return
 	}
 
@@ -1540,7 +10198,7 @@ func (s *podServer) serveUpsertUserEpisodeProtobuf(ctx context.Context, resp htt
 	callResponseSent(ctx, s.hooks)
 }
 
-func (s *podServer) serveGetSubscriptions(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *podServer) serveUpdatePodcastSettings(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	header := req.Header.Get("Content-Type")
 	i := strings.Index(header, ";")
 	if i == -1 {
@@ -1548,9 +10206,9 @@ func (s *podServer) serveGetSubscriptions(ctx context.Context, resp http.Respons
 	}
 	switch strings.TrimSpace(strings.ToLower(header[:i])) {
 	case "application/json":
-		s.serveGetSubscriptionsJSON(ctx, resp, req)
+		s.serveUpdatePodcastSettingsJSON(ctx, resp, req)
 	case "application/protobuf":
-		s.serveGetSubscriptionsProtobuf(ctx, resp, req)
+		s.serveUpdatePodcastSettingsProtobuf(ctx, resp, req)
 	default:
 		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
 		twerr := badRouteError(msg, req.Method, req.URL.Path)
@@ -1558,9 +10216,9 @@ func (s *podServer) serveGetSubscriptions(ctx context.Context, resp http.Respons
 	}
 }
 
-func (s *podServer) serveGetSubscriptionsJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *podServer) serveUpdatePodcastSettingsJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	var err error
-	ctx = ctxsetters.WithMethodName(ctx, "GetSubscriptions")
+	ctx = ctxsetters.WithMethodName(ctx, "UpdatePodcastSettings")
 	ctx, err = callRequestRouted(ctx, s.hooks)
 	if err != nil {
 		s.writeError(ctx, resp, err)
@@ -1573,29 +10231,29 @@ func (s *podServer) serveGetSubscriptionsJSON(ctx context.Context, resp http.Res
 		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
 		return
 	}
-	reqContent := new(GetSubReq)
+	reqContent := new(PodcastSettings)
 	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
 	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
 		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
 		return
 	}
 
-	handler := s.Pod.GetSubscriptions
+	handler := s.Pod.UpdatePodcastSettings
 	if s.interceptor != nil {
-		handler = func(ctx context.Context, req *GetSubReq) (*Subscriptions, error) {
+		handler = func(ctx context.Context, req *PodcastSettings) (*PodcastSettings, error) {
 			resp, err := s.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*GetSubReq)
+					typedReq, ok := req.(*PodcastSettings)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*GetSubReq) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*PodcastSettings) when calling interceptor")
 					}
-					return s.Pod.GetSubscriptions(ctx, typedReq)
+					return s.Pod.UpdatePodcastSettings(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*Subscriptions)
+				typedResp, ok := resp.(*PodcastSettings)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*Subscriptions) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*PodcastSettings) when calling interceptor")
 				}
 				return typedResp, err
 			}
@@ -1604,7 +10262,7 @@ func (s *podServer) serveGetSubscriptionsJSON(ctx context.Context, resp http.Res
 	}
 
 	// Call service method
-	var respContent *Subscriptions
+	var respContent *PodcastSettings
 	func() {
 		defer ensurePanicResponses(ctx, resp, s.hooks)
 		respContent, err = handler(ctx, reqContent)
@@ -1615,7 +10273,7 @@ func (s *podServer) serveGetSubscriptionsJSON(ctx context.Context, resp http.Res
 		return
 	}
 	if respContent == nil {
-		s.writeError(ctx, resp, twirp.InternalError("received a nil *Subscriptions and nil error while calling GetSubscriptions. nil responses are not supported"))
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *PodcastSettings and nil error while calling UpdatePodcastSettings. nil responses are not supported"))
 		return
 	}
 
@@ -1641,9 +10299,9 @@ func (s *podServer) serveGetSubscriptionsJSON(ctx context.Context, resp http.Res
 	callResponseSent(ctx, s.hooks)
 }
 
-func (s *podServer) serveGetSubscriptionsProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *podServer) serveUpdatePodcastSettingsProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	var err error
-	ctx = ctxsetters.WithMethodName(ctx, "GetSubscriptions")
+	ctx = ctxsetters.WithMethodName(ctx, "UpdatePodcastSettings")
 	ctx, err = callRequestRouted(ctx, s.hooks)
 	if err != nil {
 		s.writeError(ctx, resp, err)
@@ -1655,28 +10313,28 @@ func (s *podServer) serveGetSubscriptionsProtobuf(ctx context.Context, resp http
 		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
 		return
 	}
-	reqContent := new(GetSubReq)
+	reqContent := new(PodcastSettings)
 	if err = proto.Unmarshal(buf, reqContent); err != nil {
 		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
 		return
 	}
 
-	handler := s.Pod.GetSubscriptions
+	handler := s.Pod.UpdatePodcastSettings
 	if s.interceptor != nil {
-		handler = func(ctx context.Context, req *GetSubReq) (*Subscriptions, error) {
+		handler = func(ctx context.Context, req *PodcastSettings) (*PodcastSettings, error) {
 			resp, err := s.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*GetSubReq)
+					typedReq, ok := req.(*PodcastSettings)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*GetSubReq) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*PodcastSettings) when calling interceptor")
 					}
-					return s.Pod.GetSubscriptions(ctx, typedReq)
+					return s.Pod.UpdatePodcastSettings(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*Subscriptions)
+				typedResp, ok := resp.(*PodcastSettings)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*Subscriptions) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*PodcastSettings) when calling interceptor")
 				}
 				return typedResp, err
 			}
@@ -1685,7 +10343,7 @@ func (s *podServer) serveGetSubscriptionsProtobuf(ctx context.Context, resp http
 	}
 
 	// Call service method
-	var respContent *Subscriptions
+	var respContent *PodcastSettings
 	func() {
 		defer ensurePanicResponses(ctx, resp, s.hooks)
 		respContent, err = handler(ctx, reqContent)
@@ -1696,7 +10354,7 @@ func (s *podServer) serveGetSubscriptionsProtobuf(ctx context.Context, resp http
 		return
 	}
 	if respContent == nil {
-		s.writeError(ctx, resp, twirp.InternalError("received a nil *Subscriptions and nil error while calling GetSubscriptions. nil responses are not supported"))
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *PodcastSettings and nil error while calling UpdatePodcastSettings. nil responses are not supported"))
 		return
 	}
 
@@ -1916,66 +10574,199 @@ func (s *podServer) PathPrefix() string {
 }
 
 var twirpFileDescriptor2 = []byte{
-	// 973 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x56, 0xdd, 0x6e, 0x1b, 0x45,
-	0x14, 0x96, 0xbd, 0xf1, 0xdf, 0x31, 0x4e, 0x9d, 0x21, 0x35, 0x23, 0xe3, 0x8a, 0x65, 0xaa, 0x82,
-	0x69, 0x91, 0x2d, 0x52, 0x24, 0xa4, 0x20, 0x21, 0xd4, 0xa6, 0xaa, 0xa2, 0xa6, 0x92, 0xb5, 0x4d,
-	0x84, 0xc4, 0x4d, 0xb5, 0xf6, 0x4e, 0xcd, 0x88, 0xb5, 0x77, 0xb3, 0x67, 0x16, 0xea, 0x0b, 0x6e,
-	0x78, 0x05, 0xde, 0x88, 0x57, 0xe0, 0x01, 0x90, 0x10, 0x0f, 0x82, 0xe6, 0xcf, 0xbb, 0xeb, 0x58,
-	0x24, 0x57, 0x99, 0xf3, 0xf7, 0x9d, 0x33, 0xe7, 0xfb, 0x66, 0x1d, 0xe8, 0xa5, 0x49, 0xb4, 0x08,
-	0x51, 0x4e, 0xd2, 0x2c, 0x91, 0x09, 0x69, 0xea, 0x3f, 0x38, 0x1c, 0x2d, 0x93, 0x64, 0x19, 0xf3,
-	0x69, 0x98, 0x8a, 0x69, 0xb8, 0x5e, 0x27, 0x32, 0x94, 0x22, 0x59, 0xa3, 0xc9, 0x1a, 0x7e, 0x62,
-	0xa3, 0xda, 0x9a, 0xe7, 0xef, 0xa6, 0x52, 0xac, 0x38, 0xca, 0x70, 0x95, 0xda, 0x04, 0xc8, 0x91,
-	0x67, 0xe6, 0xcc, 0xa6, 0xd0, 0x38, 0x5f, 0x85, 0x4b, 0x4e, 0x8e, 0xa1, 0x21, 0x85, 0x8c, 0x39,
-	0xad, 0xf9, 0xb5, 0x71, 0x27, 0x30, 0x06, 0xe9, 0x83, 0x97, 0x67, 0x31, 0xad, 0x6b, 0x9f, 0x3a,
-	0xb2, 0x0b, 0x68, 0x3f, 0x0f, 0x25, 0x5f, 0x26, 0xd9, 0x86, 0x10, 0x38, 0x90, 0xfc, 0xbd, 0xb4,
-	0x25, 0xfa, 0x4c, 0xbe, 0x84, 0xf6, 0xc2, 0xc6, 0x69, 0xdd, 0xf7, 0xc6, 0xdd, 0x93, 0xbe, 0x69,
-	0x85, 0x13, 0x57, 0x17, 0x6c, 0x33, 0xd8, 0x9f, 0x1e, 0xb4, 0x66, 0xe6, 0x8e, 0xe4, 0x10, 0xea,
-	0x22, 0xb2, 0x58, 0x75, 0x11, 0x15, 0x13, 0xd5, 0xcb, 0x13, 0x0d, 0xa0, 0x19, 0xe6, 0xf2, 0xa7,
-	0x24, 0xa3, 0x9e, 0x76, 0x5b, 0x8b, 0x0c, 0xa1, 0xcd, 0x53, 0x81, 0x49, 0x24, 0x16, 0xf4, 0xc0,
-	0xaf, 0x8d, 0xdb, 0xc1, 0xd6, 0x26, 0x14, 0x5a, 0x98, 0xaf, 0x56, 0x61, 0xb6, 0xa1, 0x0d, 0x5d,
-	0xe4, 0x4c, 0x75, 0x83, 0x58, 0xac, 0x7f, 0xa6, 0x4d, 0x73, 0x03, 0x75, 0x26, 0x0f, 0xa1, 0x21,
-	0xd4, 0x4a, 0x68, 0xcb, 0xaf, 0x8d, 0xbb, 0x27, 0x3d, 0x37, 0xbe, 0xde, 0x53, 0x60, 0x62, 0xba,
-	0xdd, 0xfb, 0x34, 0x16, 0x0b, 0x21, 0x69, 0x5b, 0x17, 0x6f, 0x6d, 0x15, 0x8b, 0xc3, 0xf5, 0x32,
-	0x57, 0x18, 0x1d, 0x13, 0x73, 0xb6, 0x8a, 0xbd, 0xe2, 0x9b, 0x5f, 0x93, 0x2c, 0x42, 0x0a, 0xbe,
-	0xa7, 0x62, 0xce, 0xae, 0xac, 0xae, 0x7b, 0xdb, 0xea, 0xc8, 0xd7, 0xd0, 0x4a, 0xf3, 0xf9, 0x59,
-	0x28, 0x39, 0xfd, 0x40, 0x0f, 0x3a, 0x9c, 0x18, 0xe2, 0x27, 0x8e, 0xf8, 0xc9, 0xa5, 0x23, 0x3e,
-	0x70, 0xa9, 0xe4, 0x7b, 0xe8, 0xc5, 0x21, 0xca, 0x67, 0xb9, 0x88, 0x23, 0x5d, 0xdb, 0xbb, 0xb5,
-	0xb6, 0x5a, 0xa0, 0x24, 0x91, 0x21, 0xd2, 0x43, 0x23, 0x89, 0x0c, 0x91, 0xfd, 0xe3, 0x41, 0xeb,
-	0x85, 0xde, 0x35, 0xbf, 0x41, 0xe2, 0x08, 0x3a, 0x56, 0xc3, 0xe7, 0x67, 0x96, 0xc8, 0xc2, 0x51,
-	0x50, 0xec, 0xed, 0xa7, 0xf8, 0xa0, 0x42, 0xb1, 0x0f, 0x5d, 0x43, 0x29, 0xbf, 0xdc, 0xa4, 0xdc,
-	0x52, 0x59, 0x76, 0x15, 0xd4, 0x35, 0xff, 0x87, 0xba, 0xd2, 0xe2, 0x5a, 0x77, 0x5f, 0x9c, 0x0f,
-	0xdd, 0x88, 0xe3, 0x22, 0x13, 0xa9, 0x7a, 0x6b, 0x96, 0xf3, 0xb2, 0xab, 0xac, 0xb2, 0x4e, 0x55,
-	0x65, 0x03, 0x68, 0x22, 0x0f, 0x31, 0x59, 0x53, 0xf0, 0x6b, 0xe3, 0x46, 0x60, 0x2d, 0x55, 0x61,
-	0xa7, 0xa7, 0x5d, 0x1d, 0x70, 0x66, 0x45, 0x5e, 0xbd, 0x1d, 0x79, 0x0d, 0xa0, 0xf9, 0x7a, 0xf6,
-	0xf4, 0x2a, 0xb8, 0xb0, 0x1c, 0x58, 0x8b, 0x7c, 0x06, 0x87, 0x51, 0x9e, 0xe9, 0x4f, 0xc1, 0x6b,
-	0x11, 0xc7, 0x02, 0xe9, 0x3d, 0xbf, 0x36, 0xf6, 0x82, 0x1d, 0xaf, 0xc2, 0xc6, 0x7c, 0x6e, 0xf6,
-	0xde, 0x37, 0xd8, 0xce, 0xd6, 0x13, 0xad, 0x17, 0x49, 0xc4, 0x23, 0x7a, 0x64, 0xee, 0x60, 0x4d,
-	0xf6, 0x31, 0x74, 0x5e, 0x72, 0x39, 0x4b, 0xa2, 0x80, 0x5f, 0xef, 0xb2, 0xcc, 0x56, 0xd0, 0x0a,
-	0xf8, 0x75, 0xce, 0x51, 0xde, 0x42, 0xf8, 0x08, 0x3a, 0xf6, 0x8a, 0xe7, 0x67, 0x96, 0xf4, 0xc2,
-	0xa1, 0xe4, 0x80, 0x32, 0xcc, 0xa4, 0xe6, 0xdd, 0x0b, 0x8c, 0xa1, 0x04, 0xc7, 0xd7, 0x91, 0xa6,
-	0xdb, 0x0b, 0xd4, 0x91, 0x3d, 0xd7, 0xb3, 0xbc, 0x48, 0xc5, 0x9e, 0x59, 0x0a, 0x90, 0xfa, 0x1e,
-	0x10, 0xaf, 0x00, 0x79, 0x04, 0xbd, 0x97, 0x5c, 0x5e, 0x21, 0xcf, 0x2c, 0xd0, 0x31, 0x34, 0x78,
-	0x2a, 0xce, 0xcf, 0xdc, 0x17, 0x50, 0x1b, 0xac, 0xab, 0x7b, 0xbd, 0xc9, 0xe7, 0x01, 0xbf, 0x66,
-	0x03, 0x38, 0xb6, 0x35, 0x17, 0x21, 0xca, 0x59, 0x1c, 0x6e, 0xb8, 0xda, 0x07, 0xfb, 0x0e, 0xda,
-	0x01, 0xc7, 0x34, 0x59, 0x23, 0x37, 0x32, 0x58, 0x2c, 0x38, 0xa2, 0x06, 0x6a, 0x07, 0xce, 0x54,
-	0x91, 0x15, 0x47, 0x54, 0xfa, 0x34, 0x8b, 0x71, 0x26, 0xfb, 0x0d, 0x7a, 0x65, 0x40, 0x24, 0x5f,
-	0x40, 0xcb, 0x2e, 0x4d, 0x83, 0x74, 0x4f, 0xee, 0x39, 0x29, 0xdb, 0xaf, 0x65, 0xe0, 0xe2, 0x2a,
-	0xd5, 0x89, 0xa8, 0x5e, 0x4d, 0xb5, 0x6f, 0xb2, 0x50, 0xd5, 0x00, 0x9a, 0x2b, 0xa3, 0x0c, 0xb3,
-	0x07, 0x6b, 0xb1, 0x57, 0xd0, 0x7b, 0x93, 0xcf, 0xb7, 0x4a, 0x46, 0x72, 0x0a, 0x3d, 0x2c, 0x3b,
-	0x68, 0x4d, 0x7f, 0x8e, 0x8e, 0x1d, 0x72, 0x39, 0x3b, 0xa8, 0xa6, 0xb2, 0x6f, 0xa0, 0x6d, 0x1b,
-	0x23, 0x79, 0xe2, 0x3e, 0xca, 0xdc, 0x41, 0xdc, 0x18, 0x6e, 0x9b, 0x70, 0xf2, 0xf7, 0x01, 0x78,
-	0xb3, 0x24, 0x22, 0x97, 0x00, 0x46, 0x69, 0xfa, 0x7a, 0x47, 0xae, 0x60, 0xab, 0xbe, 0xe1, 0xee,
-	0x2e, 0x18, 0xfb, 0xfd, 0xaf, 0x7f, 0xff, 0xa8, 0x8f, 0xd8, 0x47, 0xd3, 0x5f, 0xbe, 0x9a, 0xda,
-	0xbd, 0x4c, 0x97, 0x5c, 0xbe, 0xb5, 0xe7, 0xd3, 0xda, 0x63, 0xf2, 0x03, 0x74, 0x8d, 0x66, 0xcc,
-	0x64, 0x65, 0x58, 0xc3, 0xff, 0xb0, 0xbf, 0x33, 0x1a, 0xb2, 0x87, 0x1a, 0xf7, 0x01, 0xa3, 0xbb,
-	0xb8, 0x6e, 0x66, 0x05, 0xcc, 0xe1, 0xb0, 0xd0, 0x91, 0x5e, 0xf3, 0xfd, 0x12, 0x76, 0xa1, 0xaf,
-	0xe1, 0x87, 0xce, 0x5d, 0xca, 0x65, 0x9f, 0xeb, 0x16, 0x9f, 0xb2, 0xd1, 0x6e, 0x0b, 0xf5, 0x1b,
-	0xed, 0xfa, 0xa8, 0x36, 0x0b, 0x38, 0xba, 0x4a, 0x91, 0x67, 0x95, 0x4e, 0xfb, 0x20, 0x8b, 0x7b,
-	0x38, 0x49, 0xde, 0xbd, 0xc9, 0x3b, 0xe8, 0x1b, 0xb1, 0x97, 0xb4, 0x50, 0xde, 0x94, 0x79, 0x06,
-	0xc3, 0xfb, 0xfb, 0x74, 0x80, 0x6c, 0xac, 0xdb, 0x30, 0xf6, 0x60, 0xb7, 0x4d, 0x45, 0x20, 0xaa,
-	0x4f, 0x0e, 0x47, 0x37, 0xde, 0x11, 0x19, 0xed, 0xac, 0xad, 0xf2, 0xc4, 0x8a, 0x9e, 0x95, 0x87,
-	0xc2, 0x9e, 0xe8, 0x9e, 0x8f, 0x98, 0xbf, 0xf7, 0x6a, 0xea, 0x97, 0xeb, 0x6d, 0xaa, 0x93, 0x4f,
-	0x6b, 0x8f, 0x9f, 0xc1, 0x8f, 0xed, 0xc9, 0xb7, 0x06, 0x66, 0x6e, 0xfe, 0x97, 0x7a, 0xfa, 0x5f,
-	0x00, 0x00, 0x00, 0xff, 0xff, 0xd4, 0x58, 0x95, 0xa8, 0x63, 0x09, 0x00, 0x00,
+	// 3101 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x5a, 0x5b, 0x6f, 0x1b, 0xc7,
+	0xf5, 0x0f, 0x49, 0x51, 0x22, 0x0f, 0x45, 0x89, 0x1a, 0x5b, 0x0a, 0x43, 0xcb, 0xb6, 0x32, 0x8e,
+	0x13, 0xfd, 0xad, 0x40, 0x4a, 0x94, 0xe4, 0x1f, 0x20, 0x41, 0xd3, 0x5a, 0x97, 0x3a, 0x82, 0xe5,
+	0x58, 0x5d, 0x4a, 0x4d, 0x13, 0xb4, 0x50, 0x57, 0xdc, 0x91, 0xb4, 0xd0, 0x72, 0x97, 0xde, 0x99,
+	0x4d, 0xac, 0x16, 0x41, 0x8b, 0xb6, 0x4f, 0x7d, 0x2d, 0xfa, 0xd0, 0xf6, 0xdb, 0xf4, 0xa5, 0x1f,
+	0xa0, 0x79, 0xee, 0x43, 0xd1, 0xaf, 0x51, 0xa0, 0x98, 0xdb, 0xce, 0xec, 0x85, 0x26, 0xdd, 0xf4,
+	0x49, 0x3b, 0x33, 0x67, 0xce, 0x99, 0x39, 0xb7, 0x39, 0xe7, 0x27, 0x42, 0x7b, 0x14, 0x79, 0x03,
+	0x97, 0xb2, 0xcd, 0x51, 0x1c, 0xb1, 0x08, 0xcd, 0x8a, 0x3f, 0xb4, 0xb7, 0x7a, 0x11, 0x45, 0x17,
+	0x01, 0xd9, 0x72, 0x47, 0xfe, 0x96, 0x1b, 0x86, 0x11, 0x73, 0x99, 0x1f, 0x85, 0x54, 0x52, 0xf5,
+	0xee, 0xaa, 0x55, 0x31, 0x3a, 0x4b, 0xce, 0xb7, 0x98, 0x3f, 0x24, 0x94, 0xb9, 0xc3, 0x91, 0x22,
+	0x80, 0x84, 0x92, 0x58, 0x7e, 0xe3, 0x2d, 0xa8, 0x1f, 0x0c, 0xdd, 0x0b, 0x82, 0x6e, 0x42, 0x9d,
+	0xf9, 0x2c, 0x20, 0xdd, 0xca, 0x5a, 0x65, 0xbd, 0xe9, 0xc8, 0x01, 0xea, 0x40, 0x2d, 0x89, 0x83,
+	0x6e, 0x55, 0xcc, 0xf1, 0x4f, 0xfc, 0x53, 0x68, 0xec, 0xba, 0x8c, 0x5c, 0x44, 0xf1, 0x35, 0x42,
+	0x30, 0xc3, 0xc8, 0x73, 0xa6, 0xb6, 0x88, 0x6f, 0xf4, 0x36, 0x34, 0x06, 0x6a, 0xbd, 0x5b, 0x5d,
+	0xab, 0xad, 0xb7, 0xb6, 0x3b, 0x52, 0x14, 0xdd, 0xd4, 0xfb, 0x9c, 0x94, 0x02, 0x2d, 0x40, 0xd5,
+	0xf7, 0xba, 0xb5, 0xb5, 0xca, 0x7a, 0xdd, 0xa9, 0xfa, 0x1e, 0xfe, 0x6b, 0x0d, 0xe6, 0x8e, 0xe4,
+	0x9d, 0xd5, 0x9a, 0xe4, 0x5d, 0xf5, 0x3d, 0x73, 0xc2, 0xaa, 0x7d, 0xc2, 0x15, 0x98, 0x75, 0x13,
+	0x76, 0x19, 0xc5, 0x82, 0x4b, 0xd3, 0x51, 0x23, 0xd4, 0x83, 0x06, 0x19, 0xf9, 0x34, 0xf2, 0xfc,
+	0x41, 0x77, 0x66, 0xad, 0xb2, 0xde, 0x70, 0xd2, 0x31, 0xea, 0xc2, 0x1c, 0x4d, 0x86, 0x43, 0x37,
+	0xbe, 0xee, 0xd6, 0xc5, 0x26, 0x3d, 0xe4, 0x37, 0x0a, 0xfc, 0xf0, 0xaa, 0x3b, 0x2b, 0x6f, 0xc4,
+	0xbf, 0xd1, 0x3d, 0xa8, 0xfb, 0x5c, 0x45, 0xdd, 0xb9, 0xb5, 0xca, 0x7a, 0x6b, 0xbb, 0xad, 0xaf,
+	0x23, 0xf4, 0xe6, 0xc8, 0x35, 0x21, 0xee, 0xf9, 0x28, 0xf0, 0x07, 0x3e, 0xeb, 0x36, 0xc4, 0xe6,
+	0x74, 0xcc, 0xd7, 0x02, 0x37, 0xbc, 0x48, 0x38, 0x8f, 0xa6, 0x5c, 0xd3, 0x63, 0xbe, 0xf6, 0x98,
+	0x5c, 0x7f, 0x1d, 0xc5, 0x1e, 0xed, 0xc2, 0x5a, 0x8d, 0xaf, 0xe9, 0x71, 0x46, 0x95, 0xad, 0x89,
+	0xaa, 0x7c, 0x1f, 0xe6, 0x46, 0xc9, 0xd9, 0x9e, 0xcb, 0x48, 0x77, 0x5e, 0x1c, 0xb4, 0xb7, 0x29,
+	0x1d, 0x61, 0x53, 0x3b, 0xc2, 0xe6, 0xb1, 0x76, 0x04, 0x47, 0x93, 0xa2, 0x1f, 0x40, 0x3b, 0x70,
+	0x29, 0xdb, 0x49, 0xfc, 0xc0, 0x13, 0x7b, 0xdb, 0x13, 0xf7, 0x66, 0x37, 0x70, 0x17, 0x89, 0x29,
+	0xed, 0x2e, 0x48, 0x17, 0x89, 0x29, 0xc5, 0xff, 0xac, 0xc1, 0xdc, 0xbe, 0xd0, 0x35, 0x29, 0x18,
+	0x71, 0x15, 0x9a, 0xca, 0xa7, 0x0f, 0xf6, 0x94, 0x21, 0xcd, 0x84, 0x31, 0x71, 0xad, 0xdc, 0xc4,
+	0x33, 0x19, 0x13, 0xaf, 0x41, 0x4b, 0x9a, 0x94, 0x1c, 0x5f, 0x8f, 0x88, 0x32, 0xa5, 0x3d, 0x65,
+	0x4c, 0x37, 0xfb, 0x02, 0xd3, 0x59, 0x8a, 0x9b, 0x9b, 0x5e, 0x71, 0x6b, 0xd0, 0xf2, 0x08, 0x1d,
+	0xc4, 0xfe, 0x88, 0xc7, 0x9e, 0xb2, 0xb9, 0x3d, 0x65, 0x7b, 0x59, 0x33, 0xeb, 0x65, 0x2b, 0x30,
+	0x4b, 0x89, 0x4b, 0xa3, 0xb0, 0x0b, 0xc2, 0xf3, 0xd5, 0x88, 0xef, 0x50, 0xa7, 0xef, 0xb6, 0xc4,
+	0x82, 0x1e, 0x66, 0xdc, 0xab, 0x9d, 0x73, 0xaf, 0x15, 0x98, 0x7d, 0x72, 0xf4, 0xde, 0x89, 0x73,
+	0xa8, 0x6c, 0xa0, 0x46, 0xe8, 0x4d, 0x58, 0xf0, 0x92, 0x58, 0xa4, 0x86, 0x27, 0x7e, 0x10, 0xf8,
+	0xb4, 0xbb, 0xb8, 0x56, 0x59, 0xaf, 0x39, 0xb9, 0x59, 0xce, 0x9b, 0x26, 0x67, 0x52, 0xef, 0x1d,
+	0xc9, 0x5b, 0x8f, 0xc5, 0x89, 0xc2, 0x41, 0xe4, 0x11, 0xaf, 0xbb, 0x24, 0xef, 0xa0, 0x86, 0xf8,
+	0x16, 0x34, 0x1f, 0x11, 0x76, 0x14, 0x79, 0x0e, 0x79, 0x96, 0xb7, 0x32, 0x1e, 0xc2, 0x9c, 0x43,
+	0x9e, 0x25, 0x84, 0xb2, 0x09, 0x06, 0x5f, 0x85, 0xa6, 0xba, 0xe2, 0xc1, 0x9e, 0x32, 0xba, 0x99,
+	0xe0, 0xee, 0x40, 0x99, 0x1b, 0x33, 0x61, 0xf7, 0x9a, 0x23, 0x07, 0xdc, 0xe1, 0x48, 0xe8, 0x09,
+	0x73, 0xd7, 0x1c, 0xfe, 0x89, 0x77, 0xc5, 0x59, 0xf6, 0x47, 0x7e, 0xc9, 0x59, 0x0c, 0x93, 0x6a,
+	0x09, 0x93, 0x9a, 0x61, 0x72, 0x1f, 0xda, 0x8f, 0x08, 0x3b, 0xa1, 0x24, 0x56, 0x8c, 0x6e, 0x42,
+	0x9d, 0x8c, 0xfc, 0x83, 0x3d, 0x9d, 0x11, 0xc5, 0x00, 0xb7, 0x84, 0xac, 0x7e, 0x72, 0xe6, 0x90,
+	0x67, 0x78, 0x05, 0x6e, 0xaa, 0x3d, 0x87, 0x2e, 0x65, 0x47, 0x81, 0x7b, 0x4d, 0xb8, 0x3e, 0xf0,
+	0x27, 0xd0, 0x70, 0x08, 0x1d, 0x45, 0x21, 0x25, 0xd2, 0x0d, 0x06, 0x03, 0x42, 0xa9, 0x60, 0xd4,
+	0x70, 0xf4, 0x90, 0xaf, 0x0c, 0x09, 0xa5, 0xdc, 0x3f, 0xa5, 0x62, 0xf4, 0x10, 0x7f, 0x03, 0x9d,
+	0x93, 0x11, 0x25, 0xb1, 0x39, 0x8e, 0xa0, 0x76, 0x47, 0xa3, 0xc0, 0x27, 0x9e, 0xe6, 0xa3, 0x86,
+	0xe3, 0xf9, 0xa0, 0x0f, 0xa0, 0x95, 0x48, 0x0e, 0xc2, 0xa9, 0x6a, 0xc2, 0xbd, 0x6f, 0xe8, 0x28,
+	0x38, 0x31, 0x4b, 0x8e, 0x4d, 0x87, 0xbf, 0x81, 0xb6, 0x7d, 0x1f, 0x8a, 0xfe, 0x0f, 0xe6, 0x94,
+	0xcd, 0x84, 0xec, 0xd6, 0xf6, 0xa2, 0xe6, 0xa1, 0x92, 0xb5, 0xa3, 0xd7, 0x39, 0xa9, 0xf6, 0xe1,
+	0x6a, 0x96, 0x54, 0x8b, 0x4a, 0x9d, 0x7a, 0x05, 0x66, 0x87, 0xd2, 0x31, 0xa5, 0x19, 0xd4, 0x08,
+	0x3f, 0x86, 0x76, 0x3f, 0x39, 0x4b, 0x03, 0x89, 0xa2, 0x8f, 0xa0, 0x4d, 0xed, 0x89, 0x6e, 0x45,
+	0x64, 0xc3, 0x9b, 0x9a, 0xb3, 0x4d, 0xed, 0x64, 0x49, 0xf1, 0x87, 0xd0, 0x50, 0x82, 0x29, 0xda,
+	0xd0, 0x6f, 0x02, 0xd1, 0x2c, 0x0a, 0x87, 0x4b, 0x09, 0xf8, 0x46, 0x75, 0x39, 0xb1, 0x51, 0xdd,
+	0xaf, 0xb0, 0x51, 0x2b, 0x20, 0x25, 0xc0, 0x9f, 0x00, 0xa8, 0xf4, 0xec, 0x13, 0x8a, 0xde, 0x01,
+	0x18, 0xa4, 0xa3, 0x6e, 0x65, 0x4c, 0x1a, 0xb7, 0x68, 0xf0, 0x0d, 0x58, 0x3a, 0xf4, 0x29, 0x33,
+	0x3c, 0xb8, 0x47, 0x31, 0x58, 0xda, 0x89, 0xa3, 0xaf, 0x29, 0x49, 0xb7, 0x64, 0x5c, 0x5d, 0xbc,
+	0x9e, 0xe8, 0x2d, 0x98, 0xa1, 0x91, 0xf2, 0xf4, 0x85, 0xed, 0x1b, 0xb9, 0x23, 0xf6, 0xa3, 0x98,
+	0x39, 0x82, 0xc0, 0xc4, 0x44, 0xad, 0x24, 0x26, 0x66, 0x4c, 0x4c, 0xfc, 0xa9, 0x02, 0xf3, 0x8f,
+	0x08, 0xdb, 0xbd, 0x74, 0x63, 0xc6, 0x8f, 0x81, 0xee, 0xc3, 0x0c, 0xe3, 0xb9, 0xb6, 0x22, 0x24,
+	0x2c, 0xa5, 0xf7, 0xe0, 0x04, 0x3c, 0xe3, 0x3a, 0x62, 0x19, 0xdd, 0x49, 0x2f, 0x7d, 0xad, 0xa2,
+	0xbe, 0xee, 0x58, 0x33, 0x99, 0x17, 0xb1, 0x96, 0x7b, 0x11, 0xa7, 0x0d, 0xfa, 0xef, 0xc3, 0xf2,
+	0x23, 0xc2, 0x1c, 0x32, 0x88, 0x86, 0x43, 0x12, 0x7a, 0xb2, 0x04, 0x52, 0x71, 0x2b, 0x19, 0x54,
+	0x4a, 0x18, 0x54, 0x0d, 0x83, 0xa7, 0x82, 0x41, 0xdf, 0x1f, 0xfa, 0x81, 0x1b, 0x6b, 0x53, 0x7f,
+	0x97, 0x0c, 0xd2, 0x86, 0xd6, 0x23, 0xc2, 0x7e, 0x94, 0x90, 0x84, 0x70, 0x93, 0x3d, 0x84, 0xf6,
+	0x43, 0xcf, 0x3b, 0x8e, 0xf4, 0x44, 0x36, 0xd9, 0x55, 0xf2, 0xc9, 0x0e, 0xc1, 0x4c, 0x48, 0x9e,
+	0x4b, 0x21, 0x0d, 0x47, 0x7c, 0xe3, 0x6d, 0x40, 0x0e, 0x19, 0x46, 0x5f, 0x91, 0x1f, 0xc6, 0xd1,
+	0x70, 0x3a, 0x3e, 0xf8, 0x5d, 0x58, 0x74, 0x48, 0x14, 0x7b, 0x24, 0x4e, 0x37, 0xdc, 0x01, 0x48,
+	0xd7, 0xa5, 0x0f, 0x36, 0x1d, 0x6b, 0x06, 0x2f, 0x42, 0x7b, 0x37, 0x20, 0x6e, 0xba, 0x01, 0x1f,
+	0xc0, 0x62, 0x9f, 0xb0, 0x87, 0x09, 0xcb, 0x1c, 0xde, 0xe4, 0xf1, 0x4a, 0x3e, 0x8f, 0x8b, 0x77,
+	0xc2, 0x3d, 0x0b, 0x88, 0xa7, 0xce, 0xaf, 0x87, 0xf8, 0xdf, 0x15, 0x68, 0xf0, 0x44, 0x12, 0xf8,
+	0x25, 0x25, 0x1d, 0xbf, 0xb3, 0x3b, 0xd4, 0x69, 0x4b, 0x7c, 0x0b, 0x6d, 0x0f, 0xb5, 0x6f, 0x36,
+	0x1c, 0x39, 0x40, 0x1b, 0x50, 0x8f, 0x93, 0x80, 0x50, 0xe1, 0x15, 0xad, 0xed, 0xe5, 0xd4, 0xb7,
+	0x15, 0x6b, 0x87, 0x2f, 0x3a, 0x92, 0x26, 0x77, 0xdf, 0x7a, 0xfe, 0xbe, 0xfc, 0xc5, 0x1f, 0xc4,
+	0xc4, 0x65, 0xc4, 0xeb, 0xce, 0x4e, 0x7e, 0xf1, 0x15, 0x29, 0xdf, 0x95, 0x8c, 0x3c, 0xb1, 0x6b,
+	0x8a, 0x3a, 0x41, 0x91, 0xe2, 0xbf, 0x54, 0xa1, 0x9d, 0x39, 0x24, 0xba, 0x53, 0xc8, 0x08, 0x75,
+	0x3b, 0xfe, 0xf9, 0x7a, 0xaa, 0x58, 0x2a, 0x6a, 0xe8, 0xa6, 0x63, 0xcd, 0x20, 0x0c, 0xf3, 0x49,
+	0x38, 0x12, 0xb9, 0xf9, 0x69, 0x18, 0x5c, 0x2b, 0x3d, 0x65, 0xe6, 0xd0, 0xdb, 0xb0, 0x34, 0xf4,
+	0xc3, 0xbd, 0xec, 0xf3, 0x2f, 0x03, 0xaa, 0xb8, 0x20, 0xa8, 0xdd, 0xe7, 0x39, 0xea, 0xba, 0xa2,
+	0xce, 0x2f, 0xa0, 0xf7, 0x61, 0x79, 0x94, 0x9c, 0x05, 0x3e, 0xbd, 0x24, 0xde, 0xe7, 0x3e, 0xbb,
+	0xf4, 0xf5, 0x8e, 0x59, 0xb1, 0xa3, 0x7c, 0x91, 0x9b, 0x35, 0xf0, 0x87, 0x3e, 0x13, 0xba, 0xab,
+	0x39, 0x72, 0x80, 0x3f, 0x86, 0xa6, 0x56, 0x0e, 0x45, 0x9b, 0xd0, 0x1c, 0xe9, 0x41, 0x3e, 0x53,
+	0xa6, 0x2a, 0x34, 0x24, 0xf8, 0xf7, 0x15, 0x58, 0xda, 0x15, 0xc6, 0x49, 0x57, 0xc9, 0xb3, 0xd4,
+	0xa7, 0x2a, 0x65, 0x3e, 0x55, 0x2d, 0xf5, 0xa9, 0xda, 0x4b, 0xfb, 0xd4, 0x4c, 0x21, 0x86, 0x96,
+	0x60, 0x91, 0xd7, 0x43, 0xfa, 0x70, 0x3c, 0x8a, 0x7e, 0x57, 0x81, 0xa5, 0x13, 0xe1, 0x06, 0xf6,
+	0xf9, 0xa6, 0x89, 0x81, 0xff, 0xe9, 0xc9, 0xee, 0xc1, 0xd2, 0x1e, 0x09, 0xc8, 0x0b, 0x4f, 0x81,
+	0x8f, 0x60, 0xc5, 0x3a, 0xbe, 0x7e, 0x31, 0xbf, 0x4b, 0x36, 0xbc, 0x0b, 0x73, 0xfd, 0xeb, 0x70,
+	0xa0, 0x32, 0x32, 0x8b, 0xae, 0x48, 0x98, 0xf6, 0x96, 0x7c, 0x80, 0xbf, 0xad, 0x6a, 0x0a, 0x5a,
+	0x4e, 0xc1, 0x55, 0x73, 0x9e, 0x04, 0x81, 0x4e, 0x89, 0xfc, 0xbb, 0x58, 0x0b, 0xd4, 0xa6, 0xae,
+	0x05, 0xb8, 0x9f, 0xc7, 0x22, 0x9d, 0x7a, 0x47, 0x26, 0xc0, 0xa4, 0xc2, 0x8a, 0x0b, 0xe8, 0x43,
+	0x98, 0xb7, 0x8a, 0x22, 0x99, 0x47, 0xc6, 0x54, 0x4f, 0x19, 0x42, 0x1e, 0xa0, 0xcf, 0x78, 0xda,
+	0xdc, 0xbd, 0x74, 0xc3, 0x0b, 0x95, 0x63, 0x1a, 0x4e, 0x66, 0x0e, 0xdd, 0x87, 0xba, 0x18, 0x77,
+	0xe7, 0xca, 0xeb, 0x10, 0xb9, 0x8a, 0xde, 0x85, 0x56, 0x48, 0xbe, 0x4e, 0x8f, 0xd0, 0x28, 0x27,
+	0xb6, 0x69, 0xf0, 0xaf, 0xab, 0xd0, 0xe1, 0xf5, 0x03, 0x09, 0xfd, 0xf0, 0xa2, 0x4f, 0x28, 0xe5,
+	0xbd, 0x88, 0x31, 0x62, 0x4d, 0xb7, 0x61, 0xe6, 0x09, 0xa9, 0xe6, 0x9f, 0xa2, 0x35, 0x68, 0x09,
+	0xab, 0x3e, 0x3d, 0x3f, 0xa7, 0x44, 0x17, 0x09, 0xf6, 0x94, 0xd8, 0x1f, 0x7a, 0x6a, 0x5d, 0xe6,
+	0x15, 0x33, 0xc1, 0x33, 0xa5, 0x20, 0x26, 0xf2, 0xc1, 0x9e, 0x90, 0x29, 0x15, 0x29, 0x7a, 0x07,
+	0xea, 0x24, 0xf4, 0xa6, 0xca, 0xc9, 0x92, 0x90, 0x17, 0x90, 0x1e, 0xf9, 0xca, 0x1f, 0xc8, 0xc6,
+	0xad, 0xe9, 0xa8, 0x11, 0x3e, 0x84, 0x95, 0x87, 0x9e, 0x97, 0x57, 0x02, 0xf7, 0xc4, 0x6d, 0x98,
+	0xa3, 0x72, 0xa4, 0x0a, 0xd9, 0xae, 0xd6, 0x65, 0x81, 0x5a, 0x13, 0xe2, 0x0f, 0x45, 0x63, 0xf0,
+	0xa9, 0x4f, 0x99, 0x2a, 0xbb, 0xa6, 0x2d, 0x30, 0x02, 0x98, 0x53, 0xbb, 0xd0, 0xfb, 0xd0, 0x50,
+	0xec, 0x74, 0x66, 0x1b, 0x2f, 0x38, 0xa5, 0xcc, 0xd4, 0xab, 0xd5, 0x49, 0xf5, 0xea, 0xb7, 0x15,
+	0x51, 0x7e, 0xf4, 0x99, 0x2b, 0xab, 0x98, 0x0d, 0x98, 0x3d, 0x4b, 0x06, 0x57, 0x84, 0xa9, 0x62,
+	0x2d, 0x75, 0x5c, 0x41, 0xb1, 0x23, 0x96, 0x1c, 0x45, 0x82, 0x36, 0x61, 0xe6, 0x3c, 0x8e, 0x86,
+	0xdd, 0xea, 0x44, 0xd5, 0x0b, 0x3a, 0xf4, 0x00, 0xaa, 0x2c, 0xea, 0xd6, 0x26, 0x52, 0x57, 0x59,
+	0xc4, 0x8b, 0x3d, 0x8e, 0x40, 0xfd, 0x22, 0x0a, 0x89, 0x6a, 0xe0, 0xd3, 0x31, 0xf7, 0x34, 0x16,
+	0x8d, 0x74, 0xf1, 0x25, 0xbc, 0xa5, 0xee, 0xd8, 0x53, 0xf8, 0x4b, 0x58, 0x48, 0x35, 0x74, 0x1c,
+	0x31, 0x37, 0xe0, 0x7e, 0x62, 0xd4, 0x3f, 0xc1, 0x4f, 0xa4, 0x69, 0x4c, 0xa3, 0x51, 0xcd, 0x34,
+	0x1a, 0x27, 0xd0, 0x51, 0x72, 0x52, 0x11, 0x2f, 0xd3, 0xea, 0x8c, 0x63, 0xfb, 0xc7, 0x2a, 0xd4,
+	0x85, 0x92, 0xd1, 0x26, 0xcc, 0x32, 0x7e, 0x66, 0x6d, 0xf4, 0x95, 0x82, 0xd1, 0xc5, 0x95, 0x1c,
+	0x45, 0x25, 0xd5, 0xc1, 0xdc, 0xe0, 0x89, 0xcd, 0xd6, 0x9e, 0x42, 0x1f, 0x65, 0x15, 0x56, 0xcb,
+	0xfa, 0x52, 0xfe, 0x36, 0x19, 0x55, 0xa2, 0x75, 0x58, 0xd4, 0xde, 0xd2, 0x57, 0xe1, 0x29, 0x43,
+	0x37, 0x3f, 0xcd, 0x13, 0xa5, 0x9e, 0xda, 0x8d, 0x86, 0xa3, 0x80, 0xe8, 0x50, 0xae, 0x39, 0xc5,
+	0x05, 0x0e, 0x34, 0x0c, 0xe4, 0x80, 0xbb, 0x2f, 0xc7, 0x51, 0x78, 0x04, 0x57, 0x9c, 0xdc, 0x2c,
+	0xfe, 0x73, 0x05, 0x1a, 0x3b, 0x51, 0x74, 0x35, 0x74, 0xe3, 0xab, 0x32, 0x60, 0xe8, 0x05, 0x19,
+	0x69, 0x05, 0x66, 0x23, 0x3b, 0x19, 0xa9, 0x91, 0x78, 0x3c, 0x23, 0xa6, 0xfd, 0x4a, 0x7c, 0xdb,
+	0xd5, 0x5d, 0x7d, 0xea, 0xea, 0x8e, 0x57, 0x22, 0xfa, 0x6c, 0xa2, 0x12, 0x39, 0xd3, 0x83, 0x7c,
+	0x25, 0xa2, 0xa9, 0x1c, 0x43, 0x82, 0x7f, 0xa6, 0x0b, 0x91, 0x74, 0x71, 0x62, 0xb9, 0x6f, 0x6e,
+	0x54, 0x2d, 0xbd, 0x51, 0xcd, 0xdc, 0x08, 0x6f, 0x89, 0xda, 0x22, 0x3d, 0xde, 0xe4, 0x1e, 0x20,
+	0x7d, 0xf2, 0xed, 0xf3, 0xe4, 0x9f, 0xfc, 0x7f, 0x54, 0x60, 0x66, 0x37, 0xf0, 0x47, 0x2f, 0x69,
+	0x8a, 0xef, 0xfa, 0x38, 0xa4, 0x18, 0x5f, 0xdd, 0xc6, 0xf8, 0xfe, 0xbb, 0x92, 0x7c, 0x15, 0x9a,
+	0xf4, 0xd2, 0x8d, 0xc9, 0x91, 0xcb, 0x2e, 0xd5, 0x1b, 0x60, 0x26, 0xf0, 0x06, 0xd4, 0xf9, 0xfd,
+	0xf8, 0x83, 0x5c, 0x1f, 0xf0, 0x0f, 0x65, 0xca, 0xf9, 0xb4, 0x6d, 0x0d, 0xfc, 0x91, 0x23, 0x97,
+	0xf0, 0x6f, 0x2b, 0xd0, 0x96, 0x36, 0x14, 0xb3, 0x13, 0xed, 0x97, 0x53, 0x43, 0x75, 0x82, 0x1a,
+	0x6a, 0x63, 0xd5, 0x30, 0x63, 0xa9, 0x01, 0x6f, 0x88, 0x1c, 0x2e, 0x4e, 0x3d, 0xd9, 0xca, 0x77,
+	0xa1, 0x2d, 0xad, 0xac, 0x4f, 0x9c, 0xb7, 0xf0, 0x07, 0xa2, 0xc3, 0xd5, 0xed, 0x3f, 0x61, 0xcc,
+	0x0f, 0x2f, 0xe8, 0xc4, 0x66, 0x0e, 0x1f, 0xc1, 0x72, 0x06, 0x7d, 0x4a, 0x4b, 0xc1, 0x7c, 0x45,
+	0x54, 0x99, 0xb2, 0x22, 0xc2, 0x8f, 0xcb, 0x39, 0x52, 0xfe, 0x1e, 0xc7, 0x84, 0x26, 0x01, 0x2b,
+	0x3c, 0x8b, 0x79, 0xfc, 0xcb, 0xd1, 0x84, 0xf8, 0x12, 0x6e, 0x3e, 0x71, 0xe3, 0x2b, 0x75, 0xad,
+	0x14, 0x74, 0x9b, 0xd0, 0xa1, 0x6e, 0xc3, 0xec, 0x19, 0x39, 0x8f, 0x62, 0x32, 0xc5, 0x1b, 0xa7,
+	0x28, 0xf1, 0x3b, 0xa5, 0x92, 0x04, 0x14, 0xa7, 0x3b, 0x41, 0x59, 0x02, 0xe8, 0x21, 0xfe, 0x7f,
+	0x58, 0xe1, 0x3b, 0xd4, 0x15, 0x4f, 0xc2, 0x91, 0x7d, 0xba, 0xf1, 0xa6, 0x7c, 0xf0, 0x3d, 0x68,
+	0x59, 0x28, 0x0d, 0x5a, 0x84, 0x56, 0xff, 0x64, 0xa7, 0xbf, 0xeb, 0x1c, 0xec, 0xec, 0x3b, 0xfd,
+	0xce, 0x2b, 0x08, 0xc1, 0xc2, 0xe1, 0xc3, 0xe3, 0xfd, 0xfe, 0xf1, 0xe9, 0xfe, 0xd1, 0x41, 0xff,
+	0xe9, 0xde, 0x7e, 0xa7, 0x82, 0x9a, 0x50, 0x3f, 0x3e, 0x38, 0x3e, 0xdc, 0xef, 0x54, 0x1f, 0x60,
+	0x68, 0xa6, 0x10, 0x0c, 0x9a, 0x83, 0xda, 0xf1, 0xd3, 0xa3, 0xce, 0x2b, 0x68, 0x1e, 0x1a, 0xc7,
+	0xce, 0xfe, 0x67, 0x7b, 0x07, 0x9f, 0x3d, 0xea, 0x54, 0x1e, 0x6c, 0x40, 0xcb, 0x7a, 0xf9, 0x39,
+	0xd5, 0xde, 0xc3, 0x2f, 0x3a, 0xaf, 0xa0, 0x06, 0xcc, 0x7c, 0xbe, 0xbf, 0xff, 0x58, 0x32, 0x7c,
+	0xf2, 0xf4, 0xb3, 0xe3, 0x4f, 0x3b, 0xd5, 0xed, 0xbf, 0xdd, 0x86, 0xda, 0x51, 0xe4, 0xa1, 0x63,
+	0x00, 0xe3, 0x41, 0x28, 0xc5, 0x7b, 0x52, 0xe4, 0xb7, 0x97, 0x7f, 0x1d, 0x31, 0xfe, 0xcd, 0xdf,
+	0xff, 0xf5, 0x87, 0xea, 0x2a, 0x7e, 0x75, 0xeb, 0xab, 0x77, 0xb7, 0x94, 0xfa, 0xb7, 0x2e, 0x08,
+	0x3b, 0x55, 0xdf, 0x1f, 0x55, 0x1e, 0xa0, 0xcf, 0x85, 0x97, 0xa7, 0xf5, 0xb2, 0xcd, 0x56, 0x62,
+	0xaf, 0xbd, 0x4e, 0xae, 0xce, 0xa1, 0xf8, 0x9e, 0xe0, 0x7b, 0x1b, 0x77, 0xf3, 0x7c, 0xf5, 0x7b,
+	0xc4, 0x19, 0x13, 0x58, 0x30, 0x18, 0x2e, 0x9f, 0x45, 0xcb, 0x16, 0x6f, 0x83, 0xed, 0xf6, 0xca,
+	0x7c, 0x16, 0xbf, 0x25, 0x44, 0xbc, 0x8e, 0x57, 0xf3, 0x22, 0xb8, 0x2f, 0x6b, 0x39, 0x5c, 0xcc,
+	0x15, 0x2c, 0x65, 0xdc, 0x53, 0x48, 0x2a, 0x63, 0xd9, 0x1b, 0xeb, 0xce, 0xd3, 0x0b, 0xfb, 0x15,
+	0xa0, 0x62, 0xec, 0xa0, 0xdb, 0xa5, 0x8c, 0x75, 0xa4, 0xf6, 0x5e, 0xb8, 0x4c, 0xf1, 0x86, 0x10,
+	0x7e, 0x1f, 0xaf, 0xd9, 0xc2, 0x13, 0x41, 0x9a, 0x91, 0x2f, 0x94, 0xfa, 0x4b, 0x58, 0x2a, 0x44,
+	0x01, 0x5a, 0xd5, 0x02, 0xca, 0x42, 0xb1, 0xf7, 0xa2, 0x55, 0x8a, 0x1f, 0x08, 0xe9, 0x6f, 0xe0,
+	0xbb, 0xb6, 0x74, 0xfe, 0x4a, 0x69, 0x1f, 0x39, 0x95, 0x61, 0xc3, 0x85, 0x27, 0x70, 0xa3, 0x24,
+	0xa0, 0xd0, 0x1d, 0x5b, 0x40, 0x31, 0xda, 0x8c, 0xff, 0x68, 0x18, 0x1e, 0xbf, 0x2d, 0x84, 0xbe,
+	0x89, 0x5f, 0x2f, 0x08, 0x55, 0x77, 0x3d, 0x4d, 0x42, 0x23, 0xf6, 0x1c, 0x3a, 0x12, 0xe5, 0xb7,
+	0xba, 0x47, 0xdb, 0x4d, 0x25, 0xfe, 0xdf, 0x5b, 0x2e, 0xeb, 0x3a, 0x29, 0x5e, 0x17, 0xb2, 0x30,
+	0xbe, 0x9d, 0xb7, 0x6d, 0xa6, 0x1d, 0xe5, 0x72, 0x2e, 0x64, 0x75, 0x6b, 0xe1, 0xc5, 0xaf, 0xd9,
+	0x25, 0x62, 0x06, 0x03, 0xee, 0xa1, 0x1c, 0x6c, 0xcc, 0xc1, 0xe2, 0x37, 0x85, 0xa8, 0x35, 0x7c,
+	0xcb, 0x16, 0xc5, 0x5b, 0xf8, 0x53, 0x83, 0x28, 0xa9, 0xc8, 0xc8, 0xe2, 0xc7, 0x46, 0x50, 0x01,
+	0x57, 0x36, 0xda, 0x4b, 0xab, 0xf0, 0x52, 0x31, 0x67, 0x62, 0xa3, 0x16, 0x74, 0xcd, 0xc5, 0xfc,
+	0x58, 0xfc, 0x77, 0x44, 0xe2, 0xc5, 0xe8, 0xa6, 0xa5, 0xb0, 0x14, 0x42, 0x2e, 0x61, 0xfe, 0xba,
+	0x60, 0x7e, 0x0b, 0xaf, 0xe4, 0xd5, 0x35, 0x10, 0x9b, 0x38, 0xdf, 0x67, 0x80, 0x8a, 0x60, 0xaf,
+	0x09, 0x82, 0x52, 0x20, 0xb8, 0x44, 0x52, 0xa9, 0xe7, 0x71, 0x49, 0x71, 0x76, 0x37, 0x17, 0x19,
+	0x03, 0x2a, 0xc2, 0xc3, 0x19, 0x91, 0x45, 0xe8, 0xb8, 0x44, 0x64, 0x69, 0xa8, 0x09, 0x5f, 0x90,
+	0xbb, 0xb5, 0xcf, 0x0b, 0x99, 0x7d, 0x68, 0x68, 0x04, 0xd9, 0xe4, 0x13, 0x0b, 0x53, 0x2e, 0xc9,
+	0x8b, 0x6b, 0x82, 0x7f, 0x0f, 0x2f, 0xe7, 0xf9, 0x0b, 0x00, 0x81, 0x33, 0xfd, 0x12, 0xc0, 0xe0,
+	0xd0, 0x26, 0x21, 0x66, 0xb0, 0xe9, 0x69, 0x13, 0xae, 0xeb, 0x79, 0xa7, 0x2c, 0x32, 0xbc, 0x7d,
+	0x0e, 0x36, 0x67, 0x00, 0x6a, 0xd4, 0x33, 0xa1, 0x97, 0x47, 0xae, 0x4b, 0xa4, 0x94, 0x86, 0x8a,
+	0xc4, 0x62, 0x4e, 0x79, 0xa7, 0x69, 0x44, 0x9d, 0xc2, 0xbc, 0x8d, 0x6b, 0xa3, 0x57, 0x8d, 0x9c,
+	0x0c, 0xda, 0x5d, 0x22, 0xe4, 0x0d, 0x21, 0xe4, 0x0e, 0x7e, 0x2d, 0x2b, 0x44, 0x6c, 0x33, 0x02,
+	0xbe, 0x00, 0x30, 0x28, 0xb8, 0xd1, 0x53, 0x06, 0x19, 0x2f, 0x49, 0x2c, 0xa5, 0x0f, 0xde, 0x80,
+	0x6f, 0x32, 0xac, 0x7f, 0x0e, 0xf3, 0x36, 0x9e, 0x6e, 0xce, 0x9e, 0x43, 0xd9, 0x4b, 0xd8, 0xdf,
+	0x17, 0xec, 0xef, 0xe2, 0x9e, 0xcd, 0x9e, 0x12, 0x76, 0xea, 0x26, 0xb6, 0x21, 0x08, 0x2c, 0x64,
+	0xa1, 0x50, 0x13, 0xdf, 0x05, 0x88, 0xb4, 0x57, 0x40, 0x55, 0xcb, 0xe3, 0x5b, 0x56, 0xd9, 0xa7,
+	0x1a, 0x70, 0x55, 0x17, 0xb1, 0x51, 0x4e, 0x73, 0x91, 0x1c, 0xf6, 0xd9, 0x5b, 0xca, 0x8b, 0x18,
+	0x63, 0x05, 0x51, 0x19, 0x68, 0x12, 0x75, 0x91, 0x2c, 0x66, 0x6a, 0x2e, 0x52, 0xc0, 0x52, 0xa7,
+	0xbd, 0x88, 0xac, 0xce, 0x32, 0x17, 0x21, 0xb0, 0x90, 0x05, 0x45, 0x8d, 0x98, 0x02, 0x58, 0x5a,
+	0x62, 0x95, 0x52, 0x31, 0x9e, 0xd8, 0x98, 0x11, 0x93, 0xc0, 0x8d, 0x12, 0x58, 0xd5, 0x3c, 0x5f,
+	0xe5, 0x98, 0x6b, 0x89, 0x0b, 0x97, 0x3e, 0x5f, 0xb6, 0xf2, 0x32, 0x4f, 0xf6, 0x3e, 0xcc, 0x70,
+	0x64, 0x15, 0xa5, 0xd5, 0x99, 0x42, 0x62, 0x7b, 0xb9, 0x09, 0x8a, 0x6f, 0x09, 0xbe, 0xcb, 0xb8,
+	0x93, 0x71, 0xaf, 0xeb, 0x70, 0xa0, 0x4e, 0x5f, 0x82, 0xa3, 0x99, 0xd3, 0x97, 0x83, 0x6c, 0xd3,
+	0x3e, 0xbe, 0x3c, 0x97, 0x04, 0x7a, 0xfb, 0xa9, 0x02, 0xbd, 0xb8, 0xd8, 0x9f, 0x88, 0xa2, 0x53,
+	0x43, 0x67, 0x76, 0x05, 0x67, 0x40, 0x38, 0x73, 0x13, 0x35, 0x37, 0xbe, 0xf0, 0xbc, 0x94, 0x04,
+	0x9c, 0xf3, 0x91, 0xc8, 0xaf, 0x12, 0x9b, 0xb1, 0xf3, 0xab, 0x06, 0xcd, 0x7a, 0xed, 0x0c, 0x48,
+	0x36, 0x3e, 0xb9, 0x52, 0xbe, 0x9c, 0x89, 0xbb, 0x14, 0xd8, 0xc8, 0xc5, 0x9d, 0xd5, 0x81, 0xf7,
+	0x0a, 0x18, 0xc2, 0x0b, 0xe3, 0x4e, 0xc3, 0x0b, 0x26, 0xee, 0x0c, 0x40, 0x61, 0xc7, 0x9d, 0x8d,
+	0x0b, 0x98, 0xb8, 0x4b, 0x67, 0xc7, 0xc7, 0x9d, 0x16, 0x40, 0x33, 0x01, 0x51, 0xbc, 0x48, 0x01,
+	0x4a, 0x78, 0xc9, 0x80, 0xb0, 0x2f, 0x72, 0x02, 0x60, 0xba, 0x6c, 0x2b, 0xc9, 0xda, 0x9d, 0x77,
+	0x2f, 0xd3, 0xa0, 0x8f, 0x49, 0xb0, 0x52, 0x47, 0xbc, 0x77, 0x37, 0x86, 0x95, 0xdd, 0xbe, 0x6d,
+	0x58, 0xdd, 0x49, 0x1b, 0xc3, 0x8a, 0x99, 0xf1, 0x86, 0xe5, 0x0c, 0xa9, 0x7a, 0x0d, 0x4c, 0x73,
+	0x6d, 0x0e, 0x9a, 0x69, 0xb8, 0xa7, 0x7d, 0x0d, 0x94, 0x1e, 0xf4, 0x61, 0xaf, 0x45, 0x65, 0x91,
+	0x6b, 0xcb, 0x33, 0x95, 0x45, 0xb1, 0x65, 0xef, 0xbd, 0x9a, 0xff, 0x6f, 0xbe, 0x5a, 0x1b, 0x5f,
+	0x60, 0xa8, 0xef, 0x53, 0xaa, 0x28, 0xa5, 0xe8, 0x65, 0x95, 0x45, 0x73, 0xd2, 0xc7, 0xb1, 0x1f,
+	0x2f, 0x77, 0x53, 0xc8, 0x5d, 0xc7, 0xf7, 0xca, 0x32, 0x6d, 0x89, 0xe8, 0x04, 0x96, 0x0a, 0xbf,
+	0x95, 0x31, 0x6d, 0x44, 0xd9, 0xcf, 0x68, 0x4c, 0x79, 0x7d, 0x98, 0xe9, 0x1f, 0xc6, 0xde, 0x58,
+	0xb4, 0x2e, 0x41, 0xa6, 0x81, 0xd8, 0x81, 0x2f, 0x1b, 0x9b, 0x1f, 0x4b, 0x36, 0x67, 0xf2, 0xf7,
+	0x93, 0xef, 0xfd, 0x67, 0x00, 0xf5, 0x3b, 0x33, 0x78, 0x57, 0x29, 0x00, 0x00,
 }
@@ -0,0 +1,128 @@
+package handler
+
+import (
+	"errors"
+	"html/template"
+	"log"
+	"net/http"
+	"path/filepath"
+	"strings"
+
+	"github.com/sschwartz96/syncapod-backend/internal/auth"
+)
+
+// AccountHandler serves the pages linked to by account emails
+type AccountHandler struct {
+	authController auth.Auth
+	verifyTemplate *template.Template
+	forgotTemplate *template.Template
+	resetTemplate  *template.Template
+}
+
+// accountPage is the data of the account templates
+type accountPage struct {
+	Token string
+	Error string
+	Done  bool
+}
+
+// CreateAccountHandler parses the account templates within templateDir
+func CreateAccountHandler(authController auth.Auth, templateDir string) (*AccountHandler, error) {
+	h := &AccountHandler{authController: authController}
+	var err error
+	if h.verifyTemplate, err = template.ParseFiles(filepath.Join(templateDir, "verify.gohtml")); err != nil {
+		return nil, err
+	}
+	if h.forgotTemplate, err = template.ParseFiles(filepath.Join(templateDir, "forgot.gohtml")); err != nil {
+		return nil, err
+	}
+	if h.resetTemplate, err = template.ParseFiles(filepath.Join(templateDir, "reset.gohtml")); err != nil {
+		return nil, err
+	}
+	return h, nil
+}
+
+func (h *AccountHandler) ServeHTTP(res http.ResponseWriter, req *http.Request) {
+	// path: /account/*
+	var head string
+	head, req.URL.Path = ShiftPath(req.URL.Path)
+	switch head {
+	case "verify":
+		h.Verify(res, req)
+	case "forgot":
+		h.Forgot(res, req)
+	case "reset":
+		h.Reset(res, req)
+	default:
+		http.NotFound(res, req)
+	}
+}
+
+// Verify shows the form confirming the email verification of the link and consumes its token on post,
+// so links opened by mail scanners and previews don't verify the email
+func (h *AccountHandler) Verify(res http.ResponseWriter, req *http.Request) {
+	page := accountPage{Token: req.FormValue("token")}
+	if req.Method == http.MethodPost {
+		err := h.authController.VerifyEmail(req.Context(), page.Token)
+		if err == auth.ErrInvalidToken {
+			res.WriteHeader(http.StatusBadRequest)
+			page.Error = err.Error()
+		} else if err != nil {
+			log.Printf("AccountHandler.Verify() error: %v\n", err)
+			res.WriteHeader(http.StatusInternalServerError)
+			page.Error = "could not verify email, please try again later"
+		} else {
+			page.Done = true
+		}
+	}
+	h.execute(res, h.verifyTemplate, page)
+}
+
+// Forgot shows the form requesting a password reset link and handles its post
+func (h *AccountHandler) Forgot(res http.ResponseWriter, req *http.Request) {
+	page := accountPage{}
+	if req.Method == http.MethodPost {
+		err := h.authController.RequestPasswordReset(req.Context(), strings.TrimSpace(req.FormValue("email")), remoteIP(req))
+		var throttled *auth.ThrottledError
+		if errors.As(err, &throttled) {
+			res.WriteHeader(http.StatusTooManyRequests)
+			page.Error = throttled.Error()
+		} else if err != nil {
+			log.Printf("AccountHandler.Forgot() error: %v\n", err)
+			res.WriteHeader(http.StatusInternalServerError)
+			page.Error = "could not send reset link, please try again later"
+		} else {
+			page.Done = true
+		}
+	}
+	h.execute(res, h.forgotTemplate, page)
+}
+
+// Reset shows the form choosing a new password and handles its post
+func (h *AccountHandler) Reset(res http.ResponseWriter, req *http.Request) {
+	page := accountPage{Token: req.FormValue("token")}
+	if req.Method == http.MethodPost {
+		password := req.FormValue("pass")
+		var err error
+		if password != req.FormValue("confirm") {
+			res.WriteHeader(http.StatusBadRequest)
+			page.Error = "passwords do not match"
+		} else if err = h.authController.ResetPassword(req.Context(), page.Token, password); err == auth.ErrWeakPassword || err == auth.ErrInvalidToken {
+			res.WriteHeader(http.StatusBadRequest)
+			page.Error = err.Error()
+		} else if err != nil {
+			log.Printf("AccountHandler.Reset() error: %v\n", err)
+			res.WriteHeader(http.StatusInternalServerError)
+			page.Error = "could not reset password, please try again later"
+		} else {
+			page.Done = true
+		}
+	}
+	h.execute(res, h.resetTemplate, page)
+}
+
+func (h *AccountHandler) execute(res http.ResponseWriter, t *template.Template, page accountPage) {
+	if err := t.Execute(res, page); err != nil {
+		log.Printf("AccountHandler error executing %s: %v\n", t.Name(), err)
+	}
+}
@@ -31,6 +31,8 @@ const (
 	Resume            = "AMAZON.ResumeIntent"
 
 	// Events
+	PlaybackStarted        = "AudioPlayer.PlaybackStarted"
+	PlaybackStopped        = "AudioPlayer.PlaybackStopped"
 	PlaybackNearlyFinished = "AudioPlayer.PlaybackNearlyFinished"
 	PlaybackFinished       = "AudioPlayer.PlaybackFinished"
 
@@ -40,6 +42,9 @@ const (
 	DirClearQueue = "AudioPlayer.ClearQueue"
 )
 
+// alexaDevice identifies Alexa within the listening history
+const alexaDevice = "alexa"
+
 type AlexaHandler struct {
 	auth auth.Auth
 	pod  podcast.PodController
@@ -64,7 +69,7 @@ func (h *AlexaHandler) Alexa(res http.ResponseWriter, req *http.Request) {
 	}
 
 	// audioplayer event or intent
-	if strings.Contains(string(body), "\"even\"") {
+	if strings.Contains(string(body), "\"event\"") || strings.Contains(string(body), "\"AudioPlayer.") {
 		h.AudioEvent(res, req, body)
 		return
 	}
@@ -170,12 +175,14 @@ func (h *AlexaHandler) Alexa(res http.ResponseWriter, req *http.Request) {
 			directive = DirStop
 			// TODO: handle error better back to user
 			go func() {
-				err := h.pod.PodcastStore.UpsertUserEpisode(
+				_, err := h.pod.RecordListening(
 					context.Background(),
 					&db.UserEpisode{UserID: userObj.ID, EpisodeID: epiID,
 						OffsetMillis: aData.Context.AudioPlayer.OffsetInMilliseconds,
+						LastSeen:     time.Now(),
 						Played:       false,
 					},
+					alexaDevice,
 				)
 				if err != nil {
 					fmt.Printf("error alexa_api.Pause, updating offset: %v\n", err)
@@ -241,6 +248,9 @@ func (h *AlexaHandler) Alexa(res http.ResponseWriter, req *http.Request) {
 					offset = userEpi.OffsetMillis
 				}
 			}
+			if offset == 0 {
+				offset = h.introOffset(req.Context(), userObj.ID, pod.ID, epi)
+			}
 			fmt.Println("offset: ", offset)
 			response = createAudioResponse(directive, userObj.ID.String(),
 				resText, pod, epi, offset)
@@ -354,7 +364,45 @@ func durationToText(dur time.Duration) string {
 
 func createAudioResponse(directive, userID, text string,
 	pod *db.Podcast, epi *db.Episode, offset int64) *AlexaResponseData {
+	return &AlexaResponseData{
+		Version: "1.0",
+		Response: AlexaResponse{
+			Directives: []AlexaDirective{
+				{
+					Type:         directive,
+					PlayBehavior: "REPLACE_ALL",
+					AudioItem:    createAudioItem(userID, pod, epi, offset),
+				},
+			},
+			OutputSpeech: &AlexaOutputSpeech{
+				Type: "PlainText",
+				Text: text,
+			},
+			ShouldEndSession: true,
+		},
+	}
+}
+
+// createEnqueueResponse enqueues the episode after the currently playing stream (prevToken)
+// responses to AudioPlayer requests cannot contain speech
+func createEnqueueResponse(userID, prevToken string, pod *db.Podcast, epi *db.Episode, offset int64) *AlexaResponseData {
+	audioItem := createAudioItem(userID, pod, epi, offset)
+	audioItem.Stream.ExpectedPreviousToken = prevToken
+	return &AlexaResponseData{
+		Version: "1.0",
+		Response: AlexaResponse{
+			Directives: []AlexaDirective{
+				{
+					Type:         DirPlay,
+					PlayBehavior: "ENQUEUE",
+					AudioItem:    audioItem,
+				},
+			},
+		},
+	}
+}
 
+func createAudioItem(userID string, pod *db.Podcast, epi *db.Episode, offset int64) AlexaAudioItem {
 	mp3URL := epi.EnclosureURL
 	if !strings.Contains(mp3URL, "https") {
 		mp3URL = strings.Replace(mp3URL, "http", "https", 1)
@@ -370,40 +418,24 @@ func createAudioResponse(directive, userID, text string,
 		}
 	}
 
-	return &AlexaResponseData{
-		Version: "1.0",
-		Response: AlexaResponse{
-			Directives: []AlexaDirective{
-				{
-					Type:         directive,
-					PlayBehavior: "REPLACE_ALL",
-					AudioItem: AlexaAudioItem{
-						Stream: AlexaStream{
-							URL:                  mp3URL,
-							Token:                userID + ";" + pod.ID.String() + ";" + epi.ID.String(),
-							OffsetInMilliseconds: offset,
-						},
-						Metadata: AlexaMetadata{
-							Title:    epi.Title,
-							Subtitle: epi.Summary,
-							Art: AlexaArt{
-								Sources: []AlexaURL{
-									{
-										URL:    imgURL,
-										Height: 144,
-										Width:  144,
-									},
-								},
-							},
-						},
+	return AlexaAudioItem{
+		Stream: AlexaStream{
+			URL:                  mp3URL,
+			Token:                userID + ";" + pod.ID.String() + ";" + epi.ID.String(),
+			OffsetInMilliseconds: offset,
+		},
+		Metadata: AlexaMetadata{
+			Title:    epi.Title,
+			Subtitle: epi.Summary,
+			Art: AlexaArt{
+				Sources: []AlexaURL{
+					{
+						URL:    imgURL,
+						Height: 144,
+						Width:  144,
 					},
 				},
 			},
-			OutputSpeech: AlexaOutputSpeech{
-				Type: "PlainText",
-				Text: text,
-			},
-			ShouldEndSession: true,
 		},
 	}
 }
@@ -417,7 +449,7 @@ func createPauseResponse(directive string) *AlexaResponseData {
 					Type: directive,
 				},
 			},
-			OutputSpeech: AlexaOutputSpeech{
+			OutputSpeech: &AlexaOutputSpeech{
 				Type: "PlainText",
 				Text: "Paused",
 			},
@@ -431,7 +463,7 @@ func createEmptyResponse(text string) *AlexaResponseData {
 		Version: "1.0",
 		Response: AlexaResponse{
 			Directives: nil,
-			OutputSpeech: AlexaOutputSpeech{
+			OutputSpeech: &AlexaOutputSpeech{
 				Type:         "PlainText",
 				Text:         text,
 				PlayBehavior: "REPLACE_ENQUEUE",
@@ -469,8 +501,8 @@ func convertISO8601ToMillis(data string) int64 {
 // getIDsFromToken takes token string and returns (userID,podID,epiID,error)
 // returns error if the token is malformed
 func getIDsFromToken(token string) (string, string, string, error) {
-	// token is in this format userid-podid-epiid
-	split := strings.Split(token, "-")
+	// token is in this format userid;podid;epiid
+	split := strings.Split(token, ";")
 	if len(split) != 3 {
 		return "", "", "", errors.New("not valid playback token")
 	}
@@ -494,29 +526,143 @@ func (h *AlexaHandler) AudioEvent(res http.ResponseWriter, req *http.Request, bo
 		fmt.Println("failed to unmarshal audio event: ", err)
 		return
 	}
+	var aData AlexaData
+	err = json.Unmarshal(body, &aData)
+	if err != nil {
+		fmt.Println("failed to unmarshal audio request: ", err)
+		return
+	}
+	// skill requests carry the event within the request rather than an event header
+	if data.Event.Header.Name == "" {
+		data.Event.Header.Name = aData.Request.Type
+		data.Event.Payload.Token = aData.Request.Token
+		data.Event.Payload.OffsetInMilliseconds = aData.Request.OffsetInMilliseconds
+	}
 
-	uID, pID, eID, err := getIDsFromToken(data.Event.Payload.Token)
-	userID := uuid.MustParse(uID)
-	podID := uuid.MustParse(pID)
-	epiID := uuid.MustParse(eID)
-
+	// the stream token is not signed, the user is the one of the linked account
+	token, err := getAccessToken(&aData)
+	if err != nil {
+		log.Println("audio event without accessToken")
+		res.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+	user, err := h.auth.ValidateAccessToken(req.Context(), token)
+	if err != nil {
+		log.Printf("audio event error validating token: %v", err)
+		res.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+	tokenUserID, _, epiID, err := parseAudioToken(data.Event.Payload.Token)
 	if err != nil {
 		fmt.Println(err)
 		return
 	}
+	if tokenUserID != user.ID {
+		log.Printf("audio event rejected, stream of another user: %v", tokenUserID)
+		res.WriteHeader(http.StatusForbidden)
+		return
+	}
+	userID := user.ID
 
 	fmt.Println("audio event: ", data.Event.Header.Name)
-	fmt.Printf("uID: %s, pID: %s, eID: %s\n", userID, podID, epiID)
 
 	switch data.Event.Header.Name {
+	case PlaybackStarted:
+		_, err := h.pod.UpdatePlayback(req.Context(), &db.UserEpisode{EpisodeID: epiID, UserID: userID,
+			OffsetMillis: data.Event.Payload.OffsetInMilliseconds, LastSeen: time.Now()}, false, true)
+		if err != nil {
+			fmt.Println("failed to update the userEpi offset: ", err)
+		}
+	case PlaybackStopped:
+		_, err := h.pod.RecordListening(req.Context(), &db.UserEpisode{EpisodeID: epiID, UserID: userID,
+			OffsetMillis: data.Event.Payload.OffsetInMilliseconds, LastSeen: time.Now()}, alexaDevice)
+		if err != nil {
+			fmt.Println("failed to update the userEpi offset: ", err)
+		}
 	case PlaybackNearlyFinished:
-		return
+		response, err := h.enqueueNext(req.Context(), userID, epiID, data.Event.Payload.Token)
+		if err != nil {
+			fmt.Println("nothing to enqueue: ", err)
+			return
+		}
+		jsonRes, err := json.Marshal(response)
+		if err != nil {
+			fmt.Println("couldn't marshal alexa response: ", err)
+			return
+		}
+		res.Header().Set("Content-Type", "application/json")
+		res.Write(jsonRes)
 	case PlaybackFinished:
-		err := h.pod.UpsertUserEpisode(req.Context(), &db.UserEpisode{EpisodeID: epiID, UserID: userID, Played: true, LastSeen: time.Unix(0, 0)})
+		_, err := h.pod.RecordListening(req.Context(), &db.UserEpisode{EpisodeID: epiID, UserID: userID,
+			OffsetMillis: data.Event.Payload.OffsetInMilliseconds, Played: true, LastSeen: time.Now()}, alexaDevice)
 		if err != nil {
 			fmt.Println("failed to update the userEpi as played: ", err)
 		}
+		err = h.pod.DeleteQueueItem(req.Context(), userID, epiID)
+		if err != nil {
+			fmt.Println("failed to remove the finished episode from queue: ", err)
+		} else {
+			h.pod.PublishQueueChanged(userID)
+		}
+	}
+}
+
+// parseAudioToken parses the ids of the audio stream token, see createAudioItem()
+// the token is not signed, its user id has to be checked against the access token
+func parseAudioToken(token string) (uuid.UUID, uuid.UUID, uuid.UUID, error) {
+	uID, pID, eID, err := getIDsFromToken(token)
+	if err != nil {
+		return uuid.UUID{}, uuid.UUID{}, uuid.UUID{}, err
+	}
+	userID, err := uuid.Parse(uID)
+	if err != nil {
+		return uuid.UUID{}, uuid.UUID{}, uuid.UUID{}, fmt.Errorf("invalid user id within playback token: %v", err)
+	}
+	podID, err := uuid.Parse(pID)
+	if err != nil {
+		return uuid.UUID{}, uuid.UUID{}, uuid.UUID{}, fmt.Errorf("invalid podcast id within playback token: %v", err)
+	}
+	epiID, err := uuid.Parse(eID)
+	if err != nil {
+		return uuid.UUID{}, uuid.UUID{}, uuid.UUID{}, fmt.Errorf("invalid episode id within playback token: %v", err)
+	}
+	return userID, podID, epiID, nil
+}
+
+// enqueueNext creates a response enqueuing the next episode in the user's queue
+// after the currently playing episode, userID has to be authenticated
+func (h *AlexaHandler) enqueueNext(ctx context.Context, userID, epiID uuid.UUID, prevToken string) (*AlexaResponseData, error) {
+	next, err := h.pod.FindNextInQueue(ctx, userID, epiID)
+	if err != nil {
+		return nil, fmt.Errorf("enqueueNext() error finding next episode: %v", err)
 	}
+	pod, err := h.pod.FindPodcastByID(ctx, next.PodcastID)
+	if err != nil {
+		return nil, fmt.Errorf("enqueueNext() error finding podcast: %v", err)
+	}
+	var offset int64
+	userEpi, err := h.pod.FindUserEpisode(ctx, userID, next.ID)
+	if err == nil && !userEpi.Played {
+		offset = userEpi.OffsetMillis
+	}
+	if offset == 0 {
+		offset = h.introOffset(ctx, userID, pod.ID, next)
+	}
+	return createEnqueueResponse(userID.String(), prevToken, pod, next, offset), nil
+}
+
+// introOffset returns the offset skipping the intro of a fresh episode per the user's podcast settings
+func (h *AlexaHandler) introOffset(ctx context.Context, userID, podID uuid.UUID, epi *db.Episode) int64 {
+	settings, err := h.pod.FindPodcastSettings(ctx, userID, podID)
+	if err != nil {
+		log.Printf("introOffset() error finding podcast settings: %v", err)
+		return 0
+	}
+	offset := int64(settings.SkipIntroSeconds) * 1000
+	if epi.Duration > 0 && offset >= epi.Duration {
+		return 0
+	}
+	return offset
 }
 
 // AlexaData contains all the informatino and data from request sent from alexa
@@ -595,9 +741,9 @@ type AlexaResponseData struct {
 
 // AlexaResponse contains the actual response
 type AlexaResponse struct {
-	Directives       []AlexaDirective  `json:"directives,omitempty"`
-	OutputSpeech     AlexaOutputSpeech `json:"outputSpeech,omitempty"`
-	ShouldEndSession bool              `json:"shouldEndSession,omitempty"`
+	Directives       []AlexaDirective   `json:"directives,omitempty"`
+	OutputSpeech     *AlexaOutputSpeech `json:"outputSpeech,omitempty"`
+	ShouldEndSession bool               `json:"shouldEndSession,omitempty"`
 }
 
 // AlexaDirective tells alexa what to do
@@ -615,9 +761,10 @@ type AlexaAudioItem struct {
 
 // AlexaStream contains information about the audio url and offset
 type AlexaStream struct {
-	Token                string `json:"token,omitempty"`
-	URL                  string `json:"url,omitempty"`
-	OffsetInMilliseconds int64  `json:"offsetInMilliseconds,omitempty"`
+	Token                 string `json:"token,omitempty"`
+	ExpectedPreviousToken string `json:"expectedPreviousToken,omitempty"`
+	URL                   string `json:"url,omitempty"`
+	OffsetInMilliseconds  int64  `json:"offsetInMilliseconds,omitempty"`
 }
 
 // AlexaMetadata contains information about the stream
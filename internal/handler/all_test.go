@@ -5,9 +5,11 @@ import (
 	"encoding/json"
 	"io/ioutil"
 	"log"
+	"net/http"
 	"net/http/httptest"
 	"net/url"
 	"os"
+	"regexp"
 	"strconv"
 	"strings"
 	"testing"
@@ -19,10 +21,17 @@ import (
 	"github.com/sschwartz96/syncapod-backend/internal"
 	"github.com/sschwartz96/syncapod-backend/internal/auth"
 	"github.com/sschwartz96/syncapod-backend/internal/db"
+	"github.com/sschwartz96/syncapod-backend/internal/mail"
+	"github.com/sschwartz96/syncapod-backend/internal/podcast"
 	"github.com/stretchr/testify/require"
 )
 
-var testHandler *Handler
+var (
+	testHandler *Handler
+	testAuth    *auth.AuthController
+	testPG      *pgxpool.Pool
+	testMailer  = mail.NewMemoryMailer()
+)
 
 func TestMain(t *testing.M) {
 	// connect to db
@@ -35,16 +44,31 @@ func TestMain(t *testing.M) {
 	}
 
 	// create controllers
-	authC := auth.NewAuthController(db.NewAuthStorePG(pgdb), db.NewOAuthStorePG(pgdb))
+	authC := auth.NewAuthController(db.NewAuthStorePG(pgdb), db.NewOAuthStorePG(pgdb), testMailer, "https://syncapod.com", auth.Hasher{}, db.NewMemoryLimiterStore())
+	podCon, err := podcast.NewPodController(db.NewPodcastStore(pgdb))
+	if err != nil {
+		log.Fatalf("Handler.TestMain() error creating podController: %v", err)
+	}
 
 	// create handlers
 	oauthHandler, err := createTestOAuthHandler(authC)
 	if err != nil {
 		log.Fatalf("Handler.TestMain() error creating oauthHandler: %v", err)
 	}
-	testHandler = &Handler{oauthHandler: oauthHandler}
+	accountHandler, err := CreateAccountHandler(authC, "../../templates/account")
+	if err != nil {
+		log.Fatalf("Handler.TestMain() error creating accountHandler: %v", err)
+	}
+	testHandler = &Handler{
+		oauthHandler:   oauthHandler,
+		alexaHandler:   CreateAlexaHandler(authC, podCon),
+		gpodderHandler: CreateGpodderHandler(authC, podCon),
+		accountHandler: accountHandler,
+	}
+	testAuth = authC
 
 	// setup database
+	testPG = pgdb
 	setup(pgdb)
 
 	// run tests
@@ -147,6 +171,148 @@ func testOauthToken(t *testing.T, urlValues map[string]string) string {
 	return tRes.RefreshToken
 }
 
+func Test_AlexaAudioEvent(t *testing.T) {
+	ctx := context.Background()
+	podStore := db.NewPodcastStore(testPG)
+	pod := &db.Podcast{ID: uuid.New(), Title: "Alexa Test", Category: []int{}, RSSURL: "https://syncapod.com/alexa_test.rss"}
+	epi := &db.Episode{ID: uuid.New(), PodcastID: pod.ID, Title: "Alexa 1", EnclosureURL: "https://syncapod.com/alexa_test.mp3", PubDate: time.Unix(1, 0)}
+	require.Nil(t, podStore.InsertPodcast(ctx, pod))
+	require.Nil(t, podStore.InsertEpisode(ctx, epi))
+	userID := uuid.MustParse("b7f85a20-9b8f-47f9-8cee-a553a24f2b6d")
+	code, err := testAuth.CreateAuthCode(ctx, userID, "testClientID")
+	require.Nil(t, err)
+	token, err := testAuth.CreateAccessToken(ctx, code)
+	require.Nil(t, err)
+
+	audioEvent := func(accessToken string, streamUserID uuid.UUID) *http.Response {
+		body := `{"context":{"System":{"user":{"accessToken":"` + accessToken + `"}}},` +
+			`"request":{"type":"` + PlaybackStopped + `","token":"` + streamUserID.String() + ";" + pod.ID.String() + ";" + epi.ID.String() + `",` +
+			`"offsetInMilliseconds":1000}}`
+		rec := httptest.NewRecorder()
+		req := httptest.NewRequest("POST", "https://syncapod.com/api/alexa", strings.NewReader(body))
+		testHandler.alexaHandler.Alexa(rec, req)
+		return rec.Result()
+	}
+
+	// the access token is required
+	require.Equal(t, 401, audioEvent("", userID).StatusCode)
+	require.Equal(t, 401, audioEvent("invalidToken", userID).StatusCode)
+	// the stream of another user is rejected
+	require.Equal(t, 403, audioEvent(token.Key, uuid.New()).StatusCode)
+	_, err = podStore.FindUserEpisode(ctx, userID, epi.ID)
+	require.NotNil(t, err)
+
+	require.Equal(t, 200, audioEvent(token.Key, userID).StatusCode)
+	userEpi, err := podStore.FindUserEpisode(ctx, userID, epi.ID)
+	require.Nil(t, err)
+	require.Equal(t, int64(1000), userEpi.OffsetMillis)
+}
+
+func Test_Gpodder(t *testing.T) {
+	podStore := db.NewPodcastStore(testPG)
+	pod := &db.Podcast{ID: uuid.New(), Title: "Gpodder Test", Category: []int{}, RSSURL: "https://syncapod.com/gpodder_test.rss"}
+	epi := &db.Episode{ID: uuid.New(), PodcastID: pod.ID, Title: "Gpodder 1", EnclosureURL: "https://syncapod.com/gpodder_test.mp3", PubDate: time.Unix(1, 0)}
+	if err := podStore.InsertPodcast(context.Background(), pod); err != nil {
+		t.Fatalf("Test_Gpodder() error inserting podcast: %v", err)
+	}
+	if err := podStore.InsertEpisode(context.Background(), epi); err != nil {
+		t.Fatalf("Test_Gpodder() error inserting episode: %v", err)
+	}
+	var cookie *http.Cookie
+	gpodder := func(method, path, body string) *http.Response {
+		rec := httptest.NewRecorder()
+		req := httptest.NewRequest(method, "https://syncapod.com/api/2"+path, strings.NewReader(body))
+		if cookie != nil {
+			req.AddCookie(cookie)
+		}
+		testHandler.ServeHTTP(rec, req)
+		return rec.Result()
+	}
+
+	// unauthorized without session or credentials
+	require.Equal(t, 401, gpodder("GET", "/devices/oauthTest.json", "").StatusCode)
+
+	// login
+	rec := httptest.NewRecorder()
+	req := httptest.NewRequest("POST", "https://syncapod.com/api/2/auth/oauthTest/login.json", nil)
+	req.SetBasicAuth("oauthTest", "password")
+	testHandler.ServeHTTP(rec, req)
+	res := rec.Result()
+	require.Equal(t, 200, res.StatusCode)
+	require.Len(t, res.Cookies(), 1)
+	cookie = res.Cookies()[0]
+
+	// devices
+	require.Equal(t, 200, gpodder("POST", "/devices/oauthTest/phone.json", `{"caption":"Phone","type":"mobile"}`).StatusCode)
+	require.Equal(t, 400, gpodder("POST", "/devices/oauthTest/phone.json", `{"type":"toaster"}`).StatusCode)
+	devices := []GpodderDevice{}
+	require.Nil(t, json.NewDecoder(gpodder("GET", "/devices/oauthTest.json", "").Body).Decode(&devices))
+	require.Equal(t, []GpodderDevice{{ID: "phone", Caption: "Phone", Type: "mobile"}}, devices)
+
+	// subscriptions
+	update := GpodderUpdateResult{}
+	res = gpodder("POST", "/subscriptions/oauthTest/phone.json", `{"add":[" `+pod.RSSURL+`"],"remove":[]}`)
+	require.Equal(t, 200, res.StatusCode)
+	require.Nil(t, json.NewDecoder(res.Body).Decode(&update))
+	require.Equal(t, [][2]string{{" " + pod.RSSURL, pod.RSSURL}}, update.UpdateURLs)
+	unknown := GpodderUpdateResult{}
+	res = gpodder("POST", "/subscriptions/oauthTest/phone.json", `{"add":["https://syncapod.com/gpodder_unknown.rss"],"remove":[]}`)
+	require.Equal(t, 200, res.StatusCode)
+	require.Nil(t, json.NewDecoder(res.Body).Decode(&unknown))
+	require.Equal(t, [][2]string{{"https://syncapod.com/gpodder_unknown.rss", ""}}, unknown.UpdateURLs)
+	subs := GpodderSubscriptionChanges{}
+	require.Nil(t, json.NewDecoder(gpodder("GET", "/subscriptions/oauthTest/phone.json?since=0", "").Body).Decode(&subs))
+	require.Equal(t, []string{pod.RSSURL}, subs.Add)
+	require.GreaterOrEqual(t, subs.Timestamp, update.Timestamp)
+
+	// episode actions
+	res = gpodder("POST", "/episodes/oauthTest.json", `[{"podcast":"`+pod.RSSURL+`","episode":"`+epi.EnclosureURL+
+		`","device":"phone","action":"play","timestamp":"2021-01-02T15:04:05","started":0,"position":60,"total":600}]`)
+	require.Equal(t, 200, res.StatusCode)
+	actions := GpodderEpisodeActions{}
+	require.Nil(t, json.NewDecoder(gpodder("GET", "/episodes/oauthTest.json?since="+strconv.FormatInt(subs.Timestamp, 10), "").Body).Decode(&actions))
+	require.Len(t, actions.Actions, 1)
+	require.Equal(t, int64(60), actions.Actions[0].Position)
+	require.Equal(t, "2021-01-02T15:04:05", actions.Actions[0].Timestamp)
+
+	// actions from the future are clamped to now
+	res = gpodder("POST", "/episodes/oauthTest.json", `[{"podcast":"`+pod.RSSURL+`","episode":"`+epi.EnclosureURL+
+		`","action":"play","timestamp":"2999-01-02T15:04:05","started":0,"position":90,"total":600}]`)
+	require.Equal(t, 200, res.StatusCode)
+	actions = GpodderEpisodeActions{}
+	require.Nil(t, json.NewDecoder(gpodder("GET", "/episodes/oauthTest.json?since="+strconv.FormatInt(subs.Timestamp, 10), "").Body).Decode(&actions))
+	require.Len(t, actions.Actions, 1)
+	require.Equal(t, int64(90), actions.Actions[0].Position)
+	lastSeen, err := time.Parse(gpodderTimeFormat, actions.Actions[0].Timestamp)
+	require.Nil(t, err)
+	require.False(t, lastSeen.After(time.Now()))
+
+	// basic auth verifies the password once, the session is reused by the next requests
+	basicAuth := func() *http.Response {
+		rec := httptest.NewRecorder()
+		req := httptest.NewRequest("GET", "https://syncapod.com/api/2/devices/oauthTest.json", nil)
+		req.SetBasicAuth("oauthTest", "password")
+		testHandler.ServeHTTP(rec, req)
+		return rec.Result()
+	}
+	res = basicAuth()
+	require.Equal(t, 200, res.StatusCode)
+	require.Len(t, res.Cookies(), 1)
+	res = basicAuth()
+	require.Equal(t, 200, res.StatusCode)
+	require.Empty(t, res.Cookies())
+
+	// unsubscribe
+	require.Equal(t, 200, gpodder("POST", "/subscriptions/oauthTest/phone.json", `{"add":[],"remove":["`+pod.RSSURL+`"]}`).StatusCode)
+	subs = GpodderSubscriptionChanges{}
+	require.Nil(t, json.NewDecoder(gpodder("GET", "/subscriptions/oauthTest/phone.json?since="+strconv.FormatInt(update.Timestamp, 10), "").Body).Decode(&subs))
+	require.Equal(t, []string{pod.RSSURL}, subs.Remove)
+
+	// logout
+	require.Equal(t, 200, gpodder("POST", "/auth/oauthTest/logout.json", "").StatusCode)
+	require.Equal(t, 401, gpodder("GET", "/devices/oauthTest.json", "").StatusCode)
+}
+
 //func Test_HTTP(t *testing.T) {
 //	type args struct {
 //		method string
@@ -160,16 +326,79 @@ func testOauthToken(t *testing.T, urlValues map[string]string) string {
 //	}{}
 //}
 
+func Test_Account(t *testing.T) {
+	authC := testHandler.accountHandler.authController
+	user := &db.UserRow{ID: uuid.New(), Birthdate: time.Unix(0, 0), Email: "account@test.com", Username: "accountTest",
+		PasswordHash: []byte{}, Created: time.Unix(0, 0), LastSeen: time.Unix(0, 0)}
+	insertUser(db.NewAuthStorePG(testPG), user)
+	account := func(method, path string, form url.Values) *http.Response {
+		rec := httptest.NewRecorder()
+		req := httptest.NewRequest(method, "https://syncapod.com/account"+path, strings.NewReader(form.Encode()))
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		testHandler.ServeHTTP(rec, req)
+		return rec.Result()
+	}
+	mailedToken := func() string {
+		msg := testMailer.Last(user.Email)
+		require.NotNil(t, msg)
+		match := regexp.MustCompile(`token=(\S+)`).FindStringSubmatch(msg.Body)
+		require.Len(t, match, 2)
+		token, err := url.QueryUnescape(match[1])
+		require.Nil(t, err)
+		return token
+	}
+
+	// verify, opening the link only shows the confirmation
+	require.Equal(t, 400, account("POST", "/verify", url.Values{"token": {"invalid"}}).StatusCode)
+	require.Nil(t, authC.SendVerificationEmail(context.Background(), user.ID))
+	verifyToken := mailedToken()
+	res := account("GET", "/verify?token="+url.QueryEscape(verifyToken), nil)
+	require.Equal(t, 200, res.StatusCode)
+	body, err := ioutil.ReadAll(res.Body)
+	require.Nil(t, err)
+	require.Contains(t, string(body), `name="token"`)
+	verified, err := db.NewAuthStorePG(testPG).GetUserByID(context.Background(), user.ID)
+	require.Nil(t, err)
+	require.False(t, verified.EmailVerified)
+	require.Equal(t, 200, account("POST", "/verify", url.Values{"token": {verifyToken}}).StatusCode)
+	verified, err = db.NewAuthStorePG(testPG).GetUserByID(context.Background(), user.ID)
+	require.Nil(t, err)
+	require.True(t, verified.EmailVerified)
+
+	// forgot
+	require.Equal(t, 200, account("GET", "/forgot", nil).StatusCode)
+	require.Equal(t, 200, account("POST", "/forgot", url.Values{"email": {user.Email}}).StatusCode)
+	token := mailedToken()
+
+	// reset
+	res = account("GET", "/reset?token="+url.QueryEscape(token), nil)
+	body, err = ioutil.ReadAll(res.Body)
+	require.Nil(t, err)
+	require.Contains(t, string(body), `name="token"`)
+	res = account("POST", "/reset", url.Values{"token": {token}, "pass": {"newPassword1"}, "confirm": {"other"}})
+	require.Equal(t, 400, res.StatusCode)
+	res = account("POST", "/reset", url.Values{"token": {token}, "pass": {"newPassword1"}, "confirm": {"newPassword1"}})
+	require.Equal(t, 200, res.StatusCode)
+	res = account("POST", "/reset", url.Values{"token": {token}, "pass": {"newPassword1"}, "confirm": {"newPassword1"}})
+	require.Equal(t, 400, res.StatusCode)
+	_, err = authC.Authenticate(context.Background(), user.Username, "newPassword1", "testAgent", "")
+	require.Nil(t, err)
+}
+
 func createTestOAuthHandler(authC auth.Auth) (*OauthHandler, error) {
 	loginT, err := template.ParseFiles("../../templates/oauth/login.gohtml")
 	if err != nil {
 		return nil, err
 	}
+	twoFactorT, err := template.ParseFiles("../../templates/oauth/two_factor.gohtml")
+	if err != nil {
+		return nil, err
+	}
 	authT, err := template.ParseFiles("../../templates/oauth/auth.gohtml")
 	if err != nil {
 		return nil, err
 	}
-	return &OauthHandler{authC, loginT, authT, map[string]string{"testClientID": "testClientSecret"}}, nil
+	return &OauthHandler{authC, loginT, twoFactorT, authT, map[string]string{"testClientID": "testClientSecret"}}, nil
 }
 
 func setup(pg *pgxpool.Pool) {
@@ -0,0 +1,626 @@
+package handler
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/sschwartz96/syncapod-backend/internal/auth"
+	"github.com/sschwartz96/syncapod-backend/internal/db"
+	"github.com/sschwartz96/syncapod-backend/internal/podcast"
+)
+
+const (
+	gpodderSessionCookie = "sessionid"
+	// gpodderTimeFormat is the UTC time of episode actions
+	gpodderTimeFormat = "2006-01-02T15:04:05"
+	gpodderMaxBody    = 1 << 20
+	// gpodderMaxCredentials bounds the remembered basic auth credentials
+	gpodderMaxCredentials = 10000
+)
+
+var (
+	gpodderDeviceID    = regexp.MustCompile(`^[\w.-]+$`)
+	gpodderDeviceTypes = map[string]bool{"desktop": true, "laptop": true, "mobile": true, "server": true, "other": true}
+)
+
+// GpodderHandler serves the gpodder.net v2 api under /api/2 for clients such as AntennaPod,
+// gPodder and Kasts. Subscriptions and episode actions are shared by all of the user's devices
+// and the timestamps handed to clients are positions of the ChangeLog
+type GpodderHandler struct {
+	auth        auth.Auth
+	pod         *podcast.PodController
+	credentials *gpodderCredentials
+}
+
+func CreateGpodderHandler(auth auth.Auth, podCon *podcast.PodController) *GpodderHandler {
+	return &GpodderHandler{auth: auth, pod: podCon, credentials: newGpodderCredentials()}
+}
+
+// gpodderCredentials remembers the session created for basic auth credentials, so clients
+// sending them with every request have their password verified once. Credentials are kept
+// as a keyed hash and their session is authorized again on every use
+type gpodderCredentials struct {
+	mu       sync.Mutex
+	secret   []byte
+	sessions map[[sha256.Size]byte]string
+}
+
+func newGpodderCredentials() *gpodderCredentials {
+	secret := make([]byte, sha256.Size)
+	if _, err := rand.Read(secret); err != nil {
+		panic(fmt.Sprintf("newGpodderCredentials() error creating secret: %v", err))
+	}
+	return &gpodderCredentials{secret: secret, sessions: map[[sha256.Size]byte]string{}}
+}
+
+func (c *gpodderCredentials) digest(name, password string) [sha256.Size]byte {
+	mac := hmac.New(sha256.New, c.secret)
+	mac.Write([]byte(name))
+	mac.Write([]byte{0})
+	mac.Write([]byte(password))
+	var digest [sha256.Size]byte
+	copy(digest[:], mac.Sum(nil))
+	return digest
+}
+
+func (c *gpodderCredentials) session(digest [sha256.Size]byte) (string, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	key, ok := c.sessions[digest]
+	return key, ok
+}
+
+func (c *gpodderCredentials) remember(digest [sha256.Size]byte, sessionKey string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if len(c.sessions) >= gpodderMaxCredentials {
+		c.sessions = map[[sha256.Size]byte]string{}
+	}
+	c.sessions[digest] = sessionKey
+}
+
+func (c *gpodderCredentials) forget(digest [sha256.Size]byte) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	delete(c.sessions, digest)
+}
+
+// GpodderDevice is a device as listed by the devices endpoint
+type GpodderDevice struct {
+	ID            string `json:"id"`
+	Caption       string `json:"caption"`
+	Type          string `json:"type"`
+	Subscriptions int    `json:"subscriptions"`
+}
+
+// GpodderSubscriptionChanges are the subscriptions added & removed since a timestamp
+type GpodderSubscriptionChanges struct {
+	Add       []string `json:"add"`
+	Remove    []string `json:"remove"`
+	Timestamp int64    `json:"timestamp"`
+}
+
+// GpodderEpisodeAction is an action of a device on an episode, positions are in seconds
+type GpodderEpisodeAction struct {
+	Podcast   string `json:"podcast"`
+	Episode   string `json:"episode"`
+	Device    string `json:"device,omitempty"`
+	Action    string `json:"action"`
+	Timestamp string `json:"timestamp,omitempty"`
+	Started   int64  `json:"started"`
+	Position  int64  `json:"position"`
+	Total     int64  `json:"total"`
+}
+
+// GpodderEpisodeActions are the episode actions since a timestamp
+type GpodderEpisodeActions struct {
+	Actions   []GpodderEpisodeAction `json:"actions"`
+	Timestamp int64                  `json:"timestamp"`
+}
+
+// GpodderUpdateResult is the response of an upload, UpdateURLs maps the urls that
+// were changed by the server to their new value, which is empty if it was rejected
+type GpodderUpdateResult struct {
+	Timestamp  int64       `json:"timestamp"`
+	UpdateURLs [][2]string `json:"update_urls"`
+}
+
+func (h *GpodderHandler) ServeHTTP(res http.ResponseWriter, req *http.Request) {
+	var head string
+	head, req.URL.Path = ShiftPath(req.URL.Path)
+	args := gpodderArgs(req.URL.Path)
+
+	switch {
+	case head == "auth" && len(args) == 2 && args[1] == "login":
+		h.Login(res, req, args[0])
+	case head == "auth" && len(args) == 2 && args[1] == "logout":
+		h.Logout(res, req, args[0])
+	case head == "devices" && len(args) == 1:
+		h.Devices(res, req, args[0])
+	case head == "devices" && len(args) == 2:
+		h.UpdateDevice(res, req, args[0], args[1])
+	case head == "subscriptions" && len(args) == 2:
+		h.Subscriptions(res, req, args[0], args[1])
+	case head == "episodes" && len(args) == 1:
+		h.Episodes(res, req, args[0])
+	default:
+		http.NotFound(res, req)
+	}
+}
+
+// gpodderArgs splits the path of an endpoint, ie: /{username}/{deviceid}.json
+func gpodderArgs(p string) []string {
+	p = strings.TrimPrefix(p, "/")
+	if !strings.HasSuffix(p, ".json") {
+		return nil
+	}
+	return strings.Split(strings.TrimSuffix(p, ".json"), "/")
+}
+
+// authenticate returns the user of the session cookie or basic auth credentials,
+// responds with unauthorized if neither is valid or the user is not the one of the path
+func (h *GpodderHandler) authenticate(res http.ResponseWriter, req *http.Request, username string) (*db.UserRow, bool) {
+	var user *db.UserRow
+	if cookie, err := req.Cookie(gpodderSessionCookie); err == nil {
+		user, _, _ = h.auth.Authorize(req.Context(), cookie.Value)
+	}
+	if name, password, ok := req.BasicAuth(); user == nil && ok {
+		var err error
+		user, err = h.authenticateBasic(res, req, name, password)
+		if writeThrottled(res, err) {
+			return nil, false
+		}
+	}
+	if user == nil || !strings.EqualFold(user.Username, username) {
+		res.Header().Set("WWW-Authenticate", `Basic realm="syncapod"`)
+		http.Error(res, "unauthorized", http.StatusUnauthorized)
+		return nil, false
+	}
+	return user, true
+}
+
+// authenticateBasic returns the user of the basic auth credentials. The password is verified
+// once, the session created for it authorizes the later requests with the same credentials
+// and is set as a cookie for clients keeping it
+func (h *GpodderHandler) authenticateBasic(res http.ResponseWriter, req *http.Request, name, password string) (*db.UserRow, error) {
+	digest := h.credentials.digest(name, password)
+	if key, ok := h.credentials.session(digest); ok {
+		if user, _, err := h.auth.Authorize(req.Context(), key); err == nil {
+			return user, nil
+		}
+		h.credentials.forget(digest)
+	}
+	user, session, err := h.auth.AuthenticateSession(req.Context(), name, password, req.UserAgent(), remoteIP(req))
+	if err != nil {
+		return nil, err
+	}
+	h.credentials.remember(digest, session.Key)
+	setGpodderSessionCookie(res, session)
+	return user, nil
+}
+
+func setGpodderSessionCookie(res http.ResponseWriter, session *db.SessionRow) {
+	http.SetCookie(res, &http.Cookie{
+		Name:     gpodderSessionCookie,
+		Value:    session.Key,
+		Path:     "/api/2",
+		Expires:  session.Expires,
+		Secure:   true,
+		HttpOnly: true,
+	})
+}
+
+// Login creates a session from basic auth credentials and sets it as a cookie
+func (h *GpodderHandler) Login(res http.ResponseWriter, req *http.Request, username string) {
+	if req.Method != http.MethodPost {
+		http.Error(res, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	name, password, ok := req.BasicAuth()
+	if !ok {
+		// already logged in via cookie
+		if _, ok = h.authenticate(res, req, username); ok {
+			res.WriteHeader(http.StatusOK)
+		}
+		return
+	}
+	user, session, err := h.auth.Login(req.Context(), name, password, req.UserAgent(), remoteIP(req))
+	if writeThrottled(res, err) {
+		return
+	}
+	if err != nil || !strings.EqualFold(user.Username, username) {
+		res.Header().Set("WWW-Authenticate", `Basic realm="syncapod"`)
+		http.Error(res, "unauthorized", http.StatusUnauthorized)
+		return
+	}
+	setGpodderSessionCookie(res, session)
+	res.WriteHeader(http.StatusOK)
+}
+
+// Logout deletes the session of the cookie
+func (h *GpodderHandler) Logout(res http.ResponseWriter, req *http.Request, username string) {
+	if req.Method != http.MethodPost {
+		http.Error(res, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	cookie, err := req.Cookie(gpodderSessionCookie)
+	if err == nil {
+		if err = h.auth.Logout(req.Context(), cookie.Value); err != nil {
+			log.Println("GpodderHandler.Logout() error:", err)
+		}
+	}
+	http.SetCookie(res, &http.Cookie{Name: gpodderSessionCookie, Path: "/api/2", MaxAge: -1})
+	res.WriteHeader(http.StatusOK)
+}
+
+// Devices lists the user's devices
+func (h *GpodderHandler) Devices(res http.ResponseWriter, req *http.Request, username string) {
+	if req.Method != http.MethodGet {
+		http.Error(res, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	user, ok := h.authenticate(res, req, username)
+	if !ok {
+		return
+	}
+	dbDevices, err := h.pod.FindGpodderDevices(req.Context(), user.ID)
+	if err != nil {
+		log.Println("GpodderHandler.Devices() error:", err)
+		http.Error(res, "internal server error", http.StatusInternalServerError)
+		return
+	}
+	subs, err := h.pod.FindSubscriptions(req.Context(), user.ID)
+	if err != nil {
+		log.Println("GpodderHandler.Devices() error:", err)
+		http.Error(res, "internal server error", http.StatusInternalServerError)
+		return
+	}
+	devices := make([]GpodderDevice, len(dbDevices))
+	for i, d := range dbDevices {
+		devices[i] = GpodderDevice{ID: d.ID, Caption: d.Caption, Type: d.Type, Subscriptions: len(subs)}
+	}
+	writeGpodderJSON(res, devices)
+}
+
+// UpdateDevice registers the device or updates its caption and type
+func (h *GpodderHandler) UpdateDevice(res http.ResponseWriter, req *http.Request, username, deviceID string) {
+	if req.Method != http.MethodPost {
+		http.Error(res, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	user, ok := h.authenticate(res, req, username)
+	if !ok {
+		return
+	}
+	if !gpodderDeviceID.MatchString(deviceID) {
+		http.Error(res, "invalid device id", http.StatusBadRequest)
+		return
+	}
+	body := struct {
+		Caption *string `json:"caption"`
+		Type    *string `json:"type"`
+	}{}
+	if err := json.NewDecoder(http.MaxBytesReader(res, req.Body, gpodderMaxBody)).Decode(&body); err != nil {
+		http.Error(res, "invalid json", http.StatusBadRequest)
+		return
+	}
+	if body.Type != nil && !gpodderDeviceTypes[*body.Type] {
+		http.Error(res, "invalid device type", http.StatusBadRequest)
+		return
+	}
+
+	devices, err := h.pod.FindGpodderDevices(req.Context(), user.ID)
+	if err != nil {
+		log.Println("GpodderHandler.UpdateDevice() error:", err)
+		http.Error(res, "internal server error", http.StatusInternalServerError)
+		return
+	}
+	device := &db.GpodderDevice{UserID: user.ID, ID: deviceID, Type: "other"}
+	for i := range devices {
+		if devices[i].ID == deviceID {
+			device = &devices[i]
+		}
+	}
+	if body.Caption != nil {
+		device.Caption = *body.Caption
+	}
+	if body.Type != nil {
+		device.Type = *body.Type
+	}
+	device.Updated = time.Now()
+	if err = h.pod.UpsertGpodderDevice(req.Context(), device); err != nil {
+		log.Println("GpodderHandler.UpdateDevice() error:", err)
+		http.Error(res, "internal server error", http.StatusInternalServerError)
+		return
+	}
+	res.WriteHeader(http.StatusOK)
+}
+
+// Subscriptions returns the subscription changes since the timestamp on GET
+// and subscribes to & unsubscribes from the uploaded feed urls on POST
+func (h *GpodderHandler) Subscriptions(res http.ResponseWriter, req *http.Request, username, deviceID string) {
+	if req.Method != http.MethodGet && req.Method != http.MethodPost {
+		http.Error(res, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	user, ok := h.authenticate(res, req, username)
+	if !ok || !h.registerDevice(res, req, user.ID, deviceID) {
+		return
+	}
+
+	if req.Method == http.MethodGet {
+		since, err := gpodderSince(req)
+		if err != nil {
+			http.Error(res, "invalid since", http.StatusBadRequest)
+			return
+		}
+		added, removed, latest, err := h.pod.SubscriptionChanges(req.Context(), user.ID, since)
+		if err != nil {
+			log.Println("GpodderHandler.Subscriptions() error:", err)
+			http.Error(res, "internal server error", http.StatusInternalServerError)
+			return
+		}
+		changes := GpodderSubscriptionChanges{Add: []string{}, Remove: []string{}, Timestamp: latest}
+		for i := range added {
+			changes.Add = append(changes.Add, added[i].RSSURL)
+		}
+		for i := range removed {
+			changes.Remove = append(changes.Remove, removed[i].RSSURL)
+		}
+		writeGpodderJSON(res, &changes)
+		return
+	}
+
+	changes := GpodderSubscriptionChanges{}
+	if err := json.NewDecoder(http.MaxBytesReader(res, req.Body, gpodderMaxBody)).Decode(&changes); err != nil {
+		http.Error(res, "invalid json", http.StatusBadRequest)
+		return
+	}
+	removing := map[string]bool{}
+	for _, url := range changes.Remove {
+		removing[strings.TrimSpace(url)] = true
+	}
+	for _, url := range changes.Add {
+		if removing[strings.TrimSpace(url)] {
+			http.Error(res, "url is both added and removed: "+url, http.StatusBadRequest)
+			return
+		}
+	}
+
+	subs, err := h.pod.FindSubscriptions(req.Context(), user.ID)
+	if err != nil {
+		log.Println("GpodderHandler.Subscriptions() error:", err)
+		http.Error(res, "internal server error", http.StatusInternalServerError)
+		return
+	}
+	subscribed := make(map[uuid.UUID]bool, len(subs))
+	for i := range subs {
+		subscribed[subs[i].PodcastID] = true
+	}
+	result := GpodderUpdateResult{UpdateURLs: [][2]string{}}
+	for _, url := range changes.Add {
+		cleaned := strings.TrimSpace(url)
+		// only podcasts of the catalog can be subscribed, unknown feeds are rejected
+		pod, err := h.pod.FindPodcastByRSS(req.Context(), cleaned)
+		if err != nil {
+			result.UpdateURLs = append(result.UpdateURLs, [2]string{url, ""})
+			continue
+		}
+		if cleaned != url {
+			result.UpdateURLs = append(result.UpdateURLs, [2]string{url, cleaned})
+		}
+		if subscribed[pod.ID] {
+			continue
+		}
+		if err = h.pod.InsertSubscription(req.Context(), &db.Subscription{UserID: user.ID, PodcastID: pod.ID}); err != nil {
+			log.Println("GpodderHandler.Subscriptions() error:", err)
+			http.Error(res, "internal server error", http.StatusInternalServerError)
+			return
+		}
+		subscribed[pod.ID] = true
+	}
+	for url := range removing {
+		pod, err := h.pod.FindPodcastByRSS(req.Context(), url)
+		if err != nil || !subscribed[pod.ID] {
+			continue
+		}
+		if err = h.pod.DeleteSubscription(req.Context(), user.ID, pod.ID); err != nil {
+			log.Println("GpodderHandler.Subscriptions() error:", err)
+			http.Error(res, "internal server error", http.StatusInternalServerError)
+			return
+		}
+	}
+	if result.Timestamp, err = h.pod.FindChangePosition(req.Context()); err != nil {
+		log.Println("GpodderHandler.Subscriptions() error:", err)
+		http.Error(res, "internal server error", http.StatusInternalServerError)
+		return
+	}
+	writeGpodderJSON(res, &result)
+}
+
+// Episodes returns the play actions of the episodes changed since the timestamp on GET,
+// optionally of a single podcast, and applies the uploaded actions on POST. Play actions
+// update the playback state and new actions mark the episode unplayed, others are ignored
+func (h *GpodderHandler) Episodes(res http.ResponseWriter, req *http.Request, username string) {
+	if req.Method != http.MethodGet && req.Method != http.MethodPost {
+		http.Error(res, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	user, ok := h.authenticate(res, req, username)
+	if !ok {
+		return
+	}
+	if req.Method == http.MethodGet {
+		h.getEpisodeActions(res, req, user)
+		return
+	}
+
+	actions := []GpodderEpisodeAction{}
+	if err := json.NewDecoder(http.MaxBytesReader(res, req.Body, gpodderMaxBody)).Decode(&actions); err != nil {
+		http.Error(res, "invalid json", http.StatusBadRequest)
+		return
+	}
+	pods := map[string]*db.Podcast{}
+	index := map[uuid.UUID]int{}
+	userEpis := []db.UserEpisode{}
+	resetPlayed := []bool{}
+	for _, a := range actions {
+		action := strings.ToLower(a.Action)
+		if action != "play" && action != "new" {
+			continue
+		}
+		if a.Device != "" && !h.registerDevice(res, req, user.ID, a.Device) {
+			return
+		}
+		pod, ok := pods[a.Podcast]
+		if !ok {
+			pod, _ = h.pod.FindPodcastByRSS(req.Context(), a.Podcast)
+			pods[a.Podcast] = pod
+		}
+		if pod == nil {
+			continue
+		}
+		epi, err := h.pod.FindEpisodeByURL(req.Context(), pod.ID, a.Episode)
+		if err != nil {
+			continue
+		}
+		// actions from the future are clamped to now, they would override every later update
+		lastSeen, err := time.Parse(gpodderTimeFormat, a.Timestamp)
+		if now := time.Now(); err != nil || lastSeen.After(now) {
+			lastSeen = now
+		}
+		userEpi := db.UserEpisode{UserID: user.ID, EpisodeID: epi.ID, LastSeen: lastSeen}
+		if action == "play" {
+			userEpi.OffsetMillis = a.Position * 1000
+			userEpi.Played = a.Total > 0 && a.Position >= a.Total
+		}
+		// only the latest action of an episode is applied
+		if i, ok := index[epi.ID]; ok {
+			if userEpis[i].LastSeen.After(lastSeen) {
+				continue
+			}
+			userEpis[i], resetPlayed[i] = userEpi, action == "new"
+			continue
+		}
+		index[epi.ID] = len(userEpis)
+		userEpis = append(userEpis, userEpi)
+		resetPlayed = append(resetPlayed, action == "new")
+	}
+	if len(userEpis) > 0 {
+		if _, err := h.pod.UpdatePlaybacks(req.Context(), user.ID, userEpis, resetPlayed); err != nil {
+			log.Println("GpodderHandler.Episodes() error:", err)
+			http.Error(res, "internal server error", http.StatusInternalServerError)
+			return
+		}
+	}
+	var err error
+	result := GpodderUpdateResult{UpdateURLs: [][2]string{}}
+	if result.Timestamp, err = h.pod.FindChangePosition(req.Context()); err != nil {
+		log.Println("GpodderHandler.Episodes() error:", err)
+		http.Error(res, "internal server error", http.StatusInternalServerError)
+		return
+	}
+	writeGpodderJSON(res, &result)
+}
+
+func (h *GpodderHandler) getEpisodeActions(res http.ResponseWriter, req *http.Request, user *db.UserRow) {
+	since, err := gpodderSince(req)
+	if err != nil {
+		http.Error(res, "invalid since", http.StatusBadRequest)
+		return
+	}
+	userEpis, latest, err := h.pod.UserEpisodeChanges(req.Context(), user.ID, since)
+	if err != nil {
+		log.Println("GpodderHandler.Episodes() error:", err)
+		http.Error(res, "internal server error", http.StatusInternalServerError)
+		return
+	}
+	epiIDs := make([]uuid.UUID, len(userEpis))
+	for i := range userEpis {
+		epiIDs[i] = userEpis[i].EpisodeID
+	}
+	epis, err := h.pod.FindEpisodesByIDs(req.Context(), epiIDs)
+	if err != nil {
+		log.Println("GpodderHandler.Episodes() error:", err)
+		http.Error(res, "internal server error", http.StatusInternalServerError)
+		return
+	}
+	episodes := make(map[uuid.UUID]*db.Episode, len(epis))
+	for i := range epis {
+		episodes[epis[i].ID] = &epis[i]
+	}
+
+	podcastURL := req.URL.Query().Get("podcast")
+	pods := map[uuid.UUID]*db.Podcast{}
+	result := GpodderEpisodeActions{Actions: []GpodderEpisodeAction{}, Timestamp: latest}
+	for _, userEpi := range userEpis {
+		epi, ok := episodes[userEpi.EpisodeID]
+		if !ok {
+			continue
+		}
+		pod, ok := pods[epi.PodcastID]
+		if !ok {
+			pod, _ = h.pod.FindPodcastByID(req.Context(), epi.PodcastID)
+			pods[epi.PodcastID] = pod
+		}
+		if pod == nil || (podcastURL != "" && pod.RSSURL != podcastURL) {
+			continue
+		}
+		total := epi.Duration / 1000
+		position := userEpi.OffsetMillis / 1000
+		if userEpi.Played && total > 0 {
+			position = total
+		}
+		result.Actions = append(result.Actions, GpodderEpisodeAction{
+			Podcast:   pod.RSSURL,
+			Episode:   epi.EnclosureURL,
+			Action:    "play",
+			Timestamp: userEpi.LastSeen.UTC().Format(gpodderTimeFormat),
+			Position:  position,
+			Total:     total,
+		})
+	}
+	writeGpodderJSON(res, &result)
+}
+
+// registerDevice registers the device on first use, responds with bad request if the id is invalid
+func (h *GpodderHandler) registerDevice(res http.ResponseWriter, req *http.Request, userID uuid.UUID, deviceID string) bool {
+	if !gpodderDeviceID.MatchString(deviceID) {
+		http.Error(res, "invalid device id", http.StatusBadRequest)
+		return false
+	}
+	device := &db.GpodderDevice{UserID: userID, ID: deviceID, Type: "other", Updated: time.Now()}
+	if err := h.pod.InsertGpodderDeviceIfMissing(req.Context(), device); err != nil {
+		log.Println("GpodderHandler.registerDevice() error:", err)
+		http.Error(res, "internal server error", http.StatusInternalServerError)
+		return false
+	}
+	return true
+}
+
+// gpodderSince parses the since query parameter, 0 if missing
+func gpodderSince(req *http.Request) (int64, error) {
+	since := req.URL.Query().Get("since")
+	if since == "" {
+		return 0, nil
+	}
+	return strconv.ParseInt(since, 10, 64)
+}
+
+func writeGpodderJSON(res http.ResponseWriter, v interface{}) {
+	res.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(res).Encode(v); err != nil {
+		log.Println("writeGpodderJSON() error:", err)
+	}
+}
@@ -1,11 +1,15 @@
 package handler
 
 import (
+	"errors"
 	"fmt"
 	"io/ioutil"
 	"log"
+	"math"
+	"net"
 	"net/http"
 	"path"
+	"strconv"
 	"strings"
 
 	"github.com/sschwartz96/syncapod-backend/internal/auth"
@@ -15,8 +19,11 @@ import (
 
 // Handler is the main handler for syncapod, all routes go through it
 type Handler struct {
-	oauthHandler *OauthHandler
-	alexaHandler *AlexaHandler
+	oauthHandler   *OauthHandler
+	alexaHandler   *AlexaHandler
+	shareHandler   *ShareHandler
+	gpodderHandler *GpodderHandler
+	accountHandler *AccountHandler
 }
 
 // CreateHandler sets up the main handler
@@ -32,7 +39,19 @@ func CreateHandler(cfg *config.Config, authC auth.Auth, podCon *podcast.PodContr
 		return nil, fmt.Errorf("CreateHandler() error creating oauthHandler: %v", err)
 	}
 	alexaHandler := CreateAlexaHandler(authC, podCon)
-	return &Handler{oauthHandler: oauthHandler, alexaHandler: alexaHandler}, nil
+	shareHandler := CreateShareHandler(podCon)
+	gpodderHandler := CreateGpodderHandler(authC, podCon)
+	accountHandler, err := CreateAccountHandler(authC, "./templates/account")
+	if err != nil {
+		return nil, fmt.Errorf("CreateHandler() error creating accountHandler: %v", err)
+	}
+	return &Handler{
+		oauthHandler:   oauthHandler,
+		alexaHandler:   alexaHandler,
+		shareHandler:   shareHandler,
+		gpodderHandler: gpodderHandler,
+		accountHandler: accountHandler,
+	}, nil
 }
 
 // ServeHTTP handles all requests
@@ -61,6 +80,10 @@ mx: mail.syncapod.com`))
 		h.oauthHandler.ServeHTTP(res, req)
 	case "api":
 		h.serveAPI(res, req)
+	case "share":
+		h.shareHandler.ServeHTTP(res, req)
+	case "account":
+		h.accountHandler.ServeHTTP(res, req)
 	}
 }
 
@@ -74,7 +97,30 @@ func (h *Handler) serveAPI(res http.ResponseWriter, req *http.Request) {
 	case "actions":
 		log.Println("actions req")
 		log.Println(ioutil.ReadAll(req.Body))
+	case "2":
+		// gpodder.net api v2
+		h.gpodderHandler.ServeHTTP(res, req)
+	}
+}
+
+// remoteIP returns the ip of the client connected to the server
+func remoteIP(req *http.Request) string {
+	host, _, err := net.SplitHostPort(req.RemoteAddr)
+	if err != nil {
+		return req.RemoteAddr
+	}
+	return host
+}
+
+// writeThrottled responds with too many requests if err is a *auth.ThrottledError
+func writeThrottled(res http.ResponseWriter, err error) bool {
+	var throttled *auth.ThrottledError
+	if !errors.As(err, &throttled) {
+		return false
 	}
+	res.Header().Set("Retry-After", strconv.Itoa(int(math.Ceil(throttled.RetryAfter.Seconds()))))
+	http.Error(res, throttled.Error(), http.StatusTooManyRequests)
+	return true
 }
 
 // ShiftPath splits off the first component of p, which will be cleaned of
@@ -10,17 +10,19 @@ import (
 	"net/url"
 	"strings"
 	"text/template"
+	"time"
 
-	"github.com/google/uuid"
 	"github.com/sschwartz96/syncapod-backend/internal/auth"
+	"github.com/sschwartz96/syncapod-backend/internal/db"
 )
 
 // OauthHandler handles authorization and authentication to oauth clients
 type OauthHandler struct {
-	authController auth.Auth
-	loginTemplate  *template.Template
-	authTemplate   *template.Template
-	clients        map[string]string
+	authController    auth.Auth
+	loginTemplate     *template.Template
+	twoFactorTemplate *template.Template
+	authTemplate      *template.Template
+	clients           map[string]string
 }
 
 // CreateOauthHandler just intantiates an OauthHandler
@@ -29,15 +31,20 @@ func CreateOauthHandler(authController auth.Auth, clients map[string]string) (*O
 	if err != nil {
 		return nil, err
 	}
+	twoFactorT, err := template.ParseFiles("./templates/oauth/two_factor.gohtml")
+	if err != nil {
+		return nil, err
+	}
 	authT, err := template.ParseFiles("./templates/oauth/auth.gohtml")
 	if err != nil {
 		return nil, err
 	}
 	return &OauthHandler{
-		authController: authController,
-		loginTemplate:  loginT,
-		authTemplate:   authT,
-		clients:        clients,
+		authController:    authController,
+		loginTemplate:     loginT,
+		twoFactorTemplate: twoFactorT,
+		authTemplate:      authT,
+		clients:           clients,
 	}, nil
 }
 
@@ -55,10 +62,21 @@ func (h *OauthHandler) ServeHTTP(res http.ResponseWriter, req *http.Request) {
 	}
 }
 
-// Login handles the post and get request of a login page
+// incorrectLogin is shown on the login page after a failed attempt
+const incorrectLogin = "Incorrect username or password"
+
+// twoFactorPage is the data of the two factor template, shown after the password of
+// an account with two factor enabled, the challenge is posted back with the code
+type twoFactorPage struct {
+	Challenge string
+	Message   string
+}
+
+// Login handles the post and get request of a login page,
+// the second step of accounts with two factor enabled is posted with the challenge
 func (h *OauthHandler) Login(res http.ResponseWriter, req *http.Request) {
 	if req.Method == http.MethodGet {
-		if err := h.loginTemplate.Execute(res, false); err != nil {
+		if err := h.loginTemplate.Execute(res, ""); err != nil {
 			log.Printf("OauthHandler.Login() error executing loginTemplate: %v\n", err)
 		}
 		return
@@ -66,25 +84,74 @@ func (h *OauthHandler) Login(res http.ResponseWriter, req *http.Request) {
 	err := req.ParseForm()
 	if err != nil {
 		fmt.Println("couldn't parse post values: ", err)
-		if err := h.loginTemplate.Execute(res, true); err != nil {
+		if err := h.loginTemplate.Execute(res, incorrectLogin); err != nil {
 			log.Printf("OauthHandler.Login() error executing loginTemplate: %v\n", err)
 		}
 		return
 	}
 
+	if challenge := req.FormValue("challenge"); challenge != "" {
+		h.verifyTwoFactor(res, req, challenge)
+		return
+	}
 	username := req.FormValue("uname")
 	password := req.FormValue("pass")
-	_, sesh, err := h.authController.Login(req.Context(), username, password, req.UserAgent())
+	_, sesh, err := h.authController.Login(req.Context(), username, password, req.UserAgent(), remoteIP(req))
+	var twoFactor *auth.TwoFactorRequiredError
+	if errors.As(err, &twoFactor) {
+		h.executeTwoFactor(res, twoFactorPage{Challenge: twoFactor.Challenge})
+		return
+	}
 	if err != nil {
-		if err := h.loginTemplate.Execute(res, true); err != nil {
+		message := incorrectLogin
+		var throttled *auth.ThrottledError
+		if errors.As(err, &throttled) {
+			res.WriteHeader(http.StatusTooManyRequests)
+			message = "Too many failed logins, please try again in " + throttled.RetryAfter.Round(time.Second).String()
+		}
+		if err := h.loginTemplate.Execute(res, message); err != nil {
 			log.Printf("OauthHandler.Login() error executing loginTemplate: %v\n", err)
 		}
 		return
 	}
 
+	h.redirectAuthorize(res, req, sesh.Key)
+}
+
+// verifyTwoFactor completes the login with the code, an incorrect code may be retried
+// until the challenge expires, after which the user must login again
+func (h *OauthHandler) verifyTwoFactor(res http.ResponseWriter, req *http.Request, challenge string) {
+	_, sesh, err := h.authController.VerifyTwoFactor(req.Context(), challenge, req.FormValue("code"), req.UserAgent(), remoteIP(req))
+	if err == auth.ErrInvalidChallenge {
+		if err := h.loginTemplate.Execute(res, err.Error()); err != nil {
+			log.Printf("OauthHandler.verifyTwoFactor() error executing loginTemplate: %v\n", err)
+		}
+		return
+	}
+	if err != nil {
+		page := twoFactorPage{Challenge: challenge, Message: auth.ErrIncorrectCode.Error()}
+		var throttled *auth.ThrottledError
+		if errors.As(err, &throttled) {
+			res.WriteHeader(http.StatusTooManyRequests)
+			page.Message = "Too many failed logins, please try again in " + throttled.RetryAfter.Round(time.Second).String()
+		}
+		h.executeTwoFactor(res, page)
+		return
+	}
+	h.redirectAuthorize(res, req, sesh.Key)
+}
+
+func (h *OauthHandler) executeTwoFactor(res http.ResponseWriter, page twoFactorPage) {
+	if err := h.twoFactorTemplate.Execute(res, page); err != nil {
+		log.Printf("OauthHandler.executeTwoFactor() error executing twoFactorTemplate: %v\n", err)
+	}
+}
+
+// redirectAuthorize redirects the logged in user to the authorization page of the client
+func (h *OauthHandler) redirectAuthorize(res http.ResponseWriter, req *http.Request, seshKey string) {
 	req.Method = http.MethodGet
 	values := url.Values{}
-	values.Add("sesh_key", sesh.ID.String())
+	values.Add("sesh_key", seshKey)
 	values.Add("client_id", req.URL.Query().Get("client_id"))
 	values.Add("redirect_uri", req.URL.Query().Get("redirect_uri"))
 	values.Add("state", req.URL.Query().Get("state"))
@@ -110,14 +177,13 @@ func (h *OauthHandler) Authorize(res http.ResponseWriter, req *http.Request) {
 
 	// get session key, validate and get user info
 	seshKey := strings.TrimSpace(req.URL.Query().Get("sesh_key"))
-	seshID, err := uuid.Parse(seshKey)
-	if err != nil {
-		fmt.Println("invalid session key: ", err)
+	if seshKey == "" {
+		fmt.Println("missing session key")
 		values.Add("error", "invalid_request")
 		http.Redirect(res, req, redirectURI+"?"+values.Encode(), http.StatusNotFound)
 		return
 	}
-	user, err := h.authController.Authorize(req.Context(), seshID)
+	user, _, err := h.authController.Authorize(req.Context(), seshKey)
 	if err != nil {
 		fmt.Println("couldn't not validate, redirecting to login page: ", err)
 		values.Add("error", "access_denied")
@@ -136,7 +202,7 @@ func (h *OauthHandler) Authorize(res http.ResponseWriter, req *http.Request) {
 	}
 
 	// add code to query params
-	values.Add("code", auth.EncodeKey(authCode.Code))
+	values.Add("code", authCode.Key)
 
 	// redirect
 	http.Redirect(res, req, redirectURI+"?"+values.Encode(), http.StatusSeeOther)
@@ -163,45 +229,42 @@ func (h *OauthHandler) Token(res http.ResponseWriter, req *http.Request) {
 	}
 
 	// ^^^^^^^^^^ client is authenticated after above ^^^^^^^^^^
-	var queryCode string
 	// find grant type: refresh token else authorization code
 	if err := req.ParseForm(); err != nil {
 		fmt.Println("OAuth.Token() error parsing form:", err)
 		sendTokenError(res, "server_error")
 		return
 	}
+	var token *db.AccessTokenRow
 	grantType := req.FormValue("grant_type")
 	switch grantType {
 	case "refresh_token":
 		refreshToken := req.FormValue("refresh_token")
-		accessToken, err := h.authController.ValidateRefreshToken(req.Context(), refreshToken)
+		token, err = h.authController.RefreshAccessToken(req.Context(), refreshToken)
 		if err != nil {
-			fmt.Println("OauthHandler.Token() couldn't find token based on refresh token: ", err)
+			fmt.Println("OauthHandler.Token() couldn't refresh token: ", err)
 			sendTokenError(res, "invalid_grant")
 			return
 		}
-		queryCode = auth.EncodeKey(accessToken.AuthCode)
 	case "authorization_code":
-		queryCode = req.FormValue("code")
+		// validate auth code
+		authCode, err := h.authController.ValidateAuthCode(req.Context(), req.FormValue("code"))
+		if err != nil {
+			fmt.Println("couldn't find auth code: ", err)
+			sendTokenError(res, "invalid_grant")
+			return
+		}
+		// create access token
+		token, err = h.authController.CreateAccessToken(req.Context(), authCode)
+		if err != nil {
+			fmt.Println("error oauth handler(Token), could not create access token:", err)
+			sendTokenError(res, "invalid_request")
+			return
+		}
 	default:
 		sendTokenError(res, "invalid_grant")
 		return
 	}
-
-	// validate auth code
-	authCode, err := h.authController.ValidateAuthCode(req.Context(), queryCode)
-	if err != nil {
-		fmt.Println("couldn't find auth code: ", err)
-		sendTokenError(res, "invalid_grant")
-		return
-	}
-	// create access token
-	token, err := h.authController.CreateAccessToken(req.Context(), authCode)
-	if err != nil {
-		fmt.Println("error oauth handler(Token), could not create access token:", err)
-		sendTokenError(res, "invalid_request")
-		return
-	}
 	// setup json
 	type tokenResponse struct {
 		AccessToken  string `json:"access_token"`
@@ -209,8 +272,8 @@ func (h *OauthHandler) Token(res http.ResponseWriter, req *http.Request) {
 		ExpiresIn    int    `json:"expires_in"`
 	}
 	tRes := &tokenResponse{
-		AccessToken:  auth.EncodeKey(token.Token),
-		RefreshToken: auth.EncodeKey(token.RefreshToken),
+		AccessToken:  token.Key,
+		RefreshToken: token.RefreshKey,
 		ExpiresIn:    3600,
 	}
 	// marshal data and send off
@@ -0,0 +1,97 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/sschwartz96/syncapod-backend/internal/podcast"
+)
+
+// ShareHandler serves the public pages of shared content, ie: /share/clip/{id}
+type ShareHandler struct {
+	pod *podcast.PodController
+}
+
+func CreateShareHandler(podCon *podcast.PodController) *ShareHandler {
+	return &ShareHandler{pod: podCon}
+}
+
+// SharedClip is the public metadata of a clip
+type SharedClip struct {
+	ID                string    `json:"id"`
+	Title             string    `json:"title"`
+	StartOffsetMillis int64     `json:"startOffsetMillis"`
+	EndOffsetMillis   int64     `json:"endOffsetMillis"`
+	Created           time.Time `json:"created"`
+	Episode           struct {
+		ID       string    `json:"id"`
+		Title    string    `json:"title"`
+		Summary  string    `json:"summary"`
+		PubDate  time.Time `json:"pubDate"`
+		AudioURL string    `json:"audioURL"`
+		ImageURL string    `json:"imageURL"`
+	} `json:"episode"`
+	Podcast struct {
+		ID       string `json:"id"`
+		Title    string `json:"title"`
+		Author   string `json:"author"`
+		ImageURL string `json:"imageURL"`
+	} `json:"podcast"`
+}
+
+func (h *ShareHandler) ServeHTTP(res http.ResponseWriter, req *http.Request) {
+	var head string
+	head, req.URL.Path = ShiftPath(req.URL.Path)
+
+	switch head {
+	case "clip":
+		h.Clip(res, req)
+	default:
+		http.NotFound(res, req)
+	}
+}
+
+// Clip responds with the clip's episode metadata and offsets, anyone with the id may view it
+func (h *ShareHandler) Clip(res http.ResponseWriter, req *http.Request) {
+	if req.Method != http.MethodGet {
+		http.Error(res, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	idString, _ := ShiftPath(req.URL.Path)
+	id, err := uuid.Parse(idString)
+	if err != nil {
+		http.NotFound(res, req)
+		return
+	}
+	clip, epi, pod, err := h.pod.GetSharedClip(req.Context(), id)
+	if err != nil {
+		http.NotFound(res, req)
+		return
+	}
+
+	shared := SharedClip{
+		ID:                clip.ID.String(),
+		Title:             clip.Title,
+		StartOffsetMillis: clip.StartMillis,
+		EndOffsetMillis:   clip.EndMillis,
+		Created:           clip.Created,
+	}
+	shared.Episode.ID = epi.ID.String()
+	shared.Episode.Title = epi.Title
+	shared.Episode.Summary = epi.Summary
+	shared.Episode.PubDate = epi.PubDate
+	shared.Episode.AudioURL = epi.EnclosureURL
+	shared.Episode.ImageURL = epi.ImageURL
+	if shared.Episode.ImageURL == "" {
+		shared.Episode.ImageURL = pod.ImageURL
+	}
+	shared.Podcast.ID = pod.ID.String()
+	shared.Podcast.Title = pod.Title
+	shared.Podcast.Author = pod.Author
+	shared.Podcast.ImageURL = pod.ImageURL
+
+	res.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(res).Encode(&shared)
+}
@@ -0,0 +1,124 @@
+package mail
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net"
+	"net/smtp"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Message is a plain text email
+type Message struct {
+	To      string
+	Subject string
+	Body    string
+}
+
+// Mailer sends emails to users
+type Mailer interface {
+	Send(ctx context.Context, msg *Message) error
+}
+
+// SMTPMailer sends emails through an SMTP server with PLAIN auth
+type SMTPMailer struct {
+	addr string
+	host string
+	from string
+	auth smtp.Auth
+}
+
+// NewSMTPMailer creates a mailer sending from the address through host:port,
+// username may be empty if the server does not require authentication
+func NewSMTPMailer(host string, port int, username, password, from string) *SMTPMailer {
+	m := &SMTPMailer{addr: net.JoinHostPort(host, strconv.Itoa(port)), host: host, from: from}
+	if username != "" {
+		m.auth = smtp.PlainAuth("", username, password, host)
+	}
+	return m
+}
+
+// Send sends the message, the context is not honored by net/smtp
+func (m *SMTPMailer) Send(ctx context.Context, msg *Message) error {
+	if err := validHeader(msg.To); err != nil {
+		return fmt.Errorf("SMTPMailer.Send() error: %v", err)
+	}
+	if err := validHeader(msg.Subject); err != nil {
+		return fmt.Errorf("SMTPMailer.Send() error: %v", err)
+	}
+	body := "From: " + m.from + "\r\n" +
+		"To: " + msg.To + "\r\n" +
+		"Subject: " + msg.Subject + "\r\n" +
+		"Date: " + time.Now().Format(time.RFC1123Z) + "\r\n" +
+		"MIME-Version: 1.0\r\n" +
+		"Content-Type: text/plain; charset=\"utf-8\"\r\n" +
+		"\r\n" +
+		strings.ReplaceAll(msg.Body, "\n", "\r\n")
+	if err := smtp.SendMail(m.addr, m.auth, m.from, []string{msg.To}, []byte(body)); err != nil {
+		return fmt.Errorf("SMTPMailer.Send() error: %v", err)
+	}
+	return nil
+}
+
+// validHeader prevents header injection through user supplied values
+func validHeader(v string) error {
+	if strings.ContainsAny(v, "\r\n") {
+		return fmt.Errorf("header contains a line break: %q", v)
+	}
+	return nil
+}
+
+// LogMailer drops the emails and only logs their recipient and subject,
+// for servers without an SMTP server. The body is not logged as it may contain tokens
+type LogMailer struct{}
+
+// Send logs the recipient and subject of the message
+func (LogMailer) Send(ctx context.Context, msg *Message) error {
+	log.Printf("LogMailer.Send() not sending email to %s: %s\n", msg.To, msg.Subject)
+	return nil
+}
+
+// MemoryMailer keeps the sent emails in memory, for tests
+type MemoryMailer struct {
+	mutex sync.Mutex
+	sent  []Message
+}
+
+// NewMemoryMailer creates a mailer without any sent emails
+func NewMemoryMailer() *MemoryMailer {
+	return &MemoryMailer{sent: []Message{}}
+}
+
+// Send keeps the message
+func (m *MemoryMailer) Send(ctx context.Context, msg *Message) error {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	m.sent = append(m.sent, *msg)
+	return nil
+}
+
+// Sent returns the messages sent so far in order
+func (m *MemoryMailer) Sent() []Message {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	sent := make([]Message, len(m.sent))
+	copy(sent, m.sent)
+	return sent
+}
+
+// Last returns the last message sent to the address, nil if none
+func (m *MemoryMailer) Last(to string) *Message {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	for i := len(m.sent) - 1; i >= 0; i-- {
+		if strings.EqualFold(m.sent[i].To, to) {
+			msg := m.sent[i]
+			return &msg
+		}
+	}
+	return nil
+}
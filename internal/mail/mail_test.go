@@ -0,0 +1,24 @@
+package mail
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestMemoryMailer(t *testing.T) {
+	m := NewMemoryMailer()
+	require.Nil(t, m.Last("user@syncapod.com"))
+	require.Nil(t, m.Send(context.Background(), &Message{To: "user@syncapod.com", Subject: "first"}))
+	require.Nil(t, m.Send(context.Background(), &Message{To: "other@syncapod.com", Subject: "other"}))
+	require.Nil(t, m.Send(context.Background(), &Message{To: "user@syncapod.com", Subject: "second"}))
+	require.Len(t, m.Sent(), 3)
+	require.Equal(t, "second", m.Last("User@syncapod.com").Subject)
+}
+
+func TestSMTPMailer_headerInjection(t *testing.T) {
+	m := NewSMTPMailer("localhost", 25, "", "", "noreply@syncapod.com")
+	err := m.Send(context.Background(), &Message{To: "user@syncapod.com\r\nBcc: victim@syncapod.com", Subject: "hi"})
+	require.NotNil(t, err)
+}
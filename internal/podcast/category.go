@@ -11,6 +11,9 @@ import (
 	"github.com/sschwartz96/syncapod-backend/internal/db"
 )
 
+// ErrUnknownCategory is returned for a category id which doesn't exist
+var ErrUnknownCategory = errors.New("unknown category id")
+
 type CategoryCache struct {
 	// index represents id
 	dbCats []db.Category
@@ -69,6 +72,46 @@ func (c *CategoryCache) LookupIDs(ids []int) ([]Category, error) {
 	return catSort(cats), nil
 }
 
+// Tree returns every known category as a tree of top level categories
+// with their subcategories nested within, the "nil" root is omitted
+func (c *CategoryCache) Tree() []Category {
+	c.mutex.RLock()
+	defer c.mutex.RUnlock()
+	return c.subcategories(0)
+}
+
+// subcategories recursively builds the categories under parentID
+// caller must hold the read lock
+func (c *CategoryCache) subcategories(parentID int) []Category {
+	cats := []Category{}
+	for i := range c.dbCats {
+		dbCat := c.dbCats[i]
+		if dbCat.ParentID != parentID || dbCat.ID == 0 {
+			continue
+		}
+		cats = append(cats, Category{ID: dbCat.ID, Name: dbCat.Name, Subcategories: c.subcategories(dbCat.ID)})
+	}
+	return catSort(cats)
+}
+
+// Descendants returns the category id along with the ids of all its subcategories
+func (c *CategoryCache) Descendants(id int) ([]int, error) {
+	c.mutex.RLock()
+	defer c.mutex.RUnlock()
+	if id <= 0 || id >= len(c.dbCats) {
+		return nil, fmt.Errorf("CategoryCache.Descendants() error: %w: %d", ErrUnknownCategory, id)
+	}
+	ids := []int{id}
+	for i := 0; i < len(ids); i++ {
+		for j := range c.dbCats {
+			if c.dbCats[j].ParentID == ids[i] && c.dbCats[j].ID != 0 {
+				ids = append(ids, c.dbCats[j].ID)
+			}
+		}
+	}
+	return ids, nil
+}
+
 // TranslateCategories recursively appends category ids into a slice of ids
 // Uses the codes maps held within the CategoryCache
 func (c *CategoryCache) TranslateCategories(cats []Category) ([]int, error) {
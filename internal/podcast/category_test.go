@@ -86,6 +86,53 @@ func TestCategoryController_LookupIDs(t *testing.T) {
 	}
 }
 
+func TestCategoryCache_Tree(t *testing.T) {
+	want := []Category{
+		{
+			ID:   1,
+			Name: "News",
+			Subcategories: []Category{
+				{2, "Tech News", []Category{}},
+				{3, "Sports News", []Category{}},
+			},
+		},
+		{4, "True Crime", []Category{}},
+		{
+			ID:   5,
+			Name: "Sports",
+			Subcategories: []Category{
+				{6, "Baseball", []Category{{7, "3rd Level", []Category{}}}},
+			},
+		},
+	}
+	require.Equal(t, want, catCache.Tree())
+}
+
+func TestCategoryCache_Descendants(t *testing.T) {
+	tests := []struct {
+		name    string
+		id      int
+		want    []int
+		wantErr bool
+	}{
+		{"news", 1, []int{1, 2, 3}, false},
+		{"sports", 5, []int{5, 6, 7}, false},
+		{"leaf", 4, []int{4}, false},
+		{"nil", 0, nil, true},
+		{"unknown", 100, nil, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := catCache.Descendants(tt.id)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("CategoryCache.Descendants() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
 func TestCategoryController_TranslateCategories(t *testing.T) {
 	tests := []struct {
 		name    string
@@ -0,0 +1,39 @@
+package podcast
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/sschwartz96/syncapod-backend/internal/db"
+)
+
+const (
+	// trendingWindow is how far back new subscribers & plays count towards trending
+	trendingWindow = time.Hour * 24 * 7
+	// chartSize is the maximum amount of podcasts within each chart
+	chartSize = 200
+)
+
+// UpdateCharts recomputes the popularity charts
+func (p *PodController) UpdateCharts(ctx context.Context) error {
+	err := p.RefreshCharts(ctx, trendingWindow, chartSize)
+	if err != nil {
+		return fmt.Errorf("PodController.UpdateCharts() error: %v", err)
+	}
+	return nil
+}
+
+// GetChart returns the podcasts of a chart within the category & language
+// category 0 and an empty language represent all categories and languages
+func (p *PodController) GetChart(ctx context.Context, chart db.ChartType, category int, language string, start, end int64) ([]db.Podcast, error) {
+	if end-start > chartSize {
+		end = start + chartSize
+	}
+	pods, err := p.FindChart(ctx, chart, category, strings.ToLower(language), start, end)
+	if err != nil {
+		return nil, fmt.Errorf("PodController.GetChart() error: %v", err)
+	}
+	return pods, nil
+}
@@ -0,0 +1,75 @@
+package podcast
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/sschwartz96/syncapod-backend/internal/db"
+)
+
+// Stats are the user's listening statistics within a period
+type Stats struct {
+	Totals      []db.ListeningTotal
+	TotalMillis int64
+	TopPodcasts []db.PodcastListening
+	Completion  db.Completion
+}
+
+// RecordListening updates the playback of a device which only reports where playback stopped,
+// the session since the previous update is appended to the user's history
+func (p *PodController) RecordListening(ctx context.Context, userEpi *db.UserEpisode, device string) (bool, error) {
+	prev, err := p.FindUserEpisode(ctx, userEpi.UserID, userEpi.EpisodeID)
+	if err != nil {
+		prev = nil
+	}
+	endOffset, ended := userEpi.OffsetMillis, userEpi.LastSeen
+	applied, err := p.UpdatePlayback(ctx, userEpi, false, false)
+	if err != nil {
+		return false, fmt.Errorf("PodController.RecordListening() error: %v", err)
+	}
+	if !applied || prev == nil || endOffset <= prev.OffsetMillis {
+		return applied, nil
+	}
+	// playback may have been stopped without an update, listening cannot take longer than the offset advanced
+	listened := time.Duration(endOffset-prev.OffsetMillis) * time.Millisecond
+	started := prev.LastSeen
+	if ended.Sub(started) > listened {
+		started = ended.Add(-listened)
+	}
+	err = p.InsertListeningSession(ctx, &db.ListeningSession{
+		UserID:            userEpi.UserID,
+		EpisodeID:         userEpi.EpisodeID,
+		StartOffsetMillis: prev.OffsetMillis,
+		EndOffsetMillis:   endOffset,
+		Started:           started,
+		Ended:             ended,
+		Device:            device,
+	})
+	if err != nil {
+		return applied, fmt.Errorf("PodController.RecordListening() error: %v", err)
+	}
+	return applied, nil
+}
+
+// GetStats returns the user's listening statistics of sessions started within [from,to)
+func (p *PodController) GetStats(ctx context.Context, userID uuid.UUID, bucket db.StatsBucket, from, to time.Time, timezone string, topPodcasts int) (*Stats, error) {
+	totals, err := p.FindListeningTotals(ctx, userID, bucket, from, to, timezone)
+	if err != nil {
+		return nil, fmt.Errorf("PodController.GetStats() error: %v", err)
+	}
+	top, err := p.FindTopListenedPodcasts(ctx, userID, from, to, topPodcasts)
+	if err != nil {
+		return nil, fmt.Errorf("PodController.GetStats() error: %v", err)
+	}
+	completion, err := p.FindCompletion(ctx, userID, from, to)
+	if err != nil {
+		return nil, fmt.Errorf("PodController.GetStats() error: %v", err)
+	}
+	stats := &Stats{Totals: totals, TopPodcasts: top, Completion: *completion}
+	for i := range totals {
+		stats.TotalMillis += totals[i].Millis
+	}
+	return stats, nil
+}
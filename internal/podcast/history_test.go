@@ -0,0 +1,72 @@
+package podcast
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/sschwartz96/syncapod-backend/internal/db"
+	"github.com/sschwartz96/syncapod-backend/internal/events"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_RecordListening(t *testing.T) {
+	ctx := context.Background()
+	podStore := db.NewPodcastStore(dbpg)
+	podCon, err := NewPodController(podStore)
+	if err != nil {
+		t.Fatalf("Test_RecordListening() error creating controller: %v", err)
+	}
+	user := &db.UserRow{ID: uuid.New(), Email: "history@test.test", Username: "historyUser", PasswordHash: []byte("shouldbehash")}
+	pod := &db.Podcast{ID: uuid.New(), Title: "History Test", Category: []int{}, RSSURL: "https://syncapod.com/history_test.rss"}
+	epi := &db.Episode{ID: uuid.New(), PodcastID: pod.ID, Title: "History Episode", PubDate: time.Now()}
+	if err = db.NewAuthStorePG(dbpg).InsertUser(ctx, user); err != nil {
+		t.Fatalf("Test_RecordListening() error inserting user: %v", err)
+	}
+	if err = podStore.InsertPodcast(ctx, pod); err != nil {
+		t.Fatalf("Test_RecordListening() error inserting podcast: %v", err)
+	}
+	if err = podStore.InsertEpisode(ctx, epi); err != nil {
+		t.Fatalf("Test_RecordListening() error inserting episode: %v", err)
+	}
+	sub, unsubscribe := podCon.Events().Subscribe(user.ID)
+	defer unsubscribe()
+
+	// started 10 minutes ago at 1 minute, stopped at 6 minutes
+	now := time.Now()
+	_, err = podCon.UpdatePlayback(ctx, &db.UserEpisode{UserID: user.ID, EpisodeID: epi.ID, OffsetMillis: 60000, LastSeen: now.Add(-10 * time.Minute)}, false, true)
+	if err != nil {
+		t.Fatalf("Test_RecordListening() error starting playback: %v", err)
+	}
+	require.Equal(t, events.Started, (<-sub).Kind)
+	applied, err := podCon.RecordListening(ctx, &db.UserEpisode{UserID: user.ID, EpisodeID: epi.ID, OffsetMillis: 360000, LastSeen: now}, "test")
+	if err != nil {
+		t.Fatalf("Test_RecordListening() error stopping playback: %v", err)
+	}
+	require.True(t, applied)
+	e := <-sub
+	require.Equal(t, events.Paused, e.Kind)
+	require.Equal(t, int64(360000), e.OffsetMillis)
+
+	history, err := podCon.FindHistory(ctx, user.ID, 0, 10)
+	if err != nil {
+		t.Fatalf("Test_RecordListening() error finding history: %v", err)
+	}
+	require.Len(t, history, 1)
+	require.Equal(t, int64(60000), history[0].StartOffsetMillis)
+	require.Equal(t, int64(360000), history[0].EndOffsetMillis)
+	require.Equal(t, "test", history[0].Device)
+	// the 5 minutes listened rather than the 10 minutes passed
+	require.WithinDuration(t, now.Add(-5*time.Minute), history[0].Started, time.Second)
+
+	stats, err := podCon.GetStats(ctx, user.ID, db.BucketDay, now.Add(-time.Hour), now.Add(time.Hour), "UTC", 10)
+	if err != nil {
+		t.Fatalf("Test_RecordListening() error getting stats: %v", err)
+	}
+	require.Equal(t, int64(300000), stats.TotalMillis)
+	require.NotEmpty(t, stats.Totals)
+	require.Len(t, stats.TopPodcasts, 1)
+	require.Equal(t, pod.ID, stats.TopPodcasts[0].Podcast.ID)
+	require.Equal(t, db.Completion{Started: 1, Completed: 0}, stats.Completion)
+}
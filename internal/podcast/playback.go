@@ -0,0 +1,134 @@
+package podcast
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v4"
+	"github.com/sschwartz96/syncapod-backend/internal/db"
+	"github.com/sschwartz96/syncapod-backend/internal/events"
+)
+
+// Events returns the broker of the users' playback events
+func (p *PodController) Events() events.Broker {
+	return p.events
+}
+
+// UpdatePlayback upserts the user episode and publishes the playback event to the user's
+// devices if applied, playing distinguishes a started episode from a paused one.
+// Returns ErrUnknownEpisode if the episode doesn't exist
+func (p *PodController) UpdatePlayback(ctx context.Context, userEpi *db.UserEpisode, resetPlayed, playing bool) (bool, error) {
+	missing, err := p.FindMissingEpisodes(ctx, []uuid.UUID{userEpi.EpisodeID})
+	if err != nil {
+		return false, fmt.Errorf("PodController.UpdatePlayback() error: %v", err)
+	}
+	if len(missing) > 0 {
+		return false, fmt.Errorf("PodController.UpdatePlayback() error: %w: %v", ErrUnknownEpisode, userEpi.EpisodeID)
+	}
+	// the event reflects the update rather than the stored state
+	finished := userEpi.Played
+	applied, err := p.UpsertUserEpisode(ctx, userEpi, resetPlayed)
+	if err != nil {
+		return false, fmt.Errorf("PodController.UpdatePlayback() error: %v", err)
+	}
+	if !applied {
+		return false, nil
+	}
+	kind := events.Paused
+	if finished {
+		kind = events.Finished
+	} else if playing {
+		kind = events.Started
+	}
+	p.events.Publish(userEpi.UserID, events.Event{
+		Kind:         kind,
+		EpisodeID:    userEpi.EpisodeID.String(),
+		OffsetMillis: userEpi.OffsetMillis,
+		Time:         userEpi.LastSeen,
+	})
+	return true, nil
+}
+
+// ErrUnknownPodcast is returned for podcast ids which do not belong to any podcast
+var ErrUnknownPodcast = errors.New("unknown podcast id")
+
+// UpdatePlaybacks upserts the user episodes per db.UpsertUserEpisodes() and notifies the user's devices
+// once if any update was applied, returns ErrUnknownEpisode before updating if an episode doesn't exist
+func (p *PodController) UpdatePlaybacks(ctx context.Context, userID uuid.UUID, userEpis []db.UserEpisode, resetPlayed []bool) ([]bool, error) {
+	epiIDs := make([]uuid.UUID, len(userEpis))
+	for i := range userEpis {
+		epiIDs[i] = userEpis[i].EpisodeID
+	}
+	missing, err := p.FindMissingEpisodes(ctx, epiIDs)
+	if err != nil {
+		return nil, fmt.Errorf("PodController.UpdatePlaybacks() error: %v", err)
+	}
+	if len(missing) > 0 {
+		return nil, fmt.Errorf("PodController.UpdatePlaybacks() error: %w: %v", ErrUnknownEpisode, missing[0])
+	}
+	applied, err := p.UpsertUserEpisodes(ctx, userID, userEpis, resetPlayed)
+	if err != nil {
+		return nil, fmt.Errorf("PodController.UpdatePlaybacks() error: %v", err)
+	}
+	for _, a := range applied {
+		if a {
+			p.publishPlaybackChanged(userID, uuid.Nil)
+			break
+		}
+	}
+	return applied, nil
+}
+
+// MarkPlayed marks the podcast's episodes played per db.MarkPodcastPlayed() and notifies the user's
+// devices if any episode was updated, returns ErrUnknownPodcast if the podcast doesn't exist
+func (p *PodController) MarkPlayed(ctx context.Context, userID, podID uuid.UUID, before *time.Time) (int64, error) {
+	_, err := p.FindPodcastByID(ctx, podID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return 0, fmt.Errorf("PodController.MarkPlayed() error: %w: %v", ErrUnknownPodcast, podID)
+	}
+	if err != nil {
+		return 0, fmt.Errorf("PodController.MarkPlayed() error: %v", err)
+	}
+	updated, err := p.MarkPodcastPlayed(ctx, userID, podID, before, time.Now())
+	if err != nil {
+		return 0, fmt.Errorf("PodController.MarkPlayed() error: %v", err)
+	}
+	if updated > 0 {
+		p.publishPlaybackChanged(userID, uuid.Nil)
+	}
+	return updated, nil
+}
+
+// MarkUnplayed resets the user's playback of the episode and notifies the user's devices,
+// returns ErrUnknownEpisode if the episode doesn't exist
+func (p *PodController) MarkUnplayed(ctx context.Context, userID, epiID uuid.UUID) error {
+	missing, err := p.FindMissingEpisodes(ctx, []uuid.UUID{epiID})
+	if err != nil {
+		return fmt.Errorf("PodController.MarkUnplayed() error: %v", err)
+	}
+	if len(missing) > 0 {
+		return fmt.Errorf("PodController.MarkUnplayed() error: %w: %v", ErrUnknownEpisode, epiID)
+	}
+	if err = p.MarkEpisodeUnplayed(ctx, userID, epiID, time.Now()); err != nil {
+		return fmt.Errorf("PodController.MarkUnplayed() error: %v", err)
+	}
+	p.publishPlaybackChanged(userID, epiID)
+	return nil
+}
+
+// publishPlaybackChanged notifies the user's devices of a bulk change, of every episode if epiID is uuid.Nil
+func (p *PodController) publishPlaybackChanged(userID, epiID uuid.UUID) {
+	e := events.Event{Kind: events.PlaybackChanged, Time: time.Now()}
+	if epiID != uuid.Nil {
+		e.EpisodeID = epiID.String()
+	}
+	p.events.Publish(userID, e)
+}
+
+// PublishQueueChanged notifies the user's devices that their queue changed
+func (p *PodController) PublishQueueChanged(userID uuid.UUID) {
+	p.events.Publish(userID, events.Event{Kind: events.QueueChanged, Time: time.Now()})
+}
@@ -0,0 +1,70 @@
+package podcast
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/sschwartz96/syncapod-backend/internal/db"
+)
+
+// ErrUnknownEpisode is returned for episode ids which do not belong to any episode
+var ErrUnknownEpisode = errors.New("unknown episode id")
+
+// ValidatePlaylist checks the episodes and the rule categories of the playlist exist
+func (p *PodController) ValidatePlaylist(ctx context.Context, playlist *db.Playlist) error {
+	if playlist.Rules != nil {
+		for _, id := range playlist.Rules.Categories {
+			if _, err := p.catCache.Descendants(id); err != nil {
+				return fmt.Errorf("PodController.ValidatePlaylist() error: %w", err)
+			}
+		}
+	}
+	if len(playlist.EpisodeIDs) == 0 {
+		return nil
+	}
+	missing, err := p.FindMissingEpisodes(ctx, playlist.EpisodeIDs)
+	if err != nil {
+		return fmt.Errorf("PodController.ValidatePlaylist() error: %v", err)
+	}
+	if len(missing) > 0 {
+		return fmt.Errorf("PodController.ValidatePlaylist() error: %w: %v", ErrUnknownEpisode, missing[0])
+	}
+	return nil
+}
+
+// GetPlaylistEpisodes returns the episodes of the user's playlist, the rules of a smart
+// playlist are evaluated on request and include the subcategories of its categories
+func (p *PodController) GetPlaylistEpisodes(ctx context.Context, userID, playlistID uuid.UUID, start, end int64) ([]db.Episode, error) {
+	playlist, err := p.FindPlaylist(ctx, userID, playlistID)
+	if err != nil {
+		return nil, fmt.Errorf("PodController.GetPlaylistEpisodes() error: %v", err)
+	}
+	if !playlist.Smart {
+		epis, err := p.FindPlaylistEpisodes(ctx, playlist.ID, start, end)
+		if err != nil {
+			return nil, fmt.Errorf("PodController.GetPlaylistEpisodes() error: %v", err)
+		}
+		return epis, nil
+	}
+
+	rules := db.PlaylistRules{}
+	if playlist.Rules != nil {
+		rules = *playlist.Rules
+	}
+	cats := rules.Categories
+	rules.Categories = []int{}
+	for _, id := range cats {
+		ids, err := p.catCache.Descendants(id)
+		if err != nil {
+			return nil, fmt.Errorf("PodController.GetPlaylistEpisodes() error: %v", err)
+		}
+		rules.Categories = append(rules.Categories, ids...)
+	}
+	epis, err := p.FindSmartPlaylistEpisodes(ctx, userID, &rules, start, end)
+	if err != nil {
+		return nil, fmt.Errorf("PodController.GetPlaylistEpisodes() error: %v", err)
+	}
+	return epis, nil
+}
@@ -6,11 +6,13 @@ import (
 
 	"github.com/google/uuid"
 	"github.com/sschwartz96/syncapod-backend/internal/db"
+	"github.com/sschwartz96/syncapod-backend/internal/events"
 )
 
 type PodController struct {
 	*db.PodcastStore
 	catCache *CategoryCache
+	events   events.Broker
 }
 
 func NewPodController(podStore *db.PodcastStore) (*PodController, error) {
@@ -19,13 +21,31 @@ func NewPodController(podStore *db.PodcastStore) (*PodController, error) {
 		return nil, fmt.Errorf("NewPodController() error creating CategoryCache: %v", err)
 	}
 	catCache := newCategoryCache(cats, podStore)
-	return &PodController{podStore, catCache}, nil
+	return &PodController{podStore, catCache, events.NewMemoryBroker()}, nil
 }
 
 func (p *PodController) ConvertCategories(ids []int) ([]Category, error) {
 	return p.catCache.LookupIDs(ids)
 }
 
+// ListCategories returns the full category tree
+func (p *PodController) ListCategories() []Category {
+	return p.catCache.Tree()
+}
+
+// BrowseCategory returns the podcasts within the category or any of its subcategories
+func (p *PodController) BrowseCategory(ctx context.Context, catID int, sortBy db.PodcastSort, start, end int64) ([]db.Podcast, error) {
+	ids, err := p.catCache.Descendants(catID)
+	if err != nil {
+		return nil, fmt.Errorf("PodController.BrowseCategory() error: %w", err)
+	}
+	pods, err := p.FindPodcastsByCategories(ctx, ids, sortBy, start, end)
+	if err != nil {
+		return nil, fmt.Errorf("PodController.BrowseCategory() error: %v", err)
+	}
+	return pods, nil
+}
+
 func (c *PodController) DoesPodcastExist(ctx context.Context, rssURL string) bool {
 	_, err := c.FindPodcastByRSS(ctx, rssURL)
 	return err == nil
@@ -0,0 +1,45 @@
+package podcast
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/sschwartz96/syncapod-backend/internal/db"
+)
+
+const (
+	// recommendationSize is the maximum amount of podcasts kept per podcast & user
+	recommendationSize = 50
+	// subscriptionWeight is the weight of shared subscribers over shared categories
+	subscriptionWeight = 0.8
+	// categoryCandidates is the amount of most subscribed podcasts of a subcategory
+	// other podcasts of the subcategory are compared with
+	categoryCandidates = 200
+)
+
+// UpdateRecommendations recomputes the similar podcasts & user recommendations
+func (p *PodController) UpdateRecommendations(ctx context.Context) error {
+	err := p.RefreshRecommendations(ctx, recommendationSize, subscriptionWeight, categoryCandidates)
+	if err != nil {
+		return fmt.Errorf("PodController.UpdateRecommendations() error: %v", err)
+	}
+	return nil
+}
+
+// GetRecommendations returns the podcasts recommended to the user, users
+// without any recommendations (no subscriptions yet) get the top chart instead,
+// without the podcasts they already subscribe to
+func (p *PodController) GetRecommendations(ctx context.Context, userID uuid.UUID, start, end int64) ([]db.Podcast, error) {
+	pods, err := p.FindRecommendations(ctx, userID, start, end)
+	if err != nil {
+		return nil, fmt.Errorf("PodController.GetRecommendations() error: %v", err)
+	}
+	if len(pods) == 0 && start == 0 {
+		pods, err = p.FindUserChart(ctx, db.ChartTop, userID, start, end)
+		if err != nil {
+			return nil, fmt.Errorf("PodController.GetRecommendations() error falling back to chart: %v", err)
+		}
+	}
+	return pods, nil
+}
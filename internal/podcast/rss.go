@@ -93,6 +93,10 @@ func (c *RSSController) updatePodcast(pod *db.Podcast) error {
 			if err != nil {
 				return fmt.Errorf("updatePodcast() error upserting episode: %v", err)
 			}
+			err = c.podController.QueueNewEpisode(context.Background(), pod.ID, epi.ID)
+			if err != nil {
+				log.Println("updatePodcast() error queueing new episode:", err)
+			}
 		}
 	}
 	return nil
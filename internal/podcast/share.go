@@ -0,0 +1,31 @@
+package podcast
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/sschwartz96/syncapod-backend/internal/db"
+)
+
+// ClipSharePath returns the public path the clip is shared at, see handler.Handler
+func ClipSharePath(id uuid.UUID) string {
+	return "/share/clip/" + id.String()
+}
+
+// GetSharedClip returns the clip along with its episode and podcast
+func (p *PodController) GetSharedClip(ctx context.Context, id uuid.UUID) (*db.Clip, *db.Episode, *db.Podcast, error) {
+	clip, err := p.FindClip(ctx, id)
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("PodController.GetSharedClip() error: %v", err)
+	}
+	epi, err := p.FindEpisodeByID(ctx, clip.EpisodeID)
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("PodController.GetSharedClip() error: %v", err)
+	}
+	pod, err := p.FindPodcastByID(ctx, epi.PodcastID)
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("PodController.GetSharedClip() error: %v", err)
+	}
+	return clip, epi, pod, nil
+}
@@ -0,0 +1,208 @@
+package podcast
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/sschwartz96/syncapod-backend/internal/db"
+)
+
+// SyncChanges is the user's state that changed since a sync, Seq is the position
+// of the ChangeLog to sync from next and Full is set when the entire state is returned
+type SyncChanges struct {
+	Seq               int64
+	Full              bool
+	Subscriptions     []db.Subscription
+	RemovedPodcastIDs []uuid.UUID
+	UserEpisodes      []db.UserEpisode
+	QueueChanged      bool
+	Queue             []db.Episode
+	NewEpisodes       []db.Episode
+}
+
+// Sync returns the user's changes since the position seq, everything if seq is 0
+// or the changes since have been pruned
+func (p *PodController) Sync(ctx context.Context, userID uuid.UUID, seq int64) (*SyncChanges, error) {
+	if seq <= 0 {
+		return p.fullSync(ctx, userID)
+	}
+	position, err := p.FindChangePosition(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("PodController.Sync() error: %v", err)
+	}
+	changes, err := p.FindChanges(ctx, userID, seq, position)
+	if errors.Is(err, db.ErrChangesExpired) {
+		return p.fullSync(ctx, userID)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("PodController.Sync() error: %v", err)
+	}
+	res := &SyncChanges{Seq: position, Subscriptions: []db.Subscription{}, RemovedPodcastIDs: []uuid.UUID{},
+		UserEpisodes: []db.UserEpisode{}, Queue: []db.Episode{}, NewEpisodes: []db.Episode{}}
+	if len(changes) == 0 {
+		return res, nil
+	}
+
+	podIDs := map[uuid.UUID]bool{}
+	subChanged := map[uuid.UUID]bool{}
+	epiIDs := []uuid.UUID{}
+	newEpiIDs := []uuid.UUID{}
+	for _, c := range changes {
+		if c.PodcastID != uuid.Nil {
+			podIDs[c.PodcastID] = true
+		}
+		switch c.Kind {
+		case db.ChangeSubscribed, db.ChangeUnsubscribed:
+			subChanged[c.PodcastID] = true
+		case db.ChangeUserEpisode:
+			epiIDs = append(epiIDs, c.EpisodeID)
+		case db.ChangeQueue:
+			res.QueueChanged = true
+		case db.ChangeNewEpisode:
+			newEpiIDs = append(newEpiIDs, c.EpisodeID)
+		}
+	}
+
+	if len(podIDs) > 0 {
+		subs, err := p.FindSubscriptions(ctx, userID)
+		if err != nil {
+			return nil, fmt.Errorf("PodController.Sync() error: %v", err)
+		}
+		for i := range subs {
+			if podIDs[subs[i].PodcastID] {
+				res.Subscriptions = append(res.Subscriptions, subs[i])
+				delete(subChanged, subs[i].PodcastID)
+			}
+		}
+		// what is left changed but is no longer subscribed
+		for podID := range subChanged {
+			res.RemovedPodcastIDs = append(res.RemovedPodcastIDs, podID)
+		}
+	}
+	if len(epiIDs) > 0 {
+		if res.UserEpisodes, err = p.FindUserEpisodes(ctx, userID, epiIDs); err != nil {
+			return nil, fmt.Errorf("PodController.Sync() error: %v", err)
+		}
+	}
+	if len(newEpiIDs) > 0 {
+		if res.NewEpisodes, err = p.FindEpisodesByIDs(ctx, newEpiIDs); err != nil {
+			return nil, fmt.Errorf("PodController.Sync() error: %v", err)
+		}
+	}
+	if res.QueueChanged {
+		if res.Queue, err = p.FindQueue(ctx, userID); err != nil {
+			return nil, fmt.Errorf("PodController.Sync() error: %v", err)
+		}
+	}
+	return res, nil
+}
+
+// fullSync returns the user's entire state, the position is read first so
+// changes made while reading are returned again by the next sync
+func (p *PodController) fullSync(ctx context.Context, userID uuid.UUID) (*SyncChanges, error) {
+	seq, err := p.FindChangePosition(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("PodController.fullSync() error: %v", err)
+	}
+	res := &SyncChanges{Seq: seq, Full: true, QueueChanged: true, RemovedPodcastIDs: []uuid.UUID{}, NewEpisodes: []db.Episode{}}
+	if res.Subscriptions, err = p.FindSubscriptions(ctx, userID); err != nil {
+		return nil, fmt.Errorf("PodController.fullSync() error: %v", err)
+	}
+	if res.UserEpisodes, err = p.FindUserEpisodes(ctx, userID, nil); err != nil {
+		return nil, fmt.Errorf("PodController.fullSync() error: %v", err)
+	}
+	if res.Queue, err = p.FindQueue(ctx, userID); err != nil {
+		return nil, fmt.Errorf("PodController.fullSync() error: %v", err)
+	}
+	return res, nil
+}
+
+// SubscriptionChanges returns the podcasts the user subscribed to and unsubscribed from since the
+// position seq, every subscribed podcast if seq is 0 or expired, along with the current position
+func (p *PodController) SubscriptionChanges(ctx context.Context, userID uuid.UUID, seq int64) ([]db.Podcast, []db.Podcast, int64, error) {
+	latest, err := p.FindChangePosition(ctx)
+	if err != nil {
+		return nil, nil, 0, fmt.Errorf("PodController.SubscriptionChanges() error: %v", err)
+	}
+	subs, err := p.FindSubscriptions(ctx, userID)
+	if err != nil {
+		return nil, nil, 0, fmt.Errorf("PodController.SubscriptionChanges() error: %v", err)
+	}
+	subscribed := make(map[uuid.UUID]bool, len(subs))
+	for i := range subs {
+		subscribed[subs[i].PodcastID] = true
+	}
+
+	changes, err := p.findChangesSince(ctx, userID, seq, latest)
+	if err != nil {
+		return nil, nil, 0, fmt.Errorf("PodController.SubscriptionChanges() error: %v", err)
+	}
+	changed := subscribed
+	if changes != nil {
+		changed = map[uuid.UUID]bool{}
+		for _, c := range changes {
+			if c.Kind == db.ChangeSubscribed || c.Kind == db.ChangeUnsubscribed {
+				changed[c.PodcastID] = true
+			}
+		}
+	}
+	added, removed := []db.Podcast{}, []db.Podcast{}
+	for podID := range changed {
+		pod, err := p.FindPodcastByID(ctx, podID)
+		if err != nil {
+			// the podcast no longer exists
+			continue
+		}
+		if subscribed[podID] {
+			added = append(added, *pod)
+		} else {
+			removed = append(removed, *pod)
+		}
+	}
+	return added, removed, latest, nil
+}
+
+// UserEpisodeChanges returns the user's playback state of the episodes changed since the position seq,
+// of every episode if seq is 0 or expired, along with the current position
+func (p *PodController) UserEpisodeChanges(ctx context.Context, userID uuid.UUID, seq int64) ([]db.UserEpisode, int64, error) {
+	latest, err := p.FindChangePosition(ctx)
+	if err != nil {
+		return nil, 0, fmt.Errorf("PodController.UserEpisodeChanges() error: %v", err)
+	}
+	changes, err := p.findChangesSince(ctx, userID, seq, latest)
+	if err != nil {
+		return nil, 0, fmt.Errorf("PodController.UserEpisodeChanges() error: %v", err)
+	}
+	var epiIDs []uuid.UUID
+	if changes != nil {
+		epiIDs = []uuid.UUID{}
+		for _, c := range changes {
+			if c.Kind == db.ChangeUserEpisode {
+				epiIDs = append(epiIDs, c.EpisodeID)
+			}
+		}
+		if len(epiIDs) == 0 {
+			return []db.UserEpisode{}, latest, nil
+		}
+	}
+	userEpis, err := p.FindUserEpisodes(ctx, userID, epiIDs)
+	if err != nil {
+		return nil, 0, fmt.Errorf("PodController.UserEpisodeChanges() error: %v", err)
+	}
+	return userEpis, latest, nil
+}
+
+// findChangesSince returns the user's changes since the position seq up to until,
+// nil if seq is 0 or the changes since have been pruned
+func (p *PodController) findChangesSince(ctx context.Context, userID uuid.UUID, seq, until int64) ([]db.Change, error) {
+	if seq <= 0 {
+		return nil, nil
+	}
+	changes, err := p.FindChanges(ctx, userID, seq, until)
+	if errors.Is(err, db.ErrChangesExpired) {
+		return nil, nil
+	}
+	return changes, err
+}
@@ -5,21 +5,25 @@ import (
 	"log"
 	"net/http"
 
+	"github.com/sschwartz96/syncapod-backend/internal/auth"
 	protos "github.com/sschwartz96/syncapod-backend/internal/gen"
 	"github.com/sschwartz96/syncapod-backend/internal/podcast"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 type AdminService struct {
-	podCon *podcast.PodController
-	rssCon *podcast.RSSController
+	podCon  *podcast.PodController
+	rssCon  *podcast.RSSController
+	janitor *auth.Janitor
 }
 
-func NewAdminService(podCon *podcast.PodController, rssCon *podcast.RSSController) *AdminService {
+func NewAdminService(podCon *podcast.PodController, rssCon *podcast.RSSController, janitor *auth.Janitor) *AdminService {
 	return &AdminService{
-		podCon: podCon,
-		rssCon: rssCon,
+		podCon:  podCon,
+		rssCon:  rssCon,
+		janitor: janitor,
 	}
 }
 
@@ -49,3 +53,16 @@ func (a *AdminService) RefreshPodcast(ctx context.Context, req *protos.RefPodReq
 	}
 	return &protos.RefPodRes{}, nil
 }
+
+// Maintenance
+
+// GetJanitorStats returns the totals of the expired rows purged by the janitor
+func (a *AdminService) GetJanitorStats(ctx context.Context, req *protos.GetJanitorStatsReq) (*protos.GetJanitorStatsRes, error) {
+	stats := a.janitor.Stats()
+	return &protos.GetJanitorStatsRes{
+		Runs:    stats.Runs,
+		Errors:  stats.Errors,
+		LastRun: timestamppb.New(stats.LastRun),
+		Purged:  stats.Purged,
+	}, nil
+}
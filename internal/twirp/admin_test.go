@@ -18,14 +18,21 @@ import (
 var (
 	goTimeRSSURL = "https://changelog.com/gotime/feed"
 
-	testSeshAdmin = &db.SessionRow{ID: uuid.New(), UserID: testUser.ID, LoginTime: time.Now(), LastSeenTime: time.Now(), Expires: time.Now().Add(time.Hour), UserAgent: "testUserAgent"}
+	testAdmin     = &db.UserRow{ID: uuid.New(), Email: "admin@twirp.test", Username: "admin_twirp_test", PasswordHash: []byte("shouldbehash")}
+	testSeshAdmin = &db.SessionRow{ID: uuid.New(), UserID: testAdmin.ID, LoginTime: time.Now(), LastSeenTime: time.Now(), Expires: time.Now().Add(time.Hour), UserAgent: "testUserAgent", Key: "testAdminSessionKey", KeyHash: sessionKeyHash("testAdminSessionKey")}
 )
 
 func setupAdmin() error {
 	var err error
-
-	// insert user session to mimic user already authenticated
 	authStore := db.NewAuthStorePG(dbpg)
+	if err = authStore.InsertUser(context.Background(), testAdmin); err != nil {
+		return fmt.Errorf("failed to insert admin: %v", err)
+	}
+	if err = authStore.SetUserRole(context.Background(), testAdmin.ID, db.RoleAdmin); err != nil {
+		return fmt.Errorf("failed to set admin role: %v", err)
+	}
+
+	// insert admin session to mimic admin already authenticated
 	if err = authStore.InsertSession(context.Background(), testSeshAdmin); err != nil {
 		return fmt.Errorf("failed to insert user session: %v", err)
 	}
@@ -35,7 +42,7 @@ func setupAdmin() error {
 func Test_AdminGRPC(t *testing.T) {
 	// add metadata for authorization
 	header := make(http.Header)
-	header.Set(authTokenKey, testSesh.ID.String())
+	header.Set(authTokenKey, testSeshAdmin.Key)
 
 	ctx, err := twirp.WithHTTPRequestHeaders(context.Background(), header)
 	if err != nil {
@@ -44,6 +51,20 @@ func Test_AdminGRPC(t *testing.T) {
 
 	client := protos.NewAdminJSONClient("http://localhost:8081", http.DefaultClient, twirp.WithClientPathPrefix("/rpc/admin"))
 
+	// users without the role are denied
+	userHeader := make(http.Header)
+	userHeader.Set(authTokenKey, testSesh.Key)
+	userCtx, err := twirp.WithHTTPRequestHeaders(context.Background(), userHeader)
+	if err != nil {
+		t.Fatalf("Twirp could not add add headers: %v", err)
+	}
+	_, err = client.RefreshPodcast(userCtx, &protos.RefPodReq{})
+	require.NotNil(t, err)
+	require.Equal(t, twirp.PermissionDenied, err.(twirp.Error).Code())
+	_, err = client.GetJanitorStats(userCtx, &protos.GetJanitorStatsReq{})
+	require.NotNil(t, err)
+	require.Equal(t, twirp.PermissionDenied, err.(twirp.Error).Code())
+
 	// AddPodcast
 	addPodRes, err := client.AddPodcast(ctx, &protos.AddPodReq{Url: goTimeRSSURL})
 	require.Nil(t, err, "error AddPodcast()")
@@ -52,4 +73,14 @@ func Test_AdminGRPC(t *testing.T) {
 	// RefreshPodcast
 	_, err = client.RefreshPodcast(ctx, &protos.RefPodReq{})
 	require.Nil(t, err, "error RefreshPodcast()")
+
+	// GetJanitorStats
+	expired := &db.SessionRow{ID: uuid.New(), UserID: testUser.ID, LoginTime: time.Now(), LastSeenTime: time.Now(), Expires: time.Now().Add(-time.Hour), KeyHash: sessionKeyHash("expiredSessionKey")}
+	require.Nil(t, db.NewAuthStorePG(dbpg).InsertSession(context.Background(), expired))
+	_, err = testJanitor.Run(context.Background())
+	require.Nil(t, err)
+	statsRes, err := client.GetJanitorStats(ctx, &protos.GetJanitorStatsReq{})
+	require.Nil(t, err, "error GetJanitorStats()")
+	require.Equal(t, int64(1), statsRes.Runs)
+	require.GreaterOrEqual(t, statsRes.Purged["Sessions"], int64(1))
 }
@@ -2,35 +2,355 @@ package twirp
 
 import (
 	"context"
+	"errors"
+	"math"
+	"strconv"
 
-	"github.com/google/uuid"
 	"github.com/sschwartz96/syncapod-backend/internal/auth"
+	"github.com/sschwartz96/syncapod-backend/internal/db"
 	protos "github.com/sschwartz96/syncapod-backend/internal/gen"
 	"github.com/twitchtv/twirp"
 )
 
 // AuthService is the twirp service for authentication and authorization
 type AuthService struct {
-	ac *auth.AuthController
+	ac          *auth.AuthController
+	clientNames map[string]string
 }
 
-// NewAuthService creates a new *AuthService
-func NewAuthService(a *auth.AuthController) *AuthService {
-	return &AuthService{ac: a}
+// NewAuthService creates a new *AuthService, clientNames are the display names
+// of the oauth clients keyed by client id
+func NewAuthService(a *auth.AuthController, clientNames map[string]string) *AuthService {
+	return &AuthService{ac: a, clientNames: clientNames}
 }
 
-// Authenticate handles the authentication to syncapod and returns response
+// Authenticate handles the authentication to syncapod and returns response,
+// throttled attempts fail with ResourceExhausted and the seconds to wait in the "retry_after" meta.
+// Accounts with two factor enabled get a challenge to complete with VerifyTwoFactor instead of a session
 func (a *AuthService) Authenticate(ctx context.Context, req *protos.AuthenticateReq) (*protos.AuthenticateRes, error) {
-	userRow, seshRow, err := a.ac.Login(ctx, req.Username, req.Password, req.UserAgent)
+	userRow, seshRow, err := a.ac.Login(ctx, req.Username, req.Password, req.UserAgent, getRemoteIPFromContext(ctx))
+	var twoFactor *auth.TwoFactorRequiredError
+	if errors.As(err, &twoFactor) {
+		return &protos.AuthenticateRes{TwoFactorRequired: true, TwoFactorChallenge: twoFactor.Challenge}, nil
+	}
+	if twerr := throttledError(err); twerr != nil {
+		return nil, twerr
+	}
 	if err != nil {
 		return nil, twirp.InvalidArgument.Errorf("Error on login: %w", err)
 	}
 	return &protos.AuthenticateRes{
-		SessionKey: seshRow.ID.String(),
+		SessionKey: seshRow.Key,
 		User:       convertUserFromDB(userRow),
 	}, nil
 }
 
+// VerifyTwoFactor completes the challenge of Authenticate with a TOTP or recovery code and returns the session
+func (a *AuthService) VerifyTwoFactor(ctx context.Context, req *protos.VerifyTwoFactorReq) (*protos.VerifyTwoFactorRes, error) {
+	userRow, seshRow, err := a.ac.VerifyTwoFactor(ctx, req.Challenge, req.Code, req.UserAgent, getRemoteIPFromContext(ctx))
+	if twerr := throttledError(err); twerr != nil {
+		return nil, twerr
+	}
+	if err != nil {
+		return nil, accountError(err, "Could not verify two factor")
+	}
+	return &protos.VerifyTwoFactorRes{
+		SessionKey: seshRow.Key,
+		User:       convertUserFromDB(userRow),
+	}, nil
+}
+
+// throttledError converts a *auth.ThrottledError to ResourceExhausted with the seconds
+// to wait in the "retry_after" meta, returns nil for any other error
+func throttledError(err error) error {
+	var throttled *auth.ThrottledError
+	if !errors.As(err, &throttled) {
+		return nil
+	}
+	return twirp.ResourceExhausted.Error(throttled.Error()).
+		WithMeta("retry_after", strconv.Itoa(int(math.Ceil(throttled.RetryAfter.Seconds()))))
+}
+
+// Register creates a new user, invalid details and taken emails or usernames
+// are distinguished by the code and the "argument" meta of the error
+func (a *AuthService) Register(ctx context.Context, req *protos.RegisterReq) (*protos.RegisterRes, error) {
+	if req.Birthdate == nil {
+		return nil, twirp.InvalidArgument.Error("Birthdate is required").WithMeta("argument", "birthdate")
+	}
+	userRow, err := a.ac.Register(ctx, req.Email, req.Username, req.Password, req.Birthdate.AsTime())
+	if err != nil {
+		return nil, accountError(err, "Could not register user")
+	}
+	return &protos.RegisterRes{User: convertUserFromDB(userRow)}, nil
+}
+
+// accountArguments are the request fields at fault for the errors of the account methods
+var accountArguments = map[error]struct {
+	code     twirp.ErrorCode
+	argument string
+}{
+	auth.ErrInvalidEmail:      {twirp.InvalidArgument, "email"},
+	auth.ErrInvalidUsername:   {twirp.InvalidArgument, "username"},
+	auth.ErrWeakPassword:      {twirp.InvalidArgument, "password"},
+	auth.ErrIncorrectPassword: {twirp.InvalidArgument, "password"},
+	auth.ErrTooYoung:          {twirp.InvalidArgument, "birthdate"},
+	auth.ErrInvalidToken:      {twirp.InvalidArgument, "token"},
+	auth.ErrIncorrectCode:     {twirp.InvalidArgument, "code"},
+	auth.ErrInvalidChallenge:  {twirp.InvalidArgument, "challenge"},
+	auth.ErrEmailTaken:        {twirp.AlreadyExists, "email"},
+	auth.ErrUsernameTaken:     {twirp.AlreadyExists, "username"},
+}
+
+// accountError converts the errors of the account methods, distinguished by the code
+// and the "argument" meta, any other error is internal
+func accountError(err error, msg string) error {
+	if throttled := throttledError(err); throttled != nil {
+		return throttled
+	}
+	if arg, ok := accountArguments[err]; ok {
+		return twirp.NewError(arg.code, err.Error()).WithMeta("argument", arg.argument)
+	}
+	return twirp.Internal.Errorf("%s: %w", msg, err)
+}
+
+// SendVerificationEmail emails the authenticated user a new verification link
+func (a *AuthService) SendVerificationEmail(ctx context.Context, req *protos.SendVerificationEmailReq) (*protos.SendVerificationEmailRes, error) {
+	userID, err := getUserIDFromContext(ctx)
+	if err != nil {
+		return nil, twirp.Unauthenticated.Error("User is not authenticated")
+	}
+	if err = a.ac.SendVerificationEmail(ctx, userID); err != nil {
+		return nil, twirp.Internal.Errorf("Could not send verification email: %w", err)
+	}
+	return &protos.SendVerificationEmailRes{Success: true}, nil
+}
+
+// VerifyEmail marks the email of the token's user as verified
+func (a *AuthService) VerifyEmail(ctx context.Context, req *protos.VerifyEmailReq) (*protos.VerifyEmailRes, error) {
+	if err := a.ac.VerifyEmail(ctx, req.Token); err != nil {
+		return nil, accountError(err, "Could not verify email")
+	}
+	return &protos.VerifyEmailRes{Success: true}, nil
+}
+
+// RequestPasswordReset emails a reset link, it succeeds for unknown emails too.
+// Repeated requests fail with ResourceExhausted and the seconds to wait in the "retry_after" meta
+func (a *AuthService) RequestPasswordReset(ctx context.Context, req *protos.RequestPasswordResetReq) (*protos.RequestPasswordResetRes, error) {
+	err := a.ac.RequestPasswordReset(ctx, req.Email, getRemoteIPFromContext(ctx))
+	if twerr := throttledError(err); twerr != nil {
+		return nil, twerr
+	}
+	if err != nil {
+		return nil, twirp.Internal.Errorf("Could not request password reset: %w", err)
+	}
+	return &protos.RequestPasswordResetRes{Success: true}, nil
+}
+
+// ResetPassword sets the password of the token's user and revokes their sessions
+func (a *AuthService) ResetPassword(ctx context.Context, req *protos.ResetPasswordReq) (*protos.ResetPasswordRes, error) {
+	if err := a.ac.ResetPassword(ctx, req.Token, req.Password); err != nil {
+		return nil, accountError(err, "Could not reset password")
+	}
+	return &protos.ResetPasswordRes{Success: true}, nil
+}
+
+// ChangePassword sets a new password after checking the current one
+func (a *AuthService) ChangePassword(ctx context.Context, req *protos.ChangePasswordReq) (*protos.ChangePasswordRes, error) {
+	userID, err := getUserIDFromContext(ctx)
+	if err != nil {
+		return nil, twirp.Unauthenticated.Error("User is not authenticated")
+	}
+	err = a.ac.ChangePassword(ctx, userID, req.CurrentPassword, req.NewPassword)
+	switch err {
+	case nil:
+		return &protos.ChangePasswordRes{Success: true}, nil
+	case auth.ErrIncorrectPassword:
+		return nil, twirp.InvalidArgument.Error(err.Error()).WithMeta("argument", "currentPassword")
+	case auth.ErrWeakPassword:
+		return nil, twirp.InvalidArgument.Error(err.Error()).WithMeta("argument", "newPassword")
+	}
+	return nil, accountError(err, "Could not change password")
+}
+
+// ChangeEmail changes the email of the user, who must verify it again
+func (a *AuthService) ChangeEmail(ctx context.Context, req *protos.ChangeEmailReq) (*protos.ChangeEmailRes, error) {
+	userID, err := getUserIDFromContext(ctx)
+	if err != nil {
+		return nil, twirp.Unauthenticated.Error("User is not authenticated")
+	}
+	userRow, err := a.ac.ChangeEmail(ctx, userID, req.Password, req.Email)
+	if err != nil {
+		return nil, accountError(err, "Could not change email")
+	}
+	return &protos.ChangeEmailRes{User: convertUserFromDB(userRow)}, nil
+}
+
+// UpdateProfile changes the username and birthdate of the user
+func (a *AuthService) UpdateProfile(ctx context.Context, req *protos.UpdateProfileReq) (*protos.UpdateProfileRes, error) {
+	userID, err := getUserIDFromContext(ctx)
+	if err != nil {
+		return nil, twirp.Unauthenticated.Error("User is not authenticated")
+	}
+	if req.Birthdate == nil {
+		return nil, twirp.InvalidArgument.Error("Birthdate is required").WithMeta("argument", "birthdate")
+	}
+	userRow, err := a.ac.UpdateProfile(ctx, userID, req.Username, req.Birthdate.AsTime())
+	if err != nil {
+		return nil, accountError(err, "Could not update profile")
+	}
+	return &protos.UpdateProfileRes{User: convertUserFromDB(userRow)}, nil
+}
+
+// DeleteAccount deletes the user and all of their data after checking their password
+func (a *AuthService) DeleteAccount(ctx context.Context, req *protos.DeleteAccountReq) (*protos.DeleteAccountRes, error) {
+	userID, err := getUserIDFromContext(ctx)
+	if err != nil {
+		return nil, twirp.Unauthenticated.Error("User is not authenticated")
+	}
+	if err = a.ac.DeleteAccount(ctx, userID, req.Password); err != nil {
+		return nil, accountError(err, "Could not delete account")
+	}
+	return &protos.DeleteAccountRes{Success: true}, nil
+}
+
+// EnrollTwoFactor creates a new TOTP secret for the user, enabled once confirmed with ConfirmTwoFactor
+func (a *AuthService) EnrollTwoFactor(ctx context.Context, req *protos.EnrollTwoFactorReq) (*protos.EnrollTwoFactorRes, error) {
+	userID, err := getUserIDFromContext(ctx)
+	if err != nil {
+		return nil, twirp.Unauthenticated.Error("User is not authenticated")
+	}
+	secret, uri, err := a.ac.EnrollTwoFactor(ctx, userID)
+	if err != nil {
+		return nil, twoFactorError(err, "Could not enroll two factor")
+	}
+	return &protos.EnrollTwoFactorRes{Secret: secret, ProvisioningURI: uri}, nil
+}
+
+// ConfirmTwoFactor enables two factor with a first code and returns the recovery codes
+func (a *AuthService) ConfirmTwoFactor(ctx context.Context, req *protos.ConfirmTwoFactorReq) (*protos.ConfirmTwoFactorRes, error) {
+	userID, err := getUserIDFromContext(ctx)
+	if err != nil {
+		return nil, twirp.Unauthenticated.Error("User is not authenticated")
+	}
+	codes, err := a.ac.ConfirmTwoFactor(ctx, userID, req.Code)
+	if err != nil {
+		return nil, twoFactorError(err, "Could not confirm two factor")
+	}
+	return &protos.ConfirmTwoFactorRes{RecoveryCodes: codes}, nil
+}
+
+// DisableTwoFactor disables two factor after checking the password and a code
+func (a *AuthService) DisableTwoFactor(ctx context.Context, req *protos.DisableTwoFactorReq) (*protos.DisableTwoFactorRes, error) {
+	userID, err := getUserIDFromContext(ctx)
+	if err != nil {
+		return nil, twirp.Unauthenticated.Error("User is not authenticated")
+	}
+	if err = a.ac.DisableTwoFactor(ctx, userID, req.Password, req.Code); err != nil {
+		return nil, twoFactorError(err, "Could not disable two factor")
+	}
+	return &protos.DisableTwoFactorRes{Success: true}, nil
+}
+
+// twoFactorError converts the errors of the two factor methods,
+// enrolling twice or confirming without enrolling fail with FailedPrecondition
+func twoFactorError(err error, msg string) error {
+	if err == auth.ErrTwoFactorEnabled || err == auth.ErrTwoFactorNotEnabled {
+		return twirp.FailedPrecondition.Error(err.Error())
+	}
+	return accountError(err, msg)
+}
+
+// ListSessions returns the devices signed in to the user's account
+func (a *AuthService) ListSessions(ctx context.Context, req *protos.ListSessionsReq) (*protos.ListSessionsRes, error) {
+	userID, err := getUserIDFromContext(ctx)
+	if err != nil {
+		return nil, twirp.Unauthenticated.Error("User is not authenticated")
+	}
+	currentID, _ := getSessionIDFromContext(ctx)
+	sessions, err := a.ac.ListSessions(ctx, userID)
+	if err != nil {
+		return nil, twirp.Internal.Errorf("Could not list sessions: %w", err)
+	}
+	res := &protos.ListSessionsRes{Sessions: make([]*protos.ActiveSession, len(sessions))}
+	for i := range sessions {
+		res.Sessions[i] = convertActiveSessionFromDB(&sessions[i], currentID)
+	}
+	return res, nil
+}
+
+// RevokeSession signs the device of the session handle out
+func (a *AuthService) RevokeSession(ctx context.Context, req *protos.RevokeSessionReq) (*protos.RevokeSessionRes, error) {
+	userID, err := getUserIDFromContext(ctx)
+	if err != nil {
+		return nil, twirp.Unauthenticated.Error("User is not authenticated")
+	}
+	err = a.ac.RevokeSession(ctx, userID, req.Handle)
+	if err == auth.ErrSessionNotFound {
+		return nil, twirp.NotFound.Error(err.Error()).WithMeta("argument", "handle")
+	}
+	if err != nil {
+		return nil, twirp.Internal.Errorf("Could not revoke session: %w", err)
+	}
+	return &protos.RevokeSessionRes{Success: true}, nil
+}
+
+// RevokeAllOtherSessions signs every device out except the one making the request
+func (a *AuthService) RevokeAllOtherSessions(ctx context.Context, req *protos.RevokeAllOtherSessionsReq) (*protos.RevokeAllOtherSessionsRes, error) {
+	userID, err := getUserIDFromContext(ctx)
+	if err != nil {
+		return nil, twirp.Unauthenticated.Error("User is not authenticated")
+	}
+	currentID, err := getSessionIDFromContext(ctx)
+	if err != nil {
+		return nil, twirp.Unauthenticated.Error("User is not authenticated")
+	}
+	revoked, err := a.ac.RevokeAllOtherSessions(ctx, userID, currentID)
+	if err != nil {
+		return nil, twirp.Internal.Errorf("Could not revoke sessions: %w", err)
+	}
+	return &protos.RevokeAllOtherSessionsRes{Revoked: revoked}, nil
+}
+
+// legacyClientName is the display name of the grants of unknown clients, see db.LegacyClientID
+const legacyClientName = "Unknown app"
+
+// ListGrants returns the oauth clients linked to the user's account
+func (a *AuthService) ListGrants(ctx context.Context, req *protos.ListGrantsReq) (*protos.ListGrantsRes, error) {
+	userID, err := getUserIDFromContext(ctx)
+	if err != nil {
+		return nil, twirp.Unauthenticated.Error("User is not authenticated")
+	}
+	grants, err := a.ac.ListGrants(ctx, userID)
+	if err != nil {
+		return nil, twirp.Internal.Errorf("Could not list grants: %w", err)
+	}
+	res := &protos.ListGrantsRes{Grants: make([]*protos.OAuthGrant, len(grants))}
+	for i := range grants {
+		name, ok := a.clientNames[grants[i].ClientID]
+		if !ok && grants[i].ClientID == db.LegacyClientID {
+			name = legacyClientName
+		}
+		res.Grants[i] = convertGrantFromDB(&grants[i], name)
+	}
+	return res, nil
+}
+
+// RevokeGrant unlinks the oauth client from the user's account
+func (a *AuthService) RevokeGrant(ctx context.Context, req *protos.RevokeGrantReq) (*protos.RevokeGrantRes, error) {
+	userID, err := getUserIDFromContext(ctx)
+	if err != nil {
+		return nil, twirp.Unauthenticated.Error("User is not authenticated")
+	}
+	err = a.ac.RevokeGrant(ctx, userID, req.ClientId)
+	if err == auth.ErrGrantNotFound {
+		return nil, twirp.NotFound.Error(err.Error()).WithMeta("argument", "clientId")
+	}
+	if err != nil {
+		return nil, twirp.Internal.Errorf("Could not revoke grant: %w", err)
+	}
+	return &protos.RevokeGrantRes{Success: true}, nil
+}
+
 // Authorize TODO: find use case
 // func (a *AuthService) Authorize(ctx context.Context, req *protos.AuthorizeReq) (*protos.AuthorizeRes, error) {
 // 	seshKey, err := uuid.Parse(req.GetSessionKey())
@@ -48,11 +368,11 @@ func (a *AuthService) Authenticate(ctx context.Context, req *protos.Authenticate
 
 // Logout removes the given session key from the db, in effect "logging out" of the user's session
 func (a *AuthService) Logout(ctx context.Context, req *protos.LogoutReq) (*protos.LogoutRes, error) {
-	seshKey, err := uuid.Parse(req.GetSessionKey())
-	if err != nil {
-		return nil, twirp.InvalidArgument.Error("Malformed session key uuid")
+	seshKey := req.GetSessionKey()
+	if seshKey == "" {
+		return nil, twirp.InvalidArgument.Error("Missing session key").WithMeta("argument", "sessionKey")
 	}
-	err = a.ac.Logout(ctx, seshKey)
+	err := a.ac.Logout(ctx, seshKey)
 	if err != nil {
 		return nil, twirp.Internal.Errorf("Logout error: %w", err)
 	}
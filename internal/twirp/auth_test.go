@@ -2,10 +2,13 @@ package twirp
 
 import (
 	"context"
+	"crypto/sha256"
 	"fmt"
 	"log"
 	"net/http"
+	"net/url"
 	"os"
+	"regexp"
 	"testing"
 	"time"
 
@@ -15,14 +18,18 @@ import (
 	"github.com/sschwartz96/syncapod-backend/internal/auth"
 	"github.com/sschwartz96/syncapod-backend/internal/db"
 	protos "github.com/sschwartz96/syncapod-backend/internal/gen"
+	"github.com/sschwartz96/syncapod-backend/internal/mail"
 	"github.com/sschwartz96/syncapod-backend/internal/podcast"
 	"github.com/stretchr/testify/require"
 	"github.com/twitchtv/twirp"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 var (
-	dbpg     *pgxpool.Pool
-	testUser = &db.UserRow{
+	dbpg        *pgxpool.Pool
+	testMailer  = mail.NewMemoryMailer()
+	testJanitor *auth.Janitor
+	testUser    = &db.UserRow{
 		ID:    uuid.MustParse("b921c6e3-9cd0-4aed-9c4e-1d88ae20c777"),
 		Email: "user@twirp.test", Username: "user_twirp_test",
 		Birthdate:    time.Unix(0, 0).UTC(),
@@ -53,16 +60,18 @@ func TestMain(m *testing.M) {
 		log.Fatalf("twirp.TestMain() error setting up db for admin: %v", err)
 	}
 
-	authController := auth.NewAuthController(db.NewAuthStorePG(dbpg), db.NewOAuthStorePG(dbpg))
+	authController := auth.NewAuthController(db.NewAuthStorePG(dbpg), db.NewOAuthStorePG(dbpg), testMailer, "https://syncapod.com", auth.Hasher{}, db.NewMemoryLimiterStore())
 	podController, err := podcast.NewPodController(db.NewPodcastStore(dbpg))
 	if err != nil {
 		log.Fatalf("twirp.TestMain() error setting up PodController: %v", err)
 	}
 	rssController := podcast.NewRSSController(podController)
+	testJanitor = auth.NewJanitor(db.NewAuthStorePG(dbpg), auth.JanitorBatchSize)
 
 	twirpServer := NewServer(nil, authController,
-		NewAuthService(authController), NewPodcastService(podController),
-		NewAdminService(podController, rssController),
+		NewAuthService(authController, map[string]string{"testClientID": "Test Client"}), NewPodcastService(podController),
+		NewAdminService(podController, rssController, testJanitor),
+		podController.Events(),
 	)
 
 	go func() {
@@ -137,4 +146,281 @@ func TestAuthGRPC(t *testing.T) {
 		t.Fatalf("Logout failed: %v", err)
 	}
 	require.Equal(t, true, logoutRes.Success)
+
+	// Register, without an auth token
+	birthdate := timestamppb.New(time.Now().AddDate(-20, 0, 0))
+	regRes, err := client.Register(context.Background(), &protos.RegisterReq{
+		Email: "register@syncapod.com", Username: "registerUser", Password: "password123", Birthdate: birthdate})
+	require.Equal(t, nil, err)
+	require.Equal(t, "registerUser", regRes.User.Username)
+	_, err = client.Register(context.Background(), &protos.RegisterReq{
+		Email: "register@syncapod.com", Username: "registerUser2", Password: "password123", Birthdate: birthdate})
+	require.Equal(t, twirp.AlreadyExists, err.(twirp.Error).Code())
+	require.Equal(t, "email", err.(twirp.Error).Meta("argument"))
+	_, err = client.Register(context.Background(), &protos.RegisterReq{
+		Email: "register2@syncapod.com", Username: "registerUser", Password: "password123", Birthdate: birthdate})
+	require.Equal(t, "username", err.(twirp.Error).Meta("argument"))
+	_, err = client.Register(context.Background(), &protos.RegisterReq{
+		Email: "register2@syncapod.com", Username: "registerUser2", Password: "short", Birthdate: birthdate})
+	require.Equal(t, twirp.InvalidArgument, err.(twirp.Error).Code())
+
+	// VerifyEmail, with the token emailed on registration
+	_, err = client.VerifyEmail(context.Background(), &protos.VerifyEmailReq{Token: "invalid"})
+	require.Equal(t, "token", err.(twirp.Error).Meta("argument"))
+	verifyToken := mailedToken(t, "register@syncapod.com")
+	verifyRes, err := client.VerifyEmail(context.Background(), &protos.VerifyEmailReq{Token: verifyToken})
+	require.Equal(t, nil, err)
+	require.True(t, verifyRes.Success)
+	_, err = client.VerifyEmail(context.Background(), &protos.VerifyEmailReq{Token: verifyToken})
+	require.Equal(t, twirp.InvalidArgument, err.(twirp.Error).Code())
+
+	// RequestPasswordReset, unknown emails are not reported
+	_, err = client.RequestPasswordReset(context.Background(), &protos.RequestPasswordResetReq{Email: "unknown@syncapod.com"})
+	require.Equal(t, nil, err)
+	require.Nil(t, testMailer.Last("unknown@syncapod.com"))
+	_, err = client.RequestPasswordReset(context.Background(), &protos.RequestPasswordResetReq{Email: "register@syncapod.com"})
+	require.Equal(t, nil, err)
+
+	// ResetPassword
+	resetToken := mailedToken(t, "register@syncapod.com")
+	_, err = client.ResetPassword(context.Background(), &protos.ResetPasswordReq{Token: resetToken, Password: "weak"})
+	require.Equal(t, "password", err.(twirp.Error).Meta("argument"))
+	_, err = client.ResetPassword(context.Background(), &protos.ResetPasswordReq{Token: resetToken, Password: "newPassword123"})
+	require.Equal(t, nil, err)
+	_, err = client.Authenticate(context.Background(), &protos.AuthenticateReq{Username: "registerUser", Password: "newPassword123"})
+	require.Equal(t, nil, err)
+}
+
+func TestAccountGRPC(t *testing.T) {
+	client := protos.NewAuthProtobufClient(
+		"http://localhost:8081",
+		http.DefaultClient,
+		twirp.WithClientPathPrefix("/rpc/auth"),
+	)
+	birthdate := timestamppb.New(time.Now().AddDate(-20, 0, 0))
+	_, err := client.Register(context.Background(), &protos.RegisterReq{
+		Email: "account@syncapod.com", Username: "accountUser", Password: "password123", Birthdate: birthdate})
+	require.Equal(t, nil, err)
+	autheRes, err := client.Authenticate(context.Background(), &protos.AuthenticateReq{Username: "accountUser", Password: "password123"})
+	require.Equal(t, nil, err)
+	header := make(http.Header)
+	header.Add(authTokenKey, autheRes.SessionKey)
+	ctx, err := twirp.WithHTTPRequestHeaders(context.Background(), header)
+	if err != nil {
+		t.Fatalf("Failed to add header to context: %v", err)
+	}
+
+	// ChangePassword
+	_, err = client.ChangePassword(ctx, &protos.ChangePasswordReq{CurrentPassword: "wrong", NewPassword: "password456"})
+	require.Equal(t, "currentPassword", err.(twirp.Error).Meta("argument"))
+	_, err = client.ChangePassword(ctx, &protos.ChangePasswordReq{CurrentPassword: "password123", NewPassword: "short"})
+	require.Equal(t, "newPassword", err.(twirp.Error).Meta("argument"))
+	_, err = client.ChangePassword(ctx, &protos.ChangePasswordReq{CurrentPassword: "password123", NewPassword: "password456"})
+	require.Equal(t, nil, err)
+
+	// UpdateProfile
+	_, err = client.UpdateProfile(ctx, &protos.UpdateProfileReq{Username: testUser.Username, Birthdate: birthdate})
+	require.Equal(t, twirp.AlreadyExists, err.(twirp.Error).Code())
+	profileRes, err := client.UpdateProfile(ctx, &protos.UpdateProfileReq{Username: "accountUser2", Birthdate: birthdate})
+	require.Equal(t, nil, err)
+	require.Equal(t, "accountUser2", profileRes.User.Username)
+
+	// ChangeEmail
+	_, err = client.ChangeEmail(ctx, &protos.ChangeEmailReq{Password: "password456", Email: testUser.Email})
+	require.Equal(t, twirp.AlreadyExists, err.(twirp.Error).Code())
+	emailRes, err := client.ChangeEmail(ctx, &protos.ChangeEmailReq{Password: "password456", Email: "account2@syncapod.com"})
+	require.Equal(t, nil, err)
+	require.Equal(t, "account2@syncapod.com", emailRes.User.Email)
+	require.False(t, emailRes.User.EmailVerified)
+	require.NotEmpty(t, mailedToken(t, "account2@syncapod.com"))
+
+	// DeleteAccount
+	_, err = client.DeleteAccount(ctx, &protos.DeleteAccountReq{Password: "password123"})
+	require.Equal(t, "password", err.(twirp.Error).Meta("argument"))
+	_, err = client.DeleteAccount(ctx, &protos.DeleteAccountReq{Password: "password456"})
+	require.Equal(t, nil, err)
+	_, err = client.Authenticate(context.Background(), &protos.AuthenticateReq{Username: "accountUser2", Password: "password456"})
+	require.NotNil(t, err)
+	_, err = client.ChangePassword(ctx, &protos.ChangePasswordReq{CurrentPassword: "password456", NewPassword: "password789"})
+	require.Equal(t, twirp.Unauthenticated, err.(twirp.Error).Code())
+}
+
+func TestAuthenticateThrottledGRPC(t *testing.T) {
+	client := protos.NewAuthProtobufClient(
+		"http://localhost:8081",
+		http.DefaultClient,
+		twirp.WithClientPathPrefix("/rpc/auth"),
+	)
+	birthdate := timestamppb.New(time.Now().AddDate(-20, 0, 0))
+	_, err := client.Register(context.Background(), &protos.RegisterReq{
+		Email: "throttle@syncapod.com", Username: "throttleUser", Password: "password123", Birthdate: birthdate})
+	require.Equal(t, nil, err)
+	for i := 0; i < auth.AccountThrottlePolicy.FreeAttempts; i++ {
+		_, err = client.Authenticate(context.Background(), &protos.AuthenticateReq{Username: "throttleUser", Password: "wrong"})
+		require.Equal(t, twirp.InvalidArgument, err.(twirp.Error).Code())
+	}
+	_, err = client.Authenticate(context.Background(), &protos.AuthenticateReq{Username: "throttleUser", Password: "password123"})
+	require.Equal(t, twirp.ResourceExhausted, err.(twirp.Error).Code())
+	require.NotEmpty(t, err.(twirp.Error).Meta("retry_after"))
+}
+
+func TestReauthenticateThrottledGRPC(t *testing.T) {
+	client := protos.NewAuthProtobufClient(
+		"http://localhost:8081",
+		http.DefaultClient,
+		twirp.WithClientPathPrefix("/rpc/auth"),
+	)
+	birthdate := timestamppb.New(time.Now().AddDate(-20, 0, 0))
+	_, err := client.Register(context.Background(), &protos.RegisterReq{
+		Email: "reauth@syncapod.com", Username: "reauthUser", Password: "password123", Birthdate: birthdate})
+	require.Equal(t, nil, err)
+	autheRes, err := client.Authenticate(context.Background(), &protos.AuthenticateReq{Username: "reauthUser", Password: "password123"})
+	require.Equal(t, nil, err)
+	header := make(http.Header)
+	header.Add(authTokenKey, autheRes.SessionKey)
+	ctx, err := twirp.WithHTTPRequestHeaders(context.Background(), header)
+	if err != nil {
+		t.Fatalf("Failed to add header to context: %v", err)
+	}
+
+	for i := 0; i < auth.AccountThrottlePolicy.FreeAttempts; i++ {
+		_, err = client.ChangePassword(ctx, &protos.ChangePasswordReq{CurrentPassword: "wrong", NewPassword: "password456"})
+		require.Equal(t, "currentPassword", err.(twirp.Error).Meta("argument"))
+	}
+	_, err = client.ChangePassword(ctx, &protos.ChangePasswordReq{CurrentPassword: "password123", NewPassword: "password456"})
+	require.Equal(t, twirp.ResourceExhausted, err.(twirp.Error).Code())
+	require.NotEmpty(t, err.(twirp.Error).Meta("retry_after"))
+	_, err = client.DeleteAccount(ctx, &protos.DeleteAccountReq{Password: "password123"})
+	require.Equal(t, twirp.ResourceExhausted, err.(twirp.Error).Code())
+}
+
+func TestTwoFactorGRPC(t *testing.T) {
+	client := protos.NewAuthProtobufClient(
+		"http://localhost:8081",
+		http.DefaultClient,
+		twirp.WithClientPathPrefix("/rpc/auth"),
+	)
+	birthdate := timestamppb.New(time.Now().AddDate(-20, 0, 0))
+	registerRes, err := client.Register(context.Background(), &protos.RegisterReq{
+		Email: "twofactor@syncapod.com", Username: "twoFactorUser", Password: "password123", Birthdate: birthdate})
+	require.Equal(t, nil, err)
+	autheRes, err := client.Authenticate(context.Background(), &protos.AuthenticateReq{Username: "twoFactorUser", Password: "password123"})
+	require.Equal(t, nil, err)
+	header := make(http.Header)
+	header.Add(authTokenKey, autheRes.SessionKey)
+	ctx, err := twirp.WithHTTPRequestHeaders(context.Background(), header)
+	if err != nil {
+		t.Fatalf("Failed to add header to context: %v", err)
+	}
+
+	// EnrollTwoFactor & ConfirmTwoFactor
+	enrollRes, err := client.EnrollTwoFactor(ctx, &protos.EnrollTwoFactorReq{})
+	require.Equal(t, nil, err)
+	require.NotEmpty(t, enrollRes.Secret)
+	require.Contains(t, enrollRes.ProvisioningURI, "secret="+enrollRes.Secret)
+	_, err = client.ConfirmTwoFactor(ctx, &protos.ConfirmTwoFactorReq{Code: "not a code"})
+	require.Equal(t, "code", err.(twirp.Error).Meta("argument"))
+
+	// enable with a known recovery code, TOTP codes are covered by the auth package
+	userID, err := uuid.Parse(registerRes.User.Id)
+	require.Nil(t, err)
+	recoveryHash := sha256.Sum256([]byte("abcdefghij"))
+	require.Nil(t, db.NewAuthStorePG(dbpg).EnableTwoFactor(context.Background(), userID, [][]byte{recoveryHash[:]}))
+	_, err = client.EnrollTwoFactor(ctx, &protos.EnrollTwoFactorReq{})
+	require.Equal(t, twirp.FailedPrecondition, err.(twirp.Error).Code())
+
+	// Authenticate & VerifyTwoFactor
+	autheRes, err = client.Authenticate(context.Background(), &protos.AuthenticateReq{Username: "twoFactorUser", Password: "password123"})
+	require.Equal(t, nil, err)
+	require.True(t, autheRes.TwoFactorRequired)
+	require.Empty(t, autheRes.SessionKey)
+	_, err = client.VerifyTwoFactor(context.Background(), &protos.VerifyTwoFactorReq{Challenge: autheRes.TwoFactorChallenge, Code: "wrong"})
+	require.Equal(t, "code", err.(twirp.Error).Meta("argument"))
+	verifyRes, err := client.VerifyTwoFactor(context.Background(), &protos.VerifyTwoFactorReq{Challenge: autheRes.TwoFactorChallenge, Code: "abcde-fghij"})
+	require.Equal(t, nil, err)
+	require.NotEmpty(t, verifyRes.SessionKey)
+	require.Equal(t, "twoFactorUser", verifyRes.User.Username)
+	_, err = client.VerifyTwoFactor(context.Background(), &protos.VerifyTwoFactorReq{Challenge: autheRes.TwoFactorChallenge, Code: "abcde-fghij"})
+	require.Equal(t, "challenge", err.(twirp.Error).Meta("argument"))
+}
+
+func TestSessionsGRPC(t *testing.T) {
+	client := protos.NewAuthProtobufClient(
+		"http://localhost:8081",
+		http.DefaultClient,
+		twirp.WithClientPathPrefix("/rpc/auth"),
+	)
+	birthdate := timestamppb.New(time.Now().AddDate(-20, 0, 0))
+	_, err := client.Register(context.Background(), &protos.RegisterReq{
+		Email: "sessions@syncapod.com", Username: "sessionsUser", Password: "password123", Birthdate: birthdate})
+	require.Equal(t, nil, err)
+	sessionCtx := func(userAgent string) context.Context {
+		autheRes, err := client.Authenticate(context.Background(), &protos.AuthenticateReq{Username: "sessionsUser", Password: "password123", UserAgent: userAgent})
+		require.Equal(t, nil, err)
+		header := make(http.Header)
+		header.Add(authTokenKey, autheRes.SessionKey)
+		ctx, err := twirp.WithHTTPRequestHeaders(context.Background(), header)
+		require.Nil(t, err)
+		return ctx
+	}
+	phoneCtx, laptopCtx := sessionCtx("phone"), sessionCtx("laptop")
+
+	// ListSessions
+	listRes, err := client.ListSessions(laptopCtx, &protos.ListSessionsReq{})
+	require.Equal(t, nil, err)
+	require.Len(t, listRes.Sessions, 2)
+	for _, s := range listRes.Sessions {
+		require.Equal(t, s.UserAgent == "laptop", s.Current)
+	}
+
+	// RevokeSession & RevokeAllOtherSessions
+	_, err = client.RevokeSession(laptopCtx, &protos.RevokeSessionReq{Handle: "unknown"})
+	require.Equal(t, twirp.NotFound, err.(twirp.Error).Code())
+	revokeRes, err := client.RevokeAllOtherSessions(laptopCtx, &protos.RevokeAllOtherSessionsReq{})
+	require.Equal(t, nil, err)
+	require.Equal(t, int64(1), revokeRes.Revoked)
+	_, err = client.ListSessions(phoneCtx, &protos.ListSessionsReq{})
+	require.Equal(t, twirp.Unauthenticated, err.(twirp.Error).Code())
+	listRes, err = client.ListSessions(laptopCtx, &protos.ListSessionsReq{})
+	require.Equal(t, nil, err)
+	require.Len(t, listRes.Sessions, 1)
+	_, err = client.RevokeSession(laptopCtx, &protos.RevokeSessionReq{Handle: listRes.Sessions[0].Handle})
+	require.Equal(t, nil, err)
+	_, err = client.ListSessions(laptopCtx, &protos.ListSessionsReq{})
+	require.Equal(t, twirp.Unauthenticated, err.(twirp.Error).Code())
+
+	// ListGrants & RevokeGrant
+	grantsCtx := sessionCtx("grants")
+	grantsRes, err := client.ListGrants(grantsCtx, &protos.ListGrantsReq{})
+	require.Equal(t, nil, err)
+	require.Empty(t, grantsRes.Grants)
+	_, err = client.RevokeGrant(grantsCtx, &protos.RevokeGrantReq{ClientId: "testClientID"})
+	require.Equal(t, twirp.NotFound, err.(twirp.Error).Code())
+
+	// tokens of unknown clients are listed and revoked as the legacy client
+	user, err := db.NewAuthStorePG(dbpg).GetUserByUsername(context.Background(), "sessionsUser")
+	require.Nil(t, err)
+	require.Nil(t, db.NewOAuthStorePG(dbpg).InsertAccessToken(context.Background(), &db.AccessTokenRow{Token: []byte("legacy_token"),
+		AuthCode: []byte("legacy_code"), RefreshToken: []byte("legacy_refresh"), UserID: user.ID, Created: time.Now(), Expires: 3600,
+		ClientID: db.LegacyClientID}))
+	grantsRes, err = client.ListGrants(grantsCtx, &protos.ListGrantsReq{})
+	require.Equal(t, nil, err)
+	require.Len(t, grantsRes.Grants, 1)
+	require.Equal(t, db.LegacyClientID, grantsRes.Grants[0].ClientId)
+	require.Equal(t, legacyClientName, grantsRes.Grants[0].ClientName)
+	_, err = client.RevokeGrant(grantsCtx, &protos.RevokeGrantReq{ClientId: db.LegacyClientID})
+	require.Equal(t, nil, err)
+}
+
+var tokenRegex = regexp.MustCompile(`token=(\S+)`)
+
+// mailedToken returns the token of the link last emailed to the address
+func mailedToken(t *testing.T, to string) string {
+	msg := testMailer.Last(to)
+	require.NotNil(t, msg)
+	match := tokenRegex.FindStringSubmatch(msg.Body)
+	require.Len(t, match, 2)
+	token, err := url.QueryUnescape(match[1])
+	require.Nil(t, err)
+	return token
 }
@@ -0,0 +1,157 @@
+package twirp
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/sschwartz96/syncapod-backend/internal/db"
+	protos "github.com/sschwartz96/syncapod-backend/internal/gen"
+	"github.com/twitchtv/twirp"
+)
+
+const (
+	maxNoteLength = 1000
+	maxClipMillis = int64(5 * time.Minute / time.Millisecond)
+)
+
+// CreateBookmark bookmarks a moment within an episode
+func (p *PodcastService) CreateBookmark(ctx context.Context, req *protos.CreateBookmarkReq) (*protos.Bookmark, error) {
+	userID, err := getUserIDFromContext(ctx)
+	if err != nil {
+		return nil, twirp.Unauthenticated.Error("User is not authenticated")
+	}
+	epi, err := p.findEpisode(ctx, req.EpisodeID)
+	if err != nil {
+		return nil, err
+	}
+	if !withinEpisode(epi, req.Offset) {
+		return nil, twirp.InvalidArgument.Error("Offset is outside of the episode")
+	}
+	if len(req.Note) > maxNoteLength {
+		return nil, twirp.InvalidArgument.Errorf("Note is longer than %d characters", maxNoteLength)
+	}
+	bookmark := &db.Bookmark{ID: uuid.New(), UserID: userID, EpisodeID: epi.ID, OffsetMillis: req.Offset, Note: req.Note, Created: time.Now()}
+	if err = p.podCon.InsertBookmark(ctx, bookmark); err != nil {
+		return nil, twirp.Internal.Errorf("Could not create bookmark: %w", err)
+	}
+	return convertBookmarkFromDB(bookmark), nil
+}
+
+// GetBookmarks returns the user's bookmarks of an episode or of every episode
+func (p *PodcastService) GetBookmarks(ctx context.Context, req *protos.GetBookmarksReq) (*protos.Bookmarks, error) {
+	userID, err := getUserIDFromContext(ctx)
+	if err != nil {
+		return nil, twirp.Unauthenticated.Error("User is not authenticated")
+	}
+	epiID, err := parseOptionalUUID(req.EpisodeID)
+	if err != nil {
+		return nil, twirp.InvalidArgument.Error("Could not parse episode UUID")
+	}
+	dbBookmarks, err := p.podCon.FindBookmarks(ctx, userID, epiID)
+	if err != nil {
+		return nil, twirp.Internal.Errorf("Could not find bookmarks: %w", err)
+	}
+	bookmarks := make([]*protos.Bookmark, len(dbBookmarks))
+	for i := range dbBookmarks {
+		bookmarks[i] = convertBookmarkFromDB(&dbBookmarks[i])
+	}
+	return &protos.Bookmarks{Bookmarks: bookmarks}, nil
+}
+
+// DeleteBookmark deletes the user's bookmark
+func (p *PodcastService) DeleteBookmark(ctx context.Context, req *protos.DeleteBookmarkReq) (*protos.Response, error) {
+	userID, err := getUserIDFromContext(ctx)
+	if err != nil {
+		return nil, twirp.Unauthenticated.Error("User is not authenticated")
+	}
+	id, err := uuid.Parse(req.Id)
+	if err != nil {
+		return nil, twirp.InvalidArgument.Error("Could not parse bookmark UUID")
+	}
+	if err = p.podCon.DeleteBookmark(ctx, userID, id); err != nil {
+		return nil, twirp.NotFound.Errorf("Could not delete bookmark: %w", err)
+	}
+	return &protos.Response{Success: true, Message: ""}, nil
+}
+
+// CreateClip creates a shareable clip of an episode
+func (p *PodcastService) CreateClip(ctx context.Context, req *protos.CreateClipReq) (*protos.Clip, error) {
+	userID, err := getUserIDFromContext(ctx)
+	if err != nil {
+		return nil, twirp.Unauthenticated.Error("User is not authenticated")
+	}
+	epi, err := p.findEpisode(ctx, req.EpisodeID)
+	if err != nil {
+		return nil, err
+	}
+	if req.StartOffset >= req.EndOffset || !withinEpisode(epi, req.StartOffset) || !withinEpisode(epi, req.EndOffset) {
+		return nil, twirp.InvalidArgument.Error("Clip must start before it ends within the episode")
+	}
+	if req.EndOffset-req.StartOffset > maxClipMillis {
+		return nil, twirp.InvalidArgument.Errorf("Clip is longer than %d millis", maxClipMillis)
+	}
+	if len(req.Title) > maxNoteLength {
+		return nil, twirp.InvalidArgument.Errorf("Title is longer than %d characters", maxNoteLength)
+	}
+	clip := &db.Clip{ID: uuid.New(), UserID: userID, EpisodeID: epi.ID,
+		StartMillis: req.StartOffset, EndMillis: req.EndOffset, Title: req.Title, Created: time.Now()}
+	if err = p.podCon.InsertClip(ctx, clip); err != nil {
+		return nil, twirp.Internal.Errorf("Could not create clip: %w", err)
+	}
+	return convertClipFromDB(clip), nil
+}
+
+// GetClips returns the user's clips of an episode or of every episode
+func (p *PodcastService) GetClips(ctx context.Context, req *protos.GetClipsReq) (*protos.Clips, error) {
+	userID, err := getUserIDFromContext(ctx)
+	if err != nil {
+		return nil, twirp.Unauthenticated.Error("User is not authenticated")
+	}
+	epiID, err := parseOptionalUUID(req.EpisodeID)
+	if err != nil {
+		return nil, twirp.InvalidArgument.Error("Could not parse episode UUID")
+	}
+	dbClips, err := p.podCon.FindClips(ctx, userID, epiID)
+	if err != nil {
+		return nil, twirp.Internal.Errorf("Could not find clips: %w", err)
+	}
+	clips := make([]*protos.Clip, len(dbClips))
+	for i := range dbClips {
+		clips[i] = convertClipFromDB(&dbClips[i])
+	}
+	return &protos.Clips{Clips: clips}, nil
+}
+
+// DeleteClip deletes the user's clip, it is no longer shared
+func (p *PodcastService) DeleteClip(ctx context.Context, req *protos.DeleteClipReq) (*protos.Response, error) {
+	userID, err := getUserIDFromContext(ctx)
+	if err != nil {
+		return nil, twirp.Unauthenticated.Error("User is not authenticated")
+	}
+	id, err := uuid.Parse(req.Id)
+	if err != nil {
+		return nil, twirp.InvalidArgument.Error("Could not parse clip UUID")
+	}
+	if err = p.podCon.DeleteClip(ctx, userID, id); err != nil {
+		return nil, twirp.NotFound.Errorf("Could not delete clip: %w", err)
+	}
+	return &protos.Response{Success: true, Message: ""}, nil
+}
+
+func (p *PodcastService) findEpisode(ctx context.Context, id string) (*db.Episode, error) {
+	epiID, err := uuid.Parse(id)
+	if err != nil {
+		return nil, twirp.InvalidArgument.Error("Could not parse episode UUID")
+	}
+	epi, err := p.podCon.FindEpisodeByID(ctx, epiID)
+	if err != nil {
+		return nil, twirp.NotFound.Errorf("Could not find episode: %w", err)
+	}
+	return epi, nil
+}
+
+// withinEpisode checks the offset against the duration, when the feed provided one
+func withinEpisode(epi *db.Episode, offset int64) bool {
+	return offset >= 0 && (epi.Duration <= 0 || offset <= epi.Duration)
+}
@@ -0,0 +1,59 @@
+package twirp
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+const (
+	eventsPath = "/rpc/events"
+	// keepAliveInterval keeps idle connections from being closed by proxies
+	keepAliveInterval = 30 * time.Second
+)
+
+// serveEvents streams the user's playback events as server-sent events until the client disconnects
+func (s *Server) serveEvents(res http.ResponseWriter, req *http.Request) {
+	flusher, ok := res.(http.Flusher)
+	if !ok {
+		http.Error(res, "streaming unsupported", http.StatusInternalServerError)
+		return
+	}
+	authToken, _ := req.Context().Value(twirpHeaderKey{}).(string)
+	user, _, err := s.authC.Authorize(req.Context(), authToken)
+	if err != nil {
+		http.Error(res, "invalid auth token", http.StatusUnauthorized)
+		return
+	}
+
+	sub, unsubscribe := s.broker.Subscribe(user.ID)
+	defer unsubscribe()
+
+	res.Header().Set("Content-Type", "text/event-stream")
+	res.Header().Set("Cache-Control", "no-cache")
+	res.Header().Set("Connection", "keep-alive")
+	res.WriteHeader(http.StatusOK)
+	flusher.Flush()
+
+	keepAlive := time.NewTicker(keepAliveInterval)
+	defer keepAlive.Stop()
+	for {
+		select {
+		case <-req.Context().Done():
+			return
+		case <-keepAlive.C:
+			fmt.Fprint(res, ": keep-alive\n\n")
+		case e, ok := <-sub:
+			if !ok {
+				return
+			}
+			data, err := json.Marshal(e)
+			if err != nil {
+				continue
+			}
+			fmt.Fprintf(res, "event: %s\ndata: %s\n\n", e.Kind, data)
+		}
+		flusher.Flush()
+	}
+}
@@ -0,0 +1,86 @@
+package twirp
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/sschwartz96/syncapod-backend/internal/events"
+	protos "github.com/sschwartz96/syncapod-backend/internal/gen"
+	"github.com/stretchr/testify/require"
+	"github.com/twitchtv/twirp"
+)
+
+func Test_Events(t *testing.T) {
+	// unauthenticated
+	res, err := http.Get("http://localhost:8081" + eventsPath)
+	if err != nil {
+		t.Fatalf("Test_Events() error requesting events: %v", err)
+	}
+	res.Body.Close()
+	require.Equal(t, http.StatusUnauthorized, res.StatusCode)
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*10)
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, "GET", "http://localhost:8081"+eventsPath, nil)
+	if err != nil {
+		t.Fatalf("Test_Events() error creating request: %v", err)
+	}
+	req.Header.Set(authTokenKey, testSesh.Key)
+	res, err = http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatalf("Test_Events() error requesting events: %v", err)
+	}
+	defer res.Body.Close()
+	require.Equal(t, http.StatusOK, res.StatusCode)
+	require.Equal(t, "text/event-stream", res.Header.Get("Content-Type"))
+
+	// pausing on another device is pushed to the stream
+	header := make(http.Header)
+	header.Set(authTokenKey, testSesh.Key)
+	rpcCtx, err := twirp.WithHTTPRequestHeaders(context.Background(), header)
+	if err != nil {
+		t.Fatalf("Twirp could not add add headers: %v", err)
+	}
+	client := protos.NewPodProtobufClient("http://localhost:8081", http.DefaultClient, twirp.WithClientPathPrefix("/rpc/podcast"))
+	_, err = client.UpsertUserEpisode(rpcCtx, &protos.UserEpisode{EpisodeID: testEpi2.ID.String(), Offset: 4321})
+	require.Equal(t, nil, err)
+
+	reader := bufio.NewReader(res.Body)
+	eventLine, err := reader.ReadString('\n')
+	if err != nil {
+		t.Fatalf("Test_Events() error reading event: %v", err)
+	}
+	require.Equal(t, "event: paused\n", eventLine)
+	dataLine, err := reader.ReadString('\n')
+	if err != nil {
+		t.Fatalf("Test_Events() error reading event data: %v", err)
+	}
+	e := events.Event{}
+	err = json.Unmarshal([]byte(strings.TrimPrefix(dataLine, "data: ")), &e)
+	if err != nil {
+		t.Fatalf("Test_Events() error unmarshalling event: %v", err)
+	}
+	require.Equal(t, testEpi2.ID.String(), e.EpisodeID)
+	require.Equal(t, int64(4321), e.OffsetMillis)
+
+	// bulk changes are pushed as well
+	_, err = client.MarkEpisodeUnplayed(rpcCtx, &protos.MarkEpisodeUnplayedReq{EpisodeID: testEpi2.ID.String()})
+	require.Equal(t, nil, err)
+	eventLine, err = reader.ReadString('\n')
+	if err != nil {
+		t.Fatalf("Test_Events() error reading event: %v", err)
+	}
+	// skip the blank line ending the previous event
+	if eventLine == "\n" {
+		eventLine, err = reader.ReadString('\n')
+		if err != nil {
+			t.Fatalf("Test_Events() error reading event: %v", err)
+		}
+	}
+	require.Equal(t, "event: playback_changed\n", eventLine)
+}
@@ -0,0 +1,143 @@
+package twirp
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/sschwartz96/syncapod-backend/internal/db"
+	protos "github.com/sschwartz96/syncapod-backend/internal/gen"
+	"github.com/twitchtv/twirp"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// defaultTopPodcasts is the amount of top podcasts returned by GetStats when not requested
+const defaultTopPodcasts = 10
+
+var statsBuckets = map[protos.StatsBucket]db.StatsBucket{
+	protos.StatsBucket_DAY:   db.BucketDay,
+	protos.StatsBucket_WEEK:  db.BucketWeek,
+	protos.StatsBucket_MONTH: db.BucketMonth,
+}
+
+// AddListeningSession appends a listening session to the user's history
+func (p *PodcastService) AddListeningSession(ctx context.Context, req *protos.AddListeningSessionReq) (*protos.Response, error) {
+	userID, err := getUserIDFromContext(ctx)
+	if err != nil {
+		return nil, twirp.Unauthenticated.Error("User is not authenticated")
+	}
+	s := req.Session
+	if s == nil || s.Started == nil || s.Ended == nil {
+		return nil, twirp.InvalidArgument.Error("Session with started and ended times is required")
+	}
+	epiID, err := uuid.Parse(s.EpisodeID)
+	if err != nil {
+		return nil, twirp.InvalidArgument.Error("Could not parse episode UUID")
+	}
+	if s.Ended.AsTime().Before(s.Started.AsTime()) || s.StartOffset < 0 || s.EndOffset < 0 {
+		return nil, twirp.InvalidArgument.Error("Session ends before it starts")
+	}
+	if _, err = p.podCon.FindEpisodeByID(ctx, epiID); err != nil {
+		return nil, twirp.NotFound.Errorf("Could not find episode: %w", err)
+	}
+	err = p.podCon.InsertListeningSession(ctx, &db.ListeningSession{
+		UserID:            userID,
+		EpisodeID:         epiID,
+		StartOffsetMillis: s.StartOffset,
+		EndOffsetMillis:   s.EndOffset,
+		Started:           s.Started.AsTime(),
+		Ended:             s.Ended.AsTime(),
+		Device:            s.Device,
+	})
+	if err != nil {
+		return nil, twirp.Internal.Errorf("Could not add listening session: %w", err)
+	}
+	return &protos.Response{Success: true, Message: ""}, nil
+}
+
+// GetHistory returns the user's listening sessions most recent first
+func (p *PodcastService) GetHistory(ctx context.Context, req *protos.GetHistoryReq) (*protos.History, error) {
+	userID, err := getUserIDFromContext(ctx)
+	if err != nil {
+		return nil, twirp.Unauthenticated.Error("User is not authenticated")
+	}
+	if req.End <= req.Start {
+		req.End = req.Start + 10
+	}
+	sessions, err := p.podCon.FindHistory(ctx, userID, req.Start, req.End)
+	if err != nil {
+		return nil, twirp.Internal.Errorf("Could not find history: %w", err)
+	}
+	epiIDs := []uuid.UUID{}
+	seen := map[uuid.UUID]bool{}
+	protoSessions := make([]*protos.ListeningSession, len(sessions))
+	for i := range sessions {
+		protoSessions[i] = convertSessionFromDB(&sessions[i])
+		if !seen[sessions[i].EpisodeID] {
+			seen[sessions[i].EpisodeID] = true
+			epiIDs = append(epiIDs, sessions[i].EpisodeID)
+		}
+	}
+	epis, err := p.podCon.FindEpisodesByIDs(ctx, epiIDs)
+	if err != nil {
+		return nil, twirp.Internal.Errorf("Could not find history episodes: %w", err)
+	}
+	return &protos.History{Sessions: protoSessions, Episodes: convertEpisFromDB(epis)}, nil
+}
+
+// GetStats returns the user's listening statistics within a period
+func (p *PodcastService) GetStats(ctx context.Context, req *protos.GetStatsReq) (*protos.Stats, error) {
+	userID, err := getUserIDFromContext(ctx)
+	if err != nil {
+		return nil, twirp.Unauthenticated.Error("User is not authenticated")
+	}
+	bucket, ok := statsBuckets[req.Bucket]
+	if !ok {
+		return nil, twirp.InvalidArgument.Errorf("Unknown stats bucket: %v", req.Bucket)
+	}
+	timezone := req.Timezone
+	if timezone == "" {
+		timezone = "UTC"
+	}
+	if _, err = time.LoadLocation(timezone); err != nil {
+		return nil, twirp.InvalidArgument.Errorf("Unknown timezone: %s", timezone)
+	}
+	to := time.Now()
+	if req.To != nil {
+		to = req.To.AsTime()
+	}
+	var from time.Time
+	if req.From != nil {
+		from = req.From.AsTime()
+	}
+	topPodcasts := int(req.TopPodcasts)
+	if topPodcasts <= 0 {
+		topPodcasts = defaultTopPodcasts
+	}
+
+	stats, err := p.podCon.GetStats(ctx, userID, bucket, from, to, timezone, topPodcasts)
+	if err != nil {
+		return nil, twirp.Internal.Errorf("Could not compute stats: %w", err)
+	}
+	res := &protos.Stats{
+		Totals:            make([]*protos.ListeningTotal, len(stats.Totals)),
+		TotalMillis:       stats.TotalMillis,
+		TopPodcasts:       make([]*protos.PodcastListening, len(stats.TopPodcasts)),
+		EpisodesStarted:   stats.Completion.Started,
+		EpisodesCompleted: stats.Completion.Completed,
+	}
+	if stats.Completion.Started > 0 {
+		res.CompletionRate = float64(stats.Completion.Completed) / float64(stats.Completion.Started)
+	}
+	for i := range stats.Totals {
+		res.Totals[i] = &protos.ListeningTotal{Start: timestamppb.New(stats.Totals[i].Start), Millis: stats.Totals[i].Millis}
+	}
+	for i := range stats.TopPodcasts {
+		pod, err := convertPodFromDB(&stats.TopPodcasts[i].Podcast, p.podCon)
+		if err != nil {
+			return nil, twirp.Internal.Errorf("Error converting podcast model: %w", err)
+		}
+		res.TopPodcasts[i] = &protos.PodcastListening{Podcast: pod, Millis: stats.TopPodcasts[i].Millis}
+	}
+	return res, nil
+}
@@ -0,0 +1,162 @@
+package twirp
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/sschwartz96/syncapod-backend/internal/db"
+	protos "github.com/sschwartz96/syncapod-backend/internal/gen"
+	"github.com/sschwartz96/syncapod-backend/internal/podcast"
+	"github.com/twitchtv/twirp"
+)
+
+// CreatePlaylist creates a manual playlist of episodeIDs or a smart playlist of rules
+func (p *PodcastService) CreatePlaylist(ctx context.Context, req *protos.CreatePlaylistReq) (*protos.Playlist, error) {
+	userID, err := getUserIDFromContext(ctx)
+	if err != nil {
+		return nil, twirp.Unauthenticated.Error("User is not authenticated")
+	}
+	playlist, err := playlistFromReq(req.Name, req.Smart, req.Rules, req.EpisodeIDs)
+	if err != nil {
+		return nil, err
+	}
+	if err = p.validatePlaylist(ctx, playlist); err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	playlist.ID = uuid.New()
+	playlist.UserID = userID
+	playlist.Created = now
+	playlist.Updated = now
+	if err = p.podCon.InsertPlaylist(ctx, playlist); err != nil {
+		return nil, twirp.Internal.Errorf("Could not create playlist: %w", err)
+	}
+	return convertPlaylistFromDB(playlist), nil
+}
+
+// GetPlaylists returns the user's playlists
+func (p *PodcastService) GetPlaylists(ctx context.Context, req *protos.GetPlaylistsReq) (*protos.Playlists, error) {
+	userID, err := getUserIDFromContext(ctx)
+	if err != nil {
+		return nil, twirp.Unauthenticated.Error("User is not authenticated")
+	}
+	dbPlaylists, err := p.podCon.FindPlaylists(ctx, userID)
+	if err != nil {
+		return nil, twirp.Internal.Errorf("Could not find playlists: %w", err)
+	}
+	playlists := make([]*protos.Playlist, len(dbPlaylists))
+	for i := range dbPlaylists {
+		playlists[i] = convertPlaylistFromDB(&dbPlaylists[i])
+	}
+	return &protos.Playlists{Playlists: playlists}, nil
+}
+
+// UpdatePlaylist replaces the name, rules and episodes of the user's playlist
+func (p *PodcastService) UpdatePlaylist(ctx context.Context, req *protos.UpdatePlaylistReq) (*protos.Playlist, error) {
+	userID, err := getUserIDFromContext(ctx)
+	if err != nil {
+		return nil, twirp.Unauthenticated.Error("User is not authenticated")
+	}
+	id, err := uuid.Parse(req.Id)
+	if err != nil {
+		return nil, twirp.InvalidArgument.Error("Could not parse playlist UUID")
+	}
+	existing, err := p.podCon.FindPlaylist(ctx, userID, id)
+	if err != nil {
+		return nil, twirp.NotFound.Errorf("Could not find playlist: %w", err)
+	}
+	playlist, err := playlistFromReq(req.Name, existing.Smart, req.Rules, req.EpisodeIDs)
+	if err != nil {
+		return nil, err
+	}
+	if err = p.validatePlaylist(ctx, playlist); err != nil {
+		return nil, err
+	}
+	playlist.ID = existing.ID
+	playlist.UserID = userID
+	playlist.Created = existing.Created
+	playlist.Updated = time.Now()
+	if err = p.podCon.UpdatePlaylist(ctx, playlist); err != nil {
+		return nil, twirp.Internal.Errorf("Could not update playlist: %w", err)
+	}
+	return convertPlaylistFromDB(playlist), nil
+}
+
+// DeletePlaylist deletes the user's playlist
+func (p *PodcastService) DeletePlaylist(ctx context.Context, req *protos.DeletePlaylistReq) (*protos.Response, error) {
+	userID, err := getUserIDFromContext(ctx)
+	if err != nil {
+		return nil, twirp.Unauthenticated.Error("User is not authenticated")
+	}
+	id, err := uuid.Parse(req.Id)
+	if err != nil {
+		return nil, twirp.InvalidArgument.Error("Could not parse playlist UUID")
+	}
+	if err = p.podCon.DeletePlaylist(ctx, userID, id); err != nil {
+		return nil, twirp.NotFound.Errorf("Could not delete playlist: %w", err)
+	}
+	return &protos.Response{Success: true, Message: ""}, nil
+}
+
+// GetPlaylistEpisodes returns the episodes of the user's playlist
+func (p *PodcastService) GetPlaylistEpisodes(ctx context.Context, req *protos.GetPlaylistEpisodesReq) (*protos.Episodes, error) {
+	userID, err := getUserIDFromContext(ctx)
+	if err != nil {
+		return nil, twirp.Unauthenticated.Error("User is not authenticated")
+	}
+	id, err := uuid.Parse(req.Id)
+	if err != nil {
+		return nil, twirp.InvalidArgument.Error("Could not parse playlist UUID")
+	}
+	if req.End <= req.Start {
+		req.End = req.Start + 10
+	}
+	epis, err := p.podCon.GetPlaylistEpisodes(ctx, userID, id, req.Start, req.End)
+	if err != nil {
+		return nil, twirp.NotFound.Errorf("Could not find playlist episodes: %w", err)
+	}
+	return &protos.Episodes{Episodes: convertEpisFromDB(epis)}, nil
+}
+
+// validatePlaylist rejects playlists of unknown episodes or rule categories
+func (p *PodcastService) validatePlaylist(ctx context.Context, playlist *db.Playlist) error {
+	err := p.podCon.ValidatePlaylist(ctx, playlist)
+	if errors.Is(err, podcast.ErrUnknownCategory) {
+		return twirp.InvalidArgument.Error("Unknown category within playlist rules").WithMeta("argument", "rules")
+	}
+	if errors.Is(err, podcast.ErrUnknownEpisode) {
+		return twirp.InvalidArgument.Error("Unknown episode id").WithMeta("argument", "episodeIDs")
+	}
+	if err != nil {
+		return twirp.Internal.Errorf("Could not validate playlist: %w", err)
+	}
+	return nil
+}
+
+// playlistFromReq validates the fields of a create or update request
+func playlistFromReq(name string, smart bool, protoRules *protos.PlaylistRules, episodeIDs []string) (*db.Playlist, error) {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return nil, twirp.InvalidArgument.Error("Playlist name is required")
+	}
+	rules, err := convertRulesToDB(protoRules)
+	if err != nil {
+		return nil, twirp.InvalidArgument.Errorf("Invalid playlist rules: %w", err)
+	}
+	epiIDs, err := parseUUIDs(episodeIDs)
+	if err != nil {
+		return nil, twirp.InvalidArgument.Errorf("Invalid episode ids: %w", err)
+	}
+	if smart {
+		if rules == nil {
+			return nil, twirp.InvalidArgument.Error("Smart playlists require rules")
+		}
+		epiIDs = []uuid.UUID{}
+	} else {
+		rules = nil
+	}
+	return &db.Playlist{Name: name, Smart: smart, Rules: rules, EpisodeIDs: epiIDs}, nil
+}
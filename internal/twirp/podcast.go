@@ -2,8 +2,10 @@ package twirp
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log"
+	"time"
 
 	"github.com/golang/protobuf/ptypes"
 	"github.com/google/uuid"
@@ -13,6 +15,9 @@ import (
 	"github.com/twitchtv/twirp"
 )
 
+// maxBulkUserEpisodes limits the episodes of UpsertUserEpisodes()
+const maxBulkUserEpisodes = 500
+
 // PodcastService is the gRPC service for podcast
 type PodcastService struct {
 	podCon *podcast.PodController
@@ -75,7 +80,8 @@ func (p *PodcastService) GetUserEpisode(ctx context.Context, req *protos.GetUser
 }
 
 // UpsertUserEpisode updates the user playback metadata via episode id & user id
-func (p *PodcastService) UpsertUserEpisode(ctx context.Context, userEpiReq *protos.UserEpisode) (*protos.Response, error) {
+// updates older than the stored state are not applied, see db.UpsertUserEpisode()
+func (p *PodcastService) UpsertUserEpisode(ctx context.Context, userEpiReq *protos.UserEpisode) (*protos.UpsertUserEpiRes, error) {
 	userID, err := getUserIDFromContext(ctx)
 	if err != nil {
 		return nil, twirp.Unauthenticated.Error("User is not authenticated")
@@ -94,10 +100,113 @@ func (p *PodcastService) UpsertUserEpisode(ctx context.Context, userEpiReq *prot
 		LastSeen:     userEpiReq.LastSeen.AsTime(),
 		Played:       userEpiReq.Played,
 	}
-	err = p.podCon.UpsertUserEpisode(ctx, userEpi)
+	applied, err := p.podCon.UpdatePlayback(ctx, userEpi, userEpiReq.ResetPlayed, userEpiReq.Playing)
+	if errors.Is(err, podcast.ErrUnknownEpisode) {
+		return nil, twirp.NotFound.Error("Episode not found")
+	}
 	if err != nil {
 		return nil, twirp.Internal.Errorf("Error upserting UserEpisode: %w", err)
 	}
+	message := ""
+	if !applied {
+		message = "a newer update has already been applied"
+	}
+	return &protos.UpsertUserEpiRes{Applied: applied, Message: message, UserEpisode: convertUserEpiFromDB(userEpi)}, nil
+}
+
+// UpsertUserEpisodes updates the playback metadata of many episodes in one request, see UpsertUserEpisode()
+func (p *PodcastService) UpsertUserEpisodes(ctx context.Context, req *protos.UpsertUserEpisodesReq) (*protos.UpsertUserEpisodesRes, error) {
+	userID, err := getUserIDFromContext(ctx)
+	if err != nil {
+		return nil, twirp.Unauthenticated.Error("User is not authenticated")
+	}
+	if len(req.UserEpisodes) > maxBulkUserEpisodes {
+		return nil, twirp.InvalidArgument.Errorf("Cannot upsert more than %d episodes at once", maxBulkUserEpisodes)
+	}
+	userEpis := make([]db.UserEpisode, len(req.UserEpisodes))
+	resetPlayed := make([]bool, len(req.UserEpisodes))
+	seen := make(map[uuid.UUID]bool, len(req.UserEpisodes))
+	for i, userEpiReq := range req.UserEpisodes {
+		epiID, err := uuid.Parse(userEpiReq.EpisodeID)
+		if err != nil {
+			return nil, twirp.InvalidArgument.Errorf("Could not parse episode UUID: %v", userEpiReq.EpisodeID)
+		}
+		if seen[epiID] {
+			return nil, twirp.InvalidArgument.Errorf("Duplicate episode: %v", epiID)
+		}
+		seen[epiID] = true
+		if userEpiReq.LastSeen == nil {
+			userEpiReq.LastSeen = ptypes.TimestampNow()
+		}
+		userEpis[i] = db.UserEpisode{
+			UserID:       userID,
+			EpisodeID:    epiID,
+			OffsetMillis: userEpiReq.Offset,
+			LastSeen:     userEpiReq.LastSeen.AsTime(),
+			Played:       userEpiReq.Played,
+		}
+		resetPlayed[i] = userEpiReq.ResetPlayed
+	}
+	applied, err := p.podCon.UpdatePlaybacks(ctx, userID, userEpis, resetPlayed)
+	if errors.Is(err, podcast.ErrUnknownEpisode) {
+		return nil, twirp.InvalidArgument.Error("Unknown episode").WithMeta("argument", "userEpisodes")
+	}
+	if err != nil {
+		return nil, twirp.Internal.Errorf("Error upserting UserEpisodes: %w", err)
+	}
+	results := make([]*protos.UpsertUserEpiRes, len(userEpis))
+	for i := range userEpis {
+		message := ""
+		if !applied[i] {
+			message = "a newer update has already been applied"
+		}
+		results[i] = &protos.UpsertUserEpiRes{Applied: applied[i], Message: message, UserEpisode: convertUserEpiFromDB(&userEpis[i])}
+	}
+	return &protos.UpsertUserEpisodesRes{Results: results}, nil
+}
+
+// MarkPodcastPlayed marks the episodes of a podcast as played, optionally only those published before a date
+func (p *PodcastService) MarkPodcastPlayed(ctx context.Context, req *protos.MarkPodcastPlayedReq) (*protos.MarkPodcastPlayedRes, error) {
+	userID, err := getUserIDFromContext(ctx)
+	if err != nil {
+		return nil, twirp.Unauthenticated.Error("User is not authenticated")
+	}
+	podID, err := uuid.Parse(req.PodcastID)
+	if err != nil {
+		return nil, twirp.InvalidArgument.Error("Could not parse podcast UUID")
+	}
+	var before *time.Time
+	if req.Before != nil {
+		t := req.Before.AsTime()
+		before = &t
+	}
+	updated, err := p.podCon.MarkPlayed(ctx, userID, podID, before)
+	if errors.Is(err, podcast.ErrUnknownPodcast) {
+		return nil, twirp.NotFound.Error("Podcast not found")
+	}
+	if err != nil {
+		return nil, twirp.Internal.Errorf("Could not mark podcast played: %w", err)
+	}
+	return &protos.MarkPodcastPlayedRes{Updated: updated}, nil
+}
+
+// MarkEpisodeUnplayed resets the user's playback of an episode
+func (p *PodcastService) MarkEpisodeUnplayed(ctx context.Context, req *protos.MarkEpisodeUnplayedReq) (*protos.Response, error) {
+	userID, err := getUserIDFromContext(ctx)
+	if err != nil {
+		return nil, twirp.Unauthenticated.Error("User is not authenticated")
+	}
+	epiID, err := uuid.Parse(req.EpisodeID)
+	if err != nil {
+		return nil, twirp.InvalidArgument.Error("Could not parse episode UUID")
+	}
+	err = p.podCon.MarkUnplayed(ctx, userID, epiID)
+	if errors.Is(err, podcast.ErrUnknownEpisode) {
+		return nil, twirp.NotFound.Error("Episode not found")
+	}
+	if err != nil {
+		return nil, twirp.Internal.Errorf("Could not mark episode unplayed: %w", err)
+	}
 	return &protos.Response{Success: true, Message: ""}, nil
 }
 
@@ -116,6 +225,94 @@ func (p *PodcastService) GetSubscriptions(ctx context.Context, req *protos.GetSu
 	return &protos.Subscriptions{Subscriptions: convertSubFromDB(subs)}, nil
 }
 
+// ListCategories returns the full category tree
+func (p *PodcastService) ListCategories(ctx context.Context, req *protos.ListCategoriesReq) (*protos.Categories, error) {
+	return &protos.Categories{Categories: podCatsToProtoCats(p.podCon.ListCategories())}, nil
+}
+
+// BrowseCategory returns a list of podcasts within a category and its subcategories
+func (p *PodcastService) BrowseCategory(ctx context.Context, req *protos.BrowseCategoryReq) (*protos.Podcasts, error) {
+	sortBy, ok := podcastSorts[req.Sort]
+	if !ok {
+		return nil, twirp.InvalidArgument.Errorf("Unknown sort order: %v", req.Sort)
+	}
+	if req.End == 0 {
+		req.End = 10
+	}
+	dbPods, err := p.podCon.BrowseCategory(ctx, int(req.Id), sortBy, req.Start, req.End)
+	if errors.Is(err, podcast.ErrUnknownCategory) {
+		return nil, twirp.InvalidArgument.Error("Unknown category").WithMeta("argument", "id")
+	}
+	if err != nil {
+		return nil, twirp.Internal.Errorf("Could not browse category: %w", err)
+	}
+	pods, err := convertPodsFromDB(p.podCon, dbPods)
+	if err != nil {
+		return nil, twirp.Internal.Errorf("Error converting podcast models: %w", err)
+	}
+	return &protos.Podcasts{Podcasts: pods}, nil
+}
+
+// GetCharts returns the ranked podcasts of a popularity chart
+func (p *PodcastService) GetCharts(ctx context.Context, req *protos.GetChartsReq) (*protos.Podcasts, error) {
+	chart, ok := chartTypes[req.Type]
+	if !ok {
+		return nil, twirp.InvalidArgument.Errorf("Unknown chart type: %v", req.Type)
+	}
+	if req.End == 0 {
+		req.End = 10
+	}
+	dbPods, err := p.podCon.GetChart(ctx, chart, int(req.CategoryID), req.Language, req.Start, req.End)
+	if err != nil {
+		return nil, twirp.Internal.Errorf("Could not get chart: %w", err)
+	}
+	pods, err := convertPodsFromDB(p.podCon, dbPods)
+	if err != nil {
+		return nil, twirp.Internal.Errorf("Error converting podcast models: %w", err)
+	}
+	return &protos.Podcasts{Podcasts: pods}, nil
+}
+
+// GetRecommendations returns a list of podcasts recommended to the user
+func (p *PodcastService) GetRecommendations(ctx context.Context, req *protos.GetRecommendationsReq) (*protos.Podcasts, error) {
+	userID, err := getUserIDFromContext(ctx)
+	if err != nil {
+		return nil, twirp.Unauthenticated.Error("User is not authenticated")
+	}
+	if req.End == 0 {
+		req.End = 10
+	}
+	dbPods, err := p.podCon.GetRecommendations(ctx, userID, req.Start, req.End)
+	if err != nil {
+		return nil, twirp.Internal.Errorf("Could not get recommendations: %w", err)
+	}
+	pods, err := convertPodsFromDB(p.podCon, dbPods)
+	if err != nil {
+		return nil, twirp.Internal.Errorf("Error converting podcast models: %w", err)
+	}
+	return &protos.Podcasts{Podcasts: pods}, nil
+}
+
+// GetSimilarPodcasts returns a list of podcasts similar to the podcast
+func (p *PodcastService) GetSimilarPodcasts(ctx context.Context, req *protos.GetSimilarPodcastsReq) (*protos.Podcasts, error) {
+	podID, err := uuid.Parse(req.Id)
+	if err != nil {
+		return nil, twirp.InvalidArgument.Error("Could not parse podcast UUID")
+	}
+	if req.End == 0 {
+		req.End = 10
+	}
+	dbPods, err := p.podCon.FindSimilarPodcasts(ctx, podID, req.Start, req.End)
+	if err != nil {
+		return nil, twirp.Internal.Errorf("Could not find similar podcasts: %w", err)
+	}
+	pods, err := convertPodsFromDB(p.podCon, dbPods)
+	if err != nil {
+		return nil, twirp.Internal.Errorf("Error converting podcast models: %w", err)
+	}
+	return &protos.Podcasts{Podcasts: pods}, nil
+}
+
 // GetUserLastPlayed returns the last episode the user was playing & metadata
 func (p *PodcastService) GetUserLastPlayed(ctx context.Context, req *protos.GetUserLastPlayedReq) (*protos.LastPlayedRes, error) {
 	userID, err := getUserIDFromContext(ctx)
@@ -146,3 +343,12 @@ func getUserIDFromContext(ctx context.Context) (uuid.UUID, error) {
 
 	return userData.user.ID, nil
 }
+
+// getSessionIDFromContext returns the session id the request is authenticated with
+func getSessionIDFromContext(ctx context.Context) (uuid.UUID, error) {
+	userData, ok := ctx.Value(twirpHeaderKey{}).(twirpCtxData)
+	if !ok {
+		return uuid.UUID{}, fmt.Errorf("getSessionIDFromContext() error could not extract data from context")
+	}
+	return userData.sessionID, nil
+}
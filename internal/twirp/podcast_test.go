@@ -3,6 +3,7 @@ package twirp
 
 import (
 	"context"
+	"crypto/sha256"
 	"fmt"
 	"log"
 	"net/http"
@@ -14,6 +15,7 @@ import (
 	protos "github.com/sschwartz96/syncapod-backend/internal/gen"
 	"github.com/stretchr/testify/require"
 	"github.com/twitchtv/twirp"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 var (
@@ -23,11 +25,17 @@ var (
 	testEpi     = &db.Episode{ID: uuid.New(), PodcastID: testPod.ID, Title: "Test Episode", Episode: 123, PubDate: time.Unix(1000, 0)}
 	testEpi2    = &db.Episode{ID: uuid.New(), PodcastID: testPod.ID, Title: "Test Episode 2", Episode: 124, PubDate: time.Unix(1001, 0)}
 	testUserEpi = &db.UserEpisode{EpisodeID: testEpi.ID, UserID: testUser.ID, LastSeen: time.Now(), OffsetMillis: 123456, Played: false}
-	testSub     = &db.Subscription{UserID: testUser.ID, PodcastID: testPod.ID, CompletedIDs: []uuid.UUID{testEpi.ID}, InProgressIDs: []uuid.UUID{testEpi2.ID}}
-	testSub2    = &db.Subscription{UserID: testUser.ID, PodcastID: testPod2.ID, CompletedIDs: []uuid.UUID{}, InProgressIDs: []uuid.UUID{}}
-	testSesh    = &db.SessionRow{ID: uuid.New(), UserID: testUser.ID, LoginTime: time.Now(), LastSeenTime: time.Now(), Expires: time.Now().Add(time.Hour), UserAgent: "testUserAgent"}
+	testSub     = &db.Subscription{UserID: testUser.ID, PodcastID: testPod.ID}
+	testSub2    = &db.Subscription{UserID: testUser.ID, PodcastID: testPod2.ID}
+	testSesh    = &db.SessionRow{ID: uuid.New(), UserID: testUser.ID, LoginTime: time.Now(), LastSeenTime: time.Now(), Expires: time.Now().Add(time.Hour), UserAgent: "testUserAgent", Key: "testSessionKey", KeyHash: sessionKeyHash("testSessionKey")}
 )
 
+// sessionKeyHash is the digest stored for a session key
+func sessionKeyHash(key string) []byte {
+	sum := sha256.Sum256([]byte(key))
+	return sum[:]
+}
+
 func setupPodDB() error {
 	// for podcast_test
 	var err error
@@ -50,7 +58,7 @@ func setupPodDB() error {
 	if err = podStore.InsertSubscription(context.Background(), testSub2); err != nil {
 		return fmt.Errorf("failed to insert sub: %v", err)
 	}
-	if err = podStore.UpsertUserEpisode(context.Background(), testUserEpi); err != nil {
+	if _, err = podStore.UpsertUserEpisode(context.Background(), testUserEpi, false); err != nil {
 		return fmt.Errorf("failed to insert user episode: %v", err)
 	}
 	// insert user session to mimic user already authenticated
@@ -64,7 +72,7 @@ func setupPodDB() error {
 func Test_PodcastGRPC(t *testing.T) {
 	// add metadata for authorization
 	header := make(http.Header)
-	header.Set(authTokenKey, testSesh.ID.String())
+	header.Set(authTokenKey, testSesh.Key)
 	ctx, err := twirp.WithHTTPRequestHeaders(context.Background(), header)
 	if err != nil {
 		t.Fatalf("Twirp could not add add headers: %v", err)
@@ -112,11 +120,233 @@ func Test_PodcastGRPC(t *testing.T) {
 	}
 	require.Equal(t, nil, err)
 	require.NotEqual(t, nil, res)
+	require.True(t, res.Applied)
+	// stale update
+	staleEpi := &protos.UserEpisode{EpisodeID: userEpi.EpisodeID, Offset: 1,
+		LastSeen: timestamppb.New(userEpi.LastSeen.AsTime().Add(-time.Hour))}
+	res, err = client.UpsertUserEpisode(ctx, staleEpi)
+	require.Equal(t, nil, err)
+	require.False(t, res.Applied)
+	require.Equal(t, int64(9999), res.UserEpisode.Offset)
+	// unknown episode
+	_, err = client.UpsertUserEpisode(ctx, &protos.UserEpisode{EpisodeID: uuid.New().String(), Offset: 1})
+	require.Equal(t, twirp.NotFound, err.(twirp.Error).Code())
 
 	// GetSubscriptions
 	subs, err := client.GetSubscriptions(ctx, &protos.GetSubReq{})
 	require.Equal(t, nil, err)
 	require.NotEmpty(t, subs.Subscriptions)
+	// testPod has 2 episodes
+	sub := subs.Subscriptions[0]
+	require.Equal(t, testPod.ID.String(), sub.PodcastID)
+	require.Equal(t, int64(2), sub.Unplayed+sub.InProgress+sub.Completed)
+
+	// ListCategories
+	cats, err := client.ListCategories(ctx, &protos.ListCategoriesReq{})
+	require.Equal(t, nil, err)
+	require.NotEmpty(t, cats.Categories)
+	require.Equal(t, int32(1), cats.Categories[0].Id)
+	require.NotEmpty(t, cats.Categories[0].Category)
+
+	// BrowseCategory
+	browsePods, err := client.BrowseCategory(ctx, &protos.BrowseCategoryReq{Id: 1, Sort: protos.PodcastSort_TITLE})
+	require.Equal(t, nil, err)
+	require.Equal(t, 2, len(browsePods.Podcasts))
+	_, err = client.BrowseCategory(ctx, &protos.BrowseCategoryReq{Id: 9999})
+	require.NotNil(t, err)
+	require.Equal(t, twirp.InvalidArgument, err.(twirp.Error).Code())
+
+	// GetCharts
+	charts, err := client.GetCharts(ctx, &protos.GetChartsReq{Type: protos.ChartType_TRENDING, CategoryID: 1})
+	require.Equal(t, nil, err)
+	require.NotNil(t, charts)
+
+	// GetRecommendations
+	recs, err := client.GetRecommendations(ctx, &protos.GetRecommendationsReq{})
+	require.Equal(t, nil, err)
+	require.NotNil(t, recs)
+
+	// GetSimilarPodcasts
+	similar, err := client.GetSimilarPodcasts(ctx, &protos.GetSimilarPodcastsReq{Id: testPod.ID.String()})
+	require.Equal(t, nil, err)
+	require.NotNil(t, similar)
+
+	// AddToQueue
+	queue, err := client.AddToQueue(ctx, &protos.AddToQueueReq{EpisodeID: testEpi.ID.String()})
+	require.Equal(t, nil, err)
+	queue, err = client.AddToQueue(ctx, &protos.AddToQueueReq{EpisodeID: testEpi2.ID.String(), Next: true})
+	require.Equal(t, nil, err)
+	require.Equal(t, 2, len(queue.Episodes))
+	require.Equal(t, testEpi2.ID.String(), queue.Episodes[0].Id)
+
+	// ReorderQueue
+	queue, err = client.ReorderQueue(ctx, &protos.ReorderQueueReq{EpisodeIDs: []string{testEpi.ID.String(), testEpi2.ID.String()}})
+	require.Equal(t, nil, err)
+	require.Equal(t, testEpi.ID.String(), queue.Episodes[0].Id)
+
+	// RemoveFromQueue
+	queue, err = client.RemoveFromQueue(ctx, &protos.RemoveFromQueueReq{EpisodeID: testEpi.ID.String()})
+	require.Equal(t, nil, err)
+	require.Equal(t, 1, len(queue.Episodes))
+
+	// SetAutoQueue
+	_, err = client.SetAutoQueue(ctx, &protos.SetAutoQueueReq{PodcastID: testPod.ID.String(), Enabled: true})
+	require.Equal(t, nil, err)
+
+	// ClearQueue
+	_, err = client.ClearQueue(ctx, &protos.ClearQueueReq{})
+	require.Equal(t, nil, err)
+	queue, err = client.GetQueue(ctx, &protos.GetQueueReq{})
+	require.Equal(t, nil, err)
+	require.Empty(t, queue.Episodes)
+
+	// CreatePlaylist
+	playlist, err := client.CreatePlaylist(ctx, &protos.CreatePlaylistReq{Name: "Test Playlist", EpisodeIDs: []string{testEpi.ID.String()}})
+	require.Equal(t, nil, err)
+	_, err = client.CreatePlaylist(ctx, &protos.CreatePlaylistReq{Name: "Smart", Smart: true})
+	require.NotNil(t, err)
+	smart, err := client.CreatePlaylist(ctx, &protos.CreatePlaylistReq{Name: "Smart", Smart: true,
+		Rules: &protos.PlaylistRules{PodcastIDs: []string{testPod.ID.String()}}})
+	require.Equal(t, nil, err)
+	_, err = client.CreatePlaylist(ctx, &protos.CreatePlaylistReq{Name: "Unknown", EpisodeIDs: []string{uuid.New().String()}})
+	require.Equal(t, twirp.InvalidArgument, err.(twirp.Error).Code())
+	_, err = client.CreatePlaylist(ctx, &protos.CreatePlaylistReq{Name: "Unknown", Smart: true,
+		Rules: &protos.PlaylistRules{Categories: []int32{9999}}})
+	require.Equal(t, twirp.InvalidArgument, err.(twirp.Error).Code())
+
+	// UpdatePlaylist
+	playlist, err = client.UpdatePlaylist(ctx, &protos.UpdatePlaylistReq{Id: playlist.Id, Name: "Renamed",
+		EpisodeIDs: []string{testEpi2.ID.String(), testEpi.ID.String()}})
+	require.Equal(t, nil, err)
+	require.Equal(t, "Renamed", playlist.Name)
+
+	// GetPlaylists
+	playlists, err := client.GetPlaylists(ctx, &protos.GetPlaylistsReq{})
+	require.Equal(t, nil, err)
+	require.Equal(t, 2, len(playlists.Playlists))
+
+	// GetPlaylistEpisodes
+	playlistEpis, err := client.GetPlaylistEpisodes(ctx, &protos.GetPlaylistEpisodesReq{Id: playlist.Id, End: 10})
+	require.Equal(t, nil, err)
+	require.Equal(t, testEpi2.ID.String(), playlistEpis.Episodes[0].Id)
+	playlistEpis, err = client.GetPlaylistEpisodes(ctx, &protos.GetPlaylistEpisodesReq{Id: smart.Id, End: 10})
+	require.Equal(t, nil, err)
+	require.Equal(t, 2, len(playlistEpis.Episodes))
+
+	// DeletePlaylist
+	_, err = client.DeletePlaylist(ctx, &protos.DeletePlaylistReq{Id: smart.Id})
+	require.Equal(t, nil, err)
+
+	// Sync
+	syncRes, err := client.Sync(ctx, &protos.SyncReq{})
+	require.Equal(t, nil, err)
+	require.True(t, syncRes.Full)
+	require.NotEmpty(t, syncRes.Token)
+	require.NotEmpty(t, syncRes.Subscriptions)
+	syncRes, err = client.Sync(ctx, &protos.SyncReq{Token: syncRes.Token})
+	require.Equal(t, nil, err)
+	require.False(t, syncRes.Full)
+	require.Empty(t, syncRes.Subscriptions)
+	_, err = client.Sync(ctx, &protos.SyncReq{Token: "not a token"})
+	require.NotNil(t, err)
+
+	// AddListeningSession
+	_, err = client.AddListeningSession(ctx, &protos.AddListeningSessionReq{Session: &protos.ListeningSession{
+		EpisodeID: testEpi.ID.String(), StartOffset: 0, EndOffset: 60000, Device: "test",
+		Started: timestamppb.New(time.Now().Add(-time.Minute)), Ended: timestamppb.Now()}})
+	require.Equal(t, nil, err)
+	_, err = client.AddListeningSession(ctx, &protos.AddListeningSessionReq{})
+	require.NotNil(t, err)
+
+	// GetHistory
+	history, err := client.GetHistory(ctx, &protos.GetHistoryReq{})
+	require.Equal(t, nil, err)
+	require.Equal(t, 1, len(history.Sessions))
+	require.Equal(t, testEpi.ID.String(), history.Episodes[0].Id)
+
+	// GetStats
+	stats, err := client.GetStats(ctx, &protos.GetStatsReq{Bucket: protos.StatsBucket_WEEK, Timezone: "America/Chicago"})
+	require.Equal(t, nil, err)
+	require.Equal(t, int64(60000), stats.TotalMillis)
+	require.Equal(t, 1, len(stats.TopPodcasts))
+	_, err = client.GetStats(ctx, &protos.GetStatsReq{Timezone: "Not/AZone"})
+	require.NotNil(t, err)
+
+	// CreateBookmark
+	bookmark, err := client.CreateBookmark(ctx, &protos.CreateBookmarkReq{EpisodeID: testEpi.ID.String(), Offset: 30000, Note: "note"})
+	require.Equal(t, nil, err)
+	_, err = client.CreateBookmark(ctx, &protos.CreateBookmarkReq{EpisodeID: testEpi.ID.String(), Offset: -1})
+	require.NotNil(t, err)
+
+	// GetBookmarks
+	bookmarks, err := client.GetBookmarks(ctx, &protos.GetBookmarksReq{EpisodeID: testEpi.ID.String()})
+	require.Equal(t, nil, err)
+	require.Equal(t, 1, len(bookmarks.Bookmarks))
+	require.Equal(t, "note", bookmarks.Bookmarks[0].Note)
+
+	// DeleteBookmark
+	_, err = client.DeleteBookmark(ctx, &protos.DeleteBookmarkReq{Id: bookmark.Id})
+	require.Equal(t, nil, err)
+	_, err = client.DeleteBookmark(ctx, &protos.DeleteBookmarkReq{Id: bookmark.Id})
+	require.NotNil(t, err)
+
+	// CreateClip
+	clip, err := client.CreateClip(ctx, &protos.CreateClipReq{EpisodeID: testEpi.ID.String(), StartOffset: 1000, EndOffset: 31000, Title: "clip"})
+	require.Equal(t, nil, err)
+	require.NotEmpty(t, clip.SharePath)
+	_, err = client.CreateClip(ctx, &protos.CreateClipReq{EpisodeID: testEpi.ID.String(), StartOffset: 31000, EndOffset: 1000})
+	require.NotNil(t, err)
+
+	// GetClips
+	clips, err := client.GetClips(ctx, &protos.GetClipsReq{})
+	require.Equal(t, nil, err)
+	require.Equal(t, 1, len(clips.Clips))
+
+	// DeleteClip
+	_, err = client.DeleteClip(ctx, &protos.DeleteClipReq{Id: clip.Id})
+	require.Equal(t, nil, err)
+
+	// UpdatePodcastSettings
+	_, err = client.UpdatePodcastSettings(ctx, &protos.PodcastSettings{PodcastID: testPod2.ID.String(), PlaybackSpeed: 1.5, SkipIntroSeconds: 30})
+	require.Equal(t, nil, err)
+	_, err = client.UpdatePodcastSettings(ctx, &protos.PodcastSettings{PodcastID: testPod2.ID.String(), PlaybackSpeed: 10})
+	require.NotNil(t, err)
+
+	// GetPodcastSettings
+	settings, err := client.GetPodcastSettings(ctx, &protos.GetPodcastSettingsReq{PodcastID: testPod2.ID.String()})
+	require.Equal(t, nil, err)
+	require.Equal(t, int32(30), settings.SkipIntroSeconds)
+	settings, err = client.GetPodcastSettings(ctx, &protos.GetPodcastSettingsReq{PodcastID: testPod.ID.String()})
+	require.Equal(t, nil, err)
+	require.Equal(t, float32(1), settings.PlaybackSpeed)
+
+	// UpsertUserEpisodes
+	bulkRes, err := client.UpsertUserEpisodes(ctx, &protos.UpsertUserEpisodesReq{UserEpisodes: []*protos.UserEpisode{
+		{EpisodeID: testEpi2.ID.String(), Offset: 1000, LastSeen: timestamppb.Now()},
+		{EpisodeID: testEpi.ID.String(), Offset: 1, LastSeen: timestamppb.New(time.Unix(0, 0))},
+	}})
+	require.Equal(t, nil, err)
+	require.True(t, bulkRes.Results[0].Applied)
+	require.False(t, bulkRes.Results[1].Applied)
+	_, err = client.UpsertUserEpisodes(ctx, &protos.UpsertUserEpisodesReq{UserEpisodes: []*protos.UserEpisode{
+		{EpisodeID: testEpi2.ID.String()}, {EpisodeID: testEpi2.ID.String()}}})
+	require.NotNil(t, err)
+	_, err = client.UpsertUserEpisodes(ctx, &protos.UpsertUserEpisodesReq{UserEpisodes: []*protos.UserEpisode{
+		{EpisodeID: testEpi2.ID.String()}, {EpisodeID: uuid.New().String()}}})
+	require.Equal(t, twirp.InvalidArgument, err.(twirp.Error).Code())
+
+	// MarkEpisodeUnplayed
+	_, err = client.MarkEpisodeUnplayed(ctx, &protos.MarkEpisodeUnplayedReq{EpisodeID: testEpi2.ID.String()})
+	require.Equal(t, nil, err)
+	_, err = client.MarkEpisodeUnplayed(ctx, &protos.MarkEpisodeUnplayedReq{EpisodeID: uuid.New().String()})
+	require.Equal(t, twirp.NotFound, err.(twirp.Error).Code())
+
+	// MarkPodcastPlayed
+	markRes, err := client.MarkPodcastPlayed(ctx, &protos.MarkPodcastPlayedReq{PodcastID: testPod2.ID.String()})
+	require.Equal(t, nil, err)
+	require.Equal(t, int64(0), markRes.Updated)
+	_, err = client.MarkPodcastPlayed(ctx, &protos.MarkPodcastPlayedReq{PodcastID: uuid.New().String()})
+	require.Equal(t, twirp.NotFound, err.(twirp.Error).Code())
 
 	// GetUserLastPlayed
 	lastPlayRes, err := client.GetUserLastPlayed(ctx, &protos.GetUserLastPlayedReq{})
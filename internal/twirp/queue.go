@@ -0,0 +1,109 @@
+package twirp
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	protos "github.com/sschwartz96/syncapod-backend/internal/gen"
+	"github.com/twitchtv/twirp"
+)
+
+// GetQueue returns the user's queue of episodes in order
+func (p *PodcastService) GetQueue(ctx context.Context, req *protos.GetQueueReq) (*protos.Episodes, error) {
+	userID, err := getUserIDFromContext(ctx)
+	if err != nil {
+		return nil, twirp.Unauthenticated.Error("User is not authenticated")
+	}
+	return p.queue(ctx, userID)
+}
+
+// AddToQueue adds an episode to the end, or front via next, of the user's queue
+func (p *PodcastService) AddToQueue(ctx context.Context, req *protos.AddToQueueReq) (*protos.Episodes, error) {
+	userID, err := getUserIDFromContext(ctx)
+	if err != nil {
+		return nil, twirp.Unauthenticated.Error("User is not authenticated")
+	}
+	epiID, err := uuid.Parse(req.EpisodeID)
+	if err != nil {
+		return nil, twirp.InvalidArgument.Error("Could not parse episode UUID")
+	}
+	if _, err = p.podCon.FindEpisodeByID(ctx, epiID); err != nil {
+		return nil, twirp.NotFound.Errorf("Could not find episode: %w", err)
+	}
+	if err = p.podCon.InsertQueueItem(ctx, userID, epiID, req.Next); err != nil {
+		return nil, twirp.Internal.Errorf("Could not add episode to queue: %w", err)
+	}
+	p.podCon.PublishQueueChanged(userID)
+	return p.queue(ctx, userID)
+}
+
+// RemoveFromQueue removes an episode from the user's queue
+func (p *PodcastService) RemoveFromQueue(ctx context.Context, req *protos.RemoveFromQueueReq) (*protos.Episodes, error) {
+	userID, err := getUserIDFromContext(ctx)
+	if err != nil {
+		return nil, twirp.Unauthenticated.Error("User is not authenticated")
+	}
+	epiID, err := uuid.Parse(req.EpisodeID)
+	if err != nil {
+		return nil, twirp.InvalidArgument.Error("Could not parse episode UUID")
+	}
+	if err = p.podCon.DeleteQueueItem(ctx, userID, epiID); err != nil {
+		return nil, twirp.Internal.Errorf("Could not remove episode from queue: %w", err)
+	}
+	p.podCon.PublishQueueChanged(userID)
+	return p.queue(ctx, userID)
+}
+
+// ReorderQueue orders the user's queue by the given episode ids
+func (p *PodcastService) ReorderQueue(ctx context.Context, req *protos.ReorderQueueReq) (*protos.Episodes, error) {
+	userID, err := getUserIDFromContext(ctx)
+	if err != nil {
+		return nil, twirp.Unauthenticated.Error("User is not authenticated")
+	}
+	epiIDs, err := parseUUIDs(req.EpisodeIDs)
+	if err != nil {
+		return nil, twirp.InvalidArgument.Errorf("Invalid episode ids: %w", err)
+	}
+	if err = p.podCon.ReorderQueue(ctx, userID, epiIDs); err != nil {
+		return nil, twirp.Internal.Errorf("Could not reorder queue: %w", err)
+	}
+	p.podCon.PublishQueueChanged(userID)
+	return p.queue(ctx, userID)
+}
+
+// ClearQueue removes every episode from the user's queue
+func (p *PodcastService) ClearQueue(ctx context.Context, req *protos.ClearQueueReq) (*protos.Response, error) {
+	userID, err := getUserIDFromContext(ctx)
+	if err != nil {
+		return nil, twirp.Unauthenticated.Error("User is not authenticated")
+	}
+	if err = p.podCon.ClearQueue(ctx, userID); err != nil {
+		return nil, twirp.Internal.Errorf("Could not clear queue: %w", err)
+	}
+	p.podCon.PublishQueueChanged(userID)
+	return &protos.Response{Success: true, Message: ""}, nil
+}
+
+// SetAutoQueue sets whether new episodes of a subscribed podcast are appended to the queue
+func (p *PodcastService) SetAutoQueue(ctx context.Context, req *protos.SetAutoQueueReq) (*protos.Response, error) {
+	userID, err := getUserIDFromContext(ctx)
+	if err != nil {
+		return nil, twirp.Unauthenticated.Error("User is not authenticated")
+	}
+	podID, err := uuid.Parse(req.PodcastID)
+	if err != nil {
+		return nil, twirp.InvalidArgument.Error("Could not parse podcast UUID")
+	}
+	if err = p.podCon.UpdateAutoQueue(ctx, userID, podID, req.Enabled); err != nil {
+		return nil, twirp.NotFound.Errorf("Could not update subscription: %w", err)
+	}
+	return &protos.Response{Success: true, Message: ""}, nil
+}
+
+func (p *PodcastService) queue(ctx context.Context, userID uuid.UUID) (*protos.Episodes, error) {
+	dbEpis, err := p.podCon.FindQueue(ctx, userID)
+	if err != nil {
+		return nil, twirp.Internal.Errorf("Could not find queue: %w", err)
+	}
+	return &protos.Episodes{Episodes: convertEpisFromDB(dbEpis)}, nil
+}
@@ -3,11 +3,13 @@ package twirp
 import (
 	"context"
 	"crypto/tls"
+	"net"
 	"net/http"
 
 	"github.com/google/uuid"
 	"github.com/sschwartz96/syncapod-backend/internal/auth"
 	"github.com/sschwartz96/syncapod-backend/internal/db"
+	"github.com/sschwartz96/syncapod-backend/internal/events"
 	protos "github.com/sschwartz96/syncapod-backend/internal/gen"
 	"github.com/twitchtv/twirp"
 	"golang.org/x/crypto/acme/autocert"
@@ -24,6 +26,7 @@ const (
 type Server struct {
 	authC    *auth.AuthController
 	services []TwirpService
+	broker   events.Broker
 }
 
 type TwirpService struct {
@@ -31,8 +34,8 @@ type TwirpService struct {
 	twirpServer protos.TwirpServer
 }
 
-func NewServer(a *autocert.Manager, aC *auth.AuthController, aS protos.Auth, pS protos.Pod, adminS protos.Admin) *Server {
-	s := &Server{authC: aC}
+func NewServer(a *autocert.Manager, aC *auth.AuthController, aS protos.Auth, pS protos.Pod, adminS protos.Admin, broker events.Broker) *Server {
+	s := &Server{authC: aC, broker: broker}
 	twirpServices := []TwirpService{
 		{
 			name: "admin",
@@ -104,6 +107,40 @@ func NewServer(a *autocert.Manager, aC *auth.AuthController, aS protos.Auth, pS
 // 	}
 // }
 
+// publicMethods may be called without an auth token, keyed by service.method
+var publicMethods = map[string]bool{
+	"Auth.Authenticate":         true,
+	"Auth.Register":             true,
+	"Auth.VerifyEmail":          true,
+	"Auth.RequestPasswordReset": true,
+	"Auth.ResetPassword":        true,
+	"Auth.VerifyTwoFactor":      true,
+}
+
+// methodRoles is the role required to call a method, keyed by service.method
+var methodRoles = map[string]db.Role{
+	"Admin.AddPodcast":      db.RoleModerator,
+	"Admin.RefreshPodcast":  db.RoleModerator,
+	"Admin.GetJanitorStats": db.RoleAdmin,
+}
+
+// serviceRoles is the role required to call methods of the service missing from methodRoles,
+// methods of other services only require a user
+var serviceRoles = map[string]db.Role{
+	"Admin": db.RoleAdmin,
+}
+
+// requiredRole returns the role required to call the method of the service
+func requiredRole(serviceName, methodName string) db.Role {
+	if role, ok := methodRoles[serviceName+"."+methodName]; ok {
+		return role
+	}
+	if role, ok := serviceRoles[serviceName]; ok {
+		return role
+	}
+	return db.RoleUser
+}
+
 func (s *Server) authorizeHook() *twirp.ServerHooks {
 	hooks := &twirp.ServerHooks{}
 	hooks.RequestRouted = func(ctx context.Context) (context.Context, error) {
@@ -112,8 +149,9 @@ func (s *Server) authorizeHook() *twirp.ServerHooks {
 		if !ok {
 			return ctx, twirp.NotFound.Error("Auth Hook, Method Not Found")
 		}
-		// if Authenticate method then allow the method to proceed
-		if methodName == "Authenticate" {
+		// public methods proceed without authorization
+		serviceName, _ := twirp.ServiceName(ctx)
+		if publicMethods[serviceName+"."+methodName] {
 			return ctx, nil
 		}
 
@@ -123,17 +161,19 @@ func (s *Server) authorizeHook() *twirp.ServerHooks {
 			return ctx, twirp.NotFound.Error("Auth Hook, Could Not Convert Auth Token to String")
 		}
 
-		authToken, err := uuid.Parse(authTokenString)
-		if err != nil {
-			return ctx, twirp.Unauthenticated.Error("Auth Hook, Could Not Parse Auth Token -> UUID ")
+		if authTokenString == "" {
+			return ctx, twirp.Unauthenticated.Error("Auth Hook, Missing Auth Token")
 		}
 
-		user, err := s.authC.Authorize(ctx, authToken)
+		user, session, err := s.authC.Authorize(ctx, authTokenString)
 		if err != nil {
 			return ctx, twirp.Unauthenticated.Error("")
 		}
+		if !auth.HasRole(user, requiredRole(serviceName, methodName)) {
+			return ctx, twirp.PermissionDenied.Error("User is not permitted to call " + serviceName + "." + methodName)
+		}
 		ctx = context.WithValue(ctx, twirpHeaderKey{}, twirpCtxData{
-			authToken: authToken,
+			sessionID: session.ID,
 			user:      user,
 		})
 		return ctx, nil
@@ -142,13 +182,15 @@ func (s *Server) authorizeHook() *twirp.ServerHooks {
 	return hooks
 }
 
-// withAuthTokenMiddleware extracts the Auth_Token from header and inserts it into context
+// withAuthTokenMiddleware extracts the Auth_Token from header and inserts it into context,
+// along with the ip of the client
 func withAuthTokenMiddleware(next http.Handler) http.HandlerFunc {
 	return func(res http.ResponseWriter, req *http.Request) {
 		// get the auth token from http header
 		authToken := req.Header.Get(authTokenKey)
 
 		newCtx := context.WithValue(req.Context(), twirpHeaderKey{}, authToken)
+		newCtx = context.WithValue(newCtx, remoteIPKey{}, remoteIP(req))
 
 		// call original hander's ServeHTTP function
 		next.ServeHTTP(res, req.WithContext(newCtx))
@@ -157,8 +199,25 @@ func withAuthTokenMiddleware(next http.Handler) http.HandlerFunc {
 
 type twirpHeaderKey struct{}
 
+type remoteIPKey struct{}
+
+// remoteIP returns the ip of the client connected to the server
+func remoteIP(req *http.Request) string {
+	host, _, err := net.SplitHostPort(req.RemoteAddr)
+	if err != nil {
+		return req.RemoteAddr
+	}
+	return host
+}
+
+// getRemoteIPFromContext returns the ip of the client, empty if unknown
+func getRemoteIPFromContext(ctx context.Context) string {
+	ip, _ := ctx.Value(remoteIPKey{}).(string)
+	return ip
+}
+
 type twirpCtxData struct {
-	authToken uuid.UUID
+	sessionID uuid.UUID
 	user      *db.UserRow
 }
 
@@ -168,6 +227,7 @@ func (s *Server) Start() error {
 		mux.Handle(service.twirpServer.PathPrefix(), withAuthTokenMiddleware(service.twirpServer))
 		// mux.Handle(service.twirpServer.PathPrefix(), service.twirpServer)
 	}
+	mux.Handle(eventsPath, withAuthTokenMiddleware(http.HandlerFunc(s.serveEvents)))
 	return http.ListenAndServe(":8081", mux)
 }
 
@@ -0,0 +1,76 @@
+package twirp
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/sschwartz96/syncapod-backend/internal/db"
+	protos "github.com/sschwartz96/syncapod-backend/internal/gen"
+	"github.com/twitchtv/twirp"
+)
+
+const (
+	minPlaybackSpeed = 0.5
+	maxPlaybackSpeed = 3
+	maxSkipSeconds   = 600
+)
+
+// GetPodcastSettings returns the user's settings of a podcast
+func (p *PodcastService) GetPodcastSettings(ctx context.Context, req *protos.GetPodcastSettingsReq) (*protos.PodcastSettings, error) {
+	userID, err := getUserIDFromContext(ctx)
+	if err != nil {
+		return nil, twirp.Unauthenticated.Error("User is not authenticated")
+	}
+	podID, err := uuid.Parse(req.PodcastID)
+	if err != nil {
+		return nil, twirp.InvalidArgument.Error("Could not parse podcast UUID")
+	}
+	settings, err := p.podCon.FindPodcastSettings(ctx, userID, podID)
+	if err != nil {
+		return nil, twirp.Internal.Errorf("Could not find podcast settings: %w", err)
+	}
+	return convertSettingsFromDB(settings), nil
+}
+
+// UpdatePodcastSettings replaces the user's settings of a podcast, a playback speed of 0 is normal speed
+func (p *PodcastService) UpdatePodcastSettings(ctx context.Context, req *protos.PodcastSettings) (*protos.PodcastSettings, error) {
+	userID, err := getUserIDFromContext(ctx)
+	if err != nil {
+		return nil, twirp.Unauthenticated.Error("User is not authenticated")
+	}
+	podID, err := uuid.Parse(req.PodcastID)
+	if err != nil {
+		return nil, twirp.InvalidArgument.Error("Could not parse podcast UUID")
+	}
+	sort, ok := episodeSorts[req.EpisodeSort]
+	if !ok {
+		return nil, twirp.InvalidArgument.Errorf("Unknown episode sort: %v", req.EpisodeSort)
+	}
+	if req.PlaybackSpeed == 0 {
+		req.PlaybackSpeed = 1
+	}
+	if req.PlaybackSpeed < minPlaybackSpeed || req.PlaybackSpeed > maxPlaybackSpeed {
+		return nil, twirp.InvalidArgument.Errorf("Playback speed must be within %v and %v", minPlaybackSpeed, maxPlaybackSpeed)
+	}
+	if req.SkipIntroSeconds < 0 || req.SkipIntroSeconds > maxSkipSeconds ||
+		req.SkipOutroSeconds < 0 || req.SkipOutroSeconds > maxSkipSeconds {
+		return nil, twirp.InvalidArgument.Errorf("Skipped seconds must be within 0 and %d", maxSkipSeconds)
+	}
+	if _, err = p.podCon.FindPodcastByID(ctx, podID); err != nil {
+		return nil, twirp.NotFound.Errorf("Could not find podcast: %w", err)
+	}
+	settings := &db.PodcastSettings{
+		UserID:            userID,
+		PodcastID:         podID,
+		PlaybackSpeed:     req.PlaybackSpeed,
+		SkipIntroSeconds:  req.SkipIntroSeconds,
+		SkipOutroSeconds:  req.SkipOutroSeconds,
+		AutoDownload:      req.AutoDownload,
+		NotifyNewEpisodes: req.NotifyNewEpisodes,
+		EpisodeSort:       sort,
+	}
+	if err = p.podCon.UpsertPodcastSettings(ctx, settings); err != nil {
+		return nil, twirp.Internal.Errorf("Could not update podcast settings: %w", err)
+	}
+	return convertSettingsFromDB(settings), nil
+}
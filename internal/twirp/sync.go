@@ -0,0 +1,61 @@
+package twirp
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"strconv"
+
+	protos "github.com/sschwartz96/syncapod-backend/internal/gen"
+	"github.com/twitchtv/twirp"
+)
+
+// Sync returns the user's state changed since the token of the previous sync
+func (p *PodcastService) Sync(ctx context.Context, req *protos.SyncReq) (*protos.SyncRes, error) {
+	userID, err := getUserIDFromContext(ctx)
+	if err != nil {
+		return nil, twirp.Unauthenticated.Error("User is not authenticated")
+	}
+	seq, err := decodeSyncToken(req.Token)
+	if err != nil {
+		return nil, twirp.InvalidArgument.Errorf("Invalid sync token: %w", err)
+	}
+	changes, err := p.podCon.Sync(ctx, userID, seq)
+	if err != nil {
+		return nil, twirp.Internal.Errorf("Could not sync: %w", err)
+	}
+	userEpis := make([]*protos.UserEpisode, len(changes.UserEpisodes))
+	for i := range changes.UserEpisodes {
+		userEpis[i] = convertUserEpiFromDB(&changes.UserEpisodes[i])
+	}
+	return &protos.SyncRes{
+		Token:             encodeSyncToken(changes.Seq),
+		Full:              changes.Full,
+		Subscriptions:     convertSubFromDB(changes.Subscriptions),
+		RemovedPodcastIDs: convertUUIDs(changes.RemovedPodcastIDs),
+		UserEpisodes:      userEpis,
+		QueueChanged:      changes.QueueChanged,
+		Queue:             convertEpisFromDB(changes.Queue),
+		NewEpisodes:       convertEpisFromDB(changes.NewEpisodes),
+	}, nil
+}
+
+// encodeSyncToken hides the change log position from clients, decodeSyncToken reverses it
+func encodeSyncToken(seq int64) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(strconv.FormatInt(seq, 10)))
+}
+
+func decodeSyncToken(token string) (int64, error) {
+	if token == "" {
+		return 0, nil
+	}
+	b, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return 0, err
+	}
+	seq, err := strconv.ParseInt(string(b), 10, 64)
+	if err != nil || seq < 0 {
+		return 0, fmt.Errorf("malformed token")
+	}
+	return seq, nil
+}
@@ -3,9 +3,11 @@
 package twirp
 
 import (
+	"fmt"
 	"strings"
 
 	"github.com/google/uuid"
+	"github.com/sschwartz96/syncapod-backend/internal/auth"
 	"github.com/sschwartz96/syncapod-backend/internal/db"
 	protos "github.com/sschwartz96/syncapod-backend/internal/gen"
 	"github.com/sschwartz96/syncapod-backend/internal/podcast"
@@ -15,7 +17,7 @@ import (
 func convertUserEpiFromDB(u *db.UserEpisode) *protos.UserEpisode {
 	return &protos.UserEpisode{
 		UserID:    u.UserID.String(),
-		EpisodeID: u.UserID.String(),
+		EpisodeID: u.EpisodeID.String(),
 		Offset:    u.OffsetMillis,
 		LastSeen:  timestamppb.New(u.LastSeen),
 		Played:    u.Played,
@@ -24,10 +26,32 @@ func convertUserEpiFromDB(u *db.UserEpisode) *protos.UserEpisode {
 
 func convertUserFromDB(ur *db.UserRow) *protos.User {
 	return &protos.User{
-		Id:       ur.ID.String(),
-		Email:    ur.Email,
-		Username: ur.Username,
-		DOB:      timestamppb.New(ur.Birthdate),
+		Id:            ur.ID.String(),
+		Email:         ur.Email,
+		Username:      ur.Username,
+		DOB:           timestamppb.New(ur.Birthdate),
+		EmailVerified: ur.EmailVerified,
+	}
+}
+
+func convertActiveSessionFromDB(s *db.SessionRow, currentID uuid.UUID) *protos.ActiveSession {
+	return &protos.ActiveSession{
+		Handle:       auth.SessionHandle(s.ID),
+		UserAgent:    s.UserAgent,
+		LoginTime:    timestamppb.New(s.LoginTime),
+		LastSeenTime: timestamppb.New(s.LastSeenTime),
+		Expires:      timestamppb.New(s.Expires),
+		Current:      s.ID == currentID,
+	}
+}
+
+// convertGrantFromDB converts the grant, name is the display name of its client
+func convertGrantFromDB(g *db.OAuthGrant, name string) *protos.OAuthGrant {
+	return &protos.OAuthGrant{
+		ClientId:   g.ClientID,
+		ClientName: name,
+		Authorized: timestamppb.New(g.Authorized),
+		LastUsed:   timestamppb.New(g.LastUsed),
 	}
 }
 
@@ -110,6 +134,7 @@ func podCatToProtoCat(podCat podcast.Category) *protos.Category {
 	return &protos.Category{
 		Category: podCatsToProtoCats(podCat.Subcategories),
 		Text:     podCat.Name,
+		Id:       int32(podCat.ID),
 	}
 }
 
@@ -117,19 +142,162 @@ func convertSubFromDB(s []db.Subscription) []*protos.Subscription {
 	subs := []*protos.Subscription{}
 	for i := range s {
 		subs = append(subs, &protos.Subscription{
-			UserID:        s[i].UserID.String(),
-			PodcastID:     s[i].PodcastID.String(),
-			CompletedIDs:  convertUUIDsToStrings(s[i].CompletedIDs),
-			InProgressIDs: convertUUIDsToStrings(s[i].InProgressIDs),
+			UserID:     s[i].UserID.String(),
+			PodcastID:  s[i].PodcastID.String(),
+			Unplayed:   s[i].Unplayed,
+			InProgress: s[i].InProgress,
+			Completed:  s[i].Completed,
+			AutoQueue:  s[i].AutoQueue,
+			Settings:   convertSettingsFromDB(&s[i].Settings),
 		})
 	}
 	return subs
 }
 
-func convertUUIDsToStrings(u []uuid.UUID) []string {
-	s := []string{}
-	for i := range u {
-		s = append(s, u[i].String())
+var episodeSorts = map[protos.EpisodeSort]db.EpisodeSort{
+	protos.EpisodeSort_NEWEST: db.EpisodeSortNewest,
+	protos.EpisodeSort_OLDEST: db.EpisodeSortOldest,
+}
+
+func convertSettingsFromDB(s *db.PodcastSettings) *protos.PodcastSettings {
+	sort := protos.EpisodeSort_NEWEST
+	if s.EpisodeSort == db.EpisodeSortOldest {
+		sort = protos.EpisodeSort_OLDEST
+	}
+	return &protos.PodcastSettings{
+		PodcastID:         s.PodcastID.String(),
+		PlaybackSpeed:     s.PlaybackSpeed,
+		SkipIntroSeconds:  s.SkipIntroSeconds,
+		SkipOutroSeconds:  s.SkipOutroSeconds,
+		AutoDownload:      s.AutoDownload,
+		NotifyNewEpisodes: s.NotifyNewEpisodes,
+		EpisodeSort:       sort,
+	}
+}
+
+var podcastSorts = map[protos.PodcastSort]db.PodcastSort{
+	protos.PodcastSort_SUBSCRIBERS:    db.SortBySubscribers,
+	protos.PodcastSort_LATEST_EPISODE: db.SortByLatestEpisode,
+	protos.PodcastSort_TITLE:          db.SortByTitle,
+}
+
+var chartTypes = map[protos.ChartType]db.ChartType{
+	protos.ChartType_TOP:      db.ChartTop,
+	protos.ChartType_TRENDING: db.ChartTrending,
+}
+
+func convertPlaylistFromDB(p *db.Playlist) *protos.Playlist {
+	return &protos.Playlist{
+		Id:         p.ID.String(),
+		Name:       p.Name,
+		Smart:      p.Smart,
+		Rules:      convertRulesFromDB(p.Rules),
+		EpisodeIDs: convertUUIDs(p.EpisodeIDs),
+		Created:    timestamppb.New(p.Created),
+		Updated:    timestamppb.New(p.Updated),
+	}
+}
+
+func convertRulesFromDB(r *db.PlaylistRules) *protos.PlaylistRules {
+	if r == nil {
+		return nil
+	}
+	cats := make([]int32, len(r.Categories))
+	for i := range r.Categories {
+		cats[i] = int32(r.Categories[i])
+	}
+	return &protos.PlaylistRules{
+		Categories:            cats,
+		PodcastIDs:            convertUUIDs(r.PodcastIDs),
+		UnplayedOnly:          r.UnplayedOnly,
+		MinDurationMillis:     r.MinDurationMillis,
+		MaxDurationMillis:     r.MaxDurationMillis,
+		PublishedWithinMillis: r.PublishedWithinMillis,
+		Limit:                 r.Limit,
+	}
+}
+
+func convertRulesToDB(r *protos.PlaylistRules) (*db.PlaylistRules, error) {
+	if r == nil {
+		return nil, nil
+	}
+	cats := make([]int, len(r.Categories))
+	for i := range r.Categories {
+		cats[i] = int(r.Categories[i])
+	}
+	podIDs, err := parseUUIDs(r.PodcastIDs)
+	if err != nil {
+		return nil, err
+	}
+	return &db.PlaylistRules{
+		Categories:            cats,
+		PodcastIDs:            podIDs,
+		UnplayedOnly:          r.UnplayedOnly,
+		MinDurationMillis:     r.MinDurationMillis,
+		MaxDurationMillis:     r.MaxDurationMillis,
+		PublishedWithinMillis: r.PublishedWithinMillis,
+		Limit:                 r.Limit,
+	}, nil
+}
+
+func convertSessionFromDB(s *db.ListeningSession) *protos.ListeningSession {
+	return &protos.ListeningSession{
+		Id:          s.ID,
+		EpisodeID:   s.EpisodeID.String(),
+		StartOffset: s.StartOffsetMillis,
+		EndOffset:   s.EndOffsetMillis,
+		Started:     timestamppb.New(s.Started),
+		Ended:       timestamppb.New(s.Ended),
+		Device:      s.Device,
+	}
+}
+
+func parseUUIDs(ids []string) ([]uuid.UUID, error) {
+	uuids := make([]uuid.UUID, len(ids))
+	for i := range ids {
+		var err error
+		uuids[i], err = uuid.Parse(ids[i])
+		if err != nil {
+			return nil, fmt.Errorf("could not parse UUID: %s", ids[i])
+		}
+	}
+	return uuids, nil
+}
+
+func convertUUIDs(ids []uuid.UUID) []string {
+	strs := make([]string, len(ids))
+	for i := range ids {
+		strs[i] = ids[i].String()
+	}
+	return strs
+}
+
+// parseOptionalUUID returns uuid.Nil for an empty id
+func parseOptionalUUID(id string) (uuid.UUID, error) {
+	if id == "" {
+		return uuid.Nil, nil
+	}
+	return uuid.Parse(id)
+}
+
+func convertBookmarkFromDB(b *db.Bookmark) *protos.Bookmark {
+	return &protos.Bookmark{
+		Id:        b.ID.String(),
+		EpisodeID: b.EpisodeID.String(),
+		Offset:    b.OffsetMillis,
+		Note:      b.Note,
+		Created:   timestamppb.New(b.Created),
+	}
+}
+
+func convertClipFromDB(c *db.Clip) *protos.Clip {
+	return &protos.Clip{
+		Id:          c.ID.String(),
+		EpisodeID:   c.EpisodeID.String(),
+		StartOffset: c.StartMillis,
+		EndOffset:   c.EndMillis,
+		Title:       c.Title,
+		Created:     timestamppb.New(c.Created),
+		SharePath:   podcast.ClipSharePath(c.ID),
 	}
-	return s
 }
@@ -0,0 +1,20 @@
+package twirp
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/sschwartz96/syncapod-backend/internal/db"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_convertUserEpiFromDB(t *testing.T) {
+	userEpi := &db.UserEpisode{UserID: uuid.New(), EpisodeID: uuid.New(), OffsetMillis: 1000, LastSeen: time.Unix(10, 0), Played: true}
+	converted := convertUserEpiFromDB(userEpi)
+	require.Equal(t, userEpi.UserID.String(), converted.UserID)
+	require.Equal(t, userEpi.EpisodeID.String(), converted.EpisodeID)
+	require.Equal(t, userEpi.OffsetMillis, converted.Offset)
+	require.Equal(t, userEpi.LastSeen.UTC(), converted.LastSeen.AsTime())
+	require.True(t, converted.Played)
+}
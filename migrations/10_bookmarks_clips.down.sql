@@ -0,0 +1,2 @@
+DROP TABLE Clips;
+DROP TABLE Bookmarks;
@@ -0,0 +1,25 @@
+CREATE TABLE Bookmarks (
+	id UUID PRIMARY KEY,
+	user_id UUID REFERENCES Users(id) ON DELETE CASCADE NOT NULL,
+	episode_id UUID REFERENCES Episodes(id) ON DELETE CASCADE NOT NULL,
+	offset_millis BIGINT NOT NULL,
+	note TEXT NOT NULL DEFAULT '',
+	created TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE INDEX bookmarks_user_episode_idx ON Bookmarks (user_id,episode_id);
+CREATE INDEX bookmarks_episode_idx ON Bookmarks (episode_id);
+
+-- clips are public to anyone with their id, see /share/clip/{id}
+CREATE TABLE Clips (
+	id UUID PRIMARY KEY,
+	user_id UUID REFERENCES Users(id) ON DELETE CASCADE NOT NULL,
+	episode_id UUID REFERENCES Episodes(id) ON DELETE CASCADE NOT NULL,
+	start_millis BIGINT NOT NULL,
+	end_millis BIGINT NOT NULL CHECK (end_millis > start_millis),
+	title TEXT NOT NULL DEFAULT '',
+	created TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE INDEX clips_user_episode_idx ON Clips (user_id,episode_id);
+CREATE INDEX clips_episode_idx ON Clips (episode_id);
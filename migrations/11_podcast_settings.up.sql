@@ -0,0 +1,12 @@
+-- a missing row means the default settings
+CREATE TABLE PodcastSettings (
+	user_id UUID REFERENCES Users(id) ON DELETE CASCADE NOT NULL,
+	podcast_id UUID REFERENCES Podcasts(id) ON DELETE CASCADE NOT NULL,
+	playback_speed REAL NOT NULL DEFAULT 1 CHECK (playback_speed > 0),
+	skip_intro_seconds INT NOT NULL DEFAULT 0 CHECK (skip_intro_seconds >= 0),
+	skip_outro_seconds INT NOT NULL DEFAULT 0 CHECK (skip_outro_seconds >= 0),
+	auto_download BOOLEAN NOT NULL DEFAULT FALSE,
+	notify_new_episodes BOOLEAN NOT NULL DEFAULT FALSE,
+	episode_sort TEXT NOT NULL DEFAULT 'newest',
+	PRIMARY KEY(user_id,podcast_id)
+);
@@ -0,0 +1,9 @@
+-- devices registered through the gpodder.net compatible api, see handler/gpodder.go
+CREATE TABLE GpodderDevices (
+	user_id UUID REFERENCES Users(id) ON DELETE CASCADE NOT NULL,
+	id TEXT NOT NULL,
+	caption TEXT NOT NULL DEFAULT '',
+	type TEXT NOT NULL DEFAULT 'other',
+	updated TIMESTAMPTZ NOT NULL DEFAULT now(),
+	PRIMARY KEY(user_id,id)
+);
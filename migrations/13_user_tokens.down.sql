@@ -0,0 +1,2 @@
+DROP TABLE UserTokens;
+ALTER TABLE Users DROP COLUMN email_verified;
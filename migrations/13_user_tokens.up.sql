@@ -0,0 +1,12 @@
+ALTER TABLE Users ADD COLUMN email_verified BOOLEAN NOT NULL DEFAULT FALSE;
+
+-- single-use tokens mailed to users, only the sha256 of the token is stored
+CREATE TABLE UserTokens (
+	token_hash BYTEA PRIMARY KEY,
+	user_id UUID REFERENCES Users(id) ON DELETE CASCADE NOT NULL,
+	purpose TEXT NOT NULL,
+	created TIMESTAMPTZ NOT NULL DEFAULT now(),
+	expires TIMESTAMPTZ NOT NULL
+);
+
+CREATE INDEX user_tokens_user_purpose_idx ON UserTokens (user_id,purpose);
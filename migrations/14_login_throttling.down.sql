@@ -0,0 +1,2 @@
+DROP TABLE FailedLogins;
+DROP TABLE LoginFailures;
@@ -0,0 +1,20 @@
+-- recent failed logins per key, an account or an IP, see auth.Throttler
+CREATE TABLE LoginFailures (
+	key TEXT PRIMARY KEY,
+	count INT NOT NULL,
+	last_failure TIMESTAMPTZ NOT NULL
+);
+
+-- audit of every failed login, user_id is null if the account is unknown
+CREATE TABLE FailedLogins (
+	id BIGSERIAL PRIMARY KEY,
+	user_id UUID REFERENCES Users(id) ON DELETE CASCADE,
+	identifier TEXT NOT NULL,
+	ip TEXT NOT NULL,
+	user_agent TEXT NOT NULL,
+	reason TEXT NOT NULL, -- unknown_user, incorrect_password, incorrect_two_factor
+	created TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE INDEX failed_logins_user_created_idx ON FailedLogins (user_id,created);
+CREATE INDEX failed_logins_created_idx ON FailedLogins (created);
@@ -0,0 +1,2 @@
+DROP TABLE RecoveryCodes;
+DROP TABLE TwoFactor;
@@ -0,0 +1,16 @@
+-- TOTP secrets of users, pending until confirmed with a first code,
+-- last_step is the latest time step used so codes can't be replayed
+CREATE TABLE TwoFactor (
+	user_id UUID PRIMARY KEY REFERENCES Users(id) ON DELETE CASCADE,
+	secret BYTEA NOT NULL,
+	enabled BOOLEAN NOT NULL DEFAULT FALSE,
+	last_step BIGINT NOT NULL DEFAULT 0,
+	created TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+-- single-use recovery codes, only the sha256 of the code is stored
+CREATE TABLE RecoveryCodes (
+	user_id UUID REFERENCES Users(id) ON DELETE CASCADE NOT NULL,
+	code_hash BYTEA NOT NULL,
+	PRIMARY KEY(user_id,code_hash)
+);
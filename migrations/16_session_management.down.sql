@@ -0,0 +1,4 @@
+DROP INDEX auth_codes_user_client_idx;
+DROP INDEX access_tokens_user_client_idx;
+DROP INDEX sessions_user_idx;
+ALTER TABLE AccessTokens DROP COLUMN client_id;
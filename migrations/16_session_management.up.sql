@@ -0,0 +1,10 @@
+-- the oauth client of the token, so grants can be listed and revoked per client.
+-- Tokens whose auth code is gone can't be attributed and are kept under the 'legacy' client,
+-- see db.LegacyClientID, new tokens always name their client
+ALTER TABLE AccessTokens ADD COLUMN client_id TEXT NOT NULL DEFAULT 'legacy';
+UPDATE AccessTokens a SET client_id=c.client_id FROM AuthCodes c WHERE a.auth_code=c.code AND c.client_id <> '';
+ALTER TABLE AccessTokens ALTER COLUMN client_id DROP DEFAULT;
+
+CREATE INDEX sessions_user_idx ON Sessions (user_id);
+CREATE INDEX access_tokens_user_client_idx ON AccessTokens (user_id,client_id);
+CREATE INDEX auth_codes_user_client_idx ON AuthCodes (user_id,client_id);
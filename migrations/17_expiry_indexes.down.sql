@@ -0,0 +1,6 @@
+DROP INDEX login_failures_last_failure_idx;
+DROP INDEX user_tokens_expires_idx;
+DROP INDEX access_tokens_expiry_idx;
+DROP INDEX auth_codes_expires_idx;
+DROP INDEX sessions_expires_idx;
+DROP FUNCTION access_token_expiry;
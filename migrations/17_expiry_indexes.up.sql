@@ -0,0 +1,11 @@
+-- the expiry of an access token, adding seconds doesn't depend on the timezone
+-- so the function is immutable and can be indexed
+CREATE FUNCTION access_token_expiry(created TIMESTAMPTZ, expires INT) RETURNS TIMESTAMPTZ
+	AS 'SELECT created + expires * interval ''1 second'''
+	LANGUAGE SQL IMMUTABLE;
+
+CREATE INDEX sessions_expires_idx ON Sessions (expires);
+CREATE INDEX auth_codes_expires_idx ON AuthCodes (expires);
+CREATE INDEX access_tokens_expiry_idx ON AccessTokens (access_token_expiry(created,expires));
+CREATE INDEX user_tokens_expires_idx ON UserTokens (expires);
+CREATE INDEX login_failures_last_failure_idx ON LoginFailures (last_failure);
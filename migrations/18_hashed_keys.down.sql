@@ -0,0 +1,6 @@
+-- the issued keys can't be recovered from their digests, every session and token is invalidated
+DELETE FROM AccessTokens;
+DELETE FROM AuthCodes;
+DELETE FROM Sessions;
+DROP INDEX sessions_key_hash_idx;
+ALTER TABLE Sessions DROP COLUMN key_hash;
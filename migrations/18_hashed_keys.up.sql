@@ -0,0 +1,10 @@
+-- sessions are looked up by the digest of their key, the id is no longer a bearer token.
+-- existing sessions are re-keyed so their current key, the old id, keeps working
+ALTER TABLE Sessions ADD COLUMN key_hash BYTEA;
+UPDATE Sessions SET key_hash=sha256(convert_to(id::text,'UTF8')), id=gen_random_uuid();
+ALTER TABLE Sessions ALTER COLUMN key_hash SET NOT NULL;
+CREATE UNIQUE INDEX sessions_key_hash_idx ON Sessions (key_hash);
+
+-- oauth codes and tokens only keep the digest of the issued key
+UPDATE AuthCodes SET code=sha256(code);
+UPDATE AccessTokens SET token=sha256(token), auth_code=sha256(auth_code), refresh_token=sha256(refresh_token);
@@ -0,0 +1,3 @@
+-- the role of the user, see db.Role
+ALTER TABLE Users ADD COLUMN role TEXT NOT NULL DEFAULT 'user'
+	CHECK (role IN ('user','moderator','admin'));
@@ -0,0 +1,3 @@
+DROP INDEX episodes_podcast_pub_date_idx;
+DROP INDEX subscriptions_podcast_idx;
+DROP INDEX podcasts_category_idx;
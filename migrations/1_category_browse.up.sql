@@ -0,0 +1,6 @@
+-- category browsing filters podcasts with the array overlap operator (&&)
+CREATE INDEX podcasts_category_idx ON Podcasts USING GIN (category);
+-- used to sort by subscriber count
+CREATE INDEX subscriptions_podcast_idx ON Subscriptions (podcast_id);
+-- used to sort by the most recently published episode
+CREATE INDEX episodes_podcast_pub_date_idx ON Episodes (podcast_id, pub_date DESC);
@@ -0,0 +1,4 @@
+-- users are found by their email or username regardless of case, so they must be unique regardless of case,
+-- users differing only by case have to be merged or renamed before migrating
+CREATE UNIQUE INDEX users_email_lower_key ON Users (LOWER(email));
+CREATE UNIQUE INDEX users_username_lower_key ON Users (LOWER(username));
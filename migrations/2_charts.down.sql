@@ -0,0 +1,3 @@
+DROP INDEX user_episodes_last_seen_idx;
+DROP TABLE Charts;
+ALTER TABLE Subscriptions DROP COLUMN created;
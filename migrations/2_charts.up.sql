@@ -0,0 +1,18 @@
+-- existing subscriptions predate tracking so they shouldn't count towards trending
+ALTER TABLE Subscriptions ADD COLUMN created TIMESTAMPTZ NOT NULL DEFAULT 'epoch';
+ALTER TABLE Subscriptions ALTER COLUMN created SET DEFAULT now();
+
+-- Charts is rebuilt periodically by PodcastStore.RefreshCharts()
+CREATE TABLE Charts (
+	chart TEXT NOT NULL,
+	category INTEGER NOT NULL, -- 0 represents all categories
+	language TEXT NOT NULL, -- empty represents all languages
+	rank INTEGER NOT NULL,
+	podcast_id UUID REFERENCES Podcasts(id) ON DELETE CASCADE NOT NULL,
+	score BIGINT NOT NULL,
+	computed TIMESTAMPTZ NOT NULL,
+	PRIMARY KEY (chart,category,language,rank)
+);
+
+-- used to count recent plays
+CREATE INDEX user_episodes_last_seen_idx ON UserEpisodes (last_seen);
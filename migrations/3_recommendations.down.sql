@@ -0,0 +1,2 @@
+DROP TABLE Recommendations;
+DROP TABLE SimilarPodcasts;
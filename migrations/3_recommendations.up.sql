@@ -0,0 +1,16 @@
+-- SimilarPodcasts & Recommendations are rebuilt periodically by PodcastStore.RefreshRecommendations()
+CREATE TABLE SimilarPodcasts (
+	podcast_id UUID REFERENCES Podcasts(id) ON DELETE CASCADE NOT NULL,
+	similar_id UUID REFERENCES Podcasts(id) ON DELETE CASCADE NOT NULL,
+	score DOUBLE PRECISION NOT NULL,
+	rank INTEGER NOT NULL,
+	PRIMARY KEY (podcast_id,rank)
+);
+
+CREATE TABLE Recommendations (
+	user_id UUID REFERENCES Users(id) ON DELETE CASCADE NOT NULL,
+	podcast_id UUID REFERENCES Podcasts(id) ON DELETE CASCADE NOT NULL,
+	score DOUBLE PRECISION NOT NULL,
+	rank INTEGER NOT NULL,
+	PRIMARY KEY (user_id,rank)
+);
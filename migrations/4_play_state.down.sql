@@ -0,0 +1,11 @@
+ALTER TABLE Subscriptions ADD COLUMN completed_ids UUID[];
+ALTER TABLE Subscriptions ADD COLUMN in_progress_ids UUID[];
+
+UPDATE Subscriptions s SET completed_ids = ARRAY(
+	SELECT u.episode_id FROM UserEpisodes u INNER JOIN Episodes e ON u.episode_id=e.id
+	WHERE u.user_id=s.user_id AND e.podcast_id=s.podcast_id AND u.played
+);
+UPDATE Subscriptions s SET in_progress_ids = ARRAY(
+	SELECT u.episode_id FROM UserEpisodes u INNER JOIN Episodes e ON u.episode_id=e.id
+	WHERE u.user_id=s.user_id AND e.podcast_id=s.podcast_id AND NOT u.played AND u.offset_millis>0
+);
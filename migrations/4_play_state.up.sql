@@ -0,0 +1,12 @@
+-- UserEpisodes is the single source of truth for play state, carry over any
+-- completed episodes only recorded within the Subscriptions arrays, their
+-- time is unknown so they are never seen as recently listened to
+INSERT INTO UserEpisodes(user_id,episode_id,offset_millis,last_seen,played)
+	SELECT DISTINCT s.user_id, e.id, 0, 'epoch'::timestamptz, TRUE
+	FROM Subscriptions s
+	CROSS JOIN LATERAL unnest(s.completed_ids) c
+	INNER JOIN Episodes e ON e.id=c
+	ON CONFLICT (user_id,episode_id) DO UPDATE SET played=TRUE;
+
+ALTER TABLE Subscriptions DROP COLUMN completed_ids;
+ALTER TABLE Subscriptions DROP COLUMN in_progress_ids;
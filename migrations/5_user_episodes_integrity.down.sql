@@ -0,0 +1,12 @@
+DROP INDEX user_episodes_episode_idx;
+DROP INDEX user_episodes_user_last_seen_idx;
+ALTER TABLE UserEpisodes
+	ALTER COLUMN offset_millis DROP NOT NULL,
+	ALTER COLUMN offset_millis DROP DEFAULT,
+	ALTER COLUMN last_seen DROP NOT NULL,
+	ALTER COLUMN last_seen DROP DEFAULT,
+	ALTER COLUMN played DROP NOT NULL,
+	ALTER COLUMN played DROP DEFAULT;
+ALTER TABLE UserEpisodes
+	DROP CONSTRAINT user_episodes_episode_id_fkey,
+	DROP CONSTRAINT user_episodes_user_id_fkey;
@@ -0,0 +1,24 @@
+-- remove the progress of deleted users & episodes before enforcing it
+DELETE FROM UserEpisodes u
+	WHERE NOT EXISTS (SELECT 1 FROM Users x WHERE x.id=u.user_id)
+	OR NOT EXISTS (SELECT 1 FROM Episodes x WHERE x.id=u.episode_id);
+
+ALTER TABLE UserEpisodes
+	ADD CONSTRAINT user_episodes_user_id_fkey FOREIGN KEY (user_id) REFERENCES Users(id) ON DELETE CASCADE,
+	ADD CONSTRAINT user_episodes_episode_id_fkey FOREIGN KEY (episode_id) REFERENCES Episodes(id) ON DELETE CASCADE;
+
+UPDATE UserEpisodes SET offset_millis=0 WHERE offset_millis IS NULL;
+UPDATE UserEpisodes SET last_seen='epoch' WHERE last_seen IS NULL;
+UPDATE UserEpisodes SET played=FALSE WHERE played IS NULL;
+ALTER TABLE UserEpisodes
+	ALTER COLUMN offset_millis SET NOT NULL,
+	ALTER COLUMN offset_millis SET DEFAULT 0,
+	ALTER COLUMN last_seen SET NOT NULL,
+	ALTER COLUMN last_seen SET DEFAULT now(),
+	ALTER COLUMN played SET NOT NULL,
+	ALTER COLUMN played SET DEFAULT FALSE;
+
+-- FindLastPlayed & FindLastUserEpi
+CREATE INDEX user_episodes_user_last_seen_idx ON UserEpisodes (user_id, last_seen DESC);
+-- cascading deletes of episodes
+CREATE INDEX user_episodes_episode_idx ON UserEpisodes (episode_id);
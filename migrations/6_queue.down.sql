@@ -0,0 +1,2 @@
+ALTER TABLE Subscriptions DROP COLUMN auto_queue;
+DROP TABLE QueueItems;
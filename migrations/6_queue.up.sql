@@ -0,0 +1,13 @@
+CREATE TABLE QueueItems (
+	user_id UUID REFERENCES Users(id) ON DELETE CASCADE NOT NULL,
+	episode_id UUID REFERENCES Episodes(id) ON DELETE CASCADE NOT NULL,
+	position INTEGER NOT NULL,
+	added TIMESTAMPTZ NOT NULL DEFAULT now(),
+	PRIMARY KEY(user_id,episode_id)
+);
+
+CREATE INDEX queue_items_user_position_idx ON QueueItems (user_id,position);
+CREATE INDEX queue_items_episode_idx ON QueueItems (episode_id);
+
+-- new episodes of the podcast are appended to the user's queue
+ALTER TABLE Subscriptions ADD COLUMN auto_queue BOOLEAN NOT NULL DEFAULT FALSE;
@@ -0,0 +1,2 @@
+DROP TABLE PlaylistEpisodes;
+DROP TABLE Playlists;
@@ -0,0 +1,21 @@
+CREATE TABLE Playlists (
+	id UUID PRIMARY KEY,
+	user_id UUID REFERENCES Users(id) ON DELETE CASCADE NOT NULL,
+	name TEXT NOT NULL,
+	-- smart playlists resolve their episodes from rules rather than PlaylistEpisodes
+	smart BOOLEAN NOT NULL DEFAULT FALSE,
+	rules JSONB,
+	created TIMESTAMPTZ NOT NULL DEFAULT now(),
+	updated TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE INDEX playlists_user_idx ON Playlists (user_id);
+
+CREATE TABLE PlaylistEpisodes (
+	playlist_id UUID REFERENCES Playlists(id) ON DELETE CASCADE NOT NULL,
+	episode_id UUID REFERENCES Episodes(id) ON DELETE CASCADE NOT NULL,
+	position INTEGER NOT NULL,
+	PRIMARY KEY(playlist_id,episode_id)
+);
+
+CREATE INDEX playlist_episodes_episode_idx ON PlaylistEpisodes (episode_id);
@@ -0,0 +1,10 @@
+DROP TRIGGER changelog_episodes_trigger ON Episodes;
+DROP FUNCTION changelog_episodes_trigger();
+DROP TRIGGER changelog_queue_items_trigger ON QueueItems;
+DROP FUNCTION changelog_queue_items_trigger();
+DROP TRIGGER changelog_user_episodes_trigger ON UserEpisodes;
+DROP FUNCTION changelog_user_episodes_trigger();
+DROP TRIGGER changelog_subscriptions_trigger ON Subscriptions;
+DROP FUNCTION changelog_subscriptions_trigger();
+DROP TABLE ChangeLogPruned;
+DROP TABLE ChangeLog;
@@ -0,0 +1,77 @@
+-- ChangeLog records every change to a user's synced state. Seqs are taken before
+-- their transaction commits, so clients are handed the oldest transaction still
+-- running as their position instead: every change of a lower txid is committed
+CREATE TABLE ChangeLog (
+	seq BIGSERIAL PRIMARY KEY,
+	user_id UUID REFERENCES Users(id) ON DELETE CASCADE, -- NULL for new episodes of podcast_id
+	kind TEXT NOT NULL, -- subscribed, unsubscribed, user_episode, queue, new_episode
+	podcast_id UUID,
+	episode_id UUID,
+	txid BIGINT NOT NULL DEFAULT txid_current(),
+	created TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE INDEX changelog_user_txid_idx ON ChangeLog (user_id,txid);
+CREATE INDEX changelog_podcast_txid_idx ON ChangeLog (podcast_id,txid) WHERE user_id IS NULL;
+CREATE INDEX changelog_created_idx ON ChangeLog (created);
+
+-- ChangeLogPruned holds the highest txid of the pruned changes, positions up
+-- to it may have missed changes and require the entire state
+CREATE TABLE ChangeLogPruned (
+	txid BIGINT NOT NULL
+);
+INSERT INTO ChangeLogPruned(txid) VALUES(0);
+
+-- podcast_id is set on every change affecting the counts of a subscription
+-- rows deleted by a cascading user delete are not logged, the user is already gone
+CREATE FUNCTION changelog_subscriptions_trigger() RETURNS trigger AS $$
+BEGIN
+	IF TG_OP = 'DELETE' THEN
+		INSERT INTO ChangeLog(user_id,kind,podcast_id)
+			SELECT OLD.user_id,'unsubscribed',OLD.podcast_id WHERE EXISTS (SELECT 1 FROM Users WHERE id=OLD.user_id);
+		RETURN OLD;
+	END IF;
+	INSERT INTO ChangeLog(user_id,kind,podcast_id) VALUES(NEW.user_id,'subscribed',NEW.podcast_id);
+	RETURN NEW;
+END
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER changelog_subscriptions_trigger AFTER INSERT OR UPDATE OR DELETE ON Subscriptions
+	FOR EACH ROW EXECUTE FUNCTION changelog_subscriptions_trigger();
+
+CREATE FUNCTION changelog_user_episodes_trigger() RETURNS trigger AS $$
+BEGIN
+	INSERT INTO ChangeLog(user_id,kind,podcast_id,episode_id)
+		SELECT NEW.user_id,'user_episode',podcast_id,NEW.episode_id FROM Episodes WHERE id=NEW.episode_id;
+	RETURN NEW;
+END
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER changelog_user_episodes_trigger AFTER INSERT OR UPDATE ON UserEpisodes
+	FOR EACH ROW EXECUTE FUNCTION changelog_user_episodes_trigger();
+
+CREATE FUNCTION changelog_queue_items_trigger() RETURNS trigger AS $$
+BEGIN
+	IF TG_OP = 'DELETE' THEN
+		INSERT INTO ChangeLog(user_id,kind,episode_id)
+			SELECT OLD.user_id,'queue',OLD.episode_id WHERE EXISTS (SELECT 1 FROM Users WHERE id=OLD.user_id);
+		RETURN OLD;
+	END IF;
+	INSERT INTO ChangeLog(user_id,kind,episode_id) VALUES(NEW.user_id,'queue',NEW.episode_id);
+	RETURN NEW;
+END
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER changelog_queue_items_trigger AFTER INSERT OR UPDATE OR DELETE ON QueueItems
+	FOR EACH ROW EXECUTE FUNCTION changelog_queue_items_trigger();
+
+-- new episodes are logged once and matched with the subscribers when read
+CREATE FUNCTION changelog_episodes_trigger() RETURNS trigger AS $$
+BEGIN
+	INSERT INTO ChangeLog(kind,podcast_id,episode_id) VALUES('new_episode',NEW.podcast_id,NEW.id);
+	RETURN NEW;
+END
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER changelog_episodes_trigger AFTER INSERT ON Episodes
+	FOR EACH ROW EXECUTE FUNCTION changelog_episodes_trigger();
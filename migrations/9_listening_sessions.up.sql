@@ -0,0 +1,14 @@
+-- append only log of the user's listening, one row per continuous playback
+CREATE TABLE ListeningSessions (
+	id BIGSERIAL PRIMARY KEY,
+	user_id UUID REFERENCES Users(id) ON DELETE CASCADE NOT NULL,
+	episode_id UUID REFERENCES Episodes(id) ON DELETE CASCADE NOT NULL,
+	start_offset_millis BIGINT NOT NULL,
+	end_offset_millis BIGINT NOT NULL,
+	started TIMESTAMPTZ NOT NULL,
+	ended TIMESTAMPTZ NOT NULL,
+	device TEXT NOT NULL DEFAULT ''
+);
+
+CREATE INDEX listening_sessions_user_started_idx ON ListeningSessions (user_id,started DESC);
+CREATE INDEX listening_sessions_episode_idx ON ListeningSessions (episode_id);